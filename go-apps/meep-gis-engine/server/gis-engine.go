@@ -21,24 +21,31 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
 	"net/http"
 	"os"
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
+	dkm "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-data-key-mgr"
 	dataModel "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-data-model"
 	log "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-logger"
 	mod "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-model"
 	mq "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-mq"
 	postgis "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-postgis"
+	redis "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-redis"
 	sbox "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-sandbox-ctrl-client"
 	"github.com/gorilla/mux"
 )
 
 const moduleName = "meep-gis-engine"
 const redisAddr = "meep-redis-master.default.svc.cluster.local:6379"
+const gisEngineKey = "gis-engine:"
+const propagationModelKey = "propagation-model:"
+const DEFAULT_GIS_ENGINE_DB = 0
 const sboxCtrlBasepath = "http://meep-sandbox-ctrl/sandbox-ctrl/v1"
 const postgisUser = "postgres"
 const postgisPwd = "pwd"
@@ -56,6 +63,20 @@ const (
 	AssetTypeCompute = "COMPUTE"
 )
 
+// Scenario meta keys used to configure UE handover policy, either in scenario deployment (all UEs) or in UE (UE-specific)
+const (
+	MetaHoPolicy        = "handover.policy"
+	MetaHoParamPrefix   = "handover."
+	MetaHoHysteresis    = MetaHoParamPrefix + postgis.HoParamHysteresis
+	MetaHoTimeToTrigger = MetaHoParamPrefix + postgis.HoParamTimeToTrigger
+	MetaHoRatPreference = MetaHoParamPrefix + postgis.HoParamRatPreference
+)
+
+const (
+	PropagationModelLinear      = "LINEAR"
+	PropagationModelLogDistance = "LOG-DISTANCE"
+)
+
 type Asset struct {
 	assetType       string
 	geoDataAssigned bool
@@ -67,18 +88,33 @@ type PoaInfo struct {
 	poaInRange []string
 }
 
+type NetCharInfo struct {
+	latency      int32
+	throughputDl int32
+	throughputUl int32
+	packetLoss   float64
+}
+
 type GisEngine struct {
-	sandboxName    string
-	mqLocal        *mq.MsgQueue
-	handlerId      int
-	sboxCtrlClient *sbox.APIClient
-	activeModel    *mod.Model
-	pc             *postgis.Connector
-	assets         map[string]Asset
-	uePoaInfo      map[string]PoaInfo
-	automation     map[string]bool
-	ticker         *time.Ticker
-	updateTime     time.Time
+	sandboxName       string
+	mqLocal           *mq.MsgQueue
+	rc                *redis.Connector
+	baseKey           string
+	handlerId         int
+	sboxCtrlClient    *sbox.APIClient
+	activeModel       *mod.Model
+	pc                *postgis.Connector
+	assets            map[string]Asset
+	uePoaInfo         map[string]PoaInfo
+	ueNetCharInfo     map[string]NetCharInfo
+	ueDefaultNetChar  map[string]sbox.NetworkCharacteristics
+	automation        map[string]bool
+	propagationModels map[string]PropagationModel
+	hoPolicyCfg       string
+	ueHoPolicyCfg     map[string]string
+	mutex             sync.Mutex
+	ticker            *time.Ticker
+	updateTime        time.Time
 }
 
 var ge *GisEngine
@@ -88,7 +124,11 @@ func Init() (err error) {
 	ge = new(GisEngine)
 	ge.assets = make(map[string]Asset)
 	ge.uePoaInfo = make(map[string]PoaInfo)
+	ge.ueNetCharInfo = make(map[string]NetCharInfo)
+	ge.ueDefaultNetChar = make(map[string]sbox.NetworkCharacteristics)
+	ge.ueHoPolicyCfg = make(map[string]string)
 	ge.automation = make(map[string]bool)
+	resetPropagationModels()
 	resetAutomation()
 	startAutomation()
 
@@ -103,6 +143,18 @@ func Init() (err error) {
 	}
 	log.Info("MEEP_SANDBOX_NAME: ", ge.sandboxName)
 
+	// Set base storage key
+	ge.baseKey = dkm.GetKeyRoot(ge.sandboxName) + gisEngineKey
+
+	// Connect to Redis DB & restore stored propagation models
+	ge.rc, err = redis.NewConnector(redisAddr, DEFAULT_GIS_ENGINE_DB)
+	if err != nil {
+		log.Error("Failed connection to Redis DB. Error: ", err)
+		return err
+	}
+	log.Info("Connected to Redis DB")
+	loadPropagationModels()
+
 	// Create message queue
 	ge.mqLocal, err = mq.NewMsgQueue(mq.GetLocalName(ge.sandboxName), moduleName, ge.sandboxName, redisAddr)
 	if err != nil {
@@ -217,6 +269,9 @@ func processScenarioActivate() {
 	// Sync with active scenario store
 	ge.activeModel.UpdateScenario()
 
+	// Apply scenario handover policies
+	refreshHandoverPolicies()
+
 	// Retrieve & process Assets in active scenario
 	assetList := ge.activeModel.GetNodeNames(mod.NodeTypeUE, mod.NodeTypePoa, mod.NodeTypePoaCell, mod.NodeTypeEdge, mod.NodeTypeFog, mod.NodeTypeCloud)
 	addAssets(assetList)
@@ -226,6 +281,9 @@ func processScenarioUpdate() {
 	// Sync with active scenario store
 	ge.activeModel.UpdateScenario()
 
+	// Apply scenario handover policies
+	refreshHandoverPolicies()
+
 	// Get latest asset list
 	newAssetList := ge.activeModel.GetNodeNames(mod.NodeTypeUE, mod.NodeTypePoa, mod.NodeTypePoaCell, mod.NodeTypeEdge, mod.NodeTypeFog, mod.NodeTypeCloud)
 	newAssets := make(map[string]bool)
@@ -263,6 +321,84 @@ func processScenarioTerminate() {
 	// Clear asset list
 	log.Debug("GeoData deleted for all assets")
 	ge.assets = make(map[string]Asset)
+
+	// Clear last & default network characteristics sent
+	ge.mutex.Lock()
+	ge.ueNetCharInfo = make(map[string]NetCharInfo)
+	ge.ueDefaultNetChar = make(map[string]sbox.NetworkCharacteristics)
+	ge.mutex.Unlock()
+
+	// Restore default handover policies
+	ge.pc.ResetHandoverPolicies()
+	ge.hoPolicyCfg = ""
+	ge.ueHoPolicyCfg = make(map[string]string)
+}
+
+func refreshHandoverPolicies() {
+	// Scenario-wide handover policy
+	var deploymentMeta map[string]string
+	if deployment, ok := ge.activeModel.GetNode(ge.activeModel.GetScenarioName()).(*dataModel.Deployment); ok {
+		deploymentMeta = deployment.Meta
+	}
+	policyName, params, cfg := getHoPolicyConfig(deploymentMeta)
+	if cfg != ge.hoPolicyCfg {
+		policy, err := postgis.NewHandoverPolicy(policyName, params)
+		if err != nil {
+			log.Error("Invalid scenario handover policy: ", err.Error())
+			policy = nil
+		}
+		log.Debug("Set scenario handover policy: ", cfg)
+		ge.pc.SetHandoverPolicy(policy)
+		ge.hoPolicyCfg = cfg
+	}
+
+	// UE-specific handover policies
+	ueHoPolicyCfg := make(map[string]string)
+	for _, ueName := range ge.activeModel.GetNodeNames(mod.NodeTypeUE) {
+		pl, ok := ge.activeModel.GetNode(ueName).(*dataModel.PhysicalLocation)
+		if !ok {
+			continue
+		}
+		policyName, params, cfg := getHoPolicyConfig(pl.Meta)
+		if policyName == "" {
+			continue
+		}
+		ueHoPolicyCfg[ueName] = cfg
+
+		// Only replace policy when its configuration changes to preserve policy state
+		if cfg == ge.ueHoPolicyCfg[ueName] {
+			continue
+		}
+		policy, err := postgis.NewHandoverPolicy(policyName, params)
+		if err != nil {
+			log.Error("Invalid handover policy for UE ", ueName, ": ", err.Error())
+			policy = nil
+		}
+		log.Debug("Set handover policy for UE ", ueName, ": ", cfg)
+		ge.pc.SetUeHandoverPolicy(ueName, policy)
+	}
+	for ueName := range ge.ueHoPolicyCfg {
+		if _, found := ueHoPolicyCfg[ueName]; !found {
+			log.Debug("Remove handover policy for UE: ", ueName)
+			ge.pc.SetUeHandoverPolicy(ueName, nil)
+		}
+	}
+	ge.ueHoPolicyCfg = ueHoPolicyCfg
+}
+
+func getHoPolicyConfig(meta map[string]string) (policyName string, params map[string]string, cfg string) {
+	params = make(map[string]string)
+	policyName = meta[MetaHoPolicy]
+	if policyName == "" {
+		return "", params, ""
+	}
+	for _, key := range []string{MetaHoHysteresis, MetaHoTimeToTrigger, MetaHoRatPreference} {
+		if value, found := meta[key]; found {
+			params[strings.TrimPrefix(key, MetaHoParamPrefix)] = value
+		}
+	}
+	cfg = fmt.Sprint(policyName, params)
+	return policyName, params, cfg
 }
 
 func addAssets(assetList []string) {
@@ -342,6 +478,11 @@ func removeAssets(assetList []string) {
 		delete(ge.assets, assetName)
 
 		if isUe(nodeType) {
+			ge.mutex.Lock()
+			delete(ge.ueNetCharInfo, assetName)
+			delete(ge.ueDefaultNetChar, assetName)
+			ge.mutex.Unlock()
+
 			log.Debug("GeoData deleted for UE: ", assetName)
 			err := ge.pc.DeleteUe(assetName)
 			if err != nil {
@@ -549,7 +690,10 @@ func setAutomation(automationType string, state bool) (err error) {
 
 	// Type-specific configuration
 	if automationType == AutoTypeNetChar {
-		return errors.New("Automation type not supported")
+		// Force network characteristics update for all UEs on next automation run
+		ge.mutex.Lock()
+		ge.ueNetCharInfo = make(map[string]NetCharInfo)
+		ge.mutex.Unlock()
 	} else if automationType == AutoTypeMovement {
 		if state {
 			ge.updateTime = time.Now()
@@ -583,8 +727,18 @@ func runAutomation() {
 		ge.updateTime = currentTime
 	}
 
-	// Mobility & POA In Range
-	if ge.automation[AutoTypeMobility] || ge.automation[AutoTypePoaInRange] {
+	// Mobility, POA In Range & Net Char
+	if ge.automation[AutoTypeMobility] || ge.automation[AutoTypePoaInRange] || ge.automation[AutoTypeNetChar] {
+		// Get all POA information when network characteristics must be evaluated
+		var poaMap map[string]*postgis.Poa
+		if ge.automation[AutoTypeNetChar] {
+			var err error
+			poaMap, err = ge.pc.GetAllPoa()
+			if err != nil {
+				log.Error(err.Error())
+			}
+		}
+
 		// Get all UE POA information
 		ueMap, err := ge.pc.GetAllUe()
 		if err == nil {
@@ -642,6 +796,11 @@ func runAutomation() {
 					}
 				}
 
+				// Send network characteristics update event if necessary
+				if ge.automation[AutoTypeNetChar] && poaMap != nil {
+					updateUeNetChar(ue, poaMap[ue.Poa])
+				}
+
 				// Update POA info
 				ge.uePoaInfo[ue.Name] = PoaInfo{poa: ue.Poa, distance: ue.PoaDistance, poaInRange: ue.PoaInRange}
 			}
@@ -649,11 +808,224 @@ func runAutomation() {
 			log.Error(err.Error())
 		}
 	}
+}
 
-	// Net Char
-	if ge.automation[AutoTypeNetChar] {
-		log.Debug("Auto Net Char: updating network characteristics")
+func updateUeNetChar(ue *postgis.Ue, poa *postgis.Poa) {
+	// Restore default network characteristics of UEs no longer connected to a POA with geodata
+	if poa == nil {
+		resetUeNetChar(ue.Name)
+		return
 	}
+
+	// Get propagation model for serving POA sub-type
+	ge.mutex.Lock()
+	model, found := ge.propagationModels[poa.SubType]
+	ge.mutex.Unlock()
+	if !found {
+		resetUeNetChar(ue.Name)
+		return
+	}
+
+	// Derive network characteristics from UE distance to serving POA
+	netCharInfo := calculateNetChar(&model, ue.PoaDistance, poa.Radius)
+
+	// Send network characteristics update event if changed since last update
+	if !setUeNetCharInfo(ue.Name, netCharInfo) {
+		return
+	}
+	log.Debug("Auto Net Char: updating network characteristics for UE: ", ue.Name)
+
+	// Preserve all configured network characteristics (e.g. latency variation, packet loss model &
+	// impairments) and only overwrite the characteristics derived from the propagation model
+	netChar := getUeDefaultNetChar(ue.Name)
+	netChar.Latency = netCharInfo.latency
+	netChar.Throughput = 0 // deprecated, replaced by throughputDl/Ul
+	netChar.ThroughputDl = netCharInfo.throughputDl
+	netChar.ThroughputUl = netCharInfo.throughputUl
+	netChar.PacketLoss = netCharInfo.packetLoss
+	sendUeNetCharEvent(ue.Name, netChar)
+}
+
+// resetUeNetChar - Restore scenario network characteristics of UE previously updated by automation
+func resetUeNetChar(ueName string) {
+	ge.mutex.Lock()
+	netChar, found := ge.ueDefaultNetChar[ueName]
+	ge.mutex.Unlock()
+	if !found {
+		return
+	}
+
+	// Send network characteristics update event if not already restored
+	netCharInfo := NetCharInfo{
+		latency:      netChar.Latency,
+		throughputDl: netChar.ThroughputDl,
+		throughputUl: netChar.ThroughputUl,
+		packetLoss:   netChar.PacketLoss,
+	}
+	if !setUeNetCharInfo(ueName, netCharInfo) {
+		return
+	}
+	log.Debug("Auto Net Char: restoring network characteristics for UE: ", ueName)
+	sendUeNetCharEvent(ueName, netChar)
+}
+
+// setUeNetCharInfo - Store network characteristics sent to UE; returns true if changed since last update
+func setUeNetCharInfo(ueName string, netCharInfo NetCharInfo) bool {
+	ge.mutex.Lock()
+	defer ge.mutex.Unlock()
+	lastNetCharInfo, found := ge.ueNetCharInfo[ueName]
+	ge.ueNetCharInfo[ueName] = netCharInfo
+	return !found || lastNetCharInfo != netCharInfo
+}
+
+// getUeDefaultNetChar - Get scenario network characteristics of UE, as configured before the first automation update
+func getUeDefaultNetChar(ueName string) sbox.NetworkCharacteristics {
+	ge.mutex.Lock()
+	defer ge.mutex.Unlock()
+	netChar, found := ge.ueDefaultNetChar[ueName]
+	if !found {
+		if pl, ok := ge.activeModel.GetNode(ueName).(*dataModel.PhysicalLocation); ok && pl.NetChar != nil {
+			netChar = sbox.NetworkCharacteristics(*pl.NetChar)
+		}
+		ge.ueDefaultNetChar[ueName] = netChar
+	}
+	return netChar
+}
+
+func sendUeNetCharEvent(ueName string, netChar sbox.NetworkCharacteristics) {
+	var event sbox.Event
+	var netCharEvent sbox.EventNetworkCharacteristicsUpdate
+	event.Type_ = AutoTypeNetChar
+	netCharEvent.ElementName = ueName
+	netCharEvent.ElementType = mod.NodeTypeUE
+	netCharEvent.NetChar = &netChar
+	event.EventNetworkCharacteristicsUpdate = &netCharEvent
+
+	go func() {
+		_, err := ge.sboxCtrlClient.EventsApi.SendEvent(context.TODO(), event.Type_, event)
+		if err != nil {
+			log.Error(err)
+		}
+	}()
+}
+
+func calculateNetChar(model *PropagationModel, distance float32, radius float32) (netCharInfo NetCharInfo) {
+	// Normalize distance to POA radius
+	var ratio float64
+	if radius > 0 {
+		ratio = math.Min(math.Max(float64(distance/radius), 0), 1)
+	}
+
+	// Calculate link degradation factor
+	var factor float64
+	switch model.Model {
+	case PropagationModelLogDistance:
+		factor = math.Log10(1 + 9*ratio)
+	default:
+		factor = ratio
+	}
+
+	// Interpolate network characteristics between best & worst link quality
+	latency := float64(model.LatencyMin) + factor*float64(model.LatencyMax-model.LatencyMin)
+	throughputDl := float64(model.ThroughputMax) - factor*float64(model.ThroughputMax-model.ThroughputMin)
+	throughputUl := throughputDl
+	if model.ThroughputUlMin != 0 || model.ThroughputUlMax != 0 {
+		throughputUl = float64(model.ThroughputUlMax) - factor*float64(model.ThroughputUlMax-model.ThroughputUlMin)
+	}
+	packetLoss := model.PacketLossMin + factor*(model.PacketLossMax-model.PacketLossMin)
+
+	netCharInfo.latency = int32(math.Round(latency))
+	netCharInfo.throughputDl = int32(math.Round(throughputDl))
+	netCharInfo.throughputUl = int32(math.Round(throughputUl))
+	netCharInfo.packetLoss = math.Round(packetLoss*100) / 100
+	return netCharInfo
+}
+
+func resetPropagationModels() {
+	ge.mutex.Lock()
+	defer ge.mutex.Unlock()
+
+	ge.propagationModels = make(map[string]PropagationModel)
+	ge.propagationModels[mod.NodeTypePoa] = PropagationModel{
+		SubType:         mod.NodeTypePoa,
+		Model:           PropagationModelLinear,
+		LatencyMin:      2,
+		LatencyMax:      10,
+		ThroughputMin:   50,
+		ThroughputMax:   500,
+		ThroughputUlMin: 25,
+		ThroughputUlMax: 250,
+		PacketLossMin:   0,
+		PacketLossMax:   1,
+	}
+	ge.propagationModels[mod.NodeTypePoaCell] = PropagationModel{
+		SubType:         mod.NodeTypePoaCell,
+		Model:           PropagationModelLogDistance,
+		LatencyMin:      10,
+		LatencyMax:      50,
+		ThroughputMin:   5,
+		ThroughputMax:   100,
+		ThroughputUlMin: 2,
+		ThroughputUlMax: 50,
+		PacketLossMin:   0,
+		PacketLossMax:   5,
+	}
+}
+
+// loadPropagationModels - Restore propagation models stored in Redis DB, if any
+func loadPropagationModels() {
+	keyName := ge.baseKey + propagationModelKey + "*"
+	err := ge.rc.ForEachJSONEntry(keyName, loadPropagationModel, nil)
+	if err != nil {
+		log.Error("Failed to restore propagation models: ", err.Error())
+	}
+}
+
+func loadPropagationModel(key string, jsonModel string, userData interface{}) error {
+	var model PropagationModel
+	err := json.Unmarshal([]byte(jsonModel), &model)
+	if err != nil {
+		log.Error("Failed to unmarshal propagation model: ", key)
+		return nil
+	}
+	if !isPoa(model.SubType) || validatePropagationModel(&model) != nil {
+		log.Error("Ignoring invalid stored propagation model: ", key)
+		return nil
+	}
+	log.Info("Restored propagation model for POA sub-type: ", model.SubType)
+
+	ge.mutex.Lock()
+	ge.propagationModels[model.SubType] = model
+	ge.mutex.Unlock()
+	return nil
+}
+
+// storePropagationModel - Persist propagation model in Redis DB
+func storePropagationModel(model *PropagationModel) error {
+	jsonModel, err := json.Marshal(model)
+	if err != nil {
+		return err
+	}
+	return ge.rc.JSONSetEntry(ge.baseKey+propagationModelKey+model.SubType, ".", string(jsonModel))
+}
+
+func validatePropagationModel(model *PropagationModel) error {
+	if model.Model != PropagationModelLinear && model.Model != PropagationModelLogDistance {
+		return errors.New("Unsupported propagation model: " + model.Model)
+	}
+	if model.LatencyMin < 0 || model.LatencyMax < model.LatencyMin {
+		return errors.New("Invalid latency range")
+	}
+	if model.ThroughputMin < 0 || model.ThroughputMax < model.ThroughputMin {
+		return errors.New("Invalid throughput range")
+	}
+	if model.ThroughputUlMin < 0 || model.ThroughputUlMax < model.ThroughputUlMin {
+		return errors.New("Invalid uplink throughput range")
+	}
+	if model.PacketLossMin < 0 || model.PacketLossMax < model.PacketLossMin || model.PacketLossMax > 100 {
+		return errors.New("Invalid packet loss range")
+	}
+	return nil
 }
 
 // ----------------------------  REST API  ------------------------------------
@@ -741,6 +1113,121 @@ func geSetAutomationStateByName(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusOK)
 }
 
+func geGetPropagationModel(w http.ResponseWriter, r *http.Request) {
+	log.Debug("Get all propagation models")
+
+	var modelList PropagationModelList
+	ge.mutex.Lock()
+	for _, model := range ge.propagationModels {
+		modelList.Models = append(modelList.Models, model)
+	}
+	ge.mutex.Unlock()
+
+	// Format response
+	jsonResponse, err := json.Marshal(&modelList)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	// Send response
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprint(w, string(jsonResponse))
+}
+
+func geGetPropagationModelBySubType(w http.ResponseWriter, r *http.Request) {
+	// Get POA sub-type from request path parameters
+	vars := mux.Vars(r)
+	subType := vars["subType"]
+	log.Debug("Get propagation model for POA sub-type: ", subType)
+
+	// Get propagation model
+	ge.mutex.Lock()
+	model, found := ge.propagationModels[subType]
+	ge.mutex.Unlock()
+	if !found {
+		err := errors.New("Propagation model not found")
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	// Format response
+	jsonResponse, err := json.Marshal(&model)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	// Send response
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprint(w, string(jsonResponse))
+}
+
+func geSetPropagationModelBySubType(w http.ResponseWriter, r *http.Request) {
+	// Get POA sub-type from request path parameters
+	vars := mux.Vars(r)
+	subType := vars["subType"]
+	log.Debug("Set propagation model for POA sub-type: ", subType)
+
+	// Validate POA sub-type
+	if !isPoa(subType) {
+		err := errors.New("Invalid POA sub-type")
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// Retrieve propagation model to set from request body
+	var model PropagationModel
+	if r.Body == nil {
+		err := errors.New("Request body is missing")
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	decoder := json.NewDecoder(r.Body)
+	err := decoder.Decode(&model)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// Validate request propagation model
+	if model.SubType != "" && model.SubType != subType {
+		err := errors.New("Request body sub-type differs from path sub-type")
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	model.SubType = subType
+	err = validatePropagationModel(&model)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// Store & update propagation model
+	err = storePropagationModel(&model)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	ge.mutex.Lock()
+	ge.propagationModels[subType] = model
+	ge.mutex.Unlock()
+
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	w.WriteHeader(http.StatusOK)
+}
+
 func geDeleteGeoDataByName(w http.ResponseWriter, r *http.Request) {
 	// Get asset name from request path parameters
 	vars := mux.Vars(r)
@@ -0,0 +1,208 @@
+/*
+ * Copyright (c) 2020  InterDigital Communications, Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	log "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-logger"
+	mod "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-model"
+	postgis "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-postgis"
+	sbox "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-sandbox-ctrl-client"
+)
+
+func TestCalculateNetChar(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	linear := PropagationModel{
+		Model:           PropagationModelLinear,
+		LatencyMin:      2,
+		LatencyMax:      10,
+		ThroughputMin:   50,
+		ThroughputMax:   500,
+		ThroughputUlMin: 25,
+		ThroughputUlMax: 250,
+		PacketLossMin:   0,
+		PacketLossMax:   1,
+	}
+	logDistance := PropagationModel{
+		Model:           PropagationModelLogDistance,
+		LatencyMin:      10,
+		LatencyMax:      50,
+		ThroughputMin:   5,
+		ThroughputMax:   100,
+		ThroughputUlMin: 2,
+		ThroughputUlMax: 50,
+		PacketLossMin:   0,
+		PacketLossMax:   5,
+	}
+	noUplink := linear
+	noUplink.ThroughputUlMin = 0
+	noUplink.ThroughputUlMax = 0
+
+	tests := []struct {
+		name     string
+		model    PropagationModel
+		distance float32
+		radius   float32
+		expected NetCharInfo
+	}{
+		{"linear at POA", linear, 0, 100, NetCharInfo{latency: 2, throughputDl: 500, throughputUl: 250, packetLoss: 0}},
+		{"linear half radius", linear, 50, 100, NetCharInfo{latency: 6, throughputDl: 275, throughputUl: 138, packetLoss: 0.5}},
+		{"linear at radius", linear, 100, 100, NetCharInfo{latency: 10, throughputDl: 50, throughputUl: 25, packetLoss: 1}},
+		{"linear beyond radius", linear, 250, 100, NetCharInfo{latency: 10, throughputDl: 50, throughputUl: 25, packetLoss: 1}},
+		{"linear no radius", linear, 50, 0, NetCharInfo{latency: 2, throughputDl: 500, throughputUl: 250, packetLoss: 0}},
+		{"log-distance at POA", logDistance, 0, 1000, NetCharInfo{latency: 10, throughputDl: 100, throughputUl: 50, packetLoss: 0}},
+		{"log-distance tenth radius", logDistance, 100, 1000, NetCharInfo{latency: 21, throughputDl: 74, throughputUl: 37, packetLoss: 1.39}},
+		{"log-distance half radius", logDistance, 500, 1000, NetCharInfo{latency: 40, throughputDl: 30, throughputUl: 14, packetLoss: 3.7}},
+		{"log-distance at radius", logDistance, 1000, 1000, NetCharInfo{latency: 50, throughputDl: 5, throughputUl: 2, packetLoss: 5}},
+		{"log-distance beyond radius", logDistance, 5000, 1000, NetCharInfo{latency: 50, throughputDl: 5, throughputUl: 2, packetLoss: 5}},
+		{"log-distance no radius", logDistance, 500, 0, NetCharInfo{latency: 10, throughputDl: 100, throughputUl: 50, packetLoss: 0}},
+		{"uplink defaults to downlink", noUplink, 50, 100, NetCharInfo{latency: 6, throughputDl: 275, throughputUl: 275, packetLoss: 0.5}},
+	}
+
+	for _, test := range tests {
+		netCharInfo := calculateNetChar(&test.model, test.distance, test.radius)
+		if netCharInfo != test.expected {
+			t.Fatalf("%s: expected %+v got %+v", test.name, test.expected, netCharInfo)
+		}
+	}
+}
+
+func TestValidatePropagationModel(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	valid := PropagationModel{Model: PropagationModelLinear, LatencyMin: 2, LatencyMax: 10, ThroughputMin: 50,
+		ThroughputMax: 500, ThroughputUlMin: 25, ThroughputUlMax: 250, PacketLossMax: 1}
+	if err := validatePropagationModel(&valid); err != nil {
+		t.Fatalf("Unexpected error: %s", err.Error())
+	}
+
+	invalid := []func(m *PropagationModel){
+		func(m *PropagationModel) { m.Model = "INVALID" },
+		func(m *PropagationModel) { m.LatencyMax = 1 },
+		func(m *PropagationModel) { m.ThroughputMax = 10 },
+		func(m *PropagationModel) { m.ThroughputUlMax = 10 },
+		func(m *PropagationModel) { m.ThroughputUlMin = -1 },
+		func(m *PropagationModel) { m.PacketLossMax = 101 },
+	}
+	for i, update := range invalid {
+		model := valid
+		update(&model)
+		if err := validatePropagationModel(&model); err == nil {
+			t.Fatalf("Test %d: expected validation error", i)
+		}
+	}
+}
+
+func TestResetUeNetChar(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	// Record network characteristics events sent to Sandbox Controller
+	events := make(chan sbox.Event, 10)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var event sbox.Event
+		_ = json.NewDecoder(r.Body).Decode(&event)
+		events <- event
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	ge = new(GisEngine)
+	ge.ueNetCharInfo = make(map[string]NetCharInfo)
+	ge.ueDefaultNetChar = make(map[string]sbox.NetworkCharacteristics)
+	sboxCfg := sbox.NewConfiguration()
+	sboxCfg.BasePath = server.URL
+	ge.sboxCtrlClient = sbox.NewAPIClient(sboxCfg)
+
+	fmt.Println("Ignore UE not updated by automation")
+	resetUeNetChar("ue1")
+	select {
+	case event := <-events:
+		t.Fatalf("Unexpected event: %+v", event)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	fmt.Println("Restore default network characteristics when UE loses its POA")
+	defaultNetChar := sbox.NetworkCharacteristics{Latency: 5, LatencyVariation: 1, ThroughputDl: 1000, ThroughputUl: 500, PacketLoss: 0.1}
+	ge.ueDefaultNetChar["ue1"] = defaultNetChar
+	ge.ueNetCharInfo["ue1"] = NetCharInfo{latency: 10, throughputDl: 50, throughputUl: 25, packetLoss: 1}
+	updateUeNetChar(&postgis.Ue{Name: "ue1"}, nil)
+	select {
+	case event := <-events:
+		if event.Type_ != AutoTypeNetChar || event.EventNetworkCharacteristicsUpdate == nil ||
+			event.EventNetworkCharacteristicsUpdate.ElementName != "ue1" ||
+			event.EventNetworkCharacteristicsUpdate.ElementType != mod.NodeTypeUE ||
+			*event.EventNetworkCharacteristicsUpdate.NetChar != defaultNetChar {
+			t.Fatalf("Invalid event: %+v", event)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("Missing network characteristics event")
+	}
+
+	fmt.Println("Do not resend restored network characteristics")
+	resetUeNetChar("ue1")
+	select {
+	case event := <-events:
+		t.Fatalf("Unexpected event: %+v", event)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestLoadPropagationModel(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	ge = new(GisEngine)
+	resetPropagationModels()
+
+	stored := PropagationModel{SubType: mod.NodeTypePoaCell, Model: PropagationModelLinear, LatencyMin: 1, LatencyMax: 20,
+		ThroughputMin: 10, ThroughputMax: 200, PacketLossMax: 2}
+	jsonModel, _ := json.Marshal(stored)
+	invalid := stored
+	invalid.LatencyMax = 0
+	jsonInvalid, _ := json.Marshal(invalid)
+	unknown := stored
+	unknown.SubType = "UNKNOWN"
+	jsonUnknown, _ := json.Marshal(unknown)
+
+	fmt.Println("Ignore invalid stored models")
+	for _, entry := range []string{"invalid-json", string(jsonInvalid), string(jsonUnknown)} {
+		if err := loadPropagationModel("key", entry, nil); err != nil {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+	}
+	if ge.propagationModels[mod.NodeTypePoaCell].Model != PropagationModelLogDistance || len(ge.propagationModels) != 2 {
+		t.Fatalf("Invalid stored model should be ignored")
+	}
+
+	fmt.Println("Restore stored model")
+	if err := loadPropagationModel("key", string(jsonModel), nil); err != nil {
+		t.Fatalf("Unexpected error: %s", err.Error())
+	}
+	if ge.propagationModels[mod.NodeTypePoaCell] != stored {
+		t.Fatalf("Stored model not restored: %+v", ge.propagationModels[mod.NodeTypePoaCell])
+	}
+}
@@ -0,0 +1,58 @@
+/*
+ * Copyright (c) 2020  InterDigital Communications, Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the \"License\");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an \"AS IS\" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * AdvantEDGE GIS Engine REST API
+ *
+ * This API allows to control geo-spatial behavior and simulation. <p>**Micro-service**<br>[meep-gis-engine](https://github.com/InterDigitalInc/AdvantEDGE/tree/master/go-apps/meep-gis-engine) <p>**Type & Usage**<br>Platform runtime interface to control geo-spatial behavior and simulation <p>**Details**<br>API details available at _your-AdvantEDGE-ip-address/api_
+ *
+ * API version: 1.0.0
+ * Contact: AdvantEDGE@InterDigital.com
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+
+package server
+
+type PropagationModel struct {
+
+	// POA sub-type to which the propagation model applies
+	SubType string `json:"subType,omitempty"`
+
+	// Propagation model type.<br> Link quality degrades with the UE distance to its serving POA, normalized to the POA radius.<br> <p>Supported Types: <li>LINEAR - Link quality degrades linearly with distance. <li>LOG-DISTANCE - Link quality degrades logarithmically with distance (faster degradation close to the POA)
+	Model string `json:"model,omitempty"`
+
+	// Latency in ms at the POA location
+	LatencyMin int32 `json:"latencyMin,omitempty"`
+
+	// Latency in ms at the edge of the POA radius
+	LatencyMax int32 `json:"latencyMax,omitempty"`
+
+	// Downlink throughput limit in Mbps at the edge of the POA radius
+	ThroughputMin int32 `json:"throughputMin,omitempty"`
+
+	// Downlink throughput limit in Mbps at the POA location
+	ThroughputMax int32 `json:"throughputMax,omitempty"`
+
+	// Uplink throughput limit in Mbps at the edge of the POA radius; downlink limits are used if uplink limits are not set
+	ThroughputUlMin int32 `json:"throughputUlMin,omitempty"`
+
+	// Uplink throughput limit in Mbps at the POA location; downlink limits are used if uplink limits are not set
+	ThroughputUlMax int32 `json:"throughputUlMax,omitempty"`
+
+	// Packet loss percentage at the POA location
+	PacketLossMin float64 `json:"packetLossMin,omitempty"`
+
+	// Packet loss percentage at the edge of the POA radius
+	PacketLossMax float64 `json:"packetLossMax,omitempty"`
+}
@@ -117,4 +117,25 @@ var routes = Routes{
 		"/gis/v1/geodata/{assetName}",
 		UpdateGeoDataByName,
 	},
+
+	Route{
+		"GetPropagationModel",
+		strings.ToUpper("Get"),
+		"/gis/v1/propagation",
+		GetPropagationModel,
+	},
+
+	Route{
+		"GetPropagationModelBySubType",
+		strings.ToUpper("Get"),
+		"/gis/v1/propagation/{subType}",
+		GetPropagationModelBySubType,
+	},
+
+	Route{
+		"SetPropagationModelBySubType",
+		strings.ToUpper("Post"),
+		"/gis/v1/propagation/{subType}",
+		SetPropagationModelBySubType,
+	},
 }
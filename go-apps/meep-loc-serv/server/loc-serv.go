@@ -26,6 +26,7 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	sbi "github.com/InterDigitalInc/AdvantEDGE/go-apps/meep-loc-serv/sbi"
@@ -34,6 +35,7 @@ import (
 	clientNotifOMA "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-loc-serv-notification-client"
 	log "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-logger"
 	redis "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-redis"
+	subs "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-subscriptions"
 
 	"github.com/gorilla/mux"
 )
@@ -63,10 +65,6 @@ type ApUserData struct {
 	apList             *AccessPointList
 }
 
-var nextZonalSubscriptionIdAvailable int
-var nextUserSubscriptionIdAvailable int
-var nextZoneStatusSubscriptionIdAvailable int
-
 var zonalSubscriptionEnteringMap = map[int]string{}
 var zonalSubscriptionLeavingMap = map[int]string{}
 var zonalSubscriptionTransferringMap = map[int]string{}
@@ -79,6 +77,9 @@ var userSubscriptionMap = map[int]string{}
 
 var zoneStatusSubscriptionMap = map[int]*ZoneStatusCheck{}
 
+// Protects the subscription maps
+var mutex sync.Mutex
+
 type ZoneStatusCheck struct {
 	ZoneId                 string
 	Serviceable            bool
@@ -95,8 +96,11 @@ var redisAddr string = "meep-redis-master.default.svc.cluster.local:6379"
 var influxAddr string = "http://meep-influxdb.default.svc.cluster.local:8086"
 var postgisHost string = "meep-postgis.default.svc.cluster.local"
 var postgisPort string = "5432"
+var notifRetries int = 3
+var notifBackoff time.Duration = time.Second
 
 var rc *redis.Connector
+var subMgr *subs.SubscriptionMgr
 var hostUrl *url.URL
 var sandboxName string
 var basePath string
@@ -137,6 +141,27 @@ func Init() (err error) {
 	}
 	log.Info("Connected to Redis DB, location service table")
 
+	// Create subscription manager
+	if subMgr != nil {
+		subMgr.Stop()
+	}
+	subMgrCfg := &subs.SubscriptionMgrCfg{
+		Module:       logModuleLocServ,
+		Basekey:      baseKey,
+		RedisTable:   LOC_SERV_DB,
+		ExpiredSubCb: expiredSubscriptionCb,
+		NotifRetries: notifRetries,
+		NotifBackoff: notifBackoff,
+		LegacyTypes:  []string{typeUserSubscription, typeZonalSubscription, typeZoneStatusSubscription},
+		LegacySubCb:  legacySubscriptionCb,
+	}
+	subMgr, err = subs.NewSubscriptionMgr(subMgrCfg, redisAddr)
+	if err != nil {
+		log.Error("Failed to create subscription manager. Error: ", err)
+		return err
+	}
+	log.Info("Subscription manager created")
+
 	userTrackingReInit()
 	zonalTrafficReInit()
 	zoneStatusReInit()
@@ -177,6 +202,8 @@ func createClient(notifyPath string) (*clientNotifOMA.APIClient, error) {
 	// Create & store client for App REST API
 	subsAppClientCfg := clientNotifOMA.NewConfiguration()
 	subsAppClientCfg.BasePath = notifyPath
+	// Correlation ID of the notification context allows matching notifications with the subscription request
+	subsAppClientCfg.HTTPClient = httpLog.NewCorrelationIdClient()
 	subsAppClient := clientNotifOMA.NewAPIClient(subsAppClientCfg)
 	if subsAppClient == nil {
 		log.Error("Failed to create Subscription App REST API client: ", subsAppClientCfg.BasePath)
@@ -186,14 +213,118 @@ func createClient(notifyPath string) (*clientNotifOMA.APIClient, error) {
 	return subsAppClient, nil
 }
 
+func setSubscription(ctx context.Context, subType string, subsIdStr string, resourceUrl string, callbackReference *UserTrackingSubscriptionCallbackReference, expiryTime *time.Time, jsonSub string) error {
+	subCfg := &subs.SubscriptionCfg{
+		Id:            subsIdStr,
+		Type:          subType,
+		Self:          resourceUrl,
+		ExpiryTime:    expiryTime,
+		CorrelationId: httpLog.GetCorrelationId(ctx),
+	}
+	if callbackReference != nil {
+		subCfg.NotifyUrl = callbackReference.NotifyURL
+	}
+	_, err := subMgr.SetSubscription(subCfg, jsonSub)
+	if err != nil {
+		log.Error(err.Error())
+	}
+	return err
+}
+
+// getNotifContext - Get notification context holding the correlation ID of the subscription request
+func getNotifContext(subType string, subsIdStr string) context.Context {
+	ctx := context.TODO()
+	if sub := subMgr.GetSubscription(subType, subsIdStr); sub != nil {
+		ctx = httpLog.WithCorrelationId(ctx, sub.Cfg.CorrelationId)
+	}
+	return ctx
+}
+
+// legacySubscriptionCb - converts a subscription stored by a previous location service version
+func legacySubscriptionCb(subType string, subsIdStr string, jsonSub string) (*subs.SubscriptionCfg, error) {
+	// All location service subscription types share the same resource URL & callback fields
+	var subscription struct {
+		CallbackReference *UserTrackingSubscriptionCallbackReference `json:"callbackReference"`
+		ResourceURL       string                                     `json:"resourceURL,omitempty"`
+	}
+	err := json.Unmarshal([]byte(jsonSub), &subscription)
+	if err != nil {
+		return nil, err
+	}
+	subCfg := &subs.SubscriptionCfg{
+		Id:   subsIdStr,
+		Type: subType,
+		Self: subscription.ResourceURL,
+	}
+	if subscription.CallbackReference != nil {
+		subCfg.NotifyUrl = subscription.CallbackReference.NotifyURL
+	}
+	return subCfg, nil
+}
+
+func getSubscriptionJson(subType string, subsIdStr string) string {
+	sub := subMgr.GetSubscription(subType, subsIdStr)
+	if sub == nil {
+		return ""
+	}
+	return sub.JsonSubOrig
+}
+
+func forEachSubscription(subType string, handler func(string, string, interface{}) error, userData interface{}) error {
+	for _, sub := range subMgr.GetSubscriptions(subType) {
+		err := handler(sub.Cfg.Id, sub.JsonSubOrig, userData)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func delSubscription(subType string, subsIdStr string) error {
+	sub := subMgr.GetSubscription(subType, subsIdStr)
+	if sub == nil {
+		return nil
+	}
+	return subMgr.DeleteSubscription(sub)
+}
+
+func getExpiryTime(duration string) (*time.Time, error) {
+	if duration == "" || duration == "0" {
+		return nil, nil
+	}
+	seconds, err := strconv.Atoi(duration)
+	if err != nil || seconds < 0 {
+		return nil, errors.New("Invalid duration: " + duration)
+	}
+	expiryTime := time.Now().Add(time.Duration(seconds) * time.Second)
+	return &expiryTime, nil
+}
+
+func expiredSubscriptionCb(sub *subs.Subscription) {
+	// Subscription maps are locked by the deregistration functions
+	switch sub.Cfg.Type {
+	case typeUserSubscription:
+		deregisterUser(sub.Cfg.Id)
+	case typeZonalSubscription:
+		deregisterZonal(sub.Cfg.Id)
+	case typeZoneStatusSubscription:
+		deregisterZoneStatus(sub.Cfg.Id)
+	}
+	log.Info("Subscription expired: ", sub.Cfg.Id, " type: ", sub.Cfg.Type)
+}
+
 func deregisterZoneStatus(subsIdStr string) {
 	subsId, _ := strconv.Atoi(subsIdStr)
-	zonalSubscriptionMap[subsId] = ""
+	mutex.Lock()
+	defer mutex.Unlock()
+	delete(zoneStatusSubscriptionMap, subsId)
 }
 
 func registerZoneStatus(zoneId string, nbOfUsersZoneThreshold int32, nbOfUsersAPThreshold int32, opStatus []OperationStatus, subsIdStr string) {
 
 	subsId, _ := strconv.Atoi(subsIdStr)
+	mutex.Lock()
+	defer mutex.Unlock()
 
 	var zoneStatus ZoneStatusCheck
 	if opStatus != nil {
@@ -218,6 +349,8 @@ func registerZoneStatus(zoneId string, nbOfUsersZoneThreshold int32, nbOfUsersAP
 
 func deregisterZonal(subsIdStr string) {
 	subsId, _ := strconv.Atoi(subsIdStr)
+	mutex.Lock()
+	defer mutex.Unlock()
 	zonalSubscriptionMap[subsId] = ""
 	zonalSubscriptionEnteringMap[subsId] = ""
 	zonalSubscriptionLeavingMap[subsId] = ""
@@ -227,6 +360,8 @@ func deregisterZonal(subsIdStr string) {
 func registerZonal(zoneId string, event []UserEventType, subsIdStr string) {
 
 	subsId, _ := strconv.Atoi(subsIdStr)
+	mutex.Lock()
+	defer mutex.Unlock()
 
 	if event != nil {
 		for i := 0; i < len(event); i++ {
@@ -250,6 +385,8 @@ func registerZonal(zoneId string, event []UserEventType, subsIdStr string) {
 
 func deregisterUser(subsIdStr string) {
 	subsId, _ := strconv.Atoi(subsIdStr)
+	mutex.Lock()
+	defer mutex.Unlock()
 	userSubscriptionMap[subsId] = ""
 	userSubscriptionEnteringMap[subsId] = ""
 	userSubscriptionLeavingMap[subsId] = ""
@@ -259,6 +396,8 @@ func deregisterUser(subsIdStr string) {
 func registerUser(userAddress string, event []UserEventType, subsIdStr string) {
 
 	subsId, _ := strconv.Atoi(subsIdStr)
+	mutex.Lock()
+	defer mutex.Unlock()
 
 	if event != nil {
 		for i := 0; i < len(event); i++ {
@@ -295,6 +434,8 @@ func checkNotificationRegistrations(checkType int, param1 string, param2 string,
 }
 
 func checkNotificationRegisteredZoneStatus(zoneId string, apId string, nbUsersInAPStr string, nbUsersInZoneStr string) {
+	mutex.Lock()
+	defer mutex.Unlock()
 
 	//check all that applies
 	for subsId, zoneStatus := range zoneStatusSubscriptionMap {
@@ -319,7 +460,7 @@ func checkNotificationRegisteredZoneStatus(zoneId string, apId string, nbUsersIn
 
 			if zoneWarning || apWarning {
 				subsIdStr := strconv.Itoa(subsId)
-				jsonInfo, _ := rc.JSONGetEntry(baseKey+typeZoneStatusSubscription+":"+subsIdStr, ".")
+				jsonInfo := getSubscriptionJson(typeZoneStatusSubscription, subsIdStr)
 				if jsonInfo == "" {
 					return
 				}
@@ -336,7 +477,7 @@ func checkNotificationRegisteredZoneStatus(zoneId string, apId string, nbUsersIn
 					zoneStatusNotif.NumberOfUsersInZone = (int32)(nbUsersInZone)
 				}
 				zoneStatusNotif.Timestamp = time.Now()
-				go sendStatusNotification(subscription.CallbackReference.NotifyURL, context.TODO(), subsIdStr, zoneStatusNotif)
+				go sendStatusNotification(subscription.CallbackReference.NotifyURL, getNotifContext(typeZoneStatusSubscription, subsIdStr), subsIdStr, zoneStatusNotif)
 				if apWarning {
 					log.Info("Zone Status Notification" + "(" + subsIdStr + "): " + "For event in zone " + zoneId + " which has " + nbUsersInAPStr + " users in AP " + apId)
 				} else {
@@ -349,13 +490,15 @@ func checkNotificationRegisteredZoneStatus(zoneId string, apId string, nbUsersIn
 }
 
 func checkNotificationRegisteredUsers(oldZoneId string, newZoneId string, oldApId string, newApId string, userId string) {
+	mutex.Lock()
+	defer mutex.Unlock()
 
 	//check all that applies
 	for subsId, value := range userSubscriptionMap {
 		if value == userId {
 
 			subsIdStr := strconv.Itoa(subsId)
-			jsonInfo, _ := rc.JSONGetEntry(baseKey+typeUserSubscription+":"+subsIdStr, ".")
+			jsonInfo := getSubscriptionJson(typeUserSubscription, subsIdStr)
 			if jsonInfo == "" {
 				return
 			}
@@ -375,7 +518,7 @@ func checkNotificationRegisteredUsers(oldZoneId string, newZoneId string, oldApI
 					event := new(clientNotifOMA.UserEventType)
 					*event = clientNotifOMA.ENTERING_UserEventType
 					zonal.UserEventType = event
-					go sendNotification(subscription.CallbackReference.NotifyURL, context.TODO(), subsIdStr, zonal)
+					go sendNotification(subscription.CallbackReference.NotifyURL, getNotifContext(typeUserSubscription, subsIdStr), typeUserSubscription, subsIdStr, zonal)
 					log.Info("User Notification" + "(" + subsIdStr + "): " + "Entering event in zone " + newZoneId + " for user " + userId)
 				}
 				if oldZoneId != "" {
@@ -385,7 +528,7 @@ func checkNotificationRegisteredUsers(oldZoneId string, newZoneId string, oldApI
 						event := new(clientNotifOMA.UserEventType)
 						*event = clientNotifOMA.LEAVING_UserEventType
 						zonal.UserEventType = event
-						go sendNotification(subscription.CallbackReference.NotifyURL, context.TODO(), subsIdStr, zonal)
+						go sendNotification(subscription.CallbackReference.NotifyURL, getNotifContext(typeUserSubscription, subsIdStr), typeUserSubscription, subsIdStr, zonal)
 						log.Info("User Notification" + "(" + subsIdStr + "): " + "Leaving event in zone " + oldZoneId + " for user " + userId)
 					}
 				}
@@ -398,7 +541,7 @@ func checkNotificationRegisteredUsers(oldZoneId string, newZoneId string, oldApI
 						event := new(clientNotifOMA.UserEventType)
 						*event = clientNotifOMA.TRANSFERRING_UserEventType
 						zonal.UserEventType = event
-						go sendNotification(subscription.CallbackReference.NotifyURL, context.TODO(), subsIdStr, zonal)
+						go sendNotification(subscription.CallbackReference.NotifyURL, getNotifContext(typeUserSubscription, subsIdStr), typeUserSubscription, subsIdStr, zonal)
 						log.Info("User Notification" + "(" + subsIdStr + "): " + " Transferring event within zone " + newZoneId + " for user " + userId + " from Ap " + oldApId + " to " + newApId)
 					}
 				}
@@ -407,9 +550,7 @@ func checkNotificationRegisteredUsers(oldZoneId string, newZoneId string, oldApI
 	}
 }
 
-func sendNotification(notifyUrl string, ctx context.Context, subscriptionId string, notification clientNotifOMA.TrackingNotification) {
-	startTime := time.Now()
-
+func sendNotification(notifyUrl string, ctx context.Context, subType string, subscriptionId string, notification clientNotifOMA.TrackingNotification) {
 	client, err := createClient(notifyUrl)
 	if err != nil {
 		log.Error(err)
@@ -421,18 +562,15 @@ func sendNotification(notifyUrl string, ctx context.Context, subscriptionId stri
 		log.Error(err.Error())
 	}
 
-	resp, err := client.NotificationsApi.PostTrackingNotification(ctx, subscriptionId, notification)
-	_ = httpLog.LogTx(notifyUrl, "POST", string(jsonNotif), resp, startTime)
-	if err != nil {
-		log.Error(err)
-		return
-	}
-	defer resp.Body.Close()
+	_ = subMgr.SendNotification(subType, subscriptionId, notifyUrl, func() (*http.Response, error) {
+		startTime := time.Now()
+		resp, err := client.NotificationsApi.PostTrackingNotification(ctx, subscriptionId, notification)
+		_ = httpLog.LogTx(notifyUrl, "POST", string(jsonNotif), resp, startTime)
+		return resp, err
+	})
 }
 
 func sendStatusNotification(notifyUrl string, ctx context.Context, subscriptionId string, notification clientNotifOMA.ZoneStatusNotification) {
-	startTime := time.Now()
-
 	client, err := createClient(notifyUrl)
 	if err != nil {
 		log.Error(err)
@@ -444,16 +582,17 @@ func sendStatusNotification(notifyUrl string, ctx context.Context, subscriptionI
 		log.Error(err.Error())
 	}
 
-	resp, err := client.NotificationsApi.PostZoneStatusNotification(ctx, subscriptionId, notification)
-	_ = httpLog.LogTx(notifyUrl, "POST", string(jsonNotif), resp, startTime)
-	if err != nil {
-		log.Error(err)
-		return
-	}
-	defer resp.Body.Close()
+	_ = subMgr.SendNotification(typeZoneStatusSubscription, subscriptionId, notifyUrl, func() (*http.Response, error) {
+		startTime := time.Now()
+		resp, err := client.NotificationsApi.PostZoneStatusNotification(ctx, subscriptionId, notification)
+		_ = httpLog.LogTx(notifyUrl, "POST", string(jsonNotif), resp, startTime)
+		return resp, err
+	})
 }
 
 func checkNotificationRegisteredZones(oldZoneId string, newZoneId string, oldApId string, newApId string, userId string) {
+	mutex.Lock()
+	defer mutex.Unlock()
 
 	//check all that applies
 	for subsId, value := range zonalSubscriptionMap {
@@ -465,7 +604,7 @@ func checkNotificationRegisteredZones(oldZoneId string, newZoneId string, oldApI
 				if zonalSubscriptionEnteringMap[subsId] != "" {
 					subsIdStr := strconv.Itoa(subsId)
 
-					jsonInfo, _ := rc.JSONGetEntry(baseKey+typeZonalSubscription+":"+subsIdStr, ".")
+					jsonInfo := getSubscriptionJson(typeZonalSubscription, subsIdStr)
 					if jsonInfo != "" {
 						subscription := convertJsonToZonalSubscription(jsonInfo)
 
@@ -478,7 +617,7 @@ func checkNotificationRegisteredZones(oldZoneId string, newZoneId string, oldApI
 						zonal.UserEventType = event
 						zonal.Timestamp = time.Now()
 						zonal.CallbackData = subscription.ClientCorrelator
-						go sendNotification(subscription.CallbackReference.NotifyURL, context.TODO(), subsIdStr, zonal)
+						go sendNotification(subscription.CallbackReference.NotifyURL, getNotifContext(typeZonalSubscription, subsIdStr), typeZonalSubscription, subsIdStr, zonal)
 						log.Info("Zonal Notify Entering event in zone " + newZoneId + " for user " + userId)
 					}
 				}
@@ -487,7 +626,7 @@ func checkNotificationRegisteredZones(oldZoneId string, newZoneId string, oldApI
 					if zonalSubscriptionTransferringMap[subsId] != "" {
 						subsIdStr := strconv.Itoa(subsId)
 
-						jsonInfo, _ := rc.JSONGetEntry(baseKey+typeZonalSubscription+":"+subsIdStr, ".")
+						jsonInfo := getSubscriptionJson(typeZonalSubscription, subsIdStr)
 						if jsonInfo != "" {
 							subscription := convertJsonToZonalSubscription(jsonInfo)
 
@@ -501,7 +640,7 @@ func checkNotificationRegisteredZones(oldZoneId string, newZoneId string, oldApI
 							zonal.UserEventType = event
 							zonal.Timestamp = time.Now()
 							zonal.CallbackData = subscription.ClientCorrelator
-							go sendNotification(subscription.CallbackReference.NotifyURL, context.TODO(), subsIdStr, zonal)
+							go sendNotification(subscription.CallbackReference.NotifyURL, getNotifContext(typeZonalSubscription, subsIdStr), typeZonalSubscription, subsIdStr, zonal)
 							log.Info("Zonal Notify Transferring event in zone " + newZoneId + " for user " + userId + " from Ap " + oldApId + " to " + newApId)
 						}
 					}
@@ -512,7 +651,7 @@ func checkNotificationRegisteredZones(oldZoneId string, newZoneId string, oldApI
 				if zonalSubscriptionLeavingMap[subsId] != "" {
 					subsIdStr := strconv.Itoa(subsId)
 
-					jsonInfo, _ := rc.JSONGetEntry(baseKey+typeZonalSubscription+":"+subsIdStr, ".")
+					jsonInfo := getSubscriptionJson(typeZonalSubscription, subsIdStr)
 					if jsonInfo != "" {
 
 						subscription := convertJsonToZonalSubscription(jsonInfo)
@@ -526,7 +665,7 @@ func checkNotificationRegisteredZones(oldZoneId string, newZoneId string, oldApI
 						zonal.UserEventType = event
 						zonal.Timestamp = time.Now()
 						zonal.CallbackData = subscription.ClientCorrelator
-						go sendNotification(subscription.CallbackReference.NotifyURL, context.TODO(), subsIdStr, zonal)
+						go sendNotification(subscription.CallbackReference.NotifyURL, getNotifContext(typeZonalSubscription, subsIdStr), typeZonalSubscription, subsIdStr, zonal)
 						log.Info("Zonal Notify Leaving event in zone " + oldZoneId + " for user " + userId)
 					}
 				}
@@ -810,7 +949,7 @@ func userTrackingSubDelById(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
 	vars := mux.Vars(r)
 
-	err := rc.JSONDelEntry(baseKey+typeUserSubscription+":"+vars["subscriptionId"], ".")
+	err := delSubscription(typeUserSubscription, vars["subscriptionId"])
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
@@ -828,8 +967,7 @@ func userTrackingSubGet(w http.ResponseWriter, r *http.Request) {
 	userTrackingSubList.ResourceURL = hostUrl.String() + basePath + "subscriptions/userTracking"
 	response.NotificationSubscriptionList = &userTrackingSubList
 
-	keyName := baseKey + typeUserSubscription + "*"
-	err := rc.ForEachJSONEntry(keyName, populateUserTrackingList, &userTrackingSubList)
+	err := forEachSubscription(typeUserSubscription, populateUserTrackingList, &userTrackingSubList)
 	if err != nil {
 		log.Error(err.Error())
 		http.Error(w, err.Error(), http.StatusInternalServerError)
@@ -854,7 +992,7 @@ func userTrackingSubGetById(w http.ResponseWriter, r *http.Request) {
 	var userTrackingSub UserTrackingSubscription
 	response.UserTrackingSubscription = &userTrackingSub
 
-	jsonUserTrackingSub, _ := rc.JSONGetEntry(baseKey+typeUserSubscription+":"+vars["subscriptionId"], ".")
+	jsonUserTrackingSub := getSubscriptionJson(typeUserSubscription, vars["subscriptionId"])
 	if jsonUserTrackingSub == "" {
 		w.WriteHeader(http.StatusNotFound)
 		return
@@ -892,14 +1030,12 @@ func userTrackingSubPost(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	newSubsId := nextUserSubscriptionIdAvailable
-	nextUserSubscriptionIdAvailable++
-	subsIdStr := strconv.Itoa(newSubsId)
+	subsIdStr := subMgr.AllocateId(typeUserSubscription)
 
 	registerUser(userTrackingSub.Address, userTrackingSub.UserEventCriteria, subsIdStr)
 	userTrackingSub.ResourceURL = hostUrl.String() + basePath + "subscriptions/userTracking/" + subsIdStr
 
-	_ = rc.JSONSetEntry(baseKey+typeUserSubscription+":"+subsIdStr, ".", convertUserSubscriptionToJson(userTrackingSub))
+	_ = setSubscription(r.Context(), typeUserSubscription, subsIdStr, userTrackingSub.ResourceURL, userTrackingSub.CallbackReference, nil, convertUserSubscriptionToJson(userTrackingSub))
 
 	jsonResponse, err := json.Marshal(response)
 	if err != nil {
@@ -930,7 +1066,7 @@ func userTrackingSubPutById(w http.ResponseWriter, r *http.Request) {
 	subsIdStr := vars["subscriptionId"]
 	userTrackingSub.ResourceURL = hostUrl.String() + basePath + "subscriptions/userTracking/" + subsIdStr
 
-	_ = rc.JSONSetEntry(baseKey+typeUserSubscription+":"+subsIdStr, ".", convertUserSubscriptionToJson(userTrackingSub))
+	_ = setSubscription(r.Context(), typeUserSubscription, subsIdStr, userTrackingSub.ResourceURL, userTrackingSub.CallbackReference, nil, convertUserSubscriptionToJson(userTrackingSub))
 
 	deregisterUser(subsIdStr)
 	registerUser(userTrackingSub.Address, userTrackingSub.UserEventCriteria, subsIdStr)
@@ -963,7 +1099,7 @@ func zonalTrafficSubDelById(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
 	vars := mux.Vars(r)
 
-	err := rc.JSONDelEntry(baseKey+typeZonalSubscription+":"+vars["subscriptionId"], ".")
+	err := delSubscription(typeZonalSubscription, vars["subscriptionId"])
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
@@ -981,8 +1117,7 @@ func zonalTrafficSubGet(w http.ResponseWriter, r *http.Request) {
 	zonalTrafficSubList.ResourceURL = hostUrl.String() + basePath + "subscriptions/zonalTraffic"
 	response.NotificationSubscriptionList = &zonalTrafficSubList
 
-	keyName := baseKey + typeZonalSubscription + "*"
-	err := rc.ForEachJSONEntry(keyName, populateZonalTrafficList, &zonalTrafficSubList)
+	err := forEachSubscription(typeZonalSubscription, populateZonalTrafficList, &zonalTrafficSubList)
 	if err != nil {
 		log.Error(err.Error())
 		http.Error(w, err.Error(), http.StatusInternalServerError)
@@ -1007,7 +1142,7 @@ func zonalTrafficSubGetById(w http.ResponseWriter, r *http.Request) {
 	var zonalTrafficSub ZonalTrafficSubscription
 	response.ZonalTrafficSubscription = &zonalTrafficSub
 
-	jsonZonalTrafficSub, _ := rc.JSONGetEntry(baseKey+typeZonalSubscription+":"+vars["subscriptionId"], ".")
+	jsonZonalTrafficSub := getSubscriptionJson(typeZonalSubscription, vars["subscriptionId"])
 	if jsonZonalTrafficSub == "" {
 		w.WriteHeader(http.StatusNotFound)
 		return
@@ -1045,24 +1180,19 @@ func zonalTrafficSubPost(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	newSubsId := nextZonalSubscriptionIdAvailable
-	nextZonalSubscriptionIdAvailable++
-	subsIdStr := strconv.Itoa(newSubsId)
-	/*
-		if zonalTrafficSub.Duration > 0 {
-			//TODO start a timer mecanism and expire subscription
-		}
-		//else, lasts forever or until subscription is deleted
-	*/
-	if zonalTrafficSub.Duration != "" && zonalTrafficSub.Duration != "0" {
-		//TODO start a timer mecanism and expire subscription
-		log.Info("Non zero duration")
+	// Subscription expires after requested duration, or lasts until deleted if not set
+	expiryTime, err := getExpiryTime(zonalTrafficSub.Duration)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
 	}
-	//else, lasts forever or until subscription is deleted
+
+	subsIdStr := subMgr.AllocateId(typeZonalSubscription)
 
 	zonalTrafficSub.ResourceURL = hostUrl.String() + basePath + "subscriptions/zonalTraffic/" + subsIdStr
 
-	_ = rc.JSONSetEntry(baseKey+typeZonalSubscription+":"+subsIdStr, ".", convertZonalSubscriptionToJson(zonalTrafficSub))
+	_ = setSubscription(r.Context(), typeZonalSubscription, subsIdStr, zonalTrafficSub.ResourceURL, zonalTrafficSub.CallbackReference, expiryTime, convertZonalSubscriptionToJson(zonalTrafficSub))
 
 	registerZonal(zonalTrafficSub.ZoneId, zonalTrafficSub.UserEventCriteria, subsIdStr)
 
@@ -1092,10 +1222,17 @@ func zonalTrafficSubPutById(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	expiryTime, err := getExpiryTime(zonalTrafficSub.Duration)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
 	subsIdStr := vars["subscriptionId"]
 	zonalTrafficSub.ResourceURL = hostUrl.String() + basePath + "subscriptions/zonalTraffic/" + subsIdStr
 
-	_ = rc.JSONSetEntry(baseKey+typeZonalSubscription+":"+subsIdStr, ".", convertZonalSubscriptionToJson(zonalTrafficSub))
+	_ = setSubscription(r.Context(), typeZonalSubscription, subsIdStr, zonalTrafficSub.ResourceURL, zonalTrafficSub.CallbackReference, expiryTime, convertZonalSubscriptionToJson(zonalTrafficSub))
 
 	deregisterZonal(subsIdStr)
 	registerZonal(zonalTrafficSub.ZoneId, zonalTrafficSub.UserEventCriteria, subsIdStr)
@@ -1128,7 +1265,7 @@ func zoneStatusDelById(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
 	vars := mux.Vars(r)
 
-	err := rc.JSONDelEntry(baseKey+typeZoneStatusSubscription+":"+vars["subscriptionId"], ".")
+	err := delSubscription(typeZoneStatusSubscription, vars["subscriptionId"])
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
@@ -1146,8 +1283,7 @@ func zoneStatusGet(w http.ResponseWriter, r *http.Request) {
 	zoneStatusSubList.ResourceURL = hostUrl.String() + basePath + "subscriptions/zoneStatus"
 	response.NotificationSubscriptionList = &zoneStatusSubList
 
-	keyName := baseKey + typeZoneStatusSubscription + "*"
-	err := rc.ForEachJSONEntry(keyName, populateZoneStatusList, &zoneStatusSubList)
+	err := forEachSubscription(typeZoneStatusSubscription, populateZoneStatusList, &zoneStatusSubList)
 	if err != nil {
 		log.Error(err.Error())
 		http.Error(w, err.Error(), http.StatusInternalServerError)
@@ -1172,7 +1308,7 @@ func zoneStatusGetById(w http.ResponseWriter, r *http.Request) {
 	var zoneStatusSub ZoneStatusSubscription
 	response.ZoneStatusSubscription = &zoneStatusSub
 
-	jsonZoneStatusSub, _ := rc.JSONGetEntry(baseKey+typeZoneStatusSubscription+":"+vars["subscriptionId"], ".")
+	jsonZoneStatusSub := getSubscriptionJson(typeZoneStatusSubscription, vars["subscriptionId"])
 	if jsonZoneStatusSub == "" {
 		w.WriteHeader(http.StatusNotFound)
 		return
@@ -1210,13 +1346,11 @@ func zoneStatusPost(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	newSubsId := nextZoneStatusSubscriptionIdAvailable
-	nextZoneStatusSubscriptionIdAvailable++
-	subsIdStr := strconv.Itoa(newSubsId)
+	subsIdStr := subMgr.AllocateId(typeZoneStatusSubscription)
 
 	zoneStatusSub.ResourceURL = hostUrl.String() + basePath + "subscriptions/zoneStatus/" + subsIdStr
 
-	_ = rc.JSONSetEntry(baseKey+typeZoneStatusSubscription+":"+subsIdStr, ".", convertZoneStatusSubscriptionToJson(zoneStatusSub))
+	_ = setSubscription(r.Context(), typeZoneStatusSubscription, subsIdStr, zoneStatusSub.ResourceURL, zoneStatusSub.CallbackReference, nil, convertZoneStatusSubscriptionToJson(zoneStatusSub))
 
 	registerZoneStatus(zoneStatusSub.ZoneId, zoneStatusSub.NumberOfUsersZoneThreshold, zoneStatusSub.NumberOfUsersAPThreshold,
 		zoneStatusSub.OperationStatus, subsIdStr)
@@ -1250,7 +1384,7 @@ func zoneStatusPutById(w http.ResponseWriter, r *http.Request) {
 	subsIdStr := vars["subscriptionId"]
 	zoneStatusSub.ResourceURL = hostUrl.String() + basePath + "subscriptions/zoneStatus/" + subsIdStr
 
-	_ = rc.JSONSetEntry(baseKey+typeZoneStatusSubscription+":"+subsIdStr, ".", convertZoneStatusSubscriptionToJson(zoneStatusSub))
+	_ = setSubscription(r.Context(), typeZoneStatusSubscription, subsIdStr, zoneStatusSub.ResourceURL, zoneStatusSub.CallbackReference, nil, convertZoneStatusSubscriptionToJson(zoneStatusSub))
 
 	deregisterZoneStatus(subsIdStr)
 	registerZoneStatus(zoneStatusSub.ZoneId, zoneStatusSub.NumberOfUsersZoneThreshold, zoneStatusSub.NumberOfUsersAPThreshold,
@@ -1283,10 +1417,9 @@ func populateZoneStatusList(key string, jsonInfo string, userData interface{}) e
 func cleanUp() {
 	log.Info("Terminate all")
 	rc.DBFlush(baseKey)
-	nextZonalSubscriptionIdAvailable = 1
-	nextUserSubscriptionIdAvailable = 1
-	nextZoneStatusSubscriptionIdAvailable = 1
+	subMgr.DeleteAllSubscriptions()
 
+	mutex.Lock()
 	zonalSubscriptionEnteringMap = map[int]string{}
 	zonalSubscriptionLeavingMap = map[int]string{}
 	zonalSubscriptionTransferringMap = map[int]string{}
@@ -1298,6 +1431,7 @@ func cleanUp() {
 	userSubscriptionMap = map[int]string{}
 
 	zoneStatusSubscriptionMap = map[int]*ZoneStatusCheck{}
+	mutex.Unlock()
 
 	updateStoreName("")
 }
@@ -1419,20 +1553,17 @@ func zoneStatusReInit() {
 	//reusing the object response for the get multiple zoneStatusSubscription
 	var zoneList ZoneStatusNotificationSubscriptionList
 
-	keyName := baseKey + typeZoneStatusSubscription + "*"
-	_ = rc.ForEachJSONEntry(keyName, populateZoneStatusList, &zoneList)
+	_ = forEachSubscription(typeZoneStatusSubscription, populateZoneStatusList, &zoneList)
+
+	mutex.Lock()
+	defer mutex.Unlock()
 
-	maxZoneStatusSubscriptionId := 0
 	for _, zone := range zoneList.ZoneStatusSubscription {
 		resourceUrl := strings.Split(zone.ResourceURL, "/")
 		subscriptionId, err := strconv.Atoi(resourceUrl[len(resourceUrl)-1])
 		if err != nil {
 			log.Error(err)
 		} else {
-			if subscriptionId > maxZoneStatusSubscriptionId {
-				maxZoneStatusSubscriptionId = subscriptionId
-			}
-
 			var zoneStatus ZoneStatusCheck
 			opStatus := zone.OperationStatus
 			if opStatus != nil {
@@ -1454,27 +1585,23 @@ func zoneStatusReInit() {
 			zoneStatusSubscriptionMap[subscriptionId] = &zoneStatus
 		}
 	}
-	nextZoneStatusSubscriptionIdAvailable = maxZoneStatusSubscriptionId + 1
 }
 
 func zonalTrafficReInit() {
 	//reusing the object response for the get multiple zonalSubscription
 	var zoneList ZonalTrafficNotificationSubscriptionList
 
-	keyName := baseKey + typeZonalSubscription + "*"
-	_ = rc.ForEachJSONEntry(keyName, populateZonalTrafficList, &zoneList)
+	_ = forEachSubscription(typeZonalSubscription, populateZonalTrafficList, &zoneList)
+
+	mutex.Lock()
+	defer mutex.Unlock()
 
-	maxZonalSubscriptionId := 0
 	for _, zone := range zoneList.ZonalTrafficSubscription {
 		resourceUrl := strings.Split(zone.ResourceURL, "/")
 		subscriptionId, err := strconv.Atoi(resourceUrl[len(resourceUrl)-1])
 		if err != nil {
 			log.Error(err)
 		} else {
-			if subscriptionId > maxZonalSubscriptionId {
-				maxZonalSubscriptionId = subscriptionId
-			}
-
 			for i := 0; i < len(zone.UserEventCriteria); i++ {
 				switch zone.UserEventCriteria[i] {
 				case ENTERING:
@@ -1489,27 +1616,23 @@ func zonalTrafficReInit() {
 			zonalSubscriptionMap[subscriptionId] = zone.ZoneId
 		}
 	}
-	nextZonalSubscriptionIdAvailable = maxZonalSubscriptionId + 1
 }
 
 func userTrackingReInit() {
 	//reusing the object response for the get multiple zonalSubscription
 	var userList UserTrackingNotificationSubscriptionList
 
-	keyName := baseKey + typeUserSubscription + "*"
-	_ = rc.ForEachJSONEntry(keyName, populateUserTrackingList, &userList)
+	_ = forEachSubscription(typeUserSubscription, populateUserTrackingList, &userList)
+
+	mutex.Lock()
+	defer mutex.Unlock()
 
-	maxUserSubscriptionId := 0
 	for _, user := range userList.UserTrackingSubscription {
 		resourceUrl := strings.Split(user.ResourceURL, "/")
 		subscriptionId, err := strconv.Atoi(resourceUrl[len(resourceUrl)-1])
 		if err != nil {
 			log.Error(err)
 		} else {
-			if subscriptionId > maxUserSubscriptionId {
-				maxUserSubscriptionId = subscriptionId
-			}
-
 			for i := 0; i < len(user.UserEventCriteria); i++ {
 				switch user.UserEventCriteria[i] {
 				case ENTERING:
@@ -1524,5 +1647,4 @@ func userTrackingReInit() {
 			userSubscriptionMap[subscriptionId] = user.Address
 		}
 	}
-	nextUserSubscriptionIdAvailable = maxUserSubscriptionId + 1
 }
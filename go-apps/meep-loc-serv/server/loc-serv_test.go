@@ -538,16 +538,16 @@ func TestZonalSuccessSubscription(t *testing.T) {
 	expectedGetResp := testZonalSubscriptionPost(t)
 
 	//get
-	testZonalSubscriptionGet(t, strconv.Itoa(nextZonalSubscriptionIdAvailable-1), expectedGetResp)
+	testZonalSubscriptionGet(t, strconv.Itoa(subMgr.NextId(typeZonalSubscription)-1), expectedGetResp)
 
 	//put
-	expectedGetResp = testZonalSubscriptionPut(t, strconv.Itoa(nextZonalSubscriptionIdAvailable-1), true)
+	expectedGetResp = testZonalSubscriptionPut(t, strconv.Itoa(subMgr.NextId(typeZonalSubscription)-1), true)
 
 	//get
-	testZonalSubscriptionGet(t, strconv.Itoa(nextZonalSubscriptionIdAvailable-1), expectedGetResp)
+	testZonalSubscriptionGet(t, strconv.Itoa(subMgr.NextId(typeZonalSubscription)-1), expectedGetResp)
 
 	//delete
-	testZonalSubscriptionDelete(t, strconv.Itoa(nextZonalSubscriptionIdAvailable-1))
+	testZonalSubscriptionDelete(t, strconv.Itoa(subMgr.NextId(typeZonalSubscription)-1))
 
 	terminateScenario()
 }
@@ -571,13 +571,13 @@ func TestFailZonalSubscription(t *testing.T) {
 	initialiseScenario(testScenario)
 
 	//get
-	testZonalSubscriptionGet(t, strconv.Itoa(nextZonalSubscriptionIdAvailable), "")
+	testZonalSubscriptionGet(t, strconv.Itoa(subMgr.NextId(typeZonalSubscription)), "")
 
 	//put
-	_ = testZonalSubscriptionPut(t, strconv.Itoa(nextZonalSubscriptionIdAvailable), false)
+	_ = testZonalSubscriptionPut(t, strconv.Itoa(subMgr.NextId(typeZonalSubscription)), false)
 
 	//delete
-	testZonalSubscriptionDelete(t, strconv.Itoa(nextZonalSubscriptionIdAvailable))
+	testZonalSubscriptionDelete(t, strconv.Itoa(subMgr.NextId(typeZonalSubscription)))
 
 	terminateScenario()
 }
@@ -607,7 +607,7 @@ func TestZonalSubscriptionsListGet(t *testing.T) {
 	testZonalSubscriptionList(t)
 
 	//delete
-	testZonalSubscriptionDelete(t, strconv.Itoa(nextZonalSubscriptionIdAvailable-1))
+	testZonalSubscriptionDelete(t, strconv.Itoa(subMgr.NextId(typeZonalSubscription)-1))
 
 	terminateScenario()
 }
@@ -661,7 +661,7 @@ func testZonalSubscriptionPost(t *testing.T) string {
 	requestZoneId := "zone1"
 	requestUserEvent := []UserEventType{ENTERING, TRANSFERRING}
 	requestDuration := "0"
-	requestResourceURL := "/" + testScenarioName + "/location/v1/subscriptions/zonalTraffic/" + strconv.Itoa(nextZonalSubscriptionIdAvailable)
+	requestResourceURL := "/" + testScenarioName + "/location/v1/subscriptions/zonalTraffic/" + strconv.Itoa(subMgr.NextId(typeZonalSubscription))
 
 	expectedZonalTrafficSubscription := ZonalTrafficSubscription{requestClientCorrelator, &UserTrackingSubscriptionCallbackReference{requestCallbackReference}, requestZoneId, nil, requestUserEvent, requestDuration, requestResourceURL}
 
@@ -873,16 +873,16 @@ func TestUserSuccessSubscription(t *testing.T) {
 	expectedGetResp := testUserSubscriptionPost(t)
 
 	//get
-	testUserSubscriptionGet(t, strconv.Itoa(nextUserSubscriptionIdAvailable-1), expectedGetResp)
+	testUserSubscriptionGet(t, strconv.Itoa(subMgr.NextId(typeUserSubscription)-1), expectedGetResp)
 
 	//put
-	expectedGetResp = testUserSubscriptionPut(t, strconv.Itoa(nextUserSubscriptionIdAvailable-1), true)
+	expectedGetResp = testUserSubscriptionPut(t, strconv.Itoa(subMgr.NextId(typeUserSubscription)-1), true)
 
 	//get
-	testUserSubscriptionGet(t, strconv.Itoa(nextUserSubscriptionIdAvailable-1), expectedGetResp)
+	testUserSubscriptionGet(t, strconv.Itoa(subMgr.NextId(typeUserSubscription)-1), expectedGetResp)
 
 	//delete
-	testUserSubscriptionDelete(t, strconv.Itoa(nextUserSubscriptionIdAvailable-1))
+	testUserSubscriptionDelete(t, strconv.Itoa(subMgr.NextId(typeUserSubscription)-1))
 
 	terminateScenario()
 }
@@ -906,13 +906,13 @@ func TestFailUserSubscription(t *testing.T) {
 	initialiseScenario(testScenario)
 
 	//get
-	testUserSubscriptionGet(t, strconv.Itoa(nextUserSubscriptionIdAvailable), "")
+	testUserSubscriptionGet(t, strconv.Itoa(subMgr.NextId(typeUserSubscription)), "")
 
 	//put
-	_ = testUserSubscriptionPut(t, strconv.Itoa(nextUserSubscriptionIdAvailable), false)
+	_ = testUserSubscriptionPut(t, strconv.Itoa(subMgr.NextId(typeUserSubscription)), false)
 
 	//delete
-	testUserSubscriptionDelete(t, strconv.Itoa(nextUserSubscriptionIdAvailable))
+	testUserSubscriptionDelete(t, strconv.Itoa(subMgr.NextId(typeUserSubscription)))
 
 	terminateScenario()
 }
@@ -942,7 +942,7 @@ func TestUserSubscriptionsListGet(t *testing.T) {
 	testUserSubscriptionList(t)
 
 	//delete
-	testUserSubscriptionDelete(t, strconv.Itoa(nextUserSubscriptionIdAvailable-1))
+	testUserSubscriptionDelete(t, strconv.Itoa(subMgr.NextId(typeUserSubscription)-1))
 
 	terminateScenario()
 }
@@ -995,7 +995,7 @@ func testUserSubscriptionPost(t *testing.T) string {
 	requestCallbackReference := "myCallbackRef"
 	requestAddr := "myAddr"
 	requestUserEvent := []UserEventType{ENTERING, TRANSFERRING}
-	requestResourceURL := "/" + testScenarioName + "/location/v1/subscriptions/userTracking/" + strconv.Itoa(nextUserSubscriptionIdAvailable)
+	requestResourceURL := "/" + testScenarioName + "/location/v1/subscriptions/userTracking/" + strconv.Itoa(subMgr.NextId(typeUserSubscription))
 
 	expectedUserTrackingSubscription := UserTrackingSubscription{requestClientCorrelator, &UserTrackingSubscriptionCallbackReference{requestCallbackReference}, requestAddr, requestUserEvent, requestResourceURL}
 
@@ -1206,16 +1206,16 @@ func TestZoneStatusSuccessSubscription(t *testing.T) {
 	expectedGetResp := testZoneStatusSubscriptionPost(t)
 
 	//get
-	testZoneStatusSubscriptionGet(t, strconv.Itoa(nextZoneStatusSubscriptionIdAvailable-1), expectedGetResp)
+	testZoneStatusSubscriptionGet(t, strconv.Itoa(subMgr.NextId(typeZoneStatusSubscription)-1), expectedGetResp)
 
 	//put
-	expectedGetResp = testZoneStatusSubscriptionPut(t, strconv.Itoa(nextZoneStatusSubscriptionIdAvailable-1), true)
+	expectedGetResp = testZoneStatusSubscriptionPut(t, strconv.Itoa(subMgr.NextId(typeZoneStatusSubscription)-1), true)
 
 	//get
-	testZoneStatusSubscriptionGet(t, strconv.Itoa(nextZoneStatusSubscriptionIdAvailable-1), expectedGetResp)
+	testZoneStatusSubscriptionGet(t, strconv.Itoa(subMgr.NextId(typeZoneStatusSubscription)-1), expectedGetResp)
 
 	//delete
-	testZoneStatusSubscriptionDelete(t, strconv.Itoa(nextZoneStatusSubscriptionIdAvailable-1))
+	testZoneStatusSubscriptionDelete(t, strconv.Itoa(subMgr.NextId(typeZoneStatusSubscription)-1))
 
 	terminateScenario()
 }
@@ -1239,13 +1239,13 @@ func TestFailZoneStatusSubscription(t *testing.T) {
 	initialiseScenario(testScenario)
 
 	//get
-	testZoneStatusSubscriptionGet(t, strconv.Itoa(nextZoneStatusSubscriptionIdAvailable), "")
+	testZoneStatusSubscriptionGet(t, strconv.Itoa(subMgr.NextId(typeZoneStatusSubscription)), "")
 
 	//put
-	_ = testZoneStatusSubscriptionPut(t, strconv.Itoa(nextZoneStatusSubscriptionIdAvailable), false)
+	_ = testZoneStatusSubscriptionPut(t, strconv.Itoa(subMgr.NextId(typeZoneStatusSubscription)), false)
 
 	//delete
-	testZoneStatusSubscriptionDelete(t, strconv.Itoa(nextZoneStatusSubscriptionIdAvailable))
+	testZoneStatusSubscriptionDelete(t, strconv.Itoa(subMgr.NextId(typeZoneStatusSubscription)))
 
 	terminateScenario()
 }
@@ -1275,7 +1275,7 @@ func TestZoneStatusSubscriptionsListGet(t *testing.T) {
 	testZoneStatusSubscriptionList(t)
 
 	//delete
-	testZoneStatusSubscriptionDelete(t, strconv.Itoa(nextZoneStatusSubscriptionIdAvailable-1))
+	testZoneStatusSubscriptionDelete(t, strconv.Itoa(subMgr.NextId(typeZoneStatusSubscription)-1))
 
 	terminateScenario()
 }
@@ -1330,7 +1330,7 @@ func testZoneStatusSubscriptionPost(t *testing.T) string {
 	requestOperationStatus := []OperationStatus{SERVICEABLE}
 	requestNumberOfUsersZoneThreshold := int32(10)
 	requestNumberOfUsersAPThreshold := int32(8)
-	requestResourceURL := "/" + testScenarioName + "/location/v1/subscriptions/zoneStatus/" + strconv.Itoa(nextZoneStatusSubscriptionIdAvailable)
+	requestResourceURL := "/" + testScenarioName + "/location/v1/subscriptions/zoneStatus/" + strconv.Itoa(subMgr.NextId(typeZoneStatusSubscription))
 
 	expectedZoneStatusSubscription := ZoneStatusSubscription{requestClientCorrelator, requestResourceURL, &UserTrackingSubscriptionCallbackReference{requestCallbackReference}, requestZoneId, requestNumberOfUsersZoneThreshold, requestNumberOfUsersAPThreshold, requestOperationStatus}
 
@@ -1893,7 +1893,7 @@ func TestUserSubscriptionNotification(t *testing.T) {
 	}
 
 	//cleanup allocated subscription
-	testUserSubscriptionDelete(t, strconv.Itoa(nextUserSubscriptionIdAvailable-1))
+	testUserSubscriptionDelete(t, strconv.Itoa(subMgr.NextId(typeUserSubscription)-1))
 
 	/******************************
 	 * back to initial state section
@@ -2045,7 +2045,7 @@ func TestZoneSubscriptionNotification(t *testing.T) {
 	}
 
 	//cleanup allocated subscription
-	testUserSubscriptionDelete(t, strconv.Itoa(nextUserSubscriptionIdAvailable-1))
+	testUserSubscriptionDelete(t, strconv.Itoa(subMgr.NextId(typeUserSubscription)-1))
 
 	/******************************
 	 * back to initial state section
@@ -2126,6 +2126,7 @@ func initializeVars() {
 	postgisHost = postgisTestHost
 	postgisPort = postgisTestPort
 	sandboxName = testScenarioName
+	notifRetries = 0
 }
 
 func initialiseScenario(testScenario string) {
@@ -59,6 +59,16 @@ func NewRouter() *mux.Router {
 			Handler(handler)
 	}
 
+	// Prometheus scrape endpoint; requests are not logged to avoid recording every scrape.
+	// Matched explicitly so ingress requests rewritten to /metrics/ are not redirected.
+	router.
+		Methods("GET").
+		MatcherFunc(func(r *http.Request, rm *mux.RouteMatch) bool {
+			return r.URL.Path == "/metrics" || r.URL.Path == "/metrics/"
+		}).
+		Name("GetPrometheusMetrics").
+		Handler(Logger(http.HandlerFunc(v2.GetPrometheusMetrics), "GetPrometheusMetrics"))
+
 	return router
 }
 
@@ -90,6 +100,13 @@ var routes = Routes{
 		IndexV2,
 	},
 
+	Route{
+		"GetMetricsExport",
+		strings.ToUpper("Get"),
+		"/metrics/v2/metrics/export",
+		v2.GetMetricsExport,
+	},
+
 	Route{
 		"PostEventQuery",
 		strings.ToUpper("Post"),
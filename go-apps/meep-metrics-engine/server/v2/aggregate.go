@@ -0,0 +1,133 @@
+/*
+ * Copyright (c) 2020  InterDigital Communications, Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"errors"
+	"sort"
+
+	ms "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-metric-store"
+)
+
+// Fields & tags supported by aggregation queries
+var networkAggFields = []string{ms.NetMetLatency, ms.NetMetULThroughput, ms.NetMetDLThroughput, ms.NetMetULPktLoss, ms.NetMetDLPktLoss}
+var networkAggTags = []string{ms.NetMetSrc, ms.NetMetDst}
+var eventCountFields = []string{ms.EvMetEvent}
+var eventAggTags = []string{ms.EvMetType}
+var httpAggFields = []string{ms.HttpProcDuration}
+var httpCountFields = []string{ms.HttpLogId, ms.HttpLogEndpoint, ms.HttpUrl, ms.HttpMethod, ms.HttpRespCode}
+var httpAggTags = []string{ms.HttpLoggerName, ms.HttpLoggerDirection}
+
+// validateAggregation - Validate aggregation parameters
+// aggFields support all aggregation functions; countFields only support count
+func validateAggregation(agg *Aggregation, fields []string, duration string, aggFields []string, countFields []string, tagNames []string) error {
+	if len(agg.Functions) == 0 {
+		return errors.New("Missing aggregation function")
+	}
+	countOnly := true
+	for _, function := range agg.Functions {
+		if !ms.IsValidAggregation(function) {
+			return errors.New("Unsupported aggregation function: " + function)
+		}
+		if function != ms.AggCount {
+			countOnly = false
+		}
+	}
+	if len(fields) == 0 {
+		return errors.New("Missing aggregation field")
+	}
+	for _, field := range fields {
+		if contains(aggFields, field) {
+			continue
+		}
+		if contains(countFields, field) {
+			if !countOnly {
+				return errors.New("Only count aggregation supported for field: " + field)
+			}
+			continue
+		}
+		return errors.New("Unsupported aggregation field: " + field)
+	}
+	for _, tag := range agg.GroupBy {
+		if !contains(tagNames, tag) {
+			return errors.New("Unsupported aggregation group: " + tag)
+		}
+	}
+	if agg.Interval != "" {
+		if duration == "" {
+			return errors.New("Scope duration required for aggregation interval")
+		}
+		if err := ms.ValidateDuration(agg.Interval); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// convertAggregation - Convert aggregation parameters to metric store format
+func convertAggregation(agg *Aggregation) *ms.Aggregation {
+	return &ms.Aggregation{
+		Functions: agg.Functions,
+		Interval:  agg.Interval,
+		GroupBy:   agg.GroupBy,
+	}
+}
+
+// getAggregateColumns - Get aggregation query response columns
+func getAggregateColumns(agg *Aggregation, fields []string) []string {
+	var columns []string
+	for _, field := range fields {
+		for _, function := range agg.Functions {
+			columns = append(columns, ms.AggregateColumn(function, field))
+		}
+	}
+	return append(columns, "time")
+}
+
+// formatAggregateSeries - Format metric store aggregation results
+func formatAggregateSeries(series []ms.AggregateSeries) []AggregateSeries {
+	result := make([]AggregateSeries, len(series))
+	for i, s := range series {
+		// Sort tags for a deterministic response
+		names := make([]string, 0, len(s.Tags))
+		for name := range s.Tags {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			result[i].Tags = append(result[i].Tags, Tag{Name: name, Value: s.Tags[name]})
+		}
+
+		result[i].Values = make([]AggregateMetric, len(s.Metrics))
+		for j, m := range s.Metrics {
+			if t, ok := m.Time.(string); ok {
+				result[i].Values[j].Time = t
+			}
+			result[i].Values[j].Values = m.Values
+		}
+	}
+	return result
+}
+
+func contains(list []string, val string) bool {
+	for _, v := range list {
+		if v == val {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,106 @@
+/*
+ * Copyright (c) 2020  InterDigital Communications, Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"fmt"
+	"testing"
+
+	log "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-logger"
+	ms "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-metric-store"
+)
+
+func TestValidateAggregation(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	fmt.Println("Validate network aggregation")
+	agg := &Aggregation{Functions: []string{"mean", "p95"}}
+	if validateAggregation(&Aggregation{}, []string{"lat"}, "", networkAggFields, nil, networkAggTags) == nil {
+		t.Fatalf("Missing function should have failed")
+	}
+	if validateAggregation(&Aggregation{Functions: []string{"median"}}, []string{"lat"}, "", networkAggFields, nil, networkAggTags) == nil {
+		t.Fatalf("Invalid function should have failed")
+	}
+	if validateAggregation(agg, []string{}, "", networkAggFields, nil, networkAggTags) == nil {
+		t.Fatalf("Missing field should have failed")
+	}
+	if validateAggregation(agg, []string{"invalid"}, "", networkAggFields, nil, networkAggTags) == nil {
+		t.Fatalf("Invalid field should have failed")
+	}
+	if validateAggregation(agg, []string{"lat", "ul"}, "", networkAggFields, nil, networkAggTags) != nil {
+		t.Fatalf("Valid aggregation failed")
+	}
+	agg.GroupBy = []string{"invalid"}
+	if validateAggregation(agg, []string{"lat"}, "", networkAggFields, nil, networkAggTags) == nil {
+		t.Fatalf("Invalid group should have failed")
+	}
+	agg.GroupBy = []string{"src", "dest"}
+	agg.Interval = "10s"
+	if validateAggregation(agg, []string{"lat"}, "", networkAggFields, nil, networkAggTags) == nil {
+		t.Fatalf("Interval without duration should have failed")
+	}
+	if validateAggregation(agg, []string{"lat"}, "1m", networkAggFields, nil, networkAggTags) != nil {
+		t.Fatalf("Valid aggregation failed")
+	}
+
+	fmt.Println("Validate event aggregation")
+	if validateAggregation(&Aggregation{Functions: []string{"mean"}}, []string{"event"}, "", nil, eventCountFields, eventAggTags) == nil {
+		t.Fatalf("Event mean should have failed")
+	}
+	if validateAggregation(&Aggregation{Functions: []string{"count"}, GroupBy: []string{"type"}}, []string{"event"}, "", nil, eventCountFields, eventAggTags) != nil {
+		t.Fatalf("Valid event aggregation failed")
+	}
+
+	fmt.Println("Validate http aggregation")
+	if validateAggregation(&Aggregation{Functions: []string{"p99", "count"}}, []string{"proc_duration", "resp_code"}, "", httpAggFields, httpCountFields, httpAggTags) == nil {
+		t.Fatalf("Percentile on string field should have failed")
+	}
+	if validateAggregation(&Aggregation{Functions: []string{"p99", "count"}}, []string{"proc_duration"}, "", httpAggFields, httpCountFields, httpAggTags) != nil {
+		t.Fatalf("Valid http aggregation failed")
+	}
+}
+
+func TestFormatAggregateSeries(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	agg := &Aggregation{Functions: []string{"min", "max"}}
+	columns := getAggregateColumns(agg, []string{"lat", "ul"})
+	if len(columns) != 5 || columns[0] != "min_lat" || columns[3] != "max_ul" || columns[4] != "time" {
+		t.Fatalf("Invalid aggregate columns")
+	}
+
+	series := formatAggregateSeries([]ms.AggregateSeries{
+		{
+			Tags: map[string]string{"src": "ue1", "dest": "fog1"},
+			Metrics: []ms.AggregateMetric{
+				{Time: "2020-06-01T12:00:10Z", Values: map[string]float64{"min_lat": 10, "max_lat": 40}},
+				{Time: "2020-06-01T12:00:00Z", Values: map[string]float64{"min_lat": 5}},
+			},
+		},
+	})
+	if len(series) != 1 || len(series[0].Tags) != 2 || len(series[0].Values) != 2 {
+		t.Fatalf("Invalid aggregate series")
+	}
+	if series[0].Tags[0].Name != "dest" || series[0].Tags[0].Value != "fog1" || series[0].Tags[1].Name != "src" {
+		t.Fatalf("Invalid aggregate series tags")
+	}
+	if series[0].Values[0].Time != "2020-06-01T12:00:10Z" || series[0].Values[0].Values["max_lat"] != 40 {
+		t.Fatalf("Invalid aggregate series values")
+	}
+}
@@ -28,6 +28,14 @@ import (
 	"net/http"
 )
 
+func GetMetricsExport(w http.ResponseWriter, r *http.Request) {
+	meGetMetricsExport(w, r)
+}
+
+func GetPrometheusMetrics(w http.ResponseWriter, r *http.Request) {
+	meGetPrometheusMetrics(w, r)
+}
+
 func PostEventQuery(w http.ResponseWriter, r *http.Request) {
 	mePostEventQuery(w, r)
 }
@@ -24,7 +24,6 @@ import (
 	"net/http"
 	"net/url"
 	"os"
-	"strconv"
 	"strings"
 	"time"
 
@@ -36,7 +35,7 @@ import (
 	clientv2 "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-metrics-engine-notification-client"
 	mod "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-model"
 	mq "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-mq"
-	redis "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-redis"
+	subs "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-subscriptions"
 
 	"github.com/gorilla/mux"
 )
@@ -56,9 +55,11 @@ const typeEventSubscription = "eventsubs"
 var defaultDuration string = "1s"
 var defaultLimit int32 = 1
 
+// Notification retry settings
+const notifRetries = 3
+const notifBackoff = time.Second
+
 var METRICS_DB = 0
-var nextNetworkSubscriptionIdAvailable int
-var nextEventSubscriptionIdAvailable int
 
 var networkSubscriptionMap = map[string]*NetworkRegistration{}
 var eventSubscriptionMap = map[string]*EventRegistration{}
@@ -73,18 +74,21 @@ var hostUrl *url.URL
 var basePath string
 var baseKey string
 
-var rc *redis.Connector
+var subMgr *subs.SubscriptionMgr
 
 type EventRegistration struct {
 	params        *EventSubscriptionParams
 	requestedTags map[string]string
 	ticker        *time.Ticker
+	lastEventTime string
 }
 
 type NetworkRegistration struct {
 	params        *NetworkSubscriptionParams
+	queryFields   []string
 	requestedTags map[string]string
 	ticker        *time.Ticker
+	triggerState  TriggerState
 }
 
 // Init - Metrics engine initialization
@@ -140,16 +144,22 @@ func Init() (err error) {
 	// Get base store key
 	baseKey = dkm.GetKeyRoot(sandboxName) + metricsEngineKey
 
-	// Connect to Redis DB to monitor metrics
-	rc, err = redis.NewConnector(redisAddr, METRICS_DB)
+	// Create subscription manager
+	subMgrCfg := &subs.SubscriptionMgrCfg{
+		Module:       moduleName,
+		Basekey:      baseKey,
+		RedisTable:   METRICS_DB,
+		NotifRetries: notifRetries,
+		NotifBackoff: notifBackoff,
+		LegacyTypes:  []string{typeNetworkSubscription, typeEventSubscription},
+		LegacySubCb:  legacySubscriptionCb,
+	}
+	subMgr, err = subs.NewSubscriptionMgr(subMgrCfg, redisAddr)
 	if err != nil {
-		log.Error("Failed connection to Redis DB. Error: ", err)
+		log.Error("Failed to create subscription manager. Error: ", err)
 		return err
 	}
-	log.Info("Connected to Redis DB")
-
-	nextNetworkSubscriptionIdAvailable = 1
-	nextEventSubscriptionIdAvailable = 1
+	log.Info("Subscription manager created")
 
 	networkSubscriptionReInit()
 	eventSubscriptionReInit()
@@ -270,15 +280,7 @@ func mePostEventQuery(w http.ResponseWriter, r *http.Request) {
 	err := decoder.Decode(&params)
 	if err != nil {
 		log.Error(err.Error())
-		http.Error(w, err.Error(), http.StatusInternalServerError)
-		return
-	}
-
-	// Make sure metrics store is up
-	if metricStore == nil {
-		err := errors.New("No active scenario to get metrics from")
-		log.Error(err.Error())
-		http.Error(w, err.Error(), http.StatusNotFound)
+		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
 
@@ -296,11 +298,59 @@ func mePostEventQuery(w http.ResponseWriter, r *http.Request) {
 		limit = int(params.Scope.Limit)
 	}
 
+	// Validate query parameters
+	err = ms.ValidateQuery(ms.EvMetName, tags, params.Fields, duration, limit)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if params.Aggregation != nil {
+		err = validateAggregation(params.Aggregation, params.Fields, duration, nil, eventCountFields, eventAggTags)
+		if err != nil {
+			log.Error(err.Error())
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	// Make sure metrics store is up
+	if metricStore == nil {
+		err := errors.New("No active scenario to get metrics from")
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	// Get aggregated metrics, if requested
+	if params.Aggregation != nil {
+		series, err := metricStore.GetInfluxMetricAggregate(ms.EvMetName, tags, params.Fields, duration, limit, convertAggregation(params.Aggregation))
+		if err != nil {
+			log.Error(err.Error())
+			http.Error(w, err.Error(), getQueryErrorStatus(err))
+			return
+		}
+
+		var response EventMetricList
+		response.Name = "event metrics"
+		response.Columns = getAggregateColumns(params.Aggregation, params.Fields)
+		response.Series = formatAggregateSeries(series)
+		jsonResponse, err := json.Marshal(response)
+		if err != nil {
+			log.Error(err.Error())
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, string(jsonResponse))
+		return
+	}
+
 	// Get metrics
 	valuesArray, err := metricStore.GetInfluxMetric(ms.EvMetName, tags, params.Fields, duration, limit)
 	if err != nil {
 		log.Error(err.Error())
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		http.Error(w, err.Error(), getQueryErrorStatus(err))
 		return
 	}
 	if len(valuesArray) == 0 {
@@ -351,15 +401,7 @@ func mePostHttpQuery(w http.ResponseWriter, r *http.Request) {
 	err := decoder.Decode(&params)
 	if err != nil {
 		log.Error(err.Error())
-		http.Error(w, err.Error(), http.StatusInternalServerError)
-		return
-	}
-
-	// Make sure metrics store is up
-	if metricStore == nil {
-		err := errors.New("No active scenario to get metrics from")
-		log.Error(err.Error())
-		http.Error(w, err.Error(), http.StatusNotFound)
+		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
 
@@ -376,11 +418,60 @@ func mePostHttpQuery(w http.ResponseWriter, r *http.Request) {
 		duration = params.Scope.Duration
 		limit = int(params.Scope.Limit)
 	}
+
+	// Validate query parameters
+	err = ms.ValidateQuery(ms.HttpLogMetricName, tags, params.Fields, duration, limit)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if params.Aggregation != nil {
+		err = validateAggregation(params.Aggregation, params.Fields, duration, httpAggFields, httpCountFields, httpAggTags)
+		if err != nil {
+			log.Error(err.Error())
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	// Make sure metrics store is up
+	if metricStore == nil {
+		err := errors.New("No active scenario to get metrics from")
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	// Get aggregated metrics, if requested
+	if params.Aggregation != nil {
+		series, err := metricStore.GetInfluxMetricAggregate(ms.HttpLogMetricName, tags, params.Fields, duration, limit, convertAggregation(params.Aggregation))
+		if err != nil {
+			log.Error(err.Error())
+			http.Error(w, err.Error(), getQueryErrorStatus(err))
+			return
+		}
+
+		var response HttpMetricList
+		response.Name = "http metrics"
+		response.Columns = getAggregateColumns(params.Aggregation, params.Fields)
+		response.Series = formatAggregateSeries(series)
+		jsonResponse, err := json.Marshal(response)
+		if err != nil {
+			log.Error(err.Error())
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, string(jsonResponse))
+		return
+	}
+
 	// Get metrics
 	valuesArray, err := metricStore.GetInfluxMetric(ms.HttpLogMetricName, tags, params.Fields, duration, limit)
 	if err != nil {
 		log.Error(err.Error())
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		http.Error(w, err.Error(), getQueryErrorStatus(err))
 		return
 	}
 	if len(valuesArray) == 0 {
@@ -447,6 +538,21 @@ func mePostHttpQuery(w http.ResponseWriter, r *http.Request) {
 				metric.ProcTime = val
 			}
 		}
+		if values[ms.HttpReqHeaders] != nil {
+			if val, ok := values[ms.HttpReqHeaders].(string); ok {
+				metric.ReqHeaders = val
+			}
+		}
+		if values[ms.HttpRespHeaders] != nil {
+			if val, ok := values[ms.HttpRespHeaders].(string); ok {
+				metric.RespHeaders = val
+			}
+		}
+		if values[ms.HttpCorrelationId] != nil {
+			if val, ok := values[ms.HttpCorrelationId].(string); ok {
+				metric.CorrelationId = val
+			}
+		}
 	}
 
 	jsonResponse, err := json.Marshal(response)
@@ -475,15 +581,7 @@ func mePostNetworkQuery(w http.ResponseWriter, r *http.Request) {
 	err := decoder.Decode(&params)
 	if err != nil {
 		log.Error(err.Error())
-		http.Error(w, err.Error(), http.StatusInternalServerError)
-		return
-	}
-
-	// Make sure metrics store is up
-	if metricStore == nil {
-		err := errors.New("No active scenario to get metrics from")
-		log.Error(err.Error())
-		http.Error(w, err.Error(), http.StatusNotFound)
+		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
 
@@ -501,11 +599,59 @@ func mePostNetworkQuery(w http.ResponseWriter, r *http.Request) {
 		limit = int(params.Scope.Limit)
 	}
 
+	// Validate query parameters
+	err = ms.ValidateQuery(ms.NetMetName, tags, params.Fields, duration, limit)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if params.Aggregation != nil {
+		err = validateAggregation(params.Aggregation, params.Fields, duration, networkAggFields, nil, networkAggTags)
+		if err != nil {
+			log.Error(err.Error())
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	// Make sure metrics store is up
+	if metricStore == nil {
+		err := errors.New("No active scenario to get metrics from")
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	// Get aggregated metrics, if requested
+	if params.Aggregation != nil {
+		series, err := metricStore.GetInfluxMetricAggregate(ms.NetMetName, tags, params.Fields, duration, limit, convertAggregation(params.Aggregation))
+		if err != nil {
+			log.Error(err.Error())
+			http.Error(w, err.Error(), getQueryErrorStatus(err))
+			return
+		}
+
+		var response NetworkMetricList
+		response.Name = "network metrics"
+		response.Columns = getAggregateColumns(params.Aggregation, params.Fields)
+		response.Series = formatAggregateSeries(series)
+		jsonResponse, err := json.Marshal(response)
+		if err != nil {
+			log.Error(err.Error())
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, string(jsonResponse))
+		return
+	}
+
 	// Get metrics
 	valuesArray, err := metricStore.GetInfluxMetric(ms.NetMetName, tags, params.Fields, duration, limit)
 	if err != nil {
 		log.Error(err.Error())
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		http.Error(w, err.Error(), getQueryErrorStatus(err))
 		return
 	}
 	if len(valuesArray) == 0 {
@@ -550,6 +696,119 @@ func mePostNetworkQuery(w http.ResponseWriter, r *http.Request) {
 	fmt.Fprintf(w, string(jsonResponse))
 }
 
+func meGetMetricsExport(w http.ResponseWriter, r *http.Request) {
+	// Retrieve export parameters
+	query := r.URL.Query()
+	format := query.Get("format")
+	if format == "" {
+		format = ms.ExportFormatCsv
+	}
+	if !ms.IsValidExportFormat(format) {
+		err := errors.New("Unsupported export format: " + format)
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	start, err := parseExportTime(query.Get("start"))
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	end, err := parseExportTime(query.Get("end"))
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if !start.IsZero() && !end.IsZero() && end.Before(start) {
+		err = errors.New("Export end time before start time")
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// Make sure metrics store is up
+	if metricStore == nil {
+		err := errors.New("Metrics store not available")
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+
+	// Select store to export; defaults to the active scenario store
+	storeName := query.Get("store")
+	if storeName == "" {
+		if activeScenarioName == "" {
+			err := errors.New("No active scenario to export metrics from")
+			log.Error(err.Error())
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		storeName = activeScenarioName
+	} else {
+		// Only stores with recorded run information may be exported
+		_, err = metricStore.GetStoreRunInfo(storeName)
+		if err != nil {
+			log.Error(err.Error())
+			if ms.IsRunNotFoundError(err) {
+				http.Error(w, err.Error(), http.StatusNotFound)
+			} else {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+			}
+			return
+		}
+	}
+
+	// Stream export archive; errors after this point can only truncate the download
+	w.Header().Set("Content-Type", "application/zip")
+	w.Header().Set("Content-Disposition", "attachment; filename=\"metrics-"+storeName+".zip\"")
+	w.WriteHeader(http.StatusOK)
+	if storeName == activeScenarioName {
+		err = metricStore.ExportArchive(w, "", format, start, end)
+	} else {
+		err = metricStore.ExportArchive(w, storeName, format, start, end)
+	}
+	if err != nil {
+		log.Error("Failed to export metrics: ", err.Error())
+	}
+}
+
+// parseExportTime - Parse optional RFC3339 export time range boundary
+func parseExportTime(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	t, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		return time.Time{}, errors.New("Invalid export time: " + value)
+	}
+	return t, nil
+}
+
+// validateQueryParams - Validate subscription query parameters
+func validateQueryParams(metric string, tags []Tag, fields []string, scope *Scope) error {
+	tagMap := make(map[string]string)
+	for _, tag := range tags {
+		tagMap[tag.Name] = tag.Value
+	}
+	duration := ""
+	limit := 0
+	if scope != nil {
+		duration = scope.Duration
+		limit = int(scope.Limit)
+	}
+	return ms.ValidateQuery(metric, tagMap, fields, duration, limit)
+}
+
+// getQueryErrorStatus - Get HTTP status code for a metric store query error
+func getQueryErrorStatus(err error) int {
+	if ms.IsInvalidQueryError(err) {
+		return http.StatusBadRequest
+	}
+	return http.StatusInternalServerError
+}
+
 func createEventSubscription(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
 	var response EventSubscription
@@ -562,14 +821,35 @@ func createEventSubscription(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
+	if eventSubscriptionParams.CallbackReference == nil {
+		log.Error("Missing callback reference")
+		http.Error(w, "Missing callback reference", http.StatusBadRequest)
+		return
+	}
+	if eventSubscriptionParams.SubscriptionType != subTypePeriod && eventSubscriptionParams.SubscriptionType != subTypeChange {
+		log.Error("SubscriptionType unknown")
+		http.Error(w, "SubscriptionType unknown", http.StatusBadRequest)
+		return
+	}
+	if eventSubscriptionParams.EventQueryParams != nil && eventSubscriptionParams.EventQueryParams.Aggregation != nil {
+		log.Error("Aggregation not supported in subscriptions")
+		http.Error(w, "Aggregation not supported in subscriptions", http.StatusBadRequest)
+		return
+	}
+	if eventSubscriptionParams.EventQueryParams != nil {
+		params := eventSubscriptionParams.EventQueryParams
+		err = validateQueryParams(ms.EvMetName, params.Tags, params.Fields, params.Scope)
+		if err != nil {
+			log.Error(err.Error())
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
 
-	newSubsId := nextEventSubscriptionIdAvailable
-	nextEventSubscriptionIdAvailable++
-	subsIdStr := strconv.Itoa(newSubsId)
+	subsIdStr := subMgr.AllocateId(typeEventSubscription)
 
 	err = registerEvent(eventSubscriptionParams, subsIdStr)
 	if err != nil {
-		nextEventSubscriptionIdAvailable--
 		log.Error(err.Error())
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
@@ -583,7 +863,7 @@ func createEventSubscription(w http.ResponseWriter, r *http.Request) {
 	response.CallbackReference = eventSubscriptionParams.CallbackReference
 	response.EventQueryParams = eventSubscriptionParams.EventQueryParams
 
-	_ = rc.JSONSetEntry(baseKey+typeEventSubscription+":"+subsIdStr, ".", convertEventSubscriptionToJson(&response))
+	_ = setSubscription(typeEventSubscription, subsIdStr, response.ResourceURL, response.CallbackReference.NotifyURL, convertEventSubscriptionToJson(&response))
 
 	jsonResponse, err := json.Marshal(response)
 	if err != nil {
@@ -607,14 +887,36 @@ func createNetworkSubscription(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
+	if networkSubscriptionParams.CallbackReference == nil {
+		log.Error("Missing callback reference")
+		http.Error(w, "Missing callback reference", http.StatusBadRequest)
+		return
+	}
+	err = validateNetworkTrigger(networkSubscriptionParams.SubscriptionType, networkSubscriptionParams.Trigger)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if networkSubscriptionParams.NetworkQueryParams != nil && networkSubscriptionParams.NetworkQueryParams.Aggregation != nil {
+		log.Error("Aggregation not supported in subscriptions")
+		http.Error(w, "Aggregation not supported in subscriptions", http.StatusBadRequest)
+		return
+	}
+	if networkSubscriptionParams.NetworkQueryParams != nil {
+		params := networkSubscriptionParams.NetworkQueryParams
+		err = validateQueryParams(ms.NetMetName, params.Tags, params.Fields, params.Scope)
+		if err != nil {
+			log.Error(err.Error())
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
 
-	newSubsId := nextNetworkSubscriptionIdAvailable
-	nextNetworkSubscriptionIdAvailable++
-	subsIdStr := strconv.Itoa(newSubsId)
+	subsIdStr := subMgr.AllocateId(typeNetworkSubscription)
 
 	err = registerNetwork(networkSubscriptionParams, subsIdStr)
 	if err != nil {
-		nextNetworkSubscriptionIdAvailable--
 		log.Error(err.Error())
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
@@ -627,8 +929,9 @@ func createNetworkSubscription(w http.ResponseWriter, r *http.Request) {
 	response.ClientCorrelator = networkSubscriptionParams.ClientCorrelator
 	response.CallbackReference = networkSubscriptionParams.CallbackReference
 	response.NetworkQueryParams = networkSubscriptionParams.NetworkQueryParams
+	response.Trigger = networkSubscriptionParams.Trigger
 
-	_ = rc.JSONSetEntry(baseKey+typeNetworkSubscription+":"+subsIdStr, ".", convertNetworkSubscriptionToJson(&response))
+	_ = setSubscription(typeNetworkSubscription, subsIdStr, response.ResourceURL, response.CallbackReference.NotifyURL, convertNetworkSubscriptionToJson(&response))
 
 	jsonResponse, err := json.Marshal(response)
 	if err != nil {
@@ -640,6 +943,54 @@ func createNetworkSubscription(w http.ResponseWriter, r *http.Request) {
 	fmt.Fprintf(w, string(jsonResponse))
 }
 
+func setSubscription(subType string, subsIdStr string, resourceUrl string, notifyUrl string, jsonSub string) error {
+	subCfg := &subs.SubscriptionCfg{
+		Id:        subsIdStr,
+		Type:      subType,
+		Self:      resourceUrl,
+		NotifyUrl: notifyUrl,
+	}
+	_, err := subMgr.SetSubscription(subCfg, jsonSub)
+	if err != nil {
+		log.Error(err.Error())
+	}
+	return err
+}
+
+// legacySubscriptionCb - converts a subscription stored by a previous metrics engine version
+func legacySubscriptionCb(subType string, subsIdStr string, jsonSub string) (*subs.SubscriptionCfg, error) {
+	// Network & event subscriptions share the same resource URL & callback fields
+	var subscription struct {
+		CallbackReference *struct {
+			NotifyURL string `json:"notifyURL"`
+		} `json:"callbackReference,omitempty"`
+		ResourceURL string `json:"resourceURL,omitempty"`
+	}
+	err := json.Unmarshal([]byte(jsonSub), &subscription)
+	if err != nil {
+		return nil, err
+	}
+	subCfg := &subs.SubscriptionCfg{
+		Id:   subsIdStr,
+		Type: subType,
+		Self: subscription.ResourceURL,
+	}
+	if subscription.CallbackReference != nil {
+		subCfg.NotifyUrl = subscription.CallbackReference.NotifyURL
+	}
+	return subCfg, nil
+}
+
+func forEachSubscription(subType string, handler func(string, string, interface{}) error, userData interface{}) error {
+	for _, sub := range subMgr.GetSubscriptions(subType) {
+		err := handler(sub.Cfg.Id, sub.JsonSubOrig, userData)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func populateEventList(key string, jsonInfo string, userData interface{}) error {
 	subList := userData.(*EventSubscriptionList)
 	var subInfo EventSubscription
@@ -671,7 +1022,9 @@ func deregisterEvent(subsId string) bool {
 	if eventRegistration == nil {
 		return false
 	}
-	eventRegistration.ticker.Stop()
+	if eventRegistration.ticker != nil {
+		eventRegistration.ticker.Stop()
+	}
 	eventSubscriptionMap[subsId] = nil
 	return true
 }
@@ -681,7 +1034,9 @@ func deregisterNetwork(subsId string) bool {
 	if networkRegistration == nil {
 		return false
 	}
-	networkRegistration.ticker.Stop()
+	if networkRegistration.ticker != nil {
+		networkRegistration.ticker.Stop()
+	}
 	networkSubscriptionMap[subsId] = nil
 	return true
 }
@@ -706,11 +1061,9 @@ func sendEventNotification(notifyUrl string, ctx context.Context, subscriptionId
 		return
 	}
 
-	_, err = client.NotificationsApi.PostEventNotification(ctx, subscriptionId, notification)
-	if err != nil {
-		log.Error(err)
-		return
-	}
+	_ = subMgr.SendNotification(typeEventSubscription, subscriptionId, notifyUrl, func() (*http.Response, error) {
+		return client.NotificationsApi.PostEventNotification(ctx, subscriptionId, notification)
+	})
 }
 
 func sendNetworkNotification(notifyUrl string, ctx context.Context, subscriptionId string, notification clientv2.NetworkNotification) {
@@ -720,11 +1073,9 @@ func sendNetworkNotification(notifyUrl string, ctx context.Context, subscription
 		return
 	}
 
-	_, err = client.NotificationsApi.PostNetworkNotification(ctx, subscriptionId, notification)
-	if err != nil {
-		log.Error(err)
-		return
-	}
+	_ = subMgr.SendNotification(typeNetworkSubscription, subscriptionId, notifyUrl, func() (*http.Response, error) {
+		return client.NotificationsApi.PostNetworkNotification(ctx, subscriptionId, notification)
+	})
 }
 
 func processEventNotification(subsId string) {
@@ -734,37 +1085,81 @@ func processEventNotification(subsId string) {
 		return
 	}
 
+	response, _ := getEventMetricList(eventRegistration)
+
+	// Only notify new events for change subscriptions
+	if eventRegistration.params.SubscriptionType == subTypeChange {
+		if !filterNewEvents(eventRegistration, response) {
+			return
+		}
+	}
+
+	var eventNotif clientv2.EventNotification
+	eventNotif.CallbackData = eventRegistration.params.ClientCorrelator
+	eventNotif.EventMetricList = response
+	go sendEventNotification(eventRegistration.params.CallbackReference.NotifyURL, context.TODO(), subsId, eventNotif)
+}
+
+func getEventMetricList(eventRegistration *EventRegistration) (*clientv2.EventMetricList, error) {
 	var response clientv2.EventMetricList
 	response.Name = "event metrics"
 
 	// Get metrics
-	if metricStore != nil {
-		valuesArray, err := metricStore.GetInfluxMetric(
-			metricEvent,
-			eventRegistration.requestedTags,
-			eventRegistration.params.EventQueryParams.Fields,
-			eventRegistration.params.EventQueryParams.Scope.Duration,
-			int(eventRegistration.params.EventQueryParams.Scope.Limit))
-
-		if err == nil {
-			response.Columns = append(eventRegistration.params.EventQueryParams.Fields, "time")
-			response.Values = make([]clientv2.EventMetric, len(valuesArray))
-			for index, values := range valuesArray {
-				metric := &response.Values[index]
-				metric.Time = values["time"].(string)
-				if values[ms.EvMetEvent] != nil {
-					if val, ok := values[ms.EvMetEvent].(string); ok {
-						metric.Event = val
-					}
-				}
+	if metricStore == nil {
+		return &response, errors.New("Metric store not ready")
+	}
+	valuesArray, err := metricStore.GetInfluxMetric(
+		metricEvent,
+		eventRegistration.requestedTags,
+		eventRegistration.params.EventQueryParams.Fields,
+		eventRegistration.params.EventQueryParams.Scope.Duration,
+		int(eventRegistration.params.EventQueryParams.Scope.Limit))
+	if err != nil {
+		return &response, err
+	}
+
+	response.Columns = append(eventRegistration.params.EventQueryParams.Fields, "time")
+	response.Values = make([]clientv2.EventMetric, len(valuesArray))
+	for index, values := range valuesArray {
+		metric := &response.Values[index]
+		metric.Time = values["time"].(string)
+		if values[ms.EvMetEvent] != nil {
+			if val, ok := values[ms.EvMetEvent].(string); ok {
+				metric.Event = val
 			}
 		}
 	}
+	return &response, nil
+}
 
-	var eventNotif clientv2.EventNotification
-	eventNotif.CallbackData = eventRegistration.params.ClientCorrelator
-	eventNotif.EventMetricList = &response
-	go sendEventNotification(eventRegistration.params.CallbackReference.NotifyURL, context.TODO(), subsId, eventNotif)
+// filterNewEvents - Keep only events recorded since the last evaluation; returns true if any
+func filterNewEvents(eventRegistration *EventRegistration, response *clientv2.EventMetricList) bool {
+	if len(response.Values) == 0 {
+		return false
+	}
+
+	// Events are sorted from newest to oldest; first evaluation only sets the reference
+	lastEventTime := eventRegistration.lastEventTime
+	eventRegistration.lastEventTime = response.Values[0].Time
+	if lastEventTime == "" {
+		return false
+	}
+	last, err := time.Parse(time.RFC3339Nano, lastEventTime)
+	if err != nil {
+		log.Error(err.Error())
+		return false
+	}
+
+	var newEvents []clientv2.EventMetric
+	for _, metric := range response.Values {
+		t, err := time.Parse(time.RFC3339Nano, metric.Time)
+		if err != nil || !t.After(last) {
+			break
+		}
+		newEvents = append(newEvents, metric)
+	}
+	response.Values = newEvents
+	return len(newEvents) != 0
 }
 
 func processNetworkNotification(subsId string) {
@@ -774,47 +1169,77 @@ func processNetworkNotification(subsId string) {
 		return
 	}
 
+	response, valuesArray := getNetworkMetricList(networkRegistration)
+
+	var networkNotif clientv2.NetworkNotification
+	networkNotif.CallbackData = networkRegistration.params.ClientCorrelator
+	networkNotif.NetworkMetricList = response
+
+	// Evaluate trigger on latest value for threshold & change subscriptions
+	if networkRegistration.params.SubscriptionType != subTypePeriod {
+		if len(valuesArray) == 0 {
+			return
+		}
+		trigger := networkRegistration.params.Trigger
+		value, found := getNetworkMetricValue(valuesArray[0], trigger.Field)
+		if !found {
+			return
+		}
+		state, refValue, notify := networkRegistration.triggerState.Evaluate(networkRegistration.params.SubscriptionType, trigger, value)
+		if !notify {
+			return
+		}
+		networkNotif.Trigger = &clientv2.TriggerInfo{
+			Field:    trigger.Field,
+			State:    state,
+			Value:    value,
+			RefValue: refValue,
+		}
+	}
+
+	go sendNetworkNotification(networkRegistration.params.CallbackReference.NotifyURL, context.TODO(), subsId, networkNotif)
+}
+
+func getNetworkMetricList(networkRegistration *NetworkRegistration) (*clientv2.NetworkMetricList, []map[string]interface{}) {
 	var response clientv2.NetworkMetricList
 	response.Name = "network metrics"
 
 	// Get metrics
-	if metricStore != nil {
-		valuesArray, err := metricStore.GetInfluxMetric(
-			metricNetwork,
-			networkRegistration.requestedTags,
-			networkRegistration.params.NetworkQueryParams.Fields,
-			networkRegistration.params.NetworkQueryParams.Scope.Duration,
-			int(networkRegistration.params.NetworkQueryParams.Scope.Limit))
-
-		if err == nil {
-			response.Columns = append(networkRegistration.params.NetworkQueryParams.Fields, "time")
-			response.Values = make([]clientv2.NetworkMetric, len(valuesArray))
-			for index, values := range valuesArray {
-				metric := &response.Values[index]
-				metric.Time = values["time"].(string)
-				if values[ms.NetMetLatency] != nil {
-					metric.Lat = ms.JsonNumToInt32(values[ms.NetMetLatency].(json.Number))
-				}
-				if values[ms.NetMetULThroughput] != nil {
-					metric.Ul = ms.JsonNumToFloat64(values[ms.NetMetULThroughput].(json.Number))
-				}
-				if values[ms.NetMetDLThroughput] != nil {
-					metric.Dl = ms.JsonNumToFloat64(values[ms.NetMetDLThroughput].(json.Number))
-				}
-				if values[ms.NetMetULPktLoss] != nil {
-					metric.Ulos = ms.JsonNumToFloat64(values[ms.NetMetULPktLoss].(json.Number))
-				}
-				if values[ms.NetMetDLPktLoss] != nil {
-					metric.Dlos = ms.JsonNumToFloat64(values[ms.NetMetDLPktLoss].(json.Number))
-				}
-			}
-		}
+	if metricStore == nil {
+		return &response, nil
+	}
+	valuesArray, err := metricStore.GetInfluxMetric(
+		metricNetwork,
+		networkRegistration.requestedTags,
+		networkRegistration.queryFields,
+		networkRegistration.params.NetworkQueryParams.Scope.Duration,
+		int(networkRegistration.params.NetworkQueryParams.Scope.Limit))
+	if err != nil {
+		return &response, nil
 	}
 
-	var networkNotif clientv2.NetworkNotification
-	networkNotif.CallbackData = networkRegistration.params.ClientCorrelator
-	networkNotif.NetworkMetricList = &response
-	go sendNetworkNotification(networkRegistration.params.CallbackReference.NotifyURL, context.TODO(), subsId, networkNotif)
+	response.Columns = append(append([]string{}, networkRegistration.queryFields...), "time")
+	response.Values = make([]clientv2.NetworkMetric, len(valuesArray))
+	for index, values := range valuesArray {
+		metric := &response.Values[index]
+		metric.Time = values["time"].(string)
+		if values[ms.NetMetLatency] != nil {
+			metric.Lat = ms.JsonNumToInt32(values[ms.NetMetLatency].(json.Number))
+		}
+		if values[ms.NetMetULThroughput] != nil {
+			metric.Ul = ms.JsonNumToFloat64(values[ms.NetMetULThroughput].(json.Number))
+		}
+		if values[ms.NetMetDLThroughput] != nil {
+			metric.Dl = ms.JsonNumToFloat64(values[ms.NetMetDLThroughput].(json.Number))
+		}
+		if values[ms.NetMetULPktLoss] != nil {
+			metric.Ulos = ms.JsonNumToFloat64(values[ms.NetMetULPktLoss].(json.Number))
+		}
+		if values[ms.NetMetDLPktLoss] != nil {
+			metric.Dlos = ms.JsonNumToFloat64(values[ms.NetMetDLPktLoss].(json.Number))
+		}
+	}
+	return &response, valuesArray
 }
 
 func registerEvent(params *EventSubscriptionParams, subsId string) (err error) {
@@ -823,64 +1248,73 @@ func registerEvent(params *EventSubscriptionParams, subsId string) (err error) {
 		return err
 	}
 
-	//only support one type of registration for now
 	switch params.SubscriptionType {
-	case ("period"):
-		if params.EventQueryParams.Scope == nil {
-			var scope Scope
-			scope.Limit = defaultLimit
-			scope.Duration = defaultDuration
-			params.EventQueryParams.Scope = &scope
-		} else {
-			if params.EventQueryParams.Scope.Duration == "" {
-				params.EventQueryParams.Scope.Duration = defaultDuration
-			}
-			if params.EventQueryParams.Scope.Limit == 0 {
-				params.EventQueryParams.Scope.Limit = defaultLimit
-			}
-		}
+	case subTypePeriod, subTypeChange:
+	default:
+		err = errors.New("SubscriptionType unknown")
+		return err
+	}
 
-		var eventRegistration EventRegistration
-		if params.Period != 0 {
-			ticker := time.NewTicker(time.Duration(params.Period) * time.Second)
-			eventRegistration.ticker = ticker
+	if params.EventQueryParams == nil {
+		params.EventQueryParams = new(EventQueryParams)
+	}
+	if params.EventQueryParams.Scope == nil {
+		var scope Scope
+		scope.Limit = defaultLimit
+		scope.Duration = defaultDuration
+		params.EventQueryParams.Scope = &scope
+	} else {
+		if params.EventQueryParams.Scope.Duration == "" {
+			params.EventQueryParams.Scope.Duration = defaultDuration
+		}
+		if params.EventQueryParams.Scope.Limit == 0 {
+			params.EventQueryParams.Scope.Limit = defaultLimit
 		}
-		eventRegistration.params = params
+	}
 
-		//read the json tags and store for quicker access
-		tags := make(map[string]string)
+	// Change subscriptions are always evaluated
+	period := params.Period
+	if period == 0 && params.SubscriptionType == subTypeChange {
+		period = defaultTriggerPeriod
+	}
 
-		for _, tag := range params.EventQueryParams.Tags {
-			//extracting name: and value: into a string
-			jsonInfo, err := json.Marshal(tag)
-			if err != nil {
-				log.Error(err.Error())
-				return err
-			}
-			var tmpTags map[string]string
-			//storing the tag in a temporary map to use the values
-			err = json.Unmarshal([]byte(jsonInfo), &tmpTags)
-			if err != nil {
-				log.Error(err.Error())
-				return err
-			}
-			tags[tmpTags["name"]] = tmpTags["value"]
+	var eventRegistration EventRegistration
+	if period != 0 {
+		ticker := time.NewTicker(time.Duration(period) * time.Second)
+		eventRegistration.ticker = ticker
+	}
+	eventRegistration.params = params
+
+	//read the json tags and store for quicker access
+	tags := make(map[string]string)
+
+	for _, tag := range params.EventQueryParams.Tags {
+		//extracting name: and value: into a string
+		jsonInfo, err := json.Marshal(tag)
+		if err != nil {
+			log.Error(err.Error())
+			return err
 		}
-		eventRegistration.requestedTags = tags
-		eventSubscriptionMap[subsId] = &eventRegistration
-
-		if params.Period != 0 {
-			go func() {
-				for range eventRegistration.ticker.C {
-					processEventNotification(subsId)
-				}
-			}()
+		var tmpTags map[string]string
+		//storing the tag in a temporary map to use the values
+		err = json.Unmarshal([]byte(jsonInfo), &tmpTags)
+		if err != nil {
+			log.Error(err.Error())
+			return err
 		}
-		return nil
-	default:
+		tags[tmpTags["name"]] = tmpTags["value"]
 	}
-	err = errors.New("SubscriptionType unknown")
-	return err
+	eventRegistration.requestedTags = tags
+	eventSubscriptionMap[subsId] = &eventRegistration
+
+	if period != 0 {
+		go func() {
+			for range eventRegistration.ticker.C {
+				processEventNotification(subsId)
+			}
+		}()
+	}
+	return nil
 }
 
 func registerNetwork(params *NetworkSubscriptionParams, subsId string) (err error) {
@@ -889,72 +1323,78 @@ func registerNetwork(params *NetworkSubscriptionParams, subsId string) (err erro
 		return err
 	}
 
-	//only support one type of registration for now
-	switch params.SubscriptionType {
-	case ("period"):
-
-		if params.NetworkQueryParams.Scope == nil {
-			var scope Scope
-			scope.Limit = defaultLimit
-			scope.Duration = defaultDuration
-			params.NetworkQueryParams.Scope = &scope
-		} else {
-			if params.NetworkQueryParams.Scope.Duration == "" {
-				params.NetworkQueryParams.Scope.Duration = defaultDuration
-			}
-			if params.NetworkQueryParams.Scope.Limit == 0 {
-				params.NetworkQueryParams.Scope.Limit = defaultLimit
-			}
-		}
+	err = validateNetworkTrigger(params.SubscriptionType, params.Trigger)
+	if err != nil {
+		return err
+	}
 
-		var networkRegistration NetworkRegistration
-		if params.Period != 0 {
-			ticker := time.NewTicker(time.Duration(params.Period) * time.Second)
-			networkRegistration.ticker = ticker
+	if params.NetworkQueryParams == nil {
+		params.NetworkQueryParams = new(NetworkQueryParams)
+	}
+	if params.NetworkQueryParams.Scope == nil {
+		var scope Scope
+		scope.Limit = defaultLimit
+		scope.Duration = defaultDuration
+		params.NetworkQueryParams.Scope = &scope
+	} else {
+		if params.NetworkQueryParams.Scope.Duration == "" {
+			params.NetworkQueryParams.Scope.Duration = defaultDuration
 		}
-		networkRegistration.params = params
-		//read the json tags and store for quicker access
-		tags := make(map[string]string)
-
-		for _, tag := range params.NetworkQueryParams.Tags {
-			//extracting name: and value: into a string
-			jsonInfo, err := json.Marshal(tag)
-			if err != nil {
-				log.Error(err.Error())
-				return err
-			}
-			var tmpTags map[string]string
-			//storing the tag in a temporary map to use the values
-			err = json.Unmarshal([]byte(jsonInfo), &tmpTags)
-			if err != nil {
-				log.Error(err.Error())
-				return err
-			}
-			tags[tmpTags["name"]] = tmpTags["value"]
+		if params.NetworkQueryParams.Scope.Limit == 0 {
+			params.NetworkQueryParams.Scope.Limit = defaultLimit
 		}
-		networkRegistration.requestedTags = tags
-		networkSubscriptionMap[subsId] = &networkRegistration
-
-		if params.Period != 0 {
-			go func() {
-				for range networkRegistration.ticker.C {
-					processNetworkNotification(subsId)
-				}
-			}()
+	}
+
+	// Threshold & change subscriptions are always evaluated and must query the trigger field
+	period := params.Period
+	if params.SubscriptionType != subTypePeriod && period == 0 {
+		period = defaultTriggerPeriod
+	}
+
+	var networkRegistration NetworkRegistration
+	networkRegistration.queryFields = getNetworkQueryFields(params.SubscriptionType, params.NetworkQueryParams.Fields, params.Trigger)
+	if period != 0 {
+		ticker := time.NewTicker(time.Duration(period) * time.Second)
+		networkRegistration.ticker = ticker
+	}
+	networkRegistration.params = params
+	//read the json tags and store for quicker access
+	tags := make(map[string]string)
+
+	for _, tag := range params.NetworkQueryParams.Tags {
+		//extracting name: and value: into a string
+		jsonInfo, err := json.Marshal(tag)
+		if err != nil {
+			log.Error(err.Error())
+			return err
 		}
-		return nil
-	default:
+		var tmpTags map[string]string
+		//storing the tag in a temporary map to use the values
+		err = json.Unmarshal([]byte(jsonInfo), &tmpTags)
+		if err != nil {
+			log.Error(err.Error())
+			return err
+		}
+		tags[tmpTags["name"]] = tmpTags["value"]
 	}
-	err = errors.New("SubscriptionType unknown")
-	return err
+	networkRegistration.requestedTags = tags
+	networkSubscriptionMap[subsId] = &networkRegistration
+
+	if period != 0 {
+		go func() {
+			for range networkRegistration.ticker.C {
+				processNetworkNotification(subsId)
+			}
+		}()
+	}
+	return nil
 }
 
 func getEventSubscription(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
 	var response EventSubscriptionList
 
-	keyName := baseKey + typeEventSubscription + "*"
-	_ = rc.ForEachJSONEntry(keyName, populateEventList, &response)
+	_ = forEachSubscription(typeEventSubscription, populateEventList, &response)
 
 	response.ResourceURL = hostUrl.String() + basePath + "metrics/subscriptions/event"
 	jsonResponse, err := json.Marshal(response)
@@ -971,8 +1411,7 @@ func getNetworkSubscription(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
 	var response NetworkSubscriptionList
 
-	keyName := baseKey + typeNetworkSubscription + "*"
-	_ = rc.ForEachJSONEntry(keyName, populateNetworkList, &response)
+	_ = forEachSubscription(typeNetworkSubscription, populateNetworkList, &response)
 
 	response.ResourceURL = hostUrl.String() + basePath + "metrics/subscriptions/network"
 	jsonResponse, err := json.Marshal(response)
@@ -989,11 +1428,12 @@ func getEventSubscriptionById(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
 	vars := mux.Vars(r)
 
-	jsonResponse, _ := rc.JSONGetEntry(baseKey+typeEventSubscription+":"+vars["subscriptionId"], ".")
-	if jsonResponse == "" {
+	sub := subMgr.GetSubscription(typeEventSubscription, vars["subscriptionId"])
+	if sub == nil {
 		w.WriteHeader(http.StatusNotFound)
 		return
 	}
+	jsonResponse := sub.JsonSubOrig
 
 	w.WriteHeader(http.StatusOK)
 	fmt.Fprintf(w, string(jsonResponse))
@@ -1003,11 +1443,12 @@ func getNetworkSubscriptionById(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
 	vars := mux.Vars(r)
 
-	jsonResponse, _ := rc.JSONGetEntry(baseKey+typeNetworkSubscription+":"+vars["subscriptionId"], ".")
-	if jsonResponse == "" {
+	sub := subMgr.GetSubscription(typeNetworkSubscription, vars["subscriptionId"])
+	if sub == nil {
 		w.WriteHeader(http.StatusNotFound)
 		return
 	}
+	jsonResponse := sub.JsonSubOrig
 
 	w.WriteHeader(http.StatusOK)
 	fmt.Fprintf(w, string(jsonResponse))
@@ -1017,10 +1458,13 @@ func deleteEventSubscriptionById(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
 	vars := mux.Vars(r)
 
-	err := rc.JSONDelEntry(baseKey+typeEventSubscription+":"+vars["subscriptionId"], ".")
-	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
-		return
+	sub := subMgr.GetSubscription(typeEventSubscription, vars["subscriptionId"])
+	if sub != nil {
+		err := subMgr.DeleteSubscription(sub)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
 	}
 
 	found := deregisterEvent(vars["subscriptionId"])
@@ -1035,10 +1479,13 @@ func deleteNetworkSubscriptionById(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
 	vars := mux.Vars(r)
 
-	err := rc.JSONDelEntry(baseKey+typeNetworkSubscription+":"+vars["subscriptionId"], ".")
-	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
-		return
+	sub := subMgr.GetSubscription(typeNetworkSubscription, vars["subscriptionId"])
+	if sub != nil {
+		err := subMgr.DeleteSubscription(sub)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
 	}
 
 	found := deregisterNetwork(vars["subscriptionId"])
@@ -1053,38 +1500,26 @@ func networkSubscriptionReInit() {
 	//reusing the object response for the get multiple zonalSubscription
 	var responseList NetworkSubscriptionList
 
-	keyName := baseKey + typeNetworkSubscription + "*"
-	_ = rc.ForEachJSONEntry(keyName, populateNetworkList, &responseList)
+	_ = forEachSubscription(typeNetworkSubscription, populateNetworkList, &responseList)
 
-	maxSubscriptionId := 0
 	for _, response := range responseList.NetworkSubscription {
 		var networkSubscriptionParams NetworkSubscriptionParams
 		networkSubscriptionParams.ClientCorrelator = response.ClientCorrelator
 		networkSubscriptionParams.CallbackReference = response.CallbackReference
 		networkSubscriptionParams.NetworkQueryParams = response.NetworkQueryParams
+		networkSubscriptionParams.Trigger = response.Trigger
 		networkSubscriptionParams.Period = response.Period
 		networkSubscriptionParams.SubscriptionType = response.SubscriptionType
-		subscriptionId, err := strconv.Atoi(response.SubscriptionId)
-		if err != nil {
-			log.Error(err)
-		} else {
-			if subscriptionId > maxSubscriptionId {
-				maxSubscriptionId = subscriptionId
-			}
-			_ = registerNetwork(&networkSubscriptionParams, response.SubscriptionId)
-		}
+		_ = registerNetwork(&networkSubscriptionParams, response.SubscriptionId)
 	}
-	nextNetworkSubscriptionIdAvailable = maxSubscriptionId + 1
 }
 
 func eventSubscriptionReInit() {
 	//reusing the object response for the get multiple zonalSubscription
 	var responseList EventSubscriptionList
 
-	keyName := baseKey + typeEventSubscription + "*"
-	_ = rc.ForEachJSONEntry(keyName, populateEventList, &responseList)
+	_ = forEachSubscription(typeEventSubscription, populateEventList, &responseList)
 
-	maxSubscriptionId := 0
 	for _, response := range responseList.EventSubscription {
 		var eventSubscriptionParams EventSubscriptionParams
 		eventSubscriptionParams.ClientCorrelator = response.ClientCorrelator
@@ -1092,15 +1527,6 @@ func eventSubscriptionReInit() {
 		eventSubscriptionParams.EventQueryParams = response.EventQueryParams
 		eventSubscriptionParams.Period = response.Period
 		eventSubscriptionParams.SubscriptionType = response.SubscriptionType
-		subscriptionId, err := strconv.Atoi(response.SubscriptionId)
-		if err != nil {
-			log.Error(err)
-		} else {
-			if subscriptionId > maxSubscriptionId {
-				maxSubscriptionId = subscriptionId
-			}
-			_ = registerEvent(&eventSubscriptionParams, response.SubscriptionId)
-		}
+		_ = registerEvent(&eventSubscriptionParams, response.SubscriptionId)
 	}
-	nextEventSubscriptionIdAvailable = maxSubscriptionId + 1
 }
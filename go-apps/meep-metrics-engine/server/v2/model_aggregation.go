@@ -0,0 +1,38 @@
+/*
+ * Copyright (c) 2020  InterDigital Communications, Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the \"License\");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an \"AS IS\" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * AdvantEDGE Metrics Service REST API
+ *
+ * Metrics Service provides metrics about the active scenario <p>**Micro-service**<br>[meep-metrics-engine](https://github.com/InterDigitalInc/AdvantEDGE/tree/master/go-apps/meep-metrics-engine) <p>**Type & Usage**<br>Platform Service used by control/monitoring software and possibly by edge applications that require metrics <p>**Details**<br>API details available at _your-AdvantEDGE-ip-address/api_
+ *
+ * API version: 1.0.0
+ * Contact: AdvantEDGE@InterDigital.com
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+
+package server
+
+// Aggregation applied to queried fields
+type Aggregation struct {
+
+	// Aggregation functions to apply to each queried field. Supported values:<br> <li>mean: Mean value<br> <li>min: Minimum value<br> <li>max: Maximum value<br> <li>p50: 50th percentile<br> <li>p95: 95th percentile<br> <li>p99: 99th percentile<br> <li>stddev: Standard deviation<br> <li>count: Number of values<br> <li>sum: Sum of values
+	Functions []string `json:"functions,omitempty"`
+
+	// Time bucket size used to group values (specify s for seconds, m for minutes, h for hours); values are aggregated over the whole query scope if not set. Requires a scope duration.
+	Interval string `json:"interval,omitempty"`
+
+	// Tag names used to group values; one series is returned per distinct tag value combination
+	GroupBy []string `json:"groupBy,omitempty"`
+}
@@ -34,4 +34,6 @@ type EventQueryParams struct {
 	Fields []string `json:"fields,omitempty"`
 
 	Scope *Scope `json:"scope,omitempty"`
+
+	Aggregation *Aggregation `json:"aggregation,omitempty"`
 }
@@ -40,9 +40,9 @@ type EventSubscription struct {
 
 	EventQueryParams *EventQueryParams `json:"eventQueryParams,omitempty"`
 
-	// Notification interval in seconds, disabled if set to 0
+	// Notification interval in seconds, disabled if set to 0. Event polling interval for change subscriptions (default 1s)
 	Period int32 `json:"period,omitempty"`
 
-	// Type of subscription triggering notifications
+	// Type of subscription triggering notifications:<br> <li>period: Periodic notifications <li>change: Notification when new events are recorded
 	SubscriptionType string `json:"subscriptionType,omitempty"`
 }
@@ -59,4 +59,13 @@ type HttpMetric struct {
 
 	// Http type
 	Direction string `json:"direction,omitempty"`
+
+	// Http metrics request headers (JSON object)
+	ReqHeaders string `json:"req_headers,omitempty"`
+
+	// Http metrics response headers (JSON object)
+	RespHeaders string `json:"resp_headers,omitempty"`
+
+	// Correlation identifier shared by the sent notification or request & its received counterpart
+	CorrelationId string `json:"correlation_id,omitempty"`
 }
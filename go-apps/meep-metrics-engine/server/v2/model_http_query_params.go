@@ -30,8 +30,10 @@ type HttpQueryParams struct {
 	// Tag names to match in query. Supported values:<br> <li>logger_name: Logger instances that issued the http notification or processed the request <li>direction: Notification or Request type of http metric
 	Tags []Tag `json:"tags,omitempty"`
 
-	// Field names to return in query response. Supported values:<br> <li>id: Http metrics identifier<br> <li>endpoint: Http metrics queried endpoint<br> <li>url: Http metrics queried endpoint with query parameters<br> <li>method: Http metrics method<br> <li>resp_code: Http metrics response status code<br> <li>resp_body: Http metrics response body<br> <li>body: Http metrics body<br> <li>proc_time: Request processing time in ms
+	// Field names to return in query response. Supported values:<br> <li>id: Http metrics identifier<br> <li>endpoint: Http metrics queried endpoint<br> <li>url: Http metrics queried endpoint with query parameters<br> <li>method: Http metrics method<br> <li>resp_code: Http metrics response status code<br> <li>resp_body: Http metrics response body<br> <li>body: Http metrics body<br> <li>proc_time: Request processing time in ms<br> <li>proc_duration: Numerical request processing time, used for aggregations<br> <li>req_headers: Http metrics request headers<br> <li>resp_headers: Http metrics response headers<br> <li>correlation_id: Http metrics correlation identifier
 	Fields []string `json:"fields,omitempty"`
 
 	Scope *Scope `json:"scope,omitempty"`
+
+	Aggregation *Aggregation `json:"aggregation,omitempty"`
 }
@@ -34,4 +34,7 @@ type NetworkMetricList struct {
 	Columns []string `json:"columns,omitempty"`
 
 	Values []NetworkMetric `json:"values,omitempty"`
+
+	// Aggregated metrics, one series per tag group; returned instead of values when an aggregation is requested
+	Series []AggregateSeries `json:"series,omitempty"`
 }
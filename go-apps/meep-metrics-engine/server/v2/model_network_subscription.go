@@ -40,9 +40,11 @@ type NetworkSubscription struct {
 
 	NetworkQueryParams *NetworkQueryParams `json:"networkQueryParams,omitempty"`
 
-	// Notification interval in seconds, disabled if set to 0
+	Trigger *NetworkTrigger `json:"trigger,omitempty"`
+
+	// Notification interval in seconds, disabled if set to 0. Metric evaluation interval for threshold & change subscriptions (default 1s)
 	Period int32 `json:"period,omitempty"`
 
-	// Type of subscription triggering notifications
+	// Type of subscription triggering notifications:<br> <li>period: Periodic notifications <li>threshold: Notification when the trigger field crosses the threshold <li>change: Notification when the trigger field changes by more than delta
 	SubscriptionType string `json:"subscriptionType,omitempty"`
 }
@@ -34,9 +34,11 @@ type NetworkSubscriptionParams struct {
 
 	NetworkQueryParams *NetworkQueryParams `json:"networkQueryParams,omitempty"`
 
-	// Notification interval in seconds
+	Trigger *NetworkTrigger `json:"trigger,omitempty"`
+
+	// Notification interval in seconds. Metric evaluation interval for threshold & change subscriptions (default 1s)
 	Period int32 `json:"period,omitempty"`
 
-	// Type of subscription triggering notifications
+	// Type of subscription triggering notifications:<br> <li>period: Periodic notifications <li>threshold: Notification when the trigger field crosses the threshold <li>change: Notification when the trigger field changes by more than delta
 	SubscriptionType string `json:"subscriptionType,omitempty"`
 }
@@ -0,0 +1,44 @@
+/*
+ * Copyright (c) 2020  InterDigital Communications, Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the \"License\");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an \"AS IS\" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * AdvantEDGE Metrics Service REST API
+ *
+ * Metrics Service provides metrics about the active scenario <p>**Micro-service**<br>[meep-metrics-engine](https://github.com/InterDigitalInc/AdvantEDGE/tree/master/go-apps/meep-metrics-engine) <p>**Type & Usage**<br>Platform Service used by control/monitoring software and possibly by edge applications that require metrics <p>**Details**<br>API details available at _your-AdvantEDGE-ip-address/api_
+ *
+ * API version: 1.0.0
+ * Contact: AdvantEDGE@InterDigital.com
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+
+package server
+
+// Network metrics subscription trigger, used by threshold and change subscriptions
+type NetworkTrigger struct {
+
+	// Network metric field to monitor. Supported values:<br> <li>lat: Round-trip latency (ms)<br> <li>ul: Uplink throughput from src to dest (Mbps) <li>dl: Downlink throughput from dest to src (Mbps) <li>ulos: Uplink packet loss from src to dest (%) <li>dlos: Downlink packet loss from dest to src (%)
+	Field string `json:"field,omitempty"`
+
+	// Threshold crossing direction raising the trigger (threshold subscriptions only)
+	Direction string `json:"direction,omitempty"`
+
+	// Threshold value (threshold subscriptions only)
+	Threshold float64 `json:"threshold,omitempty"`
+
+	// Minimum value change triggering a notification (change subscriptions only)
+	Delta float64 `json:"delta,omitempty"`
+
+	// Hysteresis margin. Threshold subscriptions: value must move back past the threshold by this margin to clear the trigger. Change subscriptions: additional change required to notify a change in the opposite direction.
+	Hysteresis float64 `json:"hysteresis,omitempty"`
+}
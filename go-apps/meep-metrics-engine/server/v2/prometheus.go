@@ -0,0 +1,249 @@
+/*
+ * Copyright (c) 2020  InterDigital Communications, Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"bufio"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	log "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-logger"
+	ms "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-metric-store"
+)
+
+const openMetricsContentType = "application/openmetrics-text; version=1.0.0; charset=utf-8"
+const prometheusContentType = "text/plain; version=0.0.4; charset=utf-8"
+
+// Metric family types
+const metricTypeGauge = "gauge"
+const metricTypeCounter = "counter"
+const metricTypeHistogram = "histogram"
+
+// Metric labels
+const labelSandbox = "sandbox"
+const labelScenario = "scenario"
+const labelSrc = "src"
+const labelDst = "dest"
+const labelEventType = "type"
+const labelLoggerName = "logger_name"
+const labelDirection = "direction"
+const labelBucket = "le"
+
+// HTTP processing duration histogram bucket upper bounds (seconds)
+var httpDurationBuckets = []float64{0.001, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+type metricLabel struct {
+	name  string
+	value string
+}
+
+type metricSample struct {
+	suffix string
+	labels []metricLabel
+	value  float64
+}
+
+type metricFamily struct {
+	name    string
+	help    string
+	typ     string
+	samples []metricSample
+}
+
+func meGetPrometheusMetrics(w http.ResponseWriter, r *http.Request) {
+	// Use OpenMetrics format if accepted by the scraper
+	openMetrics := strings.Contains(r.Header.Get("Accept"), "application/openmetrics-text")
+
+	families := getMetricFamilies()
+
+	if openMetrics {
+		w.Header().Set("Content-Type", openMetricsContentType)
+	} else {
+		w.Header().Set("Content-Type", prometheusContentType)
+	}
+	w.WriteHeader(http.StatusOK)
+	err := writeMetricFamilies(w, families, openMetrics)
+	if err != nil {
+		log.Error("Failed to write metrics: ", err.Error())
+	}
+}
+
+// getMetricFamilies - Collect live metrics of the active scenario
+// Families are always returned; they only contain samples while a scenario is active.
+func getMetricFamilies() []*metricFamily {
+	var networkMetrics []ms.NetworkMetric
+	var eventSeries []ms.AggregateSeries
+	var httpHistograms []ms.HttpHistogram
+
+	if metricStore != nil && activeScenarioName != "" {
+		var err error
+		networkMetrics, err = metricStore.GetCachedNetworkMetrics()
+		if err != nil {
+			log.Error("Failed to get network metrics: ", err.Error())
+		}
+		agg := &ms.Aggregation{Functions: []string{ms.AggCount}, GroupBy: []string{ms.EvMetType}}
+		eventSeries, err = metricStore.GetEventMetricAggregate("", "", agg)
+		if err != nil && !ms.IsNoResultsError(err) {
+			log.Error("Failed to get event metrics: ", err.Error())
+		}
+		buckets := make([]float64, len(httpDurationBuckets))
+		for i, bucket := range httpDurationBuckets {
+			buckets[i] = bucket * 1000000
+		}
+		httpHistograms, err = metricStore.GetHttpMetricHistogram(buckets)
+		if err != nil {
+			log.Error("Failed to get http metrics: ", err.Error())
+		}
+	}
+
+	scenarioLabels := []metricLabel{{labelSandbox, sandboxName}, {labelScenario, activeScenarioName}}
+	families := getNetworkMetricFamilies(scenarioLabels, networkMetrics)
+	families = append(families, getEventMetricFamily(scenarioLabels, eventSeries))
+	families = append(families, getHttpMetricFamily(scenarioLabels, httpHistograms))
+	return families
+}
+
+// getNetworkMetricFamilies - Latest per-pair network metrics as gauges, in base units
+func getNetworkMetricFamilies(scenarioLabels []metricLabel, metrics []ms.NetworkMetric) []*metricFamily {
+	latency := &metricFamily{"advantedge_network_latency_seconds", "Round-trip latency from src to dest", metricTypeGauge, nil}
+	ulTput := &metricFamily{"advantedge_network_ul_throughput_bits_per_second", "Uplink throughput from src to dest", metricTypeGauge, nil}
+	dlTput := &metricFamily{"advantedge_network_dl_throughput_bits_per_second", "Downlink throughput from dest to src", metricTypeGauge, nil}
+	ulLoss := &metricFamily{"advantedge_network_ul_packet_loss_ratio", "Uplink packet loss from src to dest", metricTypeGauge, nil}
+	dlLoss := &metricFamily{"advantedge_network_dl_packet_loss_ratio", "Downlink packet loss from dest to src", metricTypeGauge, nil}
+
+	// Sort pairs for a deterministic exposition
+	sort.Slice(metrics, func(i, j int) bool {
+		if metrics[i].Src != metrics[j].Src {
+			return metrics[i].Src < metrics[j].Src
+		}
+		return metrics[i].Dst < metrics[j].Dst
+	})
+	for _, metric := range metrics {
+		labels := append(append([]metricLabel{}, scenarioLabels...), metricLabel{labelSrc, metric.Src}, metricLabel{labelDst, metric.Dst})
+		latency.samples = append(latency.samples, metricSample{"", labels, float64(metric.Lat) / 1000})
+		ulTput.samples = append(ulTput.samples, metricSample{"", labels, metric.UlTput * 1000000})
+		dlTput.samples = append(dlTput.samples, metricSample{"", labels, metric.DlTput * 1000000})
+		ulLoss.samples = append(ulLoss.samples, metricSample{"", labels, metric.UlLoss / 100})
+		dlLoss.samples = append(dlLoss.samples, metricSample{"", labels, metric.DlLoss / 100})
+	}
+	return []*metricFamily{latency, ulTput, dlTput, ulLoss, dlLoss}
+}
+
+// getEventMetricFamily - Number of events received by the sandbox controller per event type
+func getEventMetricFamily(scenarioLabels []metricLabel, series []ms.AggregateSeries) *metricFamily {
+	events := &metricFamily{"advantedge_events", "Number of scenario events", metricTypeCounter, nil}
+	countCol := ms.AggregateColumn(ms.AggCount, ms.EvMetEvent)
+
+	sort.Slice(series, func(i, j int) bool {
+		return series[i].Tags[ms.EvMetType] < series[j].Tags[ms.EvMetType]
+	})
+	for _, s := range series {
+		if len(s.Metrics) == 0 {
+			continue
+		}
+		labels := append(append([]metricLabel{}, scenarioLabels...), metricLabel{labelEventType, s.Tags[ms.EvMetType]})
+		events.samples = append(events.samples, metricSample{"_total", labels, s.Metrics[0].Values[countCol]})
+	}
+	return events
+}
+
+// getHttpMetricFamily - HTTP logger processing durations per logger & direction
+func getHttpMetricFamily(scenarioLabels []metricLabel, histograms []ms.HttpHistogram) *metricFamily {
+	durations := &metricFamily{"advantedge_http_request_duration_seconds", "HTTP request processing duration", metricTypeHistogram, nil}
+
+	sort.Slice(histograms, func(i, j int) bool {
+		if histograms[i].Tags[ms.HttpLoggerName] != histograms[j].Tags[ms.HttpLoggerName] {
+			return histograms[i].Tags[ms.HttpLoggerName] < histograms[j].Tags[ms.HttpLoggerName]
+		}
+		return histograms[i].Tags[ms.HttpLoggerDirection] < histograms[j].Tags[ms.HttpLoggerDirection]
+	})
+	for _, h := range histograms {
+		labels := append(append([]metricLabel{}, scenarioLabels...),
+			metricLabel{labelLoggerName, h.Tags[ms.HttpLoggerName]},
+			metricLabel{labelDirection, h.Tags[ms.HttpLoggerDirection]})
+		for i, bucket := range httpDurationBuckets {
+			var count uint64
+			if i < len(h.Buckets) {
+				count = h.Buckets[i]
+			}
+			bucketLabels := append(append([]metricLabel{}, labels...), metricLabel{labelBucket, formatMetricValue(bucket)})
+			durations.samples = append(durations.samples, metricSample{"_bucket", bucketLabels, float64(count)})
+		}
+		infLabels := append(append([]metricLabel{}, labels...), metricLabel{labelBucket, "+Inf"})
+		durations.samples = append(durations.samples, metricSample{"_bucket", infLabels, float64(h.Count)})
+		durations.samples = append(durations.samples, metricSample{"_sum", labels, h.Sum / 1000000})
+		durations.samples = append(durations.samples, metricSample{"_count", labels, float64(h.Count)})
+	}
+	return durations
+}
+
+// writeMetricFamilies - Write metric families in Prometheus text or OpenMetrics format
+func writeMetricFamilies(w io.Writer, families []*metricFamily, openMetrics bool) error {
+	bw := bufio.NewWriter(w)
+	for _, family := range families {
+		// Prometheus text format names counter families after their samples
+		name := family.name
+		if family.typ == metricTypeCounter && !openMetrics {
+			name += "_total"
+		}
+		_, _ = bw.WriteString("# HELP " + name + " " + escapeMetricText(family.help, false) + "\n")
+		_, _ = bw.WriteString("# TYPE " + name + " " + family.typ + "\n")
+		for _, sample := range family.samples {
+			_, _ = bw.WriteString(family.name + sample.suffix)
+			if len(sample.labels) != 0 {
+				_, _ = bw.WriteString("{")
+				for i, label := range sample.labels {
+					if i != 0 {
+						_, _ = bw.WriteString(",")
+					}
+					_, _ = bw.WriteString(label.name + "=\"" + escapeMetricText(label.value, true) + "\"")
+				}
+				_, _ = bw.WriteString("}")
+			}
+			_, _ = bw.WriteString(" " + formatMetricValue(sample.value) + "\n")
+		}
+	}
+	if openMetrics {
+		_, _ = bw.WriteString("# EOF\n")
+	}
+	return bw.Flush()
+}
+
+func escapeMetricText(text string, quoted bool) string {
+	text = strings.Replace(text, `\`, `\\`, -1)
+	text = strings.Replace(text, "\n", `\n`, -1)
+	if quoted {
+		text = strings.Replace(text, `"`, `\"`, -1)
+	}
+	return text
+}
+
+func formatMetricValue(value float64) string {
+	switch {
+	case math.IsInf(value, 1):
+		return "+Inf"
+	case math.IsInf(value, -1):
+		return "-Inf"
+	case math.IsNaN(value):
+		return "NaN"
+	}
+	return strconv.FormatFloat(value, 'g', -1, 64)
+}
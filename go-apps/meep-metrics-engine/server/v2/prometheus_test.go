@@ -0,0 +1,139 @@
+/*
+ * Copyright (c) 2020  InterDigital Communications, Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	log "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-logger"
+	ms "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-metric-store"
+)
+
+func TestPrometheusMetricFamilies(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	scenarioLabels := []metricLabel{{labelSandbox, "sbx1"}, {labelScenario, "scn1"}}
+
+	fmt.Println("Network gauges")
+	networkMetrics := []ms.NetworkMetric{
+		{Src: "ue2", Dst: "ue1", Lat: 20, UlTput: 2, DlTput: 0.5, UlLoss: 1, DlLoss: 0},
+		{Src: "ue1", Dst: "ue2", Lat: 5, UlTput: 0.5, DlTput: 2, UlLoss: 0, DlLoss: 1},
+	}
+	families := getNetworkMetricFamilies(scenarioLabels, networkMetrics)
+	families = append(families, getEventMetricFamily(scenarioLabels, []ms.AggregateSeries{
+		{Tags: map[string]string{ms.EvMetType: "MOBILITY"}, Metrics: []ms.AggregateMetric{{Values: map[string]float64{"count_event": 3}}}},
+	}))
+	families = append(families, getHttpMetricFamily(scenarioLabels, []ms.HttpHistogram{
+		{
+			Tags:    map[string]string{ms.HttpLoggerName: "meep-loc-serv", ms.HttpLoggerDirection: ms.HttpRxDirection},
+			Buckets: []uint64{1, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2},
+			Count:   3,
+			Sum:     20000500,
+		},
+	}))
+
+	var buf bytes.Buffer
+	err := writeMetricFamilies(&buf, families, false)
+	if err != nil {
+		t.Fatalf("Failed to write metrics")
+	}
+	out := buf.String()
+	expected := []string{
+		"# HELP advantedge_network_latency_seconds Round-trip latency from src to dest\n" +
+			"# TYPE advantedge_network_latency_seconds gauge\n" +
+			`advantedge_network_latency_seconds{sandbox="sbx1",scenario="scn1",src="ue1",dest="ue2"} 0.005` + "\n" +
+			`advantedge_network_latency_seconds{sandbox="sbx1",scenario="scn1",src="ue2",dest="ue1"} 0.02` + "\n",
+		`advantedge_network_ul_throughput_bits_per_second{sandbox="sbx1",scenario="scn1",src="ue2",dest="ue1"} 2e+06` + "\n",
+		`advantedge_network_dl_packet_loss_ratio{sandbox="sbx1",scenario="scn1",src="ue1",dest="ue2"} 0.01` + "\n",
+		"# TYPE advantedge_events_total counter\n" +
+			`advantedge_events_total{sandbox="sbx1",scenario="scn1",type="MOBILITY"} 3` + "\n",
+		"# TYPE advantedge_http_request_duration_seconds histogram\n" +
+			`advantedge_http_request_duration_seconds_bucket{sandbox="sbx1",scenario="scn1",logger_name="meep-loc-serv",direction="RX",le="0.001"} 1` + "\n" +
+			`advantedge_http_request_duration_seconds_bucket{sandbox="sbx1",scenario="scn1",logger_name="meep-loc-serv",direction="RX",le="0.005"} 2` + "\n",
+		`advantedge_http_request_duration_seconds_bucket{sandbox="sbx1",scenario="scn1",logger_name="meep-loc-serv",direction="RX",le="+Inf"} 3` + "\n" +
+			`advantedge_http_request_duration_seconds_sum{sandbox="sbx1",scenario="scn1",logger_name="meep-loc-serv",direction="RX"} 20.0005` + "\n" +
+			`advantedge_http_request_duration_seconds_count{sandbox="sbx1",scenario="scn1",logger_name="meep-loc-serv",direction="RX"} 3` + "\n",
+	}
+	for _, e := range expected {
+		if !strings.Contains(out, e) {
+			t.Fatalf("Missing metrics:\n%s\nin exposition:\n%s", e, out)
+		}
+	}
+	if strings.Contains(out, "# EOF") {
+		t.Fatalf("Unexpected EOF marker in Prometheus text format")
+	}
+
+	fmt.Println("OpenMetrics format")
+	buf.Reset()
+	err = writeMetricFamilies(&buf, families, true)
+	if err != nil {
+		t.Fatalf("Failed to write metrics")
+	}
+	out = buf.String()
+	if !strings.Contains(out, "# TYPE advantedge_events counter\n"+`advantedge_events_total{sandbox="sbx1",scenario="scn1",type="MOBILITY"} 3`) {
+		t.Fatalf("Invalid OpenMetrics counter:\n%s", out)
+	}
+	if !strings.HasSuffix(out, "# EOF\n") {
+		t.Fatalf("Missing OpenMetrics EOF marker")
+	}
+
+	fmt.Println("Label escaping")
+	buf.Reset()
+	family := &metricFamily{"test_gauge", "Test\\gauge", metricTypeGauge, []metricSample{{"", []metricLabel{{"name", "a\"b\\c\nd"}}, 1}}}
+	_ = writeMetricFamilies(&buf, []*metricFamily{family}, false)
+	if buf.String() != "# HELP test_gauge Test\\\\gauge\n# TYPE test_gauge gauge\ntest_gauge{name=\"a\\\"b\\\\c\\nd\"} 1\n" {
+		t.Fatalf("Invalid escaping:\n%s", buf.String())
+	}
+}
+
+func TestPrometheusEndpoint(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	// No active scenario: families are exposed without samples
+	metricStore = nil
+	activeScenarioName = ""
+
+	fmt.Println("Prometheus text format")
+	req := httptest.NewRequest("GET", "/metrics", nil)
+	rr := httptest.NewRecorder()
+	meGetPrometheusMetrics(rr, req)
+	if rr.Code != http.StatusOK || rr.Header().Get("Content-Type") != prometheusContentType {
+		t.Fatalf("Unexpected response %d %s", rr.Code, rr.Header().Get("Content-Type"))
+	}
+	if !strings.Contains(rr.Body.String(), "# TYPE advantedge_network_latency_seconds gauge\n") {
+		t.Fatalf("Missing metric family")
+	}
+
+	fmt.Println("OpenMetrics format")
+	req = httptest.NewRequest("GET", "/metrics", nil)
+	req.Header.Set("Accept", "application/openmetrics-text; version=1.0.0,text/plain;version=0.0.4;q=0.5")
+	rr = httptest.NewRecorder()
+	meGetPrometheusMetrics(rr, req)
+	if rr.Code != http.StatusOK || rr.Header().Get("Content-Type") != openMetricsContentType {
+		t.Fatalf("Unexpected response %d %s", rr.Code, rr.Header().Get("Content-Type"))
+	}
+	if !strings.HasSuffix(rr.Body.String(), "# EOF\n") {
+		t.Fatalf("Missing OpenMetrics EOF marker")
+	}
+}
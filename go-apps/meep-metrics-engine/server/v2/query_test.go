@@ -0,0 +1,85 @@
+/*
+ * Copyright (c) 2020  InterDigital Communications, Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	log "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-logger"
+)
+
+func TestMalformedQueries(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	// No active scenario: well-formed queries return 404, malformed queries 400
+	metricStore = nil
+
+	testQuery := func(handler http.HandlerFunc, body string, expectedCode int) {
+		req := httptest.NewRequest("POST", "/metrics/v2/metrics/query", strings.NewReader(body))
+		rr := httptest.NewRecorder()
+		handler(rr, req)
+		if rr.Code != expectedCode {
+			t.Fatalf("Unexpected status %d (expected %d) for query: %s", rr.Code, expectedCode, body)
+		}
+	}
+
+	fmt.Println("Network queries")
+	testQuery(mePostNetworkQuery, `{"tags":[{"name":"src","value":"ue1' OR '1'='1"}],"fields":["lat"],"scope":{"duration":"10s","limit":5}}`, http.StatusNotFound)
+	testQuery(mePostNetworkQuery, `{"fields":["lat"`, http.StatusBadRequest)
+	testQuery(mePostNetworkQuery, `{"fields":["lat FROM events"]}`, http.StatusBadRequest)
+	testQuery(mePostNetworkQuery, `{"tags":[{"name":"src' OR '1'='1","value":"ue1"}]}`, http.StatusBadRequest)
+	testQuery(mePostNetworkQuery, `{"scope":{"duration":"10s OR time > 0"}}`, http.StatusBadRequest)
+	testQuery(mePostNetworkQuery, `{"scope":{"limit":-1}}`, http.StatusBadRequest)
+	testQuery(mePostNetworkQuery, `{"fields":["lat"],"aggregation":{"functions":["p95"],"interval":"1x"},"scope":{"duration":"1m"}}`, http.StatusBadRequest)
+
+	fmt.Println("Event queries")
+	testQuery(mePostEventQuery, `{"tags":[{"name":"type","value":"MOBILITY"}],"fields":["event"]}`, http.StatusNotFound)
+	testQuery(mePostEventQuery, `{"fields":["event\""]}`, http.StatusBadRequest)
+
+	fmt.Println("Http queries")
+	testQuery(mePostHttpQuery, `{"tags":[{"name":"logger_name","value":"meep-loc-serv"}],"fields":["id","url"]}`, http.StatusNotFound)
+	testQuery(mePostHttpQuery, `{"scope":{"duration":"1d; DROP DATABASE x"}}`, http.StatusBadRequest)
+}
+
+func TestMalformedExports(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	// No active scenario: well-formed exports return 404, malformed exports 400
+	metricStore = nil
+
+	testExport := func(params string, expectedCode int) {
+		req := httptest.NewRequest("GET", "/metrics/v2/metrics/export"+params, nil)
+		rr := httptest.NewRecorder()
+		meGetMetricsExport(rr, req)
+		if rr.Code != expectedCode {
+			t.Fatalf("Unexpected status %d (expected %d) for export: %s", rr.Code, expectedCode, params)
+		}
+	}
+
+	testExport("", http.StatusNotFound)
+	testExport("?format=jsonl&start=2020-05-20T18:40:00Z&end=2020-05-20T18:45:00.5Z", http.StatusNotFound)
+	testExport("?format=parquet", http.StatusBadRequest)
+	testExport("?start=yesterday", http.StatusBadRequest)
+	testExport("?end=2020-05-20", http.StatusBadRequest)
+	testExport("?start=2020-05-20T18:45:00Z&end=2020-05-20T18:40:00Z", http.StatusBadRequest)
+}
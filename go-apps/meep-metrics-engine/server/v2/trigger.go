@@ -0,0 +1,176 @@
+/*
+ * Copyright (c) 2020  InterDigital Communications, Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+
+	ms "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-metric-store"
+)
+
+// Subscription types
+const (
+	subTypePeriod    = "period"
+	subTypeThreshold = "threshold"
+	subTypeChange    = "change"
+)
+
+// Threshold directions
+const (
+	triggerDirAbove = "above"
+	triggerDirBelow = "below"
+)
+
+// Trigger notification states
+const (
+	triggerStateRaised  = "RAISED"
+	triggerStateCleared = "CLEARED"
+	triggerStateChanged = "CHANGED"
+)
+
+// Default evaluation interval for threshold & change subscriptions
+const defaultTriggerPeriod = 1
+
+// TriggerState - Runtime state of a threshold or change subscription
+type TriggerState struct {
+	raised  bool
+	refSet  bool
+	ref     float64
+	lastDir int
+}
+
+// validateNetworkTrigger - Validate network subscription type & trigger parameters
+func validateNetworkTrigger(subType string, trigger *NetworkTrigger) error {
+	switch subType {
+	case subTypePeriod:
+		return nil
+	case subTypeThreshold, subTypeChange:
+	default:
+		return errors.New("SubscriptionType unknown")
+	}
+
+	if trigger == nil {
+		return errors.New("Missing trigger for " + subType + " subscription")
+	}
+	switch trigger.Field {
+	case ms.NetMetLatency, ms.NetMetULThroughput, ms.NetMetDLThroughput, ms.NetMetULPktLoss, ms.NetMetDLPktLoss:
+	default:
+		return errors.New("Unsupported trigger field: " + trigger.Field)
+	}
+	if trigger.Hysteresis < 0 {
+		return errors.New("Trigger hysteresis must not be negative")
+	}
+	if subType == subTypeThreshold {
+		if trigger.Direction != triggerDirAbove && trigger.Direction != triggerDirBelow {
+			return errors.New("Unsupported trigger direction: " + trigger.Direction)
+		}
+	} else if trigger.Delta <= 0 {
+		return errors.New("Trigger delta must be positive")
+	}
+	return nil
+}
+
+// Evaluate - Evaluate new metric value; returns trigger state, reference value & whether to notify
+func (ts *TriggerState) Evaluate(subType string, trigger *NetworkTrigger, value float64) (string, float64, bool) {
+	switch subType {
+	case subTypeThreshold:
+		return ts.evaluateThreshold(trigger, value)
+	case subTypeChange:
+		return ts.evaluateChange(trigger, value)
+	}
+	return "", 0, false
+}
+
+func (ts *TriggerState) evaluateThreshold(trigger *NetworkTrigger, value float64) (string, float64, bool) {
+	var crossed, cleared bool
+	if trigger.Direction == triggerDirAbove {
+		crossed = value > trigger.Threshold
+		cleared = value < trigger.Threshold-trigger.Hysteresis
+	} else {
+		crossed = value < trigger.Threshold
+		cleared = value > trigger.Threshold+trigger.Hysteresis
+	}
+
+	if !ts.raised && crossed {
+		ts.raised = true
+		return triggerStateRaised, trigger.Threshold, true
+	}
+	if ts.raised && cleared {
+		ts.raised = false
+		return triggerStateCleared, trigger.Threshold, true
+	}
+	return "", 0, false
+}
+
+func (ts *TriggerState) evaluateChange(trigger *NetworkTrigger, value float64) (string, float64, bool) {
+	// First value sets the reference
+	if !ts.refSet {
+		ts.ref = value
+		ts.refSet = true
+		return "", 0, false
+	}
+
+	diff := value - ts.ref
+	dir := 1
+	if diff < 0 {
+		dir = -1
+	}
+
+	// Changing direction requires exceeding the hysteresis margin as well
+	minChange := trigger.Delta
+	if ts.lastDir != 0 && dir != ts.lastDir {
+		minChange += trigger.Hysteresis
+	}
+	if math.Abs(diff) <= minChange {
+		return "", 0, false
+	}
+
+	ref := ts.ref
+	ts.ref = value
+	ts.lastDir = dir
+	return triggerStateChanged, ref, true
+}
+
+// getNetworkQueryFields - Get network metric fields to query for subscription, including trigger field
+// NOTE: Returns a new slice to leave the subscription parameters unchanged
+func getNetworkQueryFields(subType string, fields []string, trigger *NetworkTrigger) []string {
+	queryFields := make([]string, len(fields), len(fields)+1)
+	copy(queryFields, fields)
+	if subType == subTypePeriod || trigger == nil {
+		return queryFields
+	}
+	for _, field := range fields {
+		if field == trigger.Field {
+			return queryFields
+		}
+	}
+	return append(queryFields, trigger.Field)
+}
+
+// getNetworkMetricValue - Get numerical network metric field value from query result
+func getNetworkMetricValue(values map[string]interface{}, field string) (float64, bool) {
+	if values[field] == nil {
+		return 0, false
+	}
+	num, ok := values[field].(json.Number)
+	if !ok {
+		return 0, false
+	}
+	return ms.JsonNumToFloat64(num), true
+}
@@ -0,0 +1,170 @@
+/*
+ * Copyright (c) 2020  InterDigital Communications, Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"fmt"
+	"testing"
+
+	log "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-logger"
+)
+
+func TestValidateNetworkTrigger(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	fmt.Println("Validate period subscription")
+	if validateNetworkTrigger(subTypePeriod, nil) != nil {
+		t.Fatalf("Period subscription should not require a trigger")
+	}
+	if validateNetworkTrigger("invalid", nil) == nil {
+		t.Fatalf("Invalid subscription type should have failed")
+	}
+
+	fmt.Println("Validate threshold subscription")
+	if validateNetworkTrigger(subTypeThreshold, nil) == nil {
+		t.Fatalf("Missing trigger should have failed")
+	}
+	if validateNetworkTrigger(subTypeThreshold, &NetworkTrigger{Field: "invalid", Direction: triggerDirAbove}) == nil {
+		t.Fatalf("Invalid field should have failed")
+	}
+	if validateNetworkTrigger(subTypeThreshold, &NetworkTrigger{Field: "lat"}) == nil {
+		t.Fatalf("Missing direction should have failed")
+	}
+	if validateNetworkTrigger(subTypeThreshold, &NetworkTrigger{Field: "lat", Direction: triggerDirAbove, Hysteresis: -1}) == nil {
+		t.Fatalf("Negative hysteresis should have failed")
+	}
+	if validateNetworkTrigger(subTypeThreshold, &NetworkTrigger{Field: "lat", Direction: triggerDirAbove, Threshold: 50}) != nil {
+		t.Fatalf("Valid threshold trigger failed")
+	}
+
+	fmt.Println("Validate change subscription")
+	if validateNetworkTrigger(subTypeChange, &NetworkTrigger{Field: "dl"}) == nil {
+		t.Fatalf("Missing delta should have failed")
+	}
+	if validateNetworkTrigger(subTypeChange, &NetworkTrigger{Field: "dl", Delta: 10}) != nil {
+		t.Fatalf("Valid change trigger failed")
+	}
+}
+
+func TestTriggerThreshold(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	type sample struct {
+		value  float64
+		state  string
+		notify bool
+	}
+
+	fmt.Println("Threshold above with hysteresis")
+	trigger := &NetworkTrigger{Field: "lat", Direction: triggerDirAbove, Threshold: 50, Hysteresis: 5}
+	var ts TriggerState
+	samples := []sample{
+		{40, "", false},
+		{50, "", false},
+		{51, triggerStateRaised, true},
+		{60, "", false},
+		{47, "", false},
+		{44, triggerStateCleared, true},
+		{49, "", false},
+		{52, triggerStateRaised, true},
+	}
+	for i, s := range samples {
+		state, ref, notify := ts.Evaluate(subTypeThreshold, trigger, s.value)
+		if state != s.state || notify != s.notify || (notify && ref != trigger.Threshold) {
+			t.Fatalf("Unexpected result for sample %d: %s %v", i, state, notify)
+		}
+	}
+
+	fmt.Println("Threshold below without hysteresis")
+	trigger = &NetworkTrigger{Field: "dl", Direction: triggerDirBelow, Threshold: 10}
+	ts = TriggerState{}
+	samples = []sample{
+		{5, triggerStateRaised, true},
+		{8, "", false},
+		{10, "", false},
+		{11, triggerStateCleared, true},
+	}
+	for i, s := range samples {
+		state, _, notify := ts.Evaluate(subTypeThreshold, trigger, s.value)
+		if state != s.state || notify != s.notify {
+			t.Fatalf("Unexpected result for sample %d: %s %v", i, state, notify)
+		}
+	}
+}
+
+func TestTriggerChange(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	type sample struct {
+		value  float64
+		ref    float64
+		notify bool
+	}
+
+	fmt.Println("Change with hysteresis")
+	trigger := &NetworkTrigger{Field: "lat", Delta: 10, Hysteresis: 5}
+	var ts TriggerState
+	samples := []sample{
+		{20, 0, false},
+		{30, 0, false},
+		{31, 20, true},
+		{20, 0, false},
+		{15, 31, true},
+		{4, 15, true},
+		{18, 0, false},
+		{20, 4, true},
+	}
+	for i, s := range samples {
+		state, ref, notify := ts.Evaluate(subTypeChange, trigger, s.value)
+		if notify != s.notify || (notify && (state != triggerStateChanged || ref != s.ref)) {
+			t.Fatalf("Unexpected result for sample %d: %s %f %v", i, state, ref, notify)
+		}
+	}
+}
+
+func TestNetworkQueryFields(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	fields := make([]string, 1, 4)
+	fields[0] = "lat"
+	tests := []struct {
+		name     string
+		subType  string
+		trigger  *NetworkTrigger
+		expected []string
+	}{
+		{"period subscription", subTypePeriod, nil, []string{"lat"}},
+		{"trigger field already requested", subTypeThreshold, &NetworkTrigger{Field: "lat"}, []string{"lat"}},
+		{"threshold trigger field added", subTypeThreshold, &NetworkTrigger{Field: "dl"}, []string{"lat", "dl"}},
+		{"change trigger field added", subTypeChange, &NetworkTrigger{Field: "ul"}, []string{"lat", "ul"}},
+	}
+
+	for _, test := range tests {
+		queryFields := getNetworkQueryFields(test.subType, fields, test.trigger)
+		if fmt.Sprint(queryFields) != fmt.Sprint(test.expected) {
+			t.Fatalf("%s: expected %v got %v", test.name, test.expected, queryFields)
+		}
+		queryFields[0] = "modified"
+		if len(fields) != 1 || fields[0] != "lat" || fields[:2][1] != "" {
+			t.Fatalf("%s: subscription fields modified: %v", test.name, fields[:2])
+		}
+	}
+}
@@ -28,6 +28,14 @@ import (
 	"net/http"
 )
 
+func GetMobilityGroupAppEventList(w http.ResponseWriter, r *http.Request) {
+	mgGetMobilityGroupAppEventList(w, r)
+}
+
+func TransferAppHandoffState(w http.ResponseWriter, r *http.Request) {
+	mgTransferAppHandoffState(w, r)
+}
+
 func TransferAppState(w http.ResponseWriter, r *http.Request) {
 	mgTransferAppState(w, r)
 }
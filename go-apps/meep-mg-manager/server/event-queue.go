@@ -0,0 +1,254 @@
+/*
+ * Copyright (c) 2019  InterDigital Communications, Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	log "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-logger"
+	mga "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-mg-app-client"
+	mgModel "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-mg-manager-model"
+)
+
+// Event delivery status
+const eventStatusPending = "PENDING"
+const eventStatusFailed = "FAILED"
+
+// Event delivery settings
+const eventMaxAttempts = 5
+const maxDeadLetters = 100
+
+// Event delivery timing (variables to allow shorter delays in tests)
+var eventTimeout = 5 * time.Second
+var eventRetryBackoff = 500 * time.Millisecond
+var eventRetryMaxBackoff = 8 * time.Second
+
+var errEventQueueStopped = errors.New("Event queue stopped")
+
+var lastEventId int32
+
+// eventDelivery - Event notification queued for delivery to a Group App
+type eventDelivery struct {
+	id          string
+	event       mga.MobilityGroupEvent
+	status      string
+	attempts    int32
+	lastError   string
+	created     time.Time
+	lastAttempt time.Time
+	onDone      func(err error)
+}
+
+// eventQueue - Ordered event notification delivery to a Group App
+// Events are delivered one at a time in the order they were queued. Failed deliveries
+// are retried with exponential backoff; events that cannot be delivered after the
+// maximum number of attempts are moved to the dead letter list and the next event is sent.
+type eventQueue struct {
+	appId       string
+	client      *mga.APIClient
+	pending     []*eventDelivery
+	deadLetters []*eventDelivery
+	mutex       sync.Mutex
+	notify      chan bool
+	stop        chan bool
+}
+
+// newEventQueue - Create event queue & start delivery thread for provided Group App
+func newEventQueue(appId string, client *mga.APIClient) *eventQueue {
+	q := new(eventQueue)
+	q.appId = appId
+	q.client = client
+	q.pending = []*eventDelivery{}
+	q.deadLetters = []*eventDelivery{}
+	q.notify = make(chan bool, 1)
+	q.stop = make(chan bool)
+	go q.run()
+	return q
+}
+
+// setClient - Update Group App REST API client used for next delivery attempts
+func (q *eventQueue) setClient(client *mga.APIClient) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	q.client = client
+}
+
+// push - Queue event for delivery; onDone, if provided, is invoked with the delivery result
+func (q *eventQueue) push(event mga.MobilityGroupEvent, onDone func(err error)) {
+	delivery := new(eventDelivery)
+	delivery.id = strconv.Itoa(int(atomic.AddInt32(&lastEventId, 1)))
+	delivery.event = event
+	delivery.status = eventStatusPending
+	delivery.created = time.Now()
+	delivery.onDone = onDone
+
+	q.mutex.Lock()
+	q.pending = append(q.pending, delivery)
+	q.mutex.Unlock()
+
+	// Wake up delivery thread
+	select {
+	case q.notify <- true:
+	default:
+	}
+}
+
+// close - Stop delivery thread; pending events are dropped
+func (q *eventQueue) close() {
+	close(q.stop)
+}
+
+// run - Deliver queued events in order until queue is stopped
+func (q *eventQueue) run() {
+	for {
+		select {
+		case <-q.stop:
+			return
+		default:
+		}
+
+		delivery := q.next()
+		if delivery == nil {
+			select {
+			case <-q.notify:
+				continue
+			case <-q.stop:
+				return
+			}
+		}
+
+		err := q.deliver(delivery)
+		if err == errEventQueueStopped {
+			return
+		}
+		q.complete(delivery, err)
+		if delivery.onDone != nil {
+			delivery.onDone(err)
+		}
+	}
+}
+
+// next - Get next pending event, if any
+func (q *eventQueue) next() *eventDelivery {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	if len(q.pending) == 0 {
+		return nil
+	}
+	return q.pending[0]
+}
+
+// complete - Remove delivered event from queue or move it to the dead letter list
+func (q *eventQueue) complete(delivery *eventDelivery, err error) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	q.pending = q.pending[1:]
+	if err != nil {
+		log.Error("Dropping " + delivery.event.Type_ + " event for " + delivery.event.UeId + " to " + q.appId + " after " +
+			strconv.Itoa(int(delivery.attempts)) + " attempts: " + err.Error())
+		delivery.status = eventStatusFailed
+		q.deadLetters = append(q.deadLetters, delivery)
+		if len(q.deadLetters) > maxDeadLetters {
+			q.deadLetters = q.deadLetters[len(q.deadLetters)-maxDeadLetters:]
+		}
+	}
+}
+
+// deliver - Send event to Group App, retrying with exponential backoff on failure
+func (q *eventQueue) deliver(delivery *eventDelivery) error {
+	backoff := eventRetryBackoff
+	for {
+		retry, err := q.send(delivery)
+		if err == nil {
+			return nil
+		}
+		log.Warn("Failed to send " + delivery.event.Type_ + " event for " + delivery.event.UeId + " to " + q.appId + ": " + err.Error())
+		if !retry || delivery.attempts >= eventMaxAttempts {
+			return err
+		}
+
+		// Wait before retrying
+		select {
+		case <-time.After(backoff):
+		case <-q.stop:
+			return errEventQueueStopped
+		}
+		backoff *= 2
+		if backoff > eventRetryMaxBackoff {
+			backoff = eventRetryMaxBackoff
+		}
+	}
+}
+
+// send - Attempt event delivery; returns whether failed delivery may be retried & delivery error
+// NOTE: Client errors (4xx) are not retried as the Group App rejected the event
+func (q *eventQueue) send(delivery *eventDelivery) (retry bool, err error) {
+	q.mutex.Lock()
+	client := q.client
+	delivery.attempts++
+	delivery.lastAttempt = time.Now()
+	q.mutex.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), eventTimeout)
+	defer cancel()
+	resp, err := client.StateTransferApi.HandleEvent(ctx, delivery.event)
+
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	if err != nil {
+		delivery.lastError = err.Error()
+		retry = resp == nil || resp.StatusCode < http.StatusBadRequest || resp.StatusCode >= http.StatusInternalServerError
+		return retry, err
+	}
+	delivery.lastError = ""
+	return false, nil
+}
+
+// getDeliveries - Get pending & failed deliveries, optionally filtered by UE & status
+func (q *eventQueue) getDeliveries(ueId string, status string) []mgModel.MobilityGroupEventDelivery {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	deliveries := make([]mgModel.MobilityGroupEventDelivery, 0)
+	for _, list := range [][]*eventDelivery{q.pending, q.deadLetters} {
+		for _, delivery := range list {
+			if (ueId != "" && delivery.event.UeId != ueId) || (status != "" && delivery.status != status) {
+				continue
+			}
+			var d mgModel.MobilityGroupEventDelivery
+			d.Id = delivery.id
+			d.AppId = q.appId
+			d.UeId = delivery.event.UeId
+			d.EventType = delivery.event.Type_
+			d.Status = delivery.status
+			d.Attempts = delivery.attempts
+			d.LastError = delivery.lastError
+			d.Created = delivery.created.Format(time.RFC3339Nano)
+			if !delivery.lastAttempt.IsZero() {
+				d.LastAttempt = delivery.lastAttempt.Format(time.RFC3339Nano)
+			}
+			deliveries = append(deliveries, d)
+		}
+	}
+	return deliveries
+}
@@ -0,0 +1,281 @@
+/*
+ * Copyright (c) 2019  InterDigital Communications, Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	log "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-logger"
+	mga "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-mg-app-client"
+)
+
+const testAppId = "test-app"
+
+// testGroupApp - Group App event endpoint returning the configured status for each received event
+type testGroupApp struct {
+	server   *httptest.Server
+	mutex    sync.Mutex
+	received []mga.MobilityGroupEvent
+	status   func(ueId string, attempt int) int
+	delay    time.Duration
+}
+
+func newTestGroupApp(status func(ueId string, attempt int) int) *testGroupApp {
+	app := new(testGroupApp)
+	app.status = status
+	app.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var event mga.MobilityGroupEvent
+		_ = json.NewDecoder(r.Body).Decode(&event)
+
+		app.mutex.Lock()
+		app.received = append(app.received, event)
+		attempt := app.attempts(event.UeId)
+		delay := app.delay
+		app.mutex.Unlock()
+
+		time.Sleep(delay)
+		w.WriteHeader(app.status(event.UeId, attempt))
+	}))
+	return app
+}
+
+// attempts - Number of received events for the provided UE; must be called with mutex held
+func (app *testGroupApp) attempts(ueId string) int {
+	count := 0
+	for _, event := range app.received {
+		if event.UeId == ueId {
+			count++
+		}
+	}
+	return count
+}
+
+func (app *testGroupApp) getReceived() []string {
+	app.mutex.Lock()
+	defer app.mutex.Unlock()
+	ueIds := []string{}
+	for _, event := range app.received {
+		ueIds = append(ueIds, event.UeId)
+	}
+	return ueIds
+}
+
+// waitEvents - Wait for the provided number of events to be received & return received events
+func (app *testGroupApp) waitEvents(t *testing.T, count int) []mga.MobilityGroupEvent {
+	for start := time.Now(); time.Since(start) < 5*time.Second; time.Sleep(10 * time.Millisecond) {
+		app.mutex.Lock()
+		received := append([]mga.MobilityGroupEvent{}, app.received...)
+		app.mutex.Unlock()
+		if len(received) >= count {
+			return received
+		}
+	}
+	t.Fatalf("Expected events not received")
+	return nil
+}
+
+func (app *testGroupApp) newQueue() *eventQueue {
+	cfg := mga.NewConfiguration()
+	cfg.BasePath = app.server.URL
+	return newEventQueue(testAppId, mga.NewAPIClient(cfg))
+}
+
+func setTestEventTiming(timeout time.Duration, backoff time.Duration) func() {
+	prevTimeout, prevBackoff, prevMaxBackoff := eventTimeout, eventRetryBackoff, eventRetryMaxBackoff
+	eventTimeout = timeout
+	eventRetryBackoff = backoff
+	eventRetryMaxBackoff = backoff
+	return func() {
+		eventTimeout, eventRetryBackoff, eventRetryMaxBackoff = prevTimeout, prevBackoff, prevMaxBackoff
+	}
+}
+
+func pushTestEvent(q *eventQueue, ueId string) chan error {
+	done := make(chan error, 1)
+	event := mga.MobilityGroupEvent{Name: eventTypeStateUpdate, Type_: eventTypeStateUpdate, UeId: ueId}
+	q.push(event, func(err error) { done <- err })
+	return done
+}
+
+func waitTestEvent(t *testing.T, done chan error) error {
+	select {
+	case err := <-done:
+		return err
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Event delivery did not complete")
+	}
+	return nil
+}
+
+func TestEventQueueOrdering(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+	defer setTestEventTiming(time.Second, time.Millisecond)()
+
+	app := newTestGroupApp(func(ueId string, attempt int) int {
+		// Fail first attempt for one UE to force a retry
+		if ueId == "ue2" && attempt == 1 {
+			return http.StatusServiceUnavailable
+		}
+		return http.StatusOK
+	})
+	defer app.server.Close()
+	q := app.newQueue()
+	defer q.close()
+
+	fmt.Println("Queue events")
+	var dones []chan error
+	for i := 1; i <= 4; i++ {
+		dones = append(dones, pushTestEvent(q, "ue"+strconv.Itoa(i)))
+	}
+
+	fmt.Println("Validate onDone result for each event")
+	for _, done := range dones {
+		if err := waitTestEvent(t, done); err != nil {
+			t.Fatalf("Event delivery failed: " + err.Error())
+		}
+	}
+
+	fmt.Println("Validate delivery order")
+	expected := []string{"ue1", "ue2", "ue2", "ue3", "ue4"}
+	received := app.getReceived()
+	if len(received) != len(expected) {
+		t.Fatalf("Invalid number of received events: %v", received)
+	}
+	for i := range expected {
+		if received[i] != expected[i] {
+			t.Fatalf("Invalid event order: %v", received)
+		}
+	}
+	if len(q.getDeliveries("", "")) != 0 {
+		t.Fatalf("Delivered events should be removed from queue")
+	}
+}
+
+func TestEventQueueRetry(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+	defer setTestEventTiming(time.Second, time.Millisecond)()
+
+	app := newTestGroupApp(func(ueId string, attempt int) int {
+		switch ueId {
+		case "ue-retry":
+			if attempt <= 2 {
+				return http.StatusInternalServerError
+			}
+		case "ue-rejected":
+			return http.StatusBadRequest
+		}
+		return http.StatusOK
+	})
+	defer app.server.Close()
+	q := app.newQueue()
+	defer q.close()
+
+	fmt.Println("Retry server errors")
+	if err := waitTestEvent(t, pushTestEvent(q, "ue-retry")); err != nil {
+		t.Fatalf("Event delivery failed: " + err.Error())
+	}
+	if len(app.getReceived()) != 3 {
+		t.Fatalf("Invalid retry count: %d", len(app.getReceived()))
+	}
+
+	fmt.Println("Do not retry client errors")
+	if err := waitTestEvent(t, pushTestEvent(q, "ue-rejected")); err == nil {
+		t.Fatalf("Rejected event delivery should have failed")
+	}
+	if len(app.getReceived()) != 4 {
+		t.Fatalf("Rejected event should not be retried")
+	}
+	deliveries := q.getDeliveries("ue-rejected", eventStatusFailed)
+	if len(deliveries) != 1 || deliveries[0].Attempts != 1 {
+		t.Fatalf("Rejected event should be dead-lettered after one attempt")
+	}
+}
+
+func TestEventQueueDeadLetter(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+	defer setTestEventTiming(time.Second, time.Millisecond)()
+
+	app := newTestGroupApp(func(ueId string, attempt int) int {
+		if ueId == "ue-failed" {
+			return http.StatusServiceUnavailable
+		}
+		return http.StatusOK
+	})
+	defer app.server.Close()
+	q := app.newQueue()
+	defer q.close()
+
+	fmt.Println("Queue undeliverable event followed by deliverable event")
+	failedDone := pushTestEvent(q, "ue-failed")
+	nextDone := pushTestEvent(q, "ue-next")
+
+	if err := waitTestEvent(t, failedDone); err == nil {
+		t.Fatalf("Event delivery should have failed")
+	}
+	if err := waitTestEvent(t, nextDone); err != nil {
+		t.Fatalf("Next event delivery failed: " + err.Error())
+	}
+
+	fmt.Println("Validate dead letter")
+	deliveries := q.getDeliveries("", eventStatusFailed)
+	if len(deliveries) != 1 {
+		t.Fatalf("Invalid number of dead letters: %d", len(deliveries))
+	}
+	d := deliveries[0]
+	if d.UeId != "ue-failed" || d.AppId != testAppId || d.Attempts != eventMaxAttempts || d.LastError == "" {
+		t.Fatalf("Invalid dead letter: %+v", d)
+	}
+	if len(app.getReceived()) != eventMaxAttempts+1 {
+		t.Fatalf("Invalid number of delivery attempts: %d", len(app.getReceived()))
+	}
+	if len(q.getDeliveries("", eventStatusPending)) != 0 {
+		t.Fatalf("No event should be pending")
+	}
+}
+
+func TestEventQueueTimeout(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+	defer setTestEventTiming(20*time.Millisecond, time.Millisecond)()
+
+	app := newTestGroupApp(func(ueId string, attempt int) int {
+		return http.StatusOK
+	})
+	app.delay = 100 * time.Millisecond
+	defer app.server.Close()
+	q := app.newQueue()
+	defer q.close()
+
+	fmt.Println("Event delivery times out on every attempt")
+	if err := waitTestEvent(t, pushTestEvent(q, "ue-timeout")); err == nil {
+		t.Fatalf("Event delivery should have timed out")
+	}
+	deliveries := q.getDeliveries("ue-timeout", eventStatusFailed)
+	if len(deliveries) != 1 || deliveries[0].Attempts != eventMaxAttempts {
+		t.Fatalf("Timed out event should be dead-lettered after max attempts")
+	}
+}
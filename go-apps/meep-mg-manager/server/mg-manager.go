@@ -22,17 +22,21 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	dkm "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-data-key-mgr"
 	dataModel "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-data-model"
 	httpLog "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-http-logger"
 	log "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-logger"
+	ms "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-metric-store"
 	mga "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-mg-app-client"
 	mgModel "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-mg-manager-model"
 	mod "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-model"
 	mq "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-mq"
+	postgis "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-postgis"
 	redis "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-redis"
 
 	"github.com/RyanCarrier/dijkstra"
@@ -45,6 +49,9 @@ const mgmKey string = "mg-manager:"
 const typeLb string = "lb"
 const redisAddr string = "meep-redis-master.default.svc.cluster.local:6379"
 const influxAddr string = "http://meep-influxdb.default.svc.cluster.local:8086"
+const geModuleName string = "meep-gis-engine"
+const postgisUser string = "postgres"
+const postgisPwd string = "pwd"
 
 const DEFAULT_LB_RULES_DB = 0
 
@@ -52,46 +59,78 @@ const eventTypeStateUpdate = "STATE-UPDATE"
 const eventTypeStateTransferStart = "STATE-TRANSFER-START"
 const eventTypeStateTransferComplete = "STATE-TRANSFER-COMPLETE"
 const eventTypeStateTransferCancel = "STATE-TRANSFER-CANCEL"
+const eventTypeHandoffStart = "HANDOFF-START"
+const eventTypeHandoffStateUpdate = "HANDOFF-STATE-UPDATE"
+const eventTypeHandoffComplete = "HANDOFF-COMPLETE"
+const eventTypeHandoffCancel = "HANDOFF-CANCEL"
 
-// const stateTransModeStateDirect = "STATE-DIRECT"
+const stateTransModeStateDirect = "STATE-DIRECT"
 const stateTransModeStateManaged = "STATE-MANAGED"
+const stateTransModeHandoffDirect = "HANDOFF-DIRECT"
+const stateTransModeHandoffManaged = "HANDOFF-MANAGED"
 
-// const stateTransModeInstanceDirect = "INSTANCE-DIRECT"
-// const stateTransModeInstanceManaged = "INSTANCE-MANAGED"
 // const stateTransModeNone = "NONE"
 
+// Time given to a source instance to hand off its instance state before UE traffic is switched anyway
+const handoffTimeout = 30 * time.Second
+
 const stateTransTrigNetLocInRange = "NET-LOC-IN-RANGE"
 const stateTransTrigNetLocChange = "NET-LOC-CHANGE"
+const stateTransTrigGPSProximity = "GPS-PROXIMITY"
 
-// const stateTransTrigGPSProximity = "GPS-PROXIMITY"
 // const stateTransTrigNone = "NONE"
 
-// const sessionTransModeGraceful = "GRACEFUL"
+const defaultProximityDistance = 100
+const defaultProximityTime = 10
+
+const sessionTransModeGraceful = "GRACEFUL"
 const sessionTransModeForced = "FORCED"
+const defaultSessionDrainTimeout = 300
 
 const lbAlgoHopCount = "HOP-COUNT"
+const lbAlgoLatency = "LATENCY"
+const lbAlgoLoad = "LOAD"
+const lbAlgoSticky = "STICKY"
 
-// const lbAlgoLatency = "LATENCY"
 // const lbAlgoDistance = "DISTANCE"
 // const lbAlgoNone = "NONE"
 
+const maxAppLoad = 100
+
 type mgInfo struct {
 	mg                  mgModel.MobilityGroup
 	appInfoMap          map[string]*appInfo
 	ueInfoMap           map[string]*ueInfo
 	netLocAppMap        map[string]string
 	defaultNetLocAppMap map[string]string
+	handoffMap          map[string]*handoffInfo
 }
 
 type appInfo struct {
-	app       mgModel.MobilityGroupApp
-	appClient *mga.APIClient
+	app        mgModel.MobilityGroupApp
+	appClient  *mga.APIClient
+	eventQueue *eventQueue
 }
 
 type ueInfo struct {
-	ue          mgModel.MobilityGroupUe
-	appsInRange map[string]bool
-	state       string
+	ue                 mgModel.MobilityGroupUe
+	appsInRange        map[string]bool
+	netLocsInProximity map[string]bool
+	state              string
+	transferStart      time.Time
+}
+
+// handoffInfo - UE handoff in progress between App instances
+// The UE network elements remain mapped to the source instance until the
+// source instance hands off its instance state or the handoff times out.
+// NOTE: App instances are not relocated; only UE traffic is switched.
+type handoffInfo struct {
+	ueId   string
+	elems  map[string]bool
+	srcApp string
+	dstApp string
+	start  time.Time
+	timer  *time.Timer
 }
 
 type netElemInfo struct {
@@ -101,6 +140,7 @@ type netElemInfo struct {
 	netLocsInRange     map[string]bool
 	mgSvcMap           map[string]*svcMapInfo
 	transferInProgress bool
+	transferGen        int
 }
 
 type svcMapInfo struct {
@@ -130,8 +170,12 @@ type MgManager struct {
 	handlerId    int
 	mutex        sync.Mutex
 	networkGraph *dijkstra.Graph
+	latencyGraph *dijkstra.Graph
 	activeModel  *mod.Model
 	lbRulesStore *lbRulesStore
+	metricStore  *ms.MetricStore
+	pc           *postgis.Connector
+	pcMutex      sync.Mutex
 
 	// Scenario network location list
 	netLocList []string
@@ -207,6 +251,12 @@ func Init() (err error) {
 	}
 	log.Info("Connected to LB Rules Store redis DB")
 
+	// Connect to Metric Store
+	// NOTE: Mobility metrics are optional; connection is retried on scenario activation
+	connectMetricStore()
+
+	// NOTE: Postgis DB is only required by GPS-PROXIMITY groups; connector is created on first use
+
 	// Flush module data
 	_ = mgm.lbRulesStore.rc.DBFlush(mgm.baseKey)
 
@@ -242,17 +292,44 @@ func msgHandler(msg *mq.Msg, userData interface{}) {
 	case mq.MsgScenarioTerminate:
 		log.Debug("RX MSG: ", mq.PrintMsg(msg))
 		processActiveScenarioUpdate()
+	case mq.MsgGeUpdate:
+		log.Debug("RX MSG: ", mq.PrintMsg(msg))
+		processGisEngineUpdate(msg.Payload)
 	default:
 		log.Trace("Ignoring unsupported message: ", mq.PrintMsg(msg))
 	}
 }
 
+// connectMetricStore - Connect to Metric Store; mobility metrics are not stored if unavailable
+func connectMetricStore() {
+	metricStore, err := ms.NewMetricStore("", mgm.sandboxName, influxAddr, redisAddr)
+	if err != nil {
+		log.Warn("Failed connection to Metric Store; mobility metrics disabled: ", err)
+		return
+	}
+	mgm.mutex.Lock()
+	mgm.metricStore = metricStore
+	mgm.mutex.Unlock()
+	log.Info("Connected to Metric Store")
+}
+
 func processActiveScenarioUpdate() {
 	// Sync with active scenario store
 	mgm.activeModel.UpdateScenario()
 
 	_ = httpLog.ReInit(moduleName, mgm.sandboxName, mgm.activeModel.GetScenarioName(), redisAddr, influxAddr)
 
+	// Set Metric Store
+	if mgm.metricStore == nil {
+		connectMetricStore()
+	}
+	if mgm.metricStore != nil {
+		err := mgm.metricStore.SetStore(mgm.activeModel.GetScenarioName())
+		if err != nil {
+			log.Error("Failed to set store with error: " + err.Error())
+		}
+	}
+
 	// Handle empty/missing scenario
 	if mgm.activeModel.GetScenarioName() == "" {
 		clearScenario()
@@ -276,10 +353,183 @@ func processActiveScenarioUpdate() {
 	applyMgSvcMapping()
 }
 
+// proximityQuery - GPS-PROXIMITY settings used to query POAs in proximity of UEs
+type proximityQuery struct {
+	distance int32
+	time     int32
+}
+
+func processGisEngineUpdate(assetMap map[string]string) {
+	// Only UE position updates change the POAs in proximity
+	allUes := false
+	ueNames := map[string]bool{}
+	for assetName, assetType := range assetMap {
+		if assetType != postgis.TypeUe {
+			continue
+		}
+		if assetName == postgis.AllAssets {
+			allUes = true
+		} else {
+			ueNames[assetName] = true
+		}
+	}
+	if !allUes && len(ueNames) == 0 {
+		return
+	}
+
+	// Find proximity settings of GPS proximity groups tracking the updated UEs
+	mgm.mutex.Lock()
+	queries := map[proximityQuery]bool{}
+	for _, mgInfo := range mgm.mgInfoMap {
+		if mgInfo.mg.StateTransferTrigger != stateTransTrigGPSProximity {
+			continue
+		}
+		for ueName := range mgInfo.ueInfoMap {
+			if allUes || ueNames[ueName] {
+				queries[getProximityQuery(mgInfo)] = true
+				break
+			}
+		}
+	}
+	mgm.mutex.Unlock()
+
+	// Query POAs in proximity of all UEs once per proximity setting
+	// NOTE: Postgis is queried without holding the lock
+	poaInProximityMaps := map[proximityQuery]map[string][]string{}
+	for query := range queries {
+		poaInProximityMap, err := getAllUePoaInProximity(query)
+		if err != nil {
+			log.Error("Failed to get POAs in proximity with error: ", err.Error())
+			continue
+		}
+		poaInProximityMaps[query] = poaInProximityMap
+	}
+
+	// Update POAs in proximity of tracked UEs
+	mgm.mutex.Lock()
+	updated := updateNetLocsInProximity(poaInProximityMaps)
+	mgm.mutex.Unlock()
+
+	// Re-evaluate state transfers if POAs in proximity changed
+	if updated {
+		// Re-evaluate MG Service mapping
+		refreshMgSvcMapping()
+
+		// Store & Apply latest MG Service mappings
+		applyMgSvcMapping()
+	}
+}
+
+// getPostgisConnector - Get Postgis connector, connecting on first use
+func getPostgisConnector() *postgis.Connector {
+	mgm.pcMutex.Lock()
+	defer mgm.pcMutex.Unlock()
+
+	if mgm.pc == nil {
+		pc, err := postgis.NewConnector(geModuleName, mgm.sandboxName, postgisUser, postgisPwd, "", "")
+		if err != nil {
+			log.Error("Failed to create postgis connector with error: ", err.Error())
+			return nil
+		}
+		log.Info("Postgis Connector created")
+		mgm.pc = pc
+	}
+	return mgm.pc
+}
+
+// getProximityQuery - Get GPS-PROXIMITY settings of the provided group
+func getProximityQuery(mgInfo *mgInfo) proximityQuery {
+	return proximityQuery{distance: *mgInfo.mg.ProximityDistance, time: *mgInfo.mg.ProximityTime}
+}
+
+// getProximity - Get GPS-PROXIMITY setting value, using the provided default value if not set
+func getProximity(value *int32, defaultValue int32) *int32 {
+	proximity := defaultValue
+	if value != nil {
+		proximity = *value
+	}
+	return &proximity
+}
+
+// getAllUePoaInProximity - Get POAs in proximity of all UEs using the provided proximity settings
+func getAllUePoaInProximity(query proximityQuery) (map[string][]string, error) {
+	pc := getPostgisConnector()
+	if pc == nil {
+		return nil, errors.New("Postgis connector unavailable")
+	}
+	return pc.GetAllUePoaInProximity(float32(query.distance), float32(query.time))
+}
+
+// updateNetLocsInProximity - Update POAs in proximity of UEs tracked by GPS proximity groups using
+// the provided query results; returns true if POAs in proximity changed for any UE
+// NOTE: Caller must hold the lock
+func updateNetLocsInProximity(poaInProximityMaps map[proximityQuery]map[string][]string) bool {
+	updated := false
+	for _, mgInfo := range mgm.mgInfoMap {
+		if mgInfo.mg.StateTransferTrigger != stateTransTrigGPSProximity {
+			continue
+		}
+		poaInProximityMap, found := poaInProximityMaps[getProximityQuery(mgInfo)]
+		if !found {
+			continue
+		}
+		for ueName, ueInfo := range mgInfo.ueInfoMap {
+			if setNetLocsInProximity(ueInfo, poaInProximityMap[ueName]) {
+				updated = true
+			}
+		}
+	}
+	return updated
+}
+
+// refreshNetLocsInProximity - Update POAs the UE reaches along its path within the MG proximity
+// distance or time-to-arrival; returns true if POAs in proximity changed
+func refreshNetLocsInProximity(mgInfo *mgInfo, ueInfo *ueInfo) bool {
+	pc := getPostgisConnector()
+	if pc == nil {
+		return false
+	}
+	query := getProximityQuery(mgInfo)
+	poaList, err := pc.GetUePoaInProximity(ueInfo.ue.Id, float32(query.distance), float32(query.time))
+	if err != nil {
+		log.Debug("No POAs in proximity for UE: ", ueInfo.ue.Id, ". Error: ", err.Error())
+	}
+	return setNetLocsInProximity(ueInfo, poaList)
+}
+
+// setNetLocsInProximity - Set POAs in proximity of the UE; returns true if POAs in proximity changed
+func setNetLocsInProximity(ueInfo *ueInfo, poaList []string) bool {
+	netLocsInProximity := map[string]bool{}
+	for _, poa := range poaList {
+		netLocsInProximity[poa] = true
+	}
+
+	// Compare with previous POAs in proximity
+	updated := len(netLocsInProximity) != len(ueInfo.netLocsInProximity)
+	for netLoc := range netLocsInProximity {
+		if !ueInfo.netLocsInProximity[netLoc] {
+			updated = true
+		}
+	}
+	ueInfo.netLocsInProximity = netLocsInProximity
+	return updated
+}
+
 func clearScenario() {
 	log.Debug("clearScenario() -- Resetting all variables")
 
+	// Stop pending handoffs & event deliveries
+	for _, mgInfo := range mgm.mgInfoMap {
+		for _, transfer := range mgInfo.handoffMap {
+			transfer.timer.Stop()
+		}
+		for _, appInfo := range mgInfo.appInfoMap {
+			appInfo.eventQueue.close()
+		}
+	}
+
 	mgm.networkGraph = nil
+	mgm.latencyGraph = nil
 	mgm.netLocList = make([]string, 0)
 	mgm.svcInfoMap = make(map[string]*serviceInfo)
 	mgm.mgSvcInfoMap = make(map[string]*mgServiceInfo)
@@ -310,8 +560,9 @@ func processScenario(model *mod.Model) error {
 	// Get list of processes
 	procNames := model.GetNodeNames("CLOUD-APP", "EDGE-APP", "UE-APP")
 
-	// Get network graph from model
+	// Get network graphs from model
 	mgm.networkGraph = model.GetNetworkGraph()
+	mgm.latencyGraph = model.GetLatencyGraph()
 
 	// Create NetElem for each scenario process
 	for _, name := range procNames {
@@ -440,48 +691,62 @@ func setDefaultNetLocAppMaps() {
 	log.Debug("setDefaultNetLocAppMaps")
 
 	// For each MG Service & net location in scenario, use Group App instances from scenario and
-	// default LB algorithm to determine which App instance is best for net location
+	// Group LB algorithm to determine which App instance is best for net location
 	for _, mgInfo := range mgm.mgInfoMap {
 		// Only set on first pass
 		if len(mgInfo.defaultNetLocAppMap) == 0 {
-			for _, netLoc := range mgm.netLocList {
-				mgInfo.defaultNetLocAppMap[netLoc] = runLbAlgoHopCount(mgm.mgSvcInfoMap[mgInfo.mg.Name].services, netLoc)
-			}
+			refreshDefaultNetLocAppMap(mgInfo)
 		}
 	}
 }
 
+func refreshDefaultNetLocAppMap(mgInfo *mgInfo) {
+	// Ignore groups without scenario service instances
+	mgSvcInfo := mgm.mgSvcInfoMap[mgInfo.mg.Name]
+	if mgSvcInfo == nil {
+		return
+	}
+
+	mgInfo.defaultNetLocAppMap = make(map[string]string)
+	for _, netLoc := range mgm.netLocList {
+		mgInfo.defaultNetLocAppMap[netLoc] = runLbAlgo(mgInfo.mg.LoadBalancingAlgorithm, mgSvcInfo.services, nil, netLoc)
+	}
+}
+
 func refreshNetLocAppMap(mgInfo *mgInfo) {
 	log.Debug("refreshNetLocAppMap")
 
 	// Reset Net Loc App map
 	mgInfo.netLocAppMap = make(map[string]string)
 
-	// Retrieve list of registered app services
+	// Retrieve list of registered app services & measured loads
 	var mgApps = map[string]*serviceInfo{}
+	var mgAppLoads = map[string]int32{}
 	for _, appInfo := range mgInfo.appInfoMap {
 		mgApps[appInfo.app.Id] = mgm.svcInfoMap[appInfo.app.Id]
 		if mgApps[appInfo.app.Id] == nil {
 
 			mgApps[appInfo.app.Id] = mgm.svcInfoMap[mgm.svcToElemMap[appInfo.app.Id]]
 		}
+		if mgApps[appInfo.app.Id] != nil {
+			mgAppLoads[mgApps[appInfo.app.Id].name] = appInfo.app.Load
+		}
 	}
 
 	// For each net location in scenario, use Group LB algorithm to determine which
 	// registered Group App is best for net location
 	for _, netLoc := range mgm.netLocList {
-		if mgInfo.mg.LoadBalancingAlgorithm == lbAlgoHopCount {
-			mgInfo.netLocAppMap[netLoc] = runLbAlgoHopCount(mgApps, netLoc)
-		} else {
-			log.Error("LB algorithm not yet supported: ", mgInfo.mg.LoadBalancingAlgorithm)
-			break
-		}
+		mgInfo.netLocAppMap[netLoc] = runLbAlgo(mgInfo.mg.LoadBalancingAlgorithm, mgApps, mgAppLoads, netLoc)
 	}
 }
 
 func refreshMgSvcMapping() {
 	log.Debug("refreshMgSvcMapping")
 
+	// Transfer state is also updated from event delivery callbacks
+	mgm.mutex.Lock()
+	defer mgm.mutex.Unlock()
+
 	// For each network element, populate MG Service mapping
 	for _, netElemInfo := range mgm.netElemInfoMap {
 
@@ -504,50 +769,53 @@ func refreshMgSvcMapping() {
 			// If Net Elem is not tracked, apply update immediately
 			ueInfo := mgInfo.ueInfoMap[netElemInfo.phyLoc]
 			if ueInfo == nil {
-				setSvcMap(netElemInfo, mgInfo.mg.Name, mgInfo.netLocAppMap[netElemInfo.netLoc])
+				setSvcMap(netElemInfo, mgInfo.mg.Name, getBestApp(mgInfo, netElemInfo))
 				continue
 			}
 			// If UE is tracked, use MG settings to determine if a notification must be sent
 			if mgInfo.mg.StateTransferTrigger == stateTransTrigNetLocChange {
 				// Trigger start/stop on location change only
 				var currentApp = netElemInfo.mgSvcMap[mgInfo.mg.Name].lbSvcName
-				var bestApp = mgInfo.netLocAppMap[netElemInfo.netLoc]
-
-				// If new location requires a new Group App instance, send Transfer Complete
-				// notification and update mapping
-				if bestApp != currentApp {
-					log.Info("Best App: " + bestApp + " != Current App: " + currentApp)
-					completeStateTransfer(mgInfo, netElemInfo, ueInfo, mgm.elemToSvcMap[currentApp])
-					setSvcMap(netElemInfo, mgInfo.mg.Name, bestApp)
-				}
+				var bestApp = getBestApp(mgInfo, netElemInfo)
+
+				// If new location requires a new Group App instance, transfer UE state or
+				// App instance and update mapping
+				updateAppMapping(mgInfo, netElemInfo, ueInfo, currentApp, bestApp)
 
-			} else if mgInfo.mg.StateTransferTrigger == stateTransTrigNetLocInRange {
+			} else if mgInfo.mg.StateTransferTrigger == stateTransTrigNetLocInRange ||
+				mgInfo.mg.StateTransferTrigger == stateTransTrigGPSProximity {
 				// Trigger start/complete/cancel based on network location & locations in range
+				// or, for GPS proximity, POAs reached along the UE path
 				var currentApp = netElemInfo.mgSvcMap[mgInfo.mg.Name].lbSvcName
-				var bestApp = mgInfo.netLocAppMap[netElemInfo.netLoc]
+				var bestApp = getBestApp(mgInfo, netElemInfo)
+				netLocsInRange := netElemInfo.netLocsInRange
+				if mgInfo.mg.StateTransferTrigger == stateTransTrigGPSProximity {
+					netLocsInRange = ueInfo.netLocsInProximity
+				}
 
 				// Find all Group Apps in range based on Net Locations in range
-				mgm.mutex.Lock()
+				// NOTE: Sticky sessions never leave their instance so no other App is in range
 				ueInfo.appsInRange = map[string]bool{}
 				ueInfo.appsInRange[bestApp] = true
-				for netLoc := range netElemInfo.netLocsInRange {
-					if netLoc != netElemInfo.netLoc {
-						ueInfo.appsInRange[mgInfo.netLocAppMap[netLoc]] = true
+				if mgInfo.mg.LoadBalancingAlgorithm != lbAlgoSticky {
+					for netLoc := range netLocsInRange {
+						if netLoc != netElemInfo.netLoc {
+							ueInfo.appsInRange[mgInfo.netLocAppMap[netLoc]] = true
+						}
 					}
 				}
-				mgm.mutex.Unlock()
-
-				// If new location requires a new Group App instance, send Transfer Complete
-				// notification and update mapping
-				if bestApp != currentApp {
-					log.Info("Best App: " + bestApp + " != Current App: " + currentApp)
-					completeStateTransfer(mgInfo, netElemInfo, ueInfo, mgm.elemToSvcMap[currentApp])
-					setSvcMap(netElemInfo, mgInfo.mg.Name, bestApp)
-				}
+
+				// If new location requires a new Group App instance, transfer UE state or
+				// App instance and update mapping
+				updateAppMapping(mgInfo, netElemInfo, ueInfo, currentApp, bestApp)
 
 				// Start or cancel State Transfer based on the following conditions:
 				//   - How many apps are in range
 				//   - Whether a transfer was already in progress
+				// NOTE: Handoffs start only when the UE changes location
+				if isHandoffTransMode(mgInfo.mg.StateTransferMode) {
+					continue
+				}
 				if len(ueInfo.appsInRange) > 1 && !netElemInfo.transferInProgress {
 					startStateTransfer(mgInfo, netElemInfo, ueInfo, bestApp)
 				} else if len(ueInfo.appsInRange) == 1 && netElemInfo.transferInProgress {
@@ -555,13 +823,29 @@ func refreshMgSvcMapping() {
 				}
 
 			} else {
-				log.Error("LB algorithm not yet supported: ", mgInfo.mg.LoadBalancingAlgorithm)
+				log.Error("State transfer trigger not yet supported: ", mgInfo.mg.StateTransferTrigger)
 				continue
 			}
 		}
 	}
 }
 
+// updateAppMapping - Move network element to the best Group App using the MG state transfer mode
+func updateAppMapping(mgInfo *mgInfo, netElemInfo *netElemInfo, ueInfo *ueInfo, currentApp string, bestApp string) {
+	// Handoff modes keep the current mapping until the instance state is handed off
+	if isHandoffTransMode(mgInfo.mg.StateTransferMode) {
+		updateHandoff(mgInfo, netElemInfo, ueInfo, currentApp, bestApp)
+		return
+	}
+
+	// State transfer modes send Transfer Complete notification and update mapping immediately
+	if bestApp != currentApp {
+		log.Info("Best App: " + bestApp + " != Current App: " + currentApp)
+		completeStateTransfer(mgInfo, netElemInfo, ueInfo, mgm.elemToSvcMap[currentApp], mgm.elemToSvcMap[bestApp])
+		setSvcMap(netElemInfo, mgInfo.mg.Name, bestApp)
+	}
+}
+
 func setSvcMap(netElemInfo *netElemInfo, mgSvcName string, lbSvcName string) {
 
 	// Get existing entry, if any
@@ -577,6 +861,100 @@ func setSvcMap(netElemInfo *netElemInfo, mgSvcName string, lbSvcName string) {
 	svcMap.lbSvcName = lbSvcName
 }
 
+// getBestApp - Retrieve best Group App for provided network element
+// NOTE: Sticky sessions keep their current App for as long as it remains registered
+func getBestApp(mgInfo *mgInfo, netElemInfo *netElemInfo) string {
+	if mgInfo.mg.LoadBalancingAlgorithm == lbAlgoSticky {
+		svcMap := netElemInfo.mgSvcMap[mgInfo.mg.Name]
+		if svcMap != nil && svcMap.lbSvcName != "" && mgInfo.appInfoMap[mgm.elemToSvcMap[svcMap.lbSvcName]] != nil {
+			return svcMap.lbSvcName
+		}
+	}
+	return mgInfo.netLocAppMap[netElemInfo.netLoc]
+}
+
+// isValidLbAlgo - Check if LB algorithm is supported
+func isValidLbAlgo(lbAlgo string) bool {
+	switch lbAlgo {
+	case lbAlgoHopCount, lbAlgoLatency, lbAlgoLoad, lbAlgoSticky:
+		return true
+	}
+	return false
+}
+
+// isValidSessionTransMode - Check if session transfer mode is supported
+func isValidSessionTransMode(mode string) bool {
+	switch mode {
+	case sessionTransModeForced, sessionTransModeGraceful:
+		return true
+	}
+	return false
+}
+
+// isValidStateTransMode - Check if state transfer mode is supported
+func isValidStateTransMode(mode string) bool {
+	switch mode {
+	case stateTransModeStateDirect, stateTransModeStateManaged, stateTransModeHandoffDirect, stateTransModeHandoffManaged:
+		return true
+	}
+	return false
+}
+
+// isValidStateTransTrigger - Check if state transfer trigger is supported
+func isValidStateTransTrigger(trigger string) bool {
+	switch trigger {
+	case stateTransTrigNetLocInRange, stateTransTrigNetLocChange, stateTransTrigGPSProximity:
+		return true
+	}
+	return false
+}
+
+// isHandoffTransMode - Check if state transfer mode hands off UE traffic with the App instance state
+func isHandoffTransMode(mode string) bool {
+	return mode == stateTransModeHandoffDirect || mode == stateTransModeHandoffManaged
+}
+
+// validateMobilityGroup - Check if requested mobility group settings are supported
+func validateMobilityGroup(mg *mgModel.MobilityGroup) error {
+	if mg.StateTransferMode != "" && !isValidStateTransMode(mg.StateTransferMode) {
+		return errors.New("Unsupported state transfer mode: " + mg.StateTransferMode)
+	}
+	if mg.StateTransferTrigger != "" && !isValidStateTransTrigger(mg.StateTransferTrigger) {
+		return errors.New("Unsupported state transfer trigger: " + mg.StateTransferTrigger)
+	}
+	if mg.ProximityDistance != nil && *mg.ProximityDistance < 0 {
+		return errors.New("Invalid proximity distance: " + strconv.Itoa(int(*mg.ProximityDistance)))
+	}
+	if mg.ProximityTime != nil && *mg.ProximityTime < 0 {
+		return errors.New("Invalid proximity time: " + strconv.Itoa(int(*mg.ProximityTime)))
+	}
+	if mg.LoadBalancingAlgorithm != "" && !isValidLbAlgo(mg.LoadBalancingAlgorithm) {
+		return errors.New("Unsupported LB algorithm: " + mg.LoadBalancingAlgorithm)
+	}
+	if mg.SessionTransferMode != "" && !isValidSessionTransMode(mg.SessionTransferMode) {
+		return errors.New("Unsupported session transfer mode: " + mg.SessionTransferMode)
+	}
+	if mg.SessionDrainTimeout < 0 {
+		return errors.New("Invalid session drain timeout: " + strconv.Itoa(int(mg.SessionDrainTimeout)))
+	}
+	return nil
+}
+
+// runLbAlgo - Run requested LB algorithm to determine best service instance for provided element
+func runLbAlgo(lbAlgo string, services map[string]*serviceInfo, loads map[string]int32, elem string) string {
+	switch lbAlgo {
+	case lbAlgoHopCount:
+		return runLbAlgoHopCount(services, elem)
+	case lbAlgoLatency, lbAlgoSticky:
+		return runLbAlgoLatency(services, nil, elem)
+	case lbAlgoLoad:
+		return runLbAlgoLatency(services, loads, elem)
+	default:
+		log.Error("LB algorithm not yet supported: ", lbAlgo)
+	}
+	return ""
+}
+
 // LB Algorithm:
 //   - Compare hop count from current pod to each instance
 //   - Choose closest instance
@@ -599,63 +977,312 @@ func runLbAlgoHopCount(services map[string]*serviceInfo, elem string) string {
 	return lbSvc
 }
 
-func startStateTransfer(group *mgInfo, elem *netElemInfo, ue *ueInfo, app string) {
-	log.Info("Sending " + eventTypeStateTransferStart + " Notification for " + ue.ue.Id + " to " + app)
+// LB Algorithm:
+//   - Compare configured latency from current pod to each instance
+//   - If instance loads are provided, scale latency cost by measured instance load
+//   - Choose instance with lowest cost; use hop count & name to break ties
+func runLbAlgoLatency(services map[string]*serviceInfo, loads map[string]int32, elem string) string {
+	var minCost int64 = -1
+	var minHops int64 = -1
+	var lbSvc = ""
 
-	go func() {
-		var event mga.MobilityGroupEvent
-		event.Name = eventTypeStateTransferStart
-		event.Type_ = eventTypeStateTransferStart
-		event.UeId = ue.ue.Id
-		//lint:ignore SA1012 context.TODO not supported here
-		_, err := group.appInfoMap[app].appClient.StateTransferApi.HandleEvent(nil, event)
+	for _, svc := range services {
+		// Calculate lowest latency; ignore unreachable instances
+		latency, err := getShortestDistance(mgm.latencyGraph, elem, svc.node)
 		if err != nil {
-			log.Error(err.Error())
+			continue
+		}
+		hops, _ := getShortestDistance(mgm.networkGraph, elem, svc.node)
+
+		// Weigh latency by load; latency is offset to account for load when latency is not configured
+		cost := latency
+		if loads != nil {
+			load := int64(loads[svc.name])
+			if load < 0 {
+				load = 0
+			} else if load > maxAppLoad {
+				load = maxAppLoad
+			}
+			cost = (latency + 1) * (maxAppLoad + load)
+		}
+
+		// Store as LB service if lowest cost service instance
+		if lbSvc == "" || cost < minCost ||
+			(cost == minCost && (hops < minHops || (hops == minHops && svc.name < lbSvc))) {
+			minCost = cost
+			minHops = hops
+			lbSvc = svc.name
+		}
+	}
+	return lbSvc
+}
+
+// getShortestDistance - Get shortest path distance between provided graph vertices
+func getShortestDistance(graph *dijkstra.Graph, src string, dst string) (int64, error) {
+	if graph == nil {
+		return 0, errors.New("Missing network graph")
+	}
+	srcId, err := graph.GetMapping(src)
+	if err != nil {
+		return 0, err
+	}
+	dstId, err := graph.GetMapping(dst)
+	if err != nil {
+		return 0, err
+	}
+	path, err := graph.Shortest(srcId, dstId)
+	if err != nil {
+		return 0, err
+	}
+	return path.Distance, nil
+}
+
+// sendEvent - Queue event notification for ordered delivery to Group App
+// If provided, onDone is invoked with the delivery result once delivered or dropped.
+func sendEvent(app *appInfo, event mga.MobilityGroupEvent, onDone func(err error)) {
+	log.Info("Sending " + event.Type_ + " Notification for " + event.UeId + " to " + app.app.Id)
+	app.eventQueue.push(event, onDone)
+}
+
+// getPeers - Get peer information for the provided Group Apps, if registered
+func getPeers(group *mgInfo, apps ...string) []mga.MobilityGroupPeer {
+	peers := []mga.MobilityGroupPeer{}
+	for _, app := range apps {
+		if appInfo := group.appInfoMap[app]; appInfo != nil {
+			peers = append(peers, mga.MobilityGroupPeer{AppId: appInfo.app.Id, Url: appInfo.app.Url})
 		}
-	}()
+	}
+	return peers
+}
+
+// setMobilityMetric - Store state transfer duration measured from provided start time
+func setMobilityMetric(group *mgInfo, metricType string, ueId string, srcApp string, dstApp string, result string, start time.Time) {
+	var metric ms.MobilityMetric
+	metric.MgName = group.mg.Name
+	metric.Mode = group.mg.StateTransferMode
+	metric.Type = metricType
+	metric.UeId = ueId
+	metric.SrcApp = srcApp
+	metric.DstApp = dstApp
+	metric.Result = result
+	metric.Duration = int32(time.Since(start) / time.Millisecond)
+	if mgm.metricStore == nil {
+		log.Warn("Metric Store unavailable; dropping mobility metric for UE: ", ueId)
+		return
+	}
+	err := mgm.metricStore.SetMobilityMetric(metric)
+	if err != nil {
+		log.Error("Failed to set mobility metric: ", err.Error())
+	}
+}
+
+func startStateTransfer(group *mgInfo, elem *netElemInfo, ue *ueInfo, app string) {
+	var event mga.MobilityGroupEvent
+	event.Name = eventTypeStateTransferStart
+	event.Type_ = eventTypeStateTransferStart
+	event.UeId = ue.ue.Id
+
+	// In direct mode, provide the apps in range the UE state may be transferred to
+	if group.mg.StateTransferMode == stateTransModeStateDirect {
+		var peers []string
+		for appInRange := range ue.appsInRange {
+			if appInRange != app {
+				peers = append(peers, mgm.elemToSvcMap[appInRange])
+			}
+		}
+		event.Peers = getPeers(group, peers...)
+	}
+
+	// Identify this transfer so that a late start notification failure does not reset a newer transfer
+	elem.transferGen++
+	transferGen := elem.transferGen
+
+	if appInfo := group.appInfoMap[app]; appInfo != nil {
+		sendEvent(appInfo, event, func(err error) {
+			// Restart transfer on next mapping refresh if App never received start notification
+			if err != nil {
+				mgm.mutex.Lock()
+				defer mgm.mutex.Unlock()
+				if elem.transferGen == transferGen {
+					elem.transferInProgress = false
+				}
+			}
+		})
+	}
 
 	// Set flag indicating transfer has been started
 	elem.transferInProgress = true
+	ue.transferStart = time.Now()
 }
 
-func completeStateTransfer(group *mgInfo, elem *netElemInfo, ue *ueInfo, app string) {
-	log.Info("Sending " + eventTypeStateTransferComplete + " Notification for " + ue.ue.Id + " to " + app)
+func completeStateTransfer(group *mgInfo, elem *netElemInfo, ue *ueInfo, app string, newApp string) {
+	var event mga.MobilityGroupEvent
+	event.Name = eventTypeStateTransferComplete
+	event.Type_ = eventTypeStateTransferComplete
+	event.UeId = ue.ue.Id
 
-	go func() {
-		var event mga.MobilityGroupEvent
-		event.Name = eventTypeStateTransferComplete
-		event.Type_ = eventTypeStateTransferComplete
-		event.UeId = ue.ue.Id
-		//lint:ignore SA1012 context.TODO not supported here
-		_, err := group.appInfoMap[app].appClient.StateTransferApi.HandleEvent(nil, event)
-		if err != nil {
-			log.Error(err.Error())
-		}
-	}()
+	// In direct mode, provide the app now serving the UE
+	if group.mg.StateTransferMode == stateTransModeStateDirect {
+		event.Peers = getPeers(group, newApp)
+	}
+
+	if appInfo := group.appInfoMap[app]; appInfo != nil {
+		sendEvent(appInfo, event, nil)
+	}
+
+	// Record time elapsed since transfer was started
+	if !ue.transferStart.IsZero() {
+		setMobilityMetric(group, ms.MgMetTypeStateTransfer, ue.ue.Id, app, newApp, ms.MgMetResultComplete, ue.transferStart)
+		ue.transferStart = time.Time{}
+	}
 
 	// Set flag indicating transfer has been started
 	elem.transferInProgress = false
 }
 
 func cancelStateTransfer(group *mgInfo, elem *netElemInfo, ue *ueInfo, app string) {
-	log.Info("Sending " + eventTypeStateTransferCancel + " Notification for " + ue.ue.Id + " to " + app)
+	var event mga.MobilityGroupEvent
+	event.Name = eventTypeStateTransferCancel
+	event.Type_ = eventTypeStateTransferCancel
+	event.UeId = ue.ue.Id
 
-	go func() {
-		var event mga.MobilityGroupEvent
-		event.Name = eventTypeStateTransferCancel
-		event.Type_ = eventTypeStateTransferCancel
-		event.UeId = ue.ue.Id
-		//lint:ignore SA1012 context.TODO not supported here
-		_, err := group.appInfoMap[app].appClient.StateTransferApi.HandleEvent(nil, event)
-		if err != nil {
-			log.Error(err.Error())
-		}
-	}()
+	if appInfo := group.appInfoMap[app]; appInfo != nil {
+		sendEvent(appInfo, event, nil)
+	}
+
+	// Record time elapsed since transfer was started
+	if !ue.transferStart.IsZero() {
+		setMobilityMetric(group, ms.MgMetTypeStateTransfer, ue.ue.Id, app, "", ms.MgMetResultCancel, ue.transferStart)
+		ue.transferStart = time.Time{}
+	}
 
 	// Set flag indicating transfer has been cancelled
 	elem.transferInProgress = false
 }
 
+// updateHandoff - Start, extend or cancel UE handoff to another App instance
+func updateHandoff(group *mgInfo, elem *netElemInfo, ue *ueInfo, currentApp string, bestApp string) {
+	transfer := group.handoffMap[ue.ue.Id]
+
+	// Cancel handoff if UE returned to current instance or moved towards another instance
+	if transfer != nil && transfer.dstApp != bestApp {
+		cancelHandoff(group, transfer)
+		transfer = nil
+	}
+	if bestApp == currentApp {
+		return
+	}
+
+	// Add network element to UE handoff already in progress
+	if transfer != nil {
+		transfer.elems[elem.name] = true
+		return
+	}
+	startHandoff(group, elem, ue, currentApp, bestApp)
+}
+
+// startHandoff - Request source instance to hand off its instance state to the target instance
+func startHandoff(group *mgInfo, elem *netElemInfo, ue *ueInfo, srcApp string, dstApp string) {
+	srcAppInfo := group.appInfoMap[mgm.elemToSvcMap[srcApp]]
+	dstAppInfo := group.appInfoMap[mgm.elemToSvcMap[dstApp]]
+
+	// Switch UE traffic immediately if source or target instance is not registered
+	if srcAppInfo == nil || dstAppInfo == nil {
+		log.Info("Switching " + ue.ue.Id + " from " + srcApp + " to " + dstApp + " without handoff")
+		setSvcMap(elem, group.mg.Name, dstApp)
+		return
+	}
+
+	transfer := new(handoffInfo)
+	transfer.ueId = ue.ue.Id
+	transfer.elems = map[string]bool{elem.name: true}
+	transfer.srcApp = srcApp
+	transfer.dstApp = dstApp
+	transfer.start = time.Now()
+	group.handoffMap[ue.ue.Id] = transfer
+
+	// Switch UE traffic anyway if instance state is not handed off in time
+	mgName := group.mg.Name
+	transfer.timer = time.AfterFunc(handoffTimeout, func() {
+		expireHandoff(mgName, transfer)
+	})
+
+	var event mga.MobilityGroupEvent
+	event.Name = eventTypeHandoffStart
+	event.Type_ = eventTypeHandoffStart
+	event.UeId = ue.ue.Id
+	event.Peers = getPeers(group, dstAppInfo.app.Id)
+	sendEvent(srcAppInfo, event, nil)
+}
+
+// completeHandoff - Switch UE network elements to the target instance
+// NOTE: Caller must apply the updated MG Service mappings
+func completeHandoff(group *mgInfo, transfer *handoffInfo, result string) {
+	log.Info("Handoff for " + transfer.ueId + " from " + transfer.srcApp + " to " + transfer.dstApp + ": " + result)
+	transfer.timer.Stop()
+	delete(group.handoffMap, transfer.ueId)
+
+	for elemName := range transfer.elems {
+		if elem := mgm.netElemInfoMap[elemName]; elem != nil {
+			setSvcMap(elem, group.mg.Name, transfer.dstApp)
+		}
+	}
+
+	var event mga.MobilityGroupEvent
+	event.Name = eventTypeHandoffComplete
+	event.Type_ = eventTypeHandoffComplete
+	event.UeId = transfer.ueId
+	for _, app := range []string{transfer.srcApp, transfer.dstApp} {
+		if appInfo := group.appInfoMap[mgm.elemToSvcMap[app]]; appInfo != nil {
+			sendEvent(appInfo, event, nil)
+		}
+	}
+
+	setMobilityMetric(group, ms.MgMetTypeHandoff, transfer.ueId, transfer.srcApp, transfer.dstApp, result, transfer.start)
+}
+
+// cancelHandoff - Abort handoff; UE network elements remain on the source instance
+func cancelHandoff(group *mgInfo, transfer *handoffInfo) {
+	log.Info("Handoff for " + transfer.ueId + " from " + transfer.srcApp + " to " + transfer.dstApp + ": " + ms.MgMetResultCancel)
+	transfer.timer.Stop()
+	delete(group.handoffMap, transfer.ueId)
+
+	var event mga.MobilityGroupEvent
+	event.Name = eventTypeHandoffCancel
+	event.Type_ = eventTypeHandoffCancel
+	event.UeId = transfer.ueId
+	for _, app := range []string{transfer.srcApp, transfer.dstApp} {
+		if appInfo := group.appInfoMap[mgm.elemToSvcMap[app]]; appInfo != nil {
+			sendEvent(appInfo, event, nil)
+		}
+	}
+
+	setMobilityMetric(group, ms.MgMetTypeHandoff, transfer.ueId, transfer.srcApp, transfer.dstApp, ms.MgMetResultCancel, transfer.start)
+}
+
+// cancelHandoffs - Abort all handoffs in progress for the group
+func cancelHandoffs(group *mgInfo) {
+	for _, transfer := range group.handoffMap {
+		cancelHandoff(group, transfer)
+	}
+}
+
+// expireHandoff - Switch UE traffic if handoff is still pending after timeout
+func expireHandoff(mgName string, transfer *handoffInfo) {
+	mgm.mutex.Lock()
+	defer mgm.mutex.Unlock()
+
+	group := mgm.mgInfoMap[mgName]
+	if group == nil || group.handoffMap[transfer.ueId] != transfer {
+		return
+	}
+	log.Warn("Handoff timed out for " + transfer.ueId + " in group: " + mgName)
+	completeHandoff(group, transfer, ms.MgMetResultTimeout)
+
+	// Store & Apply latest MG Service mappings
+	applyMgSvcMapping()
+}
+
 func applyMgSvcMapping() {
 	log.Debug("applyMgSvcMapping")
 
@@ -673,6 +1300,12 @@ func applyMgSvcMapping() {
 			mgSvcMap.MgSvcName = svcMap.mgSvcName
 			mgSvcMap.LbSvcName = svcMap.lbSvcName
 
+			// Session transfer settings used to enforce LB rule changes
+			if mgInfo := mgm.mgInfoMap[svcMap.mgSvcName]; mgInfo != nil {
+				mgSvcMap.SessionTransferMode = mgInfo.mg.SessionTransferMode
+				mgSvcMap.SessionDrainTimeout = mgInfo.mg.SessionDrainTimeout
+			}
+
 			// Add service maps to list
 			netElem.ServiceMaps = append(netElem.ServiceMaps, mgSvcMap)
 		}
@@ -713,10 +1346,28 @@ func mgCreate(mg *mgModel.MobilityGroup) error {
 	// Create new Mobility Group & copy data
 	mgInfo := new(mgInfo)
 	mgInfo.mg = *mg
+	if mgInfo.mg.StateTransferMode == "" {
+		mgInfo.mg.StateTransferMode = stateTransModeStateManaged
+	}
+	if mgInfo.mg.StateTransferTrigger == "" {
+		mgInfo.mg.StateTransferTrigger = stateTransTrigNetLocInRange
+	}
+	mgInfo.mg.ProximityDistance = getProximity(mg.ProximityDistance, defaultProximityDistance)
+	mgInfo.mg.ProximityTime = getProximity(mg.ProximityTime, defaultProximityTime)
+	if mgInfo.mg.LoadBalancingAlgorithm == "" {
+		mgInfo.mg.LoadBalancingAlgorithm = lbAlgoHopCount
+	}
+	if mgInfo.mg.SessionTransferMode == "" {
+		mgInfo.mg.SessionTransferMode = sessionTransModeForced
+	}
+	if mgInfo.mg.SessionDrainTimeout == 0 {
+		mgInfo.mg.SessionDrainTimeout = defaultSessionDrainTimeout
+	}
 	mgInfo.appInfoMap = make(map[string]*appInfo)
 	mgInfo.ueInfoMap = make(map[string]*ueInfo)
 	mgInfo.netLocAppMap = make(map[string]string)
 	mgInfo.defaultNetLocAppMap = make(map[string]string)
+	mgInfo.handoffMap = make(map[string]*handoffInfo)
 
 	// Add to MG map
 	mgm.mgInfoMap[mg.Name] = mgInfo
@@ -735,20 +1386,90 @@ func mgUpdate(mg *mgModel.MobilityGroup) error {
 	}
 
 	// Update Mobility Group
+	prevMg := mgInfo.mg
 	mgInfo.mg = *mg
-
+	if mgInfo.mg.StateTransferMode == "" {
+		mgInfo.mg.StateTransferMode = prevMg.StateTransferMode
+	}
+	if mgInfo.mg.StateTransferTrigger == "" {
+		mgInfo.mg.StateTransferTrigger = prevMg.StateTransferTrigger
+	}
+	mgInfo.mg.ProximityDistance = getProximity(mg.ProximityDistance, *prevMg.ProximityDistance)
+	mgInfo.mg.ProximityTime = getProximity(mg.ProximityTime, *prevMg.ProximityTime)
+	if mgInfo.mg.LoadBalancingAlgorithm == "" {
+		mgInfo.mg.LoadBalancingAlgorithm = prevMg.LoadBalancingAlgorithm
+	}
+	if mgInfo.mg.SessionTransferMode == "" {
+		mgInfo.mg.SessionTransferMode = prevMg.SessionTransferMode
+	}
+	if mgInfo.mg.SessionDrainTimeout == 0 {
+		mgInfo.mg.SessionDrainTimeout = prevMg.SessionDrainTimeout
+	}
 	log.Info("Updated MG: ", mg.Name)
+	prevLbAlgo := prevMg.LoadBalancingAlgorithm
+
+	// Pending handoffs no longer apply if state transfer mode changed
+	stateTransModeChanged := mgInfo.mg.StateTransferMode != prevMg.StateTransferMode
+	if stateTransModeChanged {
+		log.Info("MG " + mg.Name + " state transfer mode changed from " + prevMg.StateTransferMode + " to " + mgInfo.mg.StateTransferMode)
+		cancelHandoffs(mgInfo)
+	}
+
+	// Refresh POAs in proximity if GPS proximity settings changed
+	proximityChanged := *mgInfo.mg.ProximityDistance != *prevMg.ProximityDistance ||
+		*mgInfo.mg.ProximityTime != *prevMg.ProximityTime
+	stateTransTrigChanged := mgInfo.mg.StateTransferTrigger != prevMg.StateTransferTrigger ||
+		(mgInfo.mg.StateTransferTrigger == stateTransTrigGPSProximity && proximityChanged)
+	if stateTransTrigChanged && mgInfo.mg.StateTransferTrigger == stateTransTrigGPSProximity && len(mgInfo.ueInfoMap) != 0 {
+		poaInProximityMap, err := getAllUePoaInProximity(getProximityQuery(mgInfo))
+		if err != nil {
+			log.Error("Failed to get POAs in proximity with error: ", err.Error())
+		}
+		for ueName, ueInfo := range mgInfo.ueInfoMap {
+			_ = setNetLocsInProximity(ueInfo, poaInProximityMap[ueName])
+		}
+	}
+
+	// Re-evaluate Group App mappings if LB algorithm changed
+	if mgInfo.mg.LoadBalancingAlgorithm != prevLbAlgo {
+		log.Info("MG " + mg.Name + " LB algorithm changed from " + prevLbAlgo + " to " + mgInfo.mg.LoadBalancingAlgorithm)
+		refreshDefaultNetLocAppMap(mgInfo)
+		refreshNetLocAppMap(mgInfo)
+
+		// Re-evaluate MG Service mapping
+		refreshMgSvcMapping()
+
+		// Store & Apply latest MG Service mappings
+		applyMgSvcMapping()
+	} else if stateTransModeChanged || stateTransTrigChanged {
+		// Re-evaluate MG Service mapping using new state transfer settings
+		refreshMgSvcMapping()
+
+		// Store & Apply latest MG Service mappings
+		applyMgSvcMapping()
+	} else if mgInfo.mg.SessionTransferMode != prevMg.SessionTransferMode ||
+		mgInfo.mg.SessionDrainTimeout != prevMg.SessionDrainTimeout {
+		// Store & Apply MG Service mappings with new session transfer settings
+		applyMgSvcMapping()
+	}
 	return nil
 }
 
 func mgDelete(mgName string) error {
 	// Make sure group exists
-	if mgm.mgInfoMap[mgName] == nil {
+	mgInfo := mgm.mgInfoMap[mgName]
+	if mgInfo == nil {
 		log.Error("Mobility group does not exist: ", mgName)
 		err := errors.New("Mobility group does not exist")
 		return err
 	}
 
+	// Abort pending handoffs & stop event deliveries
+	cancelHandoffs(mgInfo)
+	for _, appInfo := range mgInfo.appInfoMap {
+		appInfo.eventQueue.close()
+	}
+
 	// Remove entry from map
 	delete(mgm.mgInfoMap, mgName)
 
@@ -785,6 +1506,9 @@ func mgAppCreate(mgName string, mgApp *mgModel.MobilityGroupApp) error {
 		return err
 	}
 
+	// Create event queue for ordered event delivery
+	mgAppInfo.eventQueue = newEventQueue(mgApp.Id, mgAppInfo.appClient)
+
 	// Add to MG App map & App client map
 	mgInfo.appInfoMap[mgApp.Id] = mgAppInfo
 	log.Info("Created new MG App: " + mgApp.Id + " in group: " + mgName)
@@ -816,6 +1540,7 @@ func mgAppUpdate(mgName string, mgApp *mgModel.MobilityGroupApp) error {
 	}
 
 	// Update Mobility Group App
+	prevLoad := mgAppInfo.app.Load
 	mgAppInfo.app = *mgApp
 
 	// Update & store client for MG App REST API
@@ -827,8 +1552,20 @@ func mgAppUpdate(mgName string, mgApp *mgModel.MobilityGroupApp) error {
 		err := errors.New("Failed to create MG App REST API client")
 		return err
 	}
+	mgAppInfo.eventQueue.setClient(mgAppInfo.appClient)
 
 	log.Info("Updated MG App: " + mgApp.Id + " in group: " + mgName)
+
+	// Re-evaluate Group App mappings if measured load changed
+	if mgInfo.mg.LoadBalancingAlgorithm == lbAlgoLoad && mgApp.Load != prevLoad {
+		refreshNetLocAppMap(mgInfo)
+
+		// Re-evaluate MG Service mapping
+		refreshMgSvcMapping()
+
+		// Store & Apply latest MG Service mappings
+		applyMgSvcMapping()
+	}
 	return nil
 }
 
@@ -841,12 +1578,16 @@ func mgAppDelete(mgName string, appID string) error {
 		return err
 	}
 	// Make sure App exists
-	if mgInfo.appInfoMap[appID] == nil {
+	mgAppInfo := mgInfo.appInfoMap[appID]
+	if mgAppInfo == nil {
 		log.Error("Mobility group App does not exist: ", appID)
 		err := errors.New("Mobility group App does not exist")
 		return err
 	}
 
+	// Stop event delivery
+	mgAppInfo.eventQueue.close()
+
 	// Remove entry from App map & App Client map
 	delete(mgInfo.appInfoMap, appID)
 	log.Info("Deleted MG App: " + appID + " in group: " + mgName)
@@ -877,7 +1618,11 @@ func mgUeCreate(mgName string, appID string, mgUe *mgModel.MobilityGroupUe) erro
 		UEInfo = new(ueInfo)
 		UEInfo.ue.Id = mgUe.Id
 		UEInfo.appsInRange = make(map[string]bool)
+		UEInfo.netLocsInProximity = make(map[string]bool)
 		mgInfo.ueInfoMap[mgUe.Id] = UEInfo
+		if mgInfo.mg.StateTransferTrigger == stateTransTrigGPSProximity {
+			_ = refreshNetLocsInProximity(mgInfo, UEInfo)
+		}
 
 		// Re-evaluate MG Service mapping
 		refreshMgSvcMapping()
@@ -898,6 +1643,12 @@ func processAppState(mgName string, appID string, mgAppState *mgModel.MobilityGr
 		err := errors.New("Mobility group does not exist")
 		return err
 	}
+	// UE state is relayed by the MG Manager in managed mode only
+	if mgInfo.mg.StateTransferMode != stateTransModeStateManaged {
+		log.Error("UE state transfer not supported in mode: ", mgInfo.mg.StateTransferMode)
+		err := errors.New("UE state transfer not supported in " + mgInfo.mg.StateTransferMode + " mode")
+		return err
+	}
 	// Retrieve App info
 	appInfo := mgInfo.appInfoMap[appID]
 
@@ -931,20 +1682,21 @@ func processAppState(mgName string, appID string, mgAppState *mgModel.MobilityGr
 			if appInfo == nil {
 				continue
 			}
-			// Start threads to process State update event for each app in range
-			log.Info("Sending " + eventTypeStateUpdate + " Notification for " + ueInfo.ue.Id + " to " + appName)
-			go func() {
-				var event mga.MobilityGroupEvent
-				event.Name = eventTypeStateUpdate
-				event.Type_ = eventTypeStateUpdate
-				event.UeId = ueInfo.ue.Id
-				event.AppState = appState
-				//lint:ignore SA1012 context.TODO not supported here
-				_, err := appInfo.appClient.StateTransferApi.HandleEvent(nil, event)
+			// Queue State update event for each app in range
+			var event mga.MobilityGroupEvent
+			event.Name = eventTypeStateUpdate
+			event.Type_ = eventTypeStateUpdate
+			event.UeId = ueInfo.ue.Id
+			event.AppState = appState
+			start := time.Now()
+			dstApp := appName
+			sendEvent(appInfo, event, func(err error) {
+				result := ms.MgMetResultComplete
 				if err != nil {
-					log.Error(err.Error())
+					result = ms.MgMetResultError
 				}
-			}()
+				setMobilityMetric(mgInfo, ms.MgMetTypeStateUpdate, ueInfo.ue.Id, appID, dstApp, result, start)
+			})
 		}
 	}
 	mgm.mutex.Unlock()
@@ -952,6 +1704,65 @@ func processAppState(mgName string, appID string, mgAppState *mgModel.MobilityGr
 	return nil
 }
 
+func processHandoffState(mgName string, appID string, mgHandoffState *mgModel.MobilityGroupAppState) error {
+	log.Info("Processing handoff state for UE: " + mgHandoffState.UeId + " from appID: " + appID + " in group: " + mgName)
+
+	// Handoffs are also updated by mapping refresh & transfer timeout
+	mgm.mutex.Lock()
+	defer mgm.mutex.Unlock()
+
+	// Retrieve MG info
+	mgInfo := mgm.mgInfoMap[mgName]
+	if mgInfo == nil {
+		log.Error("Mobility group does not exist: ", mgName)
+		err := errors.New("Mobility group does not exist")
+		return err
+	}
+	if !isHandoffTransMode(mgInfo.mg.StateTransferMode) {
+		log.Error("Handoff not supported in mode: ", mgInfo.mg.StateTransferMode)
+		err := errors.New("Handoff not supported in " + mgInfo.mg.StateTransferMode + " mode")
+		return err
+	}
+	// Retrieve App info
+	if mgInfo.appInfoMap[appID] == nil {
+		log.Error("Mobility group App does not exist: ", appID)
+		err := errors.New("Mobility group App does not exist")
+		return err
+	}
+	// Retrieve handoff started for UE on source App
+	transfer := mgInfo.handoffMap[mgHandoffState.UeId]
+	if transfer == nil || mgm.elemToSvcMap[transfer.srcApp] != appID {
+		log.Error("No handoff in progress for UE: " + mgHandoffState.UeId + " from appID: " + appID)
+		err := errors.New("No handoff in progress")
+		return err
+	}
+
+	// In managed mode, target instance must receive the instance state before UE traffic is switched
+	if mgInfo.mg.StateTransferMode == stateTransModeHandoffManaged {
+		dstAppInfo := mgInfo.appInfoMap[mgm.elemToSvcMap[transfer.dstApp]]
+		if dstAppInfo == nil {
+			log.Error("Mobility group App does not exist: ", transfer.dstApp)
+			err := errors.New("Mobility group target App does not exist")
+			return err
+		}
+
+		var event mga.MobilityGroupEvent
+		event.Name = eventTypeHandoffStateUpdate
+		event.Type_ = eventTypeHandoffStateUpdate
+		event.UeId = transfer.ueId
+		event.AppState = &mga.MobilityGroupAppState{UeId: transfer.ueId, UeState: mgHandoffState.UeState}
+		sendEvent(dstAppInfo, event, nil)
+	}
+
+	// Switch UE traffic to target instance
+	completeHandoff(mgInfo, transfer, ms.MgMetResultComplete)
+
+	// Store & Apply latest MG Service mappings
+	applyMgSvcMapping()
+
+	return nil
+}
+
 // GET Mobility Group List
 func mgGetMobilityGroupList(w http.ResponseWriter, r *http.Request) {
 	log.Debug("mgGetMobilityGroupList")
@@ -1027,6 +1838,14 @@ func mgCreateMobilityGroup(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// Validate MG settings
+	err = validateMobilityGroup(&mg)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
 	// Create new Mobility Group
 	err = mgCreate(&mg)
 	if err != nil {
@@ -1053,7 +1872,15 @@ func mgSetMobilityGroup(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Create new Mobility Group
+	// Validate MG settings
+	err = validateMobilityGroup(&mg)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// Update Mobility Group
 	err = mgUpdate(&mg)
 	if err != nil {
 		log.Error(err.Error())
@@ -1378,6 +2205,106 @@ func mgTransferAppState(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusOK)
 }
 
+func mgTransferAppHandoffState(w http.ResponseWriter, r *http.Request) {
+	log.Debug("mgTransferAppHandoffState")
+
+	// Get MG name from request parameters
+	vars := mux.Vars(r)
+	mgName := vars["mgName"]
+	appID := vars["appId"]
+
+	// Validate MG name
+	if mgName == "" {
+		log.Debug("Invalid MG name")
+		http.Error(w, "Invalid MG name", http.StatusBadRequest)
+		return
+	}
+	// Validate MG App name
+	if appID == "" {
+		log.Debug("Invalid MG App ID")
+		http.Error(w, "Invalid MG App ID", http.StatusBadRequest)
+		return
+	}
+
+	// Retrieve MG App instance state from request body
+	var mgHandoffState mgModel.MobilityGroupAppState
+	decoder := json.NewDecoder(r.Body)
+	err := decoder.Decode(&mgHandoffState)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// Process App instance state handoff
+	err = processHandoffState(mgName, appID, &mgHandoffState)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	w.WriteHeader(http.StatusOK)
+}
+
+func mgGetMobilityGroupAppEventList(w http.ResponseWriter, r *http.Request) {
+	log.Debug("mgGetMobilityGroupAppEventList")
+
+	// Get MG name & App ID from request parameters
+	vars := mux.Vars(r)
+	mgName := vars["mgName"]
+	appID := vars["appId"]
+	query := r.URL.Query()
+	ueID := query.Get("ueId")
+	status := query.Get("status")
+
+	// Validate MG name
+	if mgName == "" {
+		log.Debug("Invalid MG name")
+		http.Error(w, "Invalid MG name", http.StatusBadRequest)
+		return
+	}
+	// Validate MG App name
+	if appID == "" {
+		log.Debug("Invalid MG App ID")
+		http.Error(w, "Invalid MG App ID", http.StatusBadRequest)
+		return
+	}
+	// Validate event delivery status
+	if status != "" && status != eventStatusPending && status != eventStatusFailed {
+		log.Debug("Invalid event delivery status: ", status)
+		http.Error(w, "Invalid event delivery status", http.StatusBadRequest)
+		return
+	}
+
+	// Retrieve MG App from map
+	mgInfo := mgm.mgInfoMap[mgName]
+	if mgInfo == nil {
+		log.Error("Failed to find MG")
+		http.Error(w, "Failed to find MG", http.StatusNotFound)
+		return
+	}
+	mgAppInfo := mgInfo.appInfoMap[appID]
+	if mgAppInfo == nil {
+		log.Error("Failed to find MG App")
+		http.Error(w, "Failed to find MG App", http.StatusNotFound)
+		return
+	}
+
+	// Format response
+	jsonResponse, err := json.Marshal(mgAppInfo.eventQueue.getDeliveries(ueID, status))
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprint(w, string(jsonResponse))
+}
+
 // func mgmDebug(str string) {
 // 	log.Debug("+++++ " + str + " +++++")
 // 	log.Debug("+++ netLocList:")
@@ -0,0 +1,521 @@
+/*
+ * Copyright (c) 2019  InterDigital Communications, Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	log "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-logger"
+	ms "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-metric-store"
+	mga "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-mg-app-client"
+	mgModel "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-mg-manager-model"
+	mq "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-mq"
+	redis "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-redis"
+
+	"github.com/RyanCarrier/dijkstra"
+)
+
+const testRedisAddr = "localhost:30380"
+const testSandboxName = "test-sandbox"
+const testMgName = "test-mg"
+const testUeName = "ue1"
+const testApp1 = "app1"
+const testApp2 = "app2"
+
+type testMg struct {
+	group *mgInfo
+	elem  *netElemInfo
+	ue    *ueInfo
+	app1  *testGroupApp
+	app2  *testGroupApp
+}
+
+// setupTestMg - Create mobility group with 2 registered Group Apps & 1 UE served by the first App
+func setupTestMg(mode string) *testMg {
+	okStatus := func(ueId string, attempt int) int { return http.StatusOK }
+	tm := new(testMg)
+	tm.app1 = newTestGroupApp(okStatus)
+	tm.app2 = newTestGroupApp(okStatus)
+
+	mgm = new(MgManager)
+	mgm.sandboxName = testSandboxName
+	mgm.metricStore = &ms.MetricStore{}
+	mgm.svcToElemMap = map[string]string{testApp1: testApp1, testApp2: testApp2}
+	mgm.elemToSvcMap = map[string]string{testApp1: testApp1, testApp2: testApp2}
+	mgm.netElemInfoMap = make(map[string]*netElemInfo)
+	mgm.mgInfoMap = make(map[string]*mgInfo)
+
+	tm.group = new(mgInfo)
+	tm.group.mg = mgModel.MobilityGroup{Name: testMgName, StateTransferMode: mode}
+	tm.group.appInfoMap = make(map[string]*appInfo)
+	tm.group.ueInfoMap = make(map[string]*ueInfo)
+	tm.group.handoffMap = make(map[string]*handoffInfo)
+	mgm.mgInfoMap[testMgName] = tm.group
+
+	for appId, app := range map[string]*testGroupApp{testApp1: tm.app1, testApp2: tm.app2} {
+		cfg := mga.NewConfiguration()
+		cfg.BasePath = app.server.URL
+		info := new(appInfo)
+		info.app = mgModel.MobilityGroupApp{Id: appId, Url: app.server.URL}
+		info.appClient = mga.NewAPIClient(cfg)
+		info.eventQueue = newEventQueue(appId, info.appClient)
+		tm.group.appInfoMap[appId] = info
+	}
+
+	tm.elem = getNetElem(testUeName)
+	setSvcMap(tm.elem, testMgName, testApp1)
+
+	tm.ue = new(ueInfo)
+	tm.ue.ue = mgModel.MobilityGroupUe{Id: testUeName}
+	tm.ue.appsInRange = map[string]bool{testApp1: true}
+	tm.ue.netLocsInProximity = map[string]bool{}
+	tm.group.ueInfoMap[testUeName] = tm.ue
+	return tm
+}
+
+func (tm *testMg) close() {
+	cancelHandoffs(tm.group)
+	for _, info := range tm.group.appInfoMap {
+		info.eventQueue.close()
+	}
+	tm.app1.server.Close()
+	tm.app2.server.Close()
+}
+
+func (tm *testMg) currentApp() string {
+	return tm.elem.mgSvcMap[testMgName].lbSvcName
+}
+
+func validateTestEvent(t *testing.T, event mga.MobilityGroupEvent, eventType string, peers ...*testGroupApp) {
+	if event.Type_ != eventType || event.UeId != testUeName {
+		t.Fatalf("Invalid event: %+v, expected type: %s", event, eventType)
+	}
+	if len(event.Peers) != len(peers) {
+		t.Fatalf("Invalid number of peers in %s event: %d", eventType, len(event.Peers))
+	}
+	for i, peer := range peers {
+		if event.Peers[i].Url != peer.server.URL {
+			t.Fatalf("Invalid peer in %s event: %+v", eventType, event.Peers[i])
+		}
+	}
+}
+
+func TestStateTransferDirect(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	for _, mode := range []string{stateTransModeStateManaged, stateTransModeStateDirect} {
+		fmt.Println("Test state transfer mode: ", mode)
+		tm := setupTestMg(mode)
+
+		fmt.Println("Start state transfer when second App is in range")
+		tm.ue.appsInRange[testApp2] = true
+		startStateTransfer(tm.group, tm.elem, tm.ue, testApp1)
+		if !tm.elem.transferInProgress {
+			t.Fatalf("State transfer should be in progress")
+		}
+		events := tm.app1.waitEvents(t, 1)
+		if mode == stateTransModeStateDirect {
+			validateTestEvent(t, events[0], eventTypeStateTransferStart, tm.app2)
+		} else {
+			validateTestEvent(t, events[0], eventTypeStateTransferStart)
+		}
+
+		fmt.Println("Complete state transfer when UE moves to second App")
+		updateAppMapping(tm.group, tm.elem, tm.ue, testApp1, testApp2)
+		if tm.elem.transferInProgress || tm.currentApp() != testApp2 {
+			t.Fatalf("State transfer should be complete")
+		}
+		events = tm.app1.waitEvents(t, 2)
+		if mode == stateTransModeStateDirect {
+			validateTestEvent(t, events[1], eventTypeStateTransferComplete, tm.app2)
+		} else {
+			validateTestEvent(t, events[1], eventTypeStateTransferComplete)
+		}
+		tm.close()
+	}
+}
+
+func TestHandoff(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	tm := setupTestMg(stateTransModeHandoffDirect)
+	defer tm.close()
+
+	fmt.Println("Start handoff when UE moves towards second App")
+	updateAppMapping(tm.group, tm.elem, tm.ue, testApp1, testApp2)
+	transfer := tm.group.handoffMap[testUeName]
+	if transfer == nil || transfer.srcApp != testApp1 || transfer.dstApp != testApp2 {
+		t.Fatalf("Handoff should be in progress")
+	}
+	if tm.currentApp() != testApp1 {
+		t.Fatalf("UE should remain on source instance until transfer completes")
+	}
+	validateTestEvent(t, tm.app1.waitEvents(t, 1)[0], eventTypeHandoffStart, tm.app2)
+
+	fmt.Println("Reject handoff state from invalid sources")
+	state := &mgModel.MobilityGroupAppState{UeId: testUeName, UeState: "instance-state"}
+	if processHandoffState("unknown-mg", testApp1, state) == nil {
+		t.Fatalf("Handoff state for unknown group should fail")
+	}
+	if processHandoffState(testMgName, testApp2, state) == nil {
+		t.Fatalf("Handoff state from target App should fail")
+	}
+
+	fmt.Println("Cancel handoff when UE returns to source App")
+	updateAppMapping(tm.group, tm.elem, tm.ue, testApp1, testApp1)
+	if len(tm.group.handoffMap) != 0 || tm.currentApp() != testApp1 {
+		t.Fatalf("Handoff should be cancelled")
+	}
+	validateTestEvent(t, tm.app1.waitEvents(t, 2)[1], eventTypeHandoffCancel)
+	validateTestEvent(t, tm.app2.waitEvents(t, 1)[0], eventTypeHandoffCancel)
+
+	fmt.Println("Complete handoff")
+	updateAppMapping(tm.group, tm.elem, tm.ue, testApp1, testApp2)
+	transfer = tm.group.handoffMap[testUeName]
+	if transfer == nil {
+		t.Fatalf("Handoff should be in progress")
+	}
+	completeHandoff(tm.group, transfer, ms.MgMetResultComplete)
+	if len(tm.group.handoffMap) != 0 || tm.currentApp() != testApp2 {
+		t.Fatalf("UE should be switched to target instance")
+	}
+	validateTestEvent(t, tm.app1.waitEvents(t, 4)[3], eventTypeHandoffComplete)
+	validateTestEvent(t, tm.app2.waitEvents(t, 2)[1], eventTypeHandoffComplete)
+
+	fmt.Println("Reject handoff state in state transfer mode")
+	tm.group.mg.StateTransferMode = stateTransModeStateManaged
+	if processHandoffState(testMgName, testApp2, state) == nil {
+		t.Fatalf("Handoff state in state transfer mode should fail")
+	}
+}
+
+func TestHandoffStateTransfer(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	tm := setupTestMg(stateTransModeHandoffManaged)
+	defer tm.close()
+
+	// LB rules are stored & announced when UE is switched
+	var err error
+	mgm.baseKey = "test-mg-manager:"
+	mgm.lbRulesStore = new(lbRulesStore)
+	mgm.lbRulesStore.rc, err = redis.NewConnector(testRedisAddr, DEFAULT_LB_RULES_DB)
+	if err != nil {
+		t.Fatalf("Failed to connect to Redis DB")
+	}
+	defer func() { _ = mgm.lbRulesStore.rc.DBFlush(mgm.baseKey) }()
+	mgm.mqLocal, err = mq.NewMsgQueue(mq.GetLocalName(testSandboxName), moduleName, testSandboxName, testRedisAddr)
+	if err != nil {
+		t.Fatalf("Failed to create Message Queue")
+	}
+
+	fmt.Println("Start handoff")
+	updateAppMapping(tm.group, tm.elem, tm.ue, testApp1, testApp2)
+	validateTestEvent(t, tm.app1.waitEvents(t, 1)[0], eventTypeHandoffStart)
+
+	fmt.Println("Switch UE when source instance hands off its state")
+	state := &mgModel.MobilityGroupAppState{UeId: testUeName, UeState: "instance-state"}
+	err = processHandoffState(testMgName, testApp1, state)
+	if err != nil {
+		t.Fatalf("Failed to process handoff state: " + err.Error())
+	}
+	if len(tm.group.handoffMap) != 0 || tm.currentApp() != testApp2 {
+		t.Fatalf("UE should be switched to target instance")
+	}
+	events := tm.app2.waitEvents(t, 2)
+	validateTestEvent(t, events[0], eventTypeHandoffStateUpdate)
+	if events[0].AppState == nil || events[0].AppState.UeState != state.UeState {
+		t.Fatalf("Instance state not forwarded to target instance")
+	}
+	validateTestEvent(t, events[1], eventTypeHandoffComplete)
+	validateTestEvent(t, tm.app1.waitEvents(t, 2)[1], eventTypeHandoffComplete)
+
+	fmt.Println("Switch UE when handoff times out")
+	updateAppMapping(tm.group, tm.elem, tm.ue, testApp2, testApp1)
+	transfer := tm.group.handoffMap[testUeName]
+	if transfer == nil {
+		t.Fatalf("Handoff should be in progress")
+	}
+	expireHandoff(testMgName, transfer)
+	if len(tm.group.handoffMap) != 0 || tm.currentApp() != testApp1 {
+		t.Fatalf("UE should be switched after handoff timeout")
+	}
+	validateTestEvent(t, tm.app1.waitEvents(t, 4)[3], eventTypeHandoffComplete)
+}
+
+func TestValidateMobilityGroup(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	zeroProximity := int32(0)
+	negProximity := int32(-1)
+	tests := []struct {
+		name  string
+		mg    mgModel.MobilityGroup
+		valid bool
+	}{
+		{"defaults", mgModel.MobilityGroup{Name: testMgName}, true},
+		{"forced session transfer", mgModel.MobilityGroup{Name: testMgName, SessionTransferMode: sessionTransModeForced}, true},
+		{"graceful session transfer", mgModel.MobilityGroup{Name: testMgName, SessionTransferMode: sessionTransModeGraceful, SessionDrainTimeout: 30}, true},
+		{"graceful session transfer default timeout", mgModel.MobilityGroup{Name: testMgName, SessionTransferMode: sessionTransModeGraceful}, true},
+		{"lowercase session transfer", mgModel.MobilityGroup{Name: testMgName, SessionTransferMode: "graceful"}, false},
+		{"unsupported session transfer", mgModel.MobilityGroup{Name: testMgName, SessionTransferMode: "NONE"}, false},
+		{"negative drain timeout", mgModel.MobilityGroup{Name: testMgName, SessionTransferMode: sessionTransModeGraceful, SessionDrainTimeout: -1}, false},
+		{"handoff", mgModel.MobilityGroup{Name: testMgName, StateTransferMode: stateTransModeHandoffManaged, SessionTransferMode: sessionTransModeGraceful}, true},
+		{"unsupported state transfer", mgModel.MobilityGroup{Name: testMgName, StateTransferMode: "INVALID"}, false},
+		{"unsupported state transfer trigger", mgModel.MobilityGroup{Name: testMgName, StateTransferTrigger: "INVALID"}, false},
+		{"unsupported LB algorithm", mgModel.MobilityGroup{Name: testMgName, LoadBalancingAlgorithm: "INVALID"}, false},
+		{"zero proximity", mgModel.MobilityGroup{Name: testMgName, ProximityDistance: &zeroProximity, ProximityTime: &zeroProximity}, true},
+		{"negative proximity distance", mgModel.MobilityGroup{Name: testMgName, ProximityDistance: &negProximity}, false},
+		{"negative proximity time", mgModel.MobilityGroup{Name: testMgName, ProximityTime: &negProximity}, false},
+	}
+
+	for _, test := range tests {
+		err := validateMobilityGroup(&test.mg)
+		if test.valid && err != nil {
+			t.Fatalf("%s: unexpected error: %s", test.name, err.Error())
+		}
+		if !test.valid && err == nil {
+			t.Fatalf("%s: expected validation error", test.name)
+		}
+	}
+}
+
+func TestProximitySettings(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	mgm = new(MgManager)
+	mgm.mgInfoMap = make(map[string]*mgInfo)
+
+	fmt.Println("Use default proximity when omitted")
+	err := mgCreate(&mgModel.MobilityGroup{Name: testMgName})
+	if err != nil {
+		t.Fatalf("Failed to create group: " + err.Error())
+	}
+	if getProximityQuery(mgm.mgInfoMap[testMgName]) != (proximityQuery{defaultProximityDistance, defaultProximityTime}) {
+		t.Fatalf("Invalid default proximity")
+	}
+
+	fmt.Println("Keep zero proximity")
+	zeroProximity := int32(0)
+	err = mgUpdate(&mgModel.MobilityGroup{Name: testMgName, ProximityDistance: &zeroProximity})
+	if err != nil {
+		t.Fatalf("Failed to update group: " + err.Error())
+	}
+	if getProximityQuery(mgm.mgInfoMap[testMgName]) != (proximityQuery{0, defaultProximityTime}) {
+		t.Fatalf("Zero proximity distance should not be replaced")
+	}
+
+	fmt.Println("Keep previous proximity when omitted")
+	err = mgUpdate(&mgModel.MobilityGroup{Name: testMgName, ProximityTime: &zeroProximity})
+	if err != nil {
+		t.Fatalf("Failed to update group: " + err.Error())
+	}
+	if getProximityQuery(mgm.mgInfoMap[testMgName]) != (proximityQuery{0, 0}) {
+		t.Fatalf("Omitted proximity distance should keep previous value")
+	}
+	zeroProximity = 50
+	if getProximityQuery(mgm.mgInfoMap[testMgName]) != (proximityQuery{0, 0}) {
+		t.Fatalf("Group proximity should not alias request values")
+	}
+}
+
+func TestGpsProximityStateTransfer(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	tm := setupTestMg(stateTransModeStateManaged)
+	defer tm.close()
+
+	// UE served by first App at POA1; second App serves POA2
+	proximity := int32(100)
+	tm.group.mg.StateTransferTrigger = stateTransTrigGPSProximity
+	tm.group.mg.ProximityDistance = &proximity
+	tm.group.mg.ProximityTime = &proximity
+	tm.group.netLocAppMap = map[string]string{"poa1": testApp1, "poa2": testApp2}
+	mgm.mgSvcInfoMap = map[string]*mgServiceInfo{testMgName: {name: testMgName}}
+	tm.elem.phyLoc = testUeName
+	tm.elem.netLoc = "poa1"
+	query := getProximityQuery(tm.group)
+
+	fmt.Println("Start state transfer when POA served by second App is in proximity")
+	if !updateNetLocsInProximity(map[proximityQuery]map[string][]string{query: {testUeName: {"poa1", "poa2"}}}) {
+		t.Fatalf("POAs in proximity should be updated")
+	}
+	refreshMgSvcMapping()
+	if !tm.elem.transferInProgress || tm.currentApp() != testApp1 {
+		t.Fatalf("State transfer should be in progress")
+	}
+	validateTestEvent(t, tm.app1.waitEvents(t, 1)[0], eventTypeStateTransferStart)
+
+	fmt.Println("Ignore unchanged POAs in proximity & other proximity settings")
+	if updateNetLocsInProximity(map[proximityQuery]map[string][]string{query: {testUeName: {"poa2", "poa1"}}}) {
+		t.Fatalf("Unchanged POAs in proximity should not be updated")
+	}
+	if updateNetLocsInProximity(map[proximityQuery]map[string][]string{{0, 0}: {testUeName: {"poa1"}}}) {
+		t.Fatalf("POAs in proximity for other settings should be ignored")
+	}
+
+	fmt.Println("Cancel state transfer when POA served by second App is no longer in proximity")
+	if !updateNetLocsInProximity(map[proximityQuery]map[string][]string{query: {testUeName: {"poa1"}}}) {
+		t.Fatalf("POAs in proximity should be updated")
+	}
+	refreshMgSvcMapping()
+	if tm.elem.transferInProgress || tm.currentApp() != testApp1 {
+		t.Fatalf("State transfer should be cancelled")
+	}
+	validateTestEvent(t, tm.app1.waitEvents(t, 2)[1], eventTypeStateTransferCancel)
+
+	fmt.Println("Complete state transfer when UE reaches POA served by second App")
+	_ = updateNetLocsInProximity(map[proximityQuery]map[string][]string{query: {testUeName: {"poa1", "poa2"}}})
+	refreshMgSvcMapping()
+	validateTestEvent(t, tm.app1.waitEvents(t, 3)[2], eventTypeStateTransferStart)
+	tm.elem.netLoc = "poa2"
+	_ = updateNetLocsInProximity(map[proximityQuery]map[string][]string{query: {testUeName: {"poa2"}}})
+	refreshMgSvcMapping()
+	if tm.elem.transferInProgress || tm.currentApp() != testApp2 {
+		t.Fatalf("State transfer should be complete")
+	}
+	validateTestEvent(t, tm.app1.waitEvents(t, 4)[3], eventTypeStateTransferComplete)
+}
+
+// setupTestLbGraph - Create network & latency graphs with App instances at the edge of POA1 & POA2
+//
+//	edge1 --(1)-- poa1 --(5)--+
+//	                          |
+//	edge2 --(1)-- poa2 --(5)--+-- zone --(5)-- poa3
+//	  |                       |
+//	  +-----(6)---- poa4 --(0)+
+func setupTestLbGraph() map[string]*serviceInfo {
+	links := []struct {
+		src     string
+		dst     string
+		latency int64
+	}{
+		{"edge1", "poa1", 1},
+		{"edge2", "poa2", 1},
+		{"poa1", "zone", 5},
+		{"poa2", "zone", 5},
+		{"poa3", "zone", 5},
+		{"poa4", "zone", 0},
+		{"poa4", "edge2", 6},
+	}
+
+	mgm = new(MgManager)
+	mgm.networkGraph = dijkstra.NewGraph()
+	mgm.latencyGraph = dijkstra.NewGraph()
+	for _, node := range []string{"edge1", "edge2", "poa1", "poa2", "poa3", "poa4", "zone"} {
+		mgm.networkGraph.AddMappedVertex(node)
+		mgm.latencyGraph.AddMappedVertex(node)
+	}
+	for _, link := range links {
+		_ = mgm.networkGraph.AddMappedArc(link.src, link.dst, 1)
+		_ = mgm.networkGraph.AddMappedArc(link.dst, link.src, 1)
+		_ = mgm.latencyGraph.AddMappedArc(link.src, link.dst, link.latency)
+		_ = mgm.latencyGraph.AddMappedArc(link.dst, link.src, link.latency)
+	}
+
+	return map[string]*serviceInfo{
+		testApp1: {name: testApp1, node: "edge1"},
+		testApp2: {name: testApp2, node: "edge2"},
+	}
+}
+
+func TestRunLbAlgo(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	services := setupTestLbGraph()
+	tests := []struct {
+		name   string
+		lbAlgo string
+		loads  map[string]int32
+		elem   string
+		app    string
+	}{
+		{"latency closest instance", lbAlgoLatency, nil, "poa2", testApp2},
+		{"latency tie broken by name", lbAlgoLatency, nil, "poa3", testApp1},
+		{"latency tie broken by hop count", lbAlgoLatency, nil, "poa4", testApp2},
+		{"latency ignores loads", lbAlgoLatency, map[string]int32{testApp1: 100}, "poa3", testApp1},
+		{"load idle instance", lbAlgoLoad, map[string]int32{testApp1: 100, testApp2: 0}, "poa3", testApp2},
+		{"load tie broken by name", lbAlgoLoad, map[string]int32{testApp1: 50, testApp2: 50}, "poa3", testApp1},
+		{"load tie broken by hop count", lbAlgoLoad, map[string]int32{testApp1: 50, testApp2: 50}, "poa4", testApp2},
+		{"load clamped to range", lbAlgoLoad, map[string]int32{testApp1: -10, testApp2: 0}, "poa3", testApp1},
+		{"load clamped to max", lbAlgoLoad, map[string]int32{testApp1: 100, testApp2: 500}, "poa3", testApp1},
+		{"load does not override latency", lbAlgoLoad, map[string]int32{testApp1: 100, testApp2: 0}, "poa1", testApp1},
+		{"sticky closest instance", lbAlgoSticky, nil, "poa2", testApp2},
+		{"sticky ignores loads", lbAlgoSticky, map[string]int32{testApp1: 100, testApp2: 0}, "poa3", testApp1},
+		{"unreachable element", lbAlgoLatency, nil, "poa5", ""},
+		{"unsupported algorithm", "INVALID", nil, "poa1", ""},
+	}
+
+	for _, test := range tests {
+		app := runLbAlgo(test.lbAlgo, services, test.loads, test.elem)
+		if app != test.app {
+			t.Fatalf("%s: invalid instance: %s, expected: %s", test.name, app, test.app)
+		}
+	}
+}
+
+func TestGetBestApp(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	tests := []struct {
+		name       string
+		lbAlgo     string
+		currentApp string
+		registered []string
+		app        string
+	}{
+		{"latency re-balances to best instance", lbAlgoLatency, testApp1, []string{testApp1, testApp2}, testApp2},
+		{"load re-balances to best instance", lbAlgoLoad, testApp1, []string{testApp1, testApp2}, testApp2},
+		{"sticky keeps current instance", lbAlgoSticky, testApp1, []string{testApp1, testApp2}, testApp1},
+		{"sticky without current instance", lbAlgoSticky, "", []string{testApp1, testApp2}, testApp2},
+		{"sticky with unregistered current instance", lbAlgoSticky, testApp1, []string{testApp2}, testApp2},
+	}
+
+	for _, test := range tests {
+		tm := setupTestMg(stateTransModeStateManaged)
+		tm.group.mg.LoadBalancingAlgorithm = test.lbAlgo
+		tm.group.netLocAppMap = map[string]string{"poa1": testApp1, "poa2": testApp2}
+		for appId := range tm.group.appInfoMap {
+			registered := false
+			for _, id := range test.registered {
+				registered = registered || id == appId
+			}
+			if !registered {
+				tm.group.appInfoMap[appId].eventQueue.close()
+				delete(tm.group.appInfoMap, appId)
+			}
+		}
+		setSvcMap(tm.elem, testMgName, test.currentApp)
+		tm.elem.netLoc = "poa2"
+
+		app := getBestApp(tm.group, tm.elem)
+		tm.close()
+		if app != test.app {
+			t.Fatalf("%s: invalid instance: %s, expected: %s", test.name, app, test.app)
+		}
+	}
+}
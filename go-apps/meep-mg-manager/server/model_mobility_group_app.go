@@ -32,4 +32,7 @@ type MobilityGroupApp struct {
 
 	// Event handler url
 	Url string `json:"url,omitempty"`
+
+	// Measured application instance load, in percent (0-100)
+	Load int32 `json:"load,omitempty"`
 }
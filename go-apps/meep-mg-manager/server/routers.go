@@ -155,4 +155,18 @@ var routes = Routes{
 		"/mgm/v1/mg/{mgName}/app/{appId}/state",
 		TransferAppState,
 	},
+
+	Route{
+		"GetMobilityGroupAppEventList",
+		strings.ToUpper("Get"),
+		"/mgm/v1/mg/{mgName}/app/{appId}/event",
+		GetMobilityGroupAppEventList,
+	},
+
+	Route{
+		"TransferAppHandoffState",
+		strings.ToUpper("Post"),
+		"/mgm/v1/mg/{mgName}/app/{appId}/handoff",
+		TransferAppHandoffState,
+	},
 }
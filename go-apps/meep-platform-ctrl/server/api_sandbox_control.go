@@ -44,6 +44,10 @@ func DeleteSandboxList(w http.ResponseWriter, r *http.Request) {
 	pcDeleteSandboxList(w, r)
 }
 
+func ExtendSandboxLease(w http.ResponseWriter, r *http.Request) {
+	pcExtendSandboxLease(w, r)
+}
+
 func GetSandbox(w http.ResponseWriter, r *http.Request) {
 	pcGetSandbox(w, r)
 }
@@ -57,3 +57,33 @@ func GetScenarioList(w http.ResponseWriter, r *http.Request) {
 func SetScenario(w http.ResponseWriter, r *http.Request) {
 	pcSetScenario(w, r)
 }
+
+// DiffScenario - Compare scenario versions
+func DiffScenario(w http.ResponseWriter, r *http.Request) {
+	pcDiffScenario(w, r)
+}
+
+// GetScenarioHistory - Retrieve scenario version history
+func GetScenarioHistory(w http.ResponseWriter, r *http.Request) {
+	pcGetScenarioHistory(w, r)
+}
+
+// GetScenarioVersion - Retrieve scenario version from MEEP store
+func GetScenarioVersion(w http.ResponseWriter, r *http.Request) {
+	pcGetScenarioVersion(w, r)
+}
+
+// RollbackScenario - Restore scenario version in MEEP store
+func RollbackScenario(w http.ResponseWriter, r *http.Request) {
+	pcRollbackScenario(w, r)
+}
+
+// SetScenarioVersion - Update scenario version tag & comment
+func SetScenarioVersion(w http.ResponseWriter, r *http.Request) {
+	pcSetScenarioVersion(w, r)
+}
+
+// ValidateScenario - Validate scenario without storing it in MEEP store
+func ValidateScenario(w http.ResponseWriter, r *http.Request) {
+	pcValidateScenario(w, r)
+}
@@ -23,10 +23,12 @@ import (
 	"io/ioutil"
 	"math/rand"
 	"net/http"
+	"sync"
 	"time"
 
 	"github.com/gorilla/mux"
 
+	auth "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-auth"
 	couch "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-couch"
 	dataModel "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-data-model"
 	log "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-logger"
@@ -42,9 +44,13 @@ type Scenario struct {
 
 type PlatformCtrl struct {
 	scenarioStore *couch.Connector
+	historyStore  *couch.Connector
 	sandboxStore  *ss.SandboxStore
 	veWatchdog    *wd.Watchdog
 	mqGlobal      *mq.MsgQueue
+	auth          *auth.Authenticator
+	// Serializes sandbox quota checks & creation
+	sandboxMutex sync.Mutex
 }
 
 const scenarioDBName = "scenarios"
@@ -56,10 +62,21 @@ const moduleVirtEngineNamespace = "default"
 // MQ payload fields
 const fieldSandboxName = "sandbox-name"
 const fieldScenarioName = "scenario-name"
+const fieldExpiryReason = "reason"
+const fieldExpiryTime = "expiry-time"
+
+// Sandbox expiry reasons
+const expiryReasonTtl = "ttl"
+const expiryReasonIdle = "idle"
+
+// Sandbox reaper period & delay before expiry at which sandbox users are warned
+const reaperPeriod = 10 * time.Second
+const expiryWarningPeriod = 5 * time.Minute
 
 // Declare as variables to enable overwrite in test
 var couchDBAddr = "http://meep-couchdb-svc-couchdb:5984/"
 var redisDBAddr = "meep-redis-master:6379"
+var authConfigFile = "/auth/auth.yaml"
 
 // Platform Controller
 var pfmCtrl *PlatformCtrl
@@ -117,6 +134,21 @@ func Init() (err error) {
 		}
 	}
 
+	// Connect to Scenario History Store
+	pfmCtrl.historyStore, err = couch.NewConnector(couchDBAddr, scenarioHistoryDBName)
+	if err != nil {
+		log.Error("Failed connection to Scenario History Store. Error: ", err)
+		return err
+	}
+	log.Info("Connected to Scenario History Store")
+
+	// Make sure all scenarios have a history
+	err = initScenarioHistory()
+	if err != nil {
+		log.Error("Failed to initialize scenario history. Error: ", err)
+		return err
+	}
+
 	// Connect to Sandbox Store
 	pfmCtrl.sandboxStore, err = ss.NewSandboxStore(redisDBAddr)
 	if err != nil {
@@ -125,6 +157,13 @@ func Init() (err error) {
 	}
 	log.Info("Connected to Sandbox Store")
 
+	// Load API authentication config
+	pfmCtrl.auth, err = auth.NewAuthenticator(authConfigFile)
+	if err != nil {
+		log.Error("Failed to initialize authentication. Error: ", err)
+		return err
+	}
+
 	// Setup for virt-engine monitoring
 	pfmCtrl.veWatchdog, err = wd.NewWatchdog(moduleName, moduleNamespace, moduleVirtEngineName, moduleVirtEngineNamespace, "")
 	if err != nil {
@@ -145,9 +184,28 @@ func Run() (err error) {
 		return err
 	}
 
+	// Start expired sandbox reaper
+	go func() {
+		ticker := time.NewTicker(reaperPeriod)
+		for range ticker.C {
+			reapSandboxes()
+		}
+	}()
+
 	return nil
 }
 
+// authenticate - Authenticate API requests when authentication is enabled
+func authenticate(inner http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if pfmCtrl == nil {
+			inner(w, r)
+			return
+		}
+		pfmCtrl.auth.Handler(inner)(w, r)
+	}
+}
+
 // Create a new scenario in the scenario store
 // POST /scenario/{name}
 func pcCreateScenario(w http.ResponseWriter, r *http.Request) {
@@ -189,6 +247,13 @@ func pcCreateScenario(w http.ResponseWriter, r *http.Request) {
 	}
 	log.Debug("Scenario added with rev: ", rev)
 
+	// Start new scenario history
+	deleteScenarioHistory(scenarioName)
+	_, err = addScenarioVersion(scenarioName, validScenario, auth.GetUserName(r), "")
+	if err != nil {
+		log.Error("Failed to add scenario version: ", err.Error())
+	}
+
 	// OK
 	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
 	w.WriteHeader(http.StatusOK)
@@ -212,6 +277,9 @@ func pcDeleteScenario(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// Remove scenario history
+	deleteScenarioHistory(scenarioName)
+
 	// OK
 	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
 	w.WriteHeader(http.StatusOK)
@@ -230,6 +298,12 @@ func pcDeleteScenarioList(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// Remove all scenario history
+	err = pfmCtrl.historyStore.DeleteAllDocs()
+	if err != nil {
+		log.Error("Failed to delete scenario history: ", err.Error())
+	}
+
 	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
 	w.WriteHeader(http.StatusOK)
 }
@@ -330,20 +404,65 @@ func pcSetScenario(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Update scenario in DB
-	rev, err := pfmCtrl.scenarioStore.UpdateDoc(scenarioName, validScenario)
+	// Record new scenario version & update scenario in DB
+	_, code, err := storeScenario(scenarioName, validScenario, auth.GetUserName(r), "")
 	if err != nil {
 		log.Error(err.Error())
-		http.Error(w, err.Error(), http.StatusNotFound)
+		http.Error(w, err.Error(), code)
 		return
 	}
-	log.Debug("Scenario updated with rev: ", rev)
 
 	// OK
 	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
 	w.WriteHeader(http.StatusOK)
 }
 
+// Validate scenario without storing it in scenario store
+// POST /scenarios/{name}/validate
+func pcValidateScenario(w http.ResponseWriter, r *http.Request) {
+	log.Debug("pcValidateScenario")
+
+	// Get scenario name from request parameters
+	vars := mux.Vars(r)
+	scenarioName := vars["name"]
+	log.Debug("Scenario name: ", scenarioName)
+
+	// Retrieve scenario from request body
+	if r.Body == nil {
+		err := errors.New("Request body is missing")
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	b, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// Validate scenario
+	var validation dataModel.ScenarioValidation
+	_, validation.Status, err = mod.ValidateScenario(b)
+	if err != nil {
+		log.Debug("Invalid scenario: ", err.Error())
+		validation.Errors = convertValidationErrorsToApiModel(err)
+	}
+
+	// Format response
+	jsonResponse, err := json.Marshal(validation)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	// Send response
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprint(w, string(jsonResponse))
+}
+
 // Create new Sandbox
 // POST /sandboxes
 func pcCreateSandbox(w http.ResponseWriter, r *http.Request) {
@@ -358,6 +477,24 @@ func pcCreateSandbox(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
+	if sandboxConfig.Ttl < 0 || sandboxConfig.IdleTimeout < 0 {
+		err = errors.New("Invalid sandbox ttl or idle timeout")
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// Check quota & create sandbox atomically so that concurrent requests cannot exceed the user quota
+	pfmCtrl.sandboxMutex.Lock()
+	defer pfmCtrl.sandboxMutex.Unlock()
+
+	// Make sure user quota is not exceeded
+	pods, code, err := checkQuota(auth.GetUser(r), sandboxConfig.ScenarioName)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), code)
+		return
+	}
 
 	// Get unique sandbox name
 	var sandboxName string
@@ -379,7 +516,7 @@ func pcCreateSandbox(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Create sandbox in DB
-	err = createSandbox(sandboxName, &sandboxConfig)
+	sbox, err := createSandbox(sandboxName, auth.GetUserName(r), pods, &sandboxConfig)
 	if err != nil {
 		log.Error("Failed to create sandbox with error: ", err.Error())
 		http.Error(w, err.Error(), http.StatusInternalServerError)
@@ -387,8 +524,7 @@ func pcCreateSandbox(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Prepare response
-	var sandbox dataModel.Sandbox
-	sandbox.Name = sandboxName
+	sandbox := convertSandboxToApiModel(sbox)
 
 	// Format response
 	jsonResponse, err := json.Marshal(sandbox)
@@ -423,6 +559,16 @@ func pcCreateSandboxWithName(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
+	if sandboxConfig.Ttl < 0 || sandboxConfig.IdleTimeout < 0 {
+		err = errors.New("Invalid sandbox ttl or idle timeout")
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// Check quota & create sandbox atomically so that concurrent requests cannot exceed the user quota
+	pfmCtrl.sandboxMutex.Lock()
+	defer pfmCtrl.sandboxMutex.Unlock()
 
 	// Make sure sandbox does not already exist
 	if sbox, _ := pfmCtrl.sandboxStore.Get(sandboxName); sbox != nil {
@@ -432,8 +578,16 @@ func pcCreateSandboxWithName(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// Make sure user quota is not exceeded
+	pods, code, err := checkQuota(auth.GetUser(r), sandboxConfig.ScenarioName)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), code)
+		return
+	}
+
 	// Create sandbox in DB
-	err = createSandbox(sandboxName, &sandboxConfig)
+	sbox, err := createSandbox(sandboxName, auth.GetUserName(r), pods, &sandboxConfig)
 	if err != nil {
 		log.Error("Failed to create sandbox with error: ", err.Error())
 		http.Error(w, err.Error(), http.StatusInternalServerError)
@@ -441,8 +595,7 @@ func pcCreateSandboxWithName(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Prepare response
-	var sandbox dataModel.Sandbox
-	sandbox.Name = sandboxName
+	sandbox := convertSandboxToApiModel(sbox)
 
 	// Format response
 	jsonResponse, err := json.Marshal(sandbox)
@@ -469,13 +622,22 @@ func pcDeleteSandbox(w http.ResponseWriter, r *http.Request) {
 	log.Debug("Sandbox to delete: ", sandboxName)
 
 	// Make sure sandbox exists
-	if sbox, _ := pfmCtrl.sandboxStore.Get(sandboxName); sbox == nil {
+	sbox, _ := pfmCtrl.sandboxStore.Get(sandboxName)
+	if sbox == nil {
 		err := errors.New("Sandbox not found")
 		log.Error(err.Error())
 		http.Error(w, err.Error(), http.StatusNotFound)
 		return
 	}
 
+	// Only sandbox owner or admin may delete sandbox
+	if !auth.IsAuthorized(auth.GetUser(r), sandboxName, sbox.Owner) {
+		err := errors.New("Not authorized to delete sandbox " + sandboxName)
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+
 	// Delete sandbox
 	deleteSandbox(sandboxName)
 
@@ -496,6 +658,19 @@ func pcDeleteSandboxList(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// Non-admin users may only delete their own sandboxes
+	user := auth.GetUser(r)
+	if user != nil && !user.Admin {
+		for _, sbox := range sboxMap {
+			if sbox.Owner == user.Name {
+				deleteSandbox(sbox.Name)
+			}
+		}
+		w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
 	// Delete all sandboxes
 	for _, sbox := range sboxMap {
 		deleteSandbox(sbox.Name)
@@ -527,9 +702,16 @@ func pcGetSandbox(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// Only sandbox owner or admin may retrieve sandbox
+	if !auth.IsAuthorized(auth.GetUser(r), sandboxName, sbox.Owner) {
+		err := errors.New("Not authorized to access sandbox " + sandboxName)
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+
 	// Prepare response
-	var sandbox dataModel.Sandbox
-	sandbox.Name = sbox.Name
+	sandbox := convertSandboxToApiModel(sbox)
 
 	// Format response
 	jsonResponse, err := json.Marshal(sandbox)
@@ -558,12 +740,14 @@ func pcGetSandboxList(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Update sandbox list
+	// Update sandbox list; non-admin users only see their own sandboxes
+	user := auth.GetUser(r)
 	var sandboxList dataModel.SandboxList
 	for _, sbox := range sboxMap {
-		var sandbox dataModel.Sandbox
-		sandbox.Name = sbox.Name
-		sandboxList.Sandboxes = append(sandboxList.Sandboxes, sandbox)
+		if !auth.IsAuthorized(user, sbox.Name, sbox.Owner) {
+			continue
+		}
+		sandboxList.Sandboxes = append(sandboxList.Sandboxes, convertSandboxToApiModel(sbox))
 	}
 
 	// Format response
@@ -580,17 +764,96 @@ func pcGetSandboxList(w http.ResponseWriter, r *http.Request) {
 	fmt.Fprint(w, string(jsonResponse))
 }
 
+// Extend lease of Sandbox with provided name
+// PUT /sandboxes/{name}/lease
+func pcExtendSandboxLease(w http.ResponseWriter, r *http.Request) {
+	log.Debug("pcExtendSandboxLease")
+
+	// Get sandbox name from request parameters
+	vars := mux.Vars(r)
+	sandboxName := vars["name"]
+	log.Debug("Sandbox lease to extend: ", sandboxName)
+
+	// Retrieve lease from request body
+	var lease dataModel.SandboxLease
+	decoder := json.NewDecoder(r.Body)
+	err := decoder.Decode(&lease)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if lease.Ttl <= 0 {
+		err = errors.New("Invalid sandbox ttl")
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// Make sure sandbox exists
+	sbox, _ := pfmCtrl.sandboxStore.Get(sandboxName)
+	if sbox == nil {
+		err = errors.New("Sandbox not found")
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	// Only sandbox owner or admin may extend sandbox lease
+	if !auth.IsAuthorized(auth.GetUser(r), sandboxName, sbox.Owner) {
+		err = errors.New("Not authorized to extend sandbox " + sandboxName)
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+
+	// Extend lease & restart idle timeout
+	now := time.Now()
+	sbox.Expiry = now.Add(time.Duration(lease.Ttl) * time.Second)
+	sbox.LastActivity = now
+	err = pfmCtrl.sandboxStore.SetExpiry(sandboxName, sbox.Expiry)
+	if err == nil {
+		err = pfmCtrl.sandboxStore.SetLastActivity(sandboxName, sbox.LastActivity)
+	}
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	// Format response
+	jsonResponse, err := json.Marshal(convertSandboxToApiModel(sbox))
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	// Send response
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprint(w, string(jsonResponse))
+}
+
 // Create new sandbox in store and publish updagte
-func createSandbox(sandboxName string, sandboxConfig *dataModel.SandboxConfig) (err error) {
+func createSandbox(sandboxName string, owner string, pods int, sandboxConfig *dataModel.SandboxConfig) (sbox *ss.Sandbox, err error) {
 
 	// Create sandbox in DB
-	sbox := new(ss.Sandbox)
+	now := time.Now()
+	sbox = new(ss.Sandbox)
 	sbox.Name = sandboxName
 	sbox.ScenarioName = sandboxConfig.ScenarioName
+	sbox.Owner = owner
+	sbox.Pods = pods
+	if sandboxConfig.Ttl > 0 {
+		sbox.Expiry = now.Add(time.Duration(sandboxConfig.Ttl) * time.Second)
+	}
+	sbox.IdleTimeout = time.Duration(sandboxConfig.IdleTimeout) * time.Second
+	sbox.LastActivity = now
 	err = pfmCtrl.sandboxStore.Set(sbox)
 	if err != nil {
 		log.Error(err.Error())
-		return err
+		return nil, err
 	}
 
 	// Send message to create sandbox
@@ -601,10 +864,10 @@ func createSandbox(sandboxName string, sandboxConfig *dataModel.SandboxConfig) (
 	err = pfmCtrl.mqGlobal.SendMsg(msg)
 	if err != nil {
 		log.Error("Failed to send message. Error: ", err.Error())
-		return err
+		return nil, err
 	}
 
-	return nil
+	return sbox, nil
 }
 
 func deleteSandbox(sandboxName string) {
@@ -622,6 +885,113 @@ func deleteSandbox(sandboxName string) {
 	}
 }
 
+// Destroy expired sandboxes & warn sandboxes about to expire
+func reapSandboxes() {
+	sboxMap, err := pfmCtrl.sandboxStore.GetAll()
+	if err != nil {
+		log.Error("Failed to get sandboxes: ", err.Error())
+		return
+	}
+
+	now := time.Now()
+	for _, sbox := range sboxMap {
+		expiry := sbox.GetExpiryTime()
+		if expiry.IsZero() {
+			continue
+		}
+
+		// Destroy expired sandbox
+		if !now.Before(expiry) {
+			log.Info("Sandbox expired: ", sbox.Name, " (", getExpiryReason(sbox), ")")
+			deleteSandbox(sbox.Name)
+			continue
+		}
+
+		// Warn sandbox once per expiry deadline
+		if expiry.Sub(now) <= expiryWarningPeriod && !sbox.ExpiryWarning.Equal(expiry) {
+			msg := pfmCtrl.mqGlobal.CreateMsg(mq.MsgSandboxExpiryWarning, mq.TargetAll, sbox.Name)
+			msg.Payload[fieldSandboxName] = sbox.Name
+			msg.Payload[fieldExpiryReason] = getExpiryReason(sbox)
+			msg.Payload[fieldExpiryTime] = expiry.Format(time.RFC3339)
+			log.Debug("TX MSG: ", mq.PrintMsg(msg))
+			err = pfmCtrl.mqGlobal.SendMsg(msg)
+			if err != nil {
+				log.Error("Failed to send message. Error: ", err.Error())
+				continue
+			}
+			_ = pfmCtrl.sandboxStore.SetExpiryWarning(sbox.Name, expiry)
+		}
+	}
+}
+
+// Return the reason for which the sandbox expires
+func getExpiryReason(sbox *ss.Sandbox) string {
+	if !sbox.Expiry.IsZero() && sbox.GetExpiryTime().Equal(sbox.Expiry) {
+		return expiryReasonTtl
+	}
+	return expiryReasonIdle
+}
+
+// Convert scenario validation error to API model
+// Errors that are not structural (e.g. invalid JSON or version) are reported at the scenario root.
+func convertValidationErrorsToApiModel(err error) (apiErrors []dataModel.ScenarioValidationError) {
+	errs, ok := err.(mod.ValidationErrors)
+	if !ok {
+		return []dataModel.ScenarioValidationError{{Path: "$", Message: err.Error()}}
+	}
+	for _, e := range errs {
+		apiErrors = append(apiErrors, dataModel.ScenarioValidationError{Path: e.Path, Message: e.Message})
+	}
+	return apiErrors
+}
+
+// Convert sandbox store entry to API model
+func convertSandboxToApiModel(sbox *ss.Sandbox) dataModel.Sandbox {
+	var sandbox dataModel.Sandbox
+	sandbox.Name = sbox.Name
+	sandbox.Owner = sbox.Owner
+	if expiry := sbox.GetExpiryTime(); !expiry.IsZero() {
+		sandbox.ExpiryTime = expiry.Format(time.RFC3339)
+	}
+	return sandbox
+}
+
+// Check that a new sandbox running the provided scenario does not exceed the user quota
+// Returns the scenario pods to reserve in the new sandbox until its sandbox controller updates
+// the deployed pod count, and the HTTP status code to use on failure.
+// Admins are not subject to quotas. Must be called with the sandbox mutex held.
+func checkQuota(user *auth.User, scenarioName string) (scenarioPods int, code int, err error) {
+	if user == nil || user.Admin {
+		return 0, http.StatusOK, nil
+	}
+	quota := pfmCtrl.auth.GetQuota(user.Name)
+
+	// Get current owner usage
+	sandboxes, pods, err := pfmCtrl.sandboxStore.GetOwnerUsage(user.Name, "")
+	if err != nil {
+		return 0, http.StatusInternalServerError, err
+	}
+
+	// Get pods required by scenario activated on sandbox creation
+	if scenarioName != "" {
+		scenario, err := pfmCtrl.scenarioStore.GetDoc(false, scenarioName)
+		if err == nil {
+			scenarioPods, err = mod.GetScenarioPodCount(scenario)
+			if err != nil {
+				return 0, http.StatusInternalServerError, err
+			}
+		}
+	}
+
+	if err = quota.CheckSandboxes(sandboxes + 1); err != nil {
+		return 0, http.StatusForbidden, err
+	}
+	if err = quota.CheckPods(pods + scenarioPods); err != nil {
+		return 0, http.StatusForbidden, err
+	}
+	return scenarioPods, http.StatusOK, nil
+}
+
 var charset = []rune("abcdefghijklmnopqrstuvwxyz0123456789")
 
 func randSeq(n int) string {
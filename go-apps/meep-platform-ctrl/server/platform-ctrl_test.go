@@ -23,6 +23,7 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
@@ -54,6 +55,9 @@ func TestPlatformCtrl(t *testing.T) {
 		t.Errorf("Error initializing platform-ctrl")
 	}
 
+	fmt.Println("Test ValidateScenario")
+	testValidateScenario(t)
+
 	fmt.Println("Test CreateScenario")
 	testCreateScenario(t)
 
@@ -66,6 +70,9 @@ func TestPlatformCtrl(t *testing.T) {
 	fmt.Println("Test SetScenario")
 	testSetScenario(t)
 
+	fmt.Println("Test ScenarioHistory")
+	testScenarioHistory(t)
+
 	fmt.Println("Test DeleteScenario")
 	testDeleteScenario(t)
 }
@@ -133,6 +140,50 @@ func testCreateScenario(t *testing.T) {
 	if err != nil {
 		t.Errorf(err.Error())
 	}
+	//---- invalid scenario
+	err = sendRequest(http.MethodPost, "/scenarios", bytes.NewBuffer([]byte(invalidTestScenario())), vars, nil, http.StatusBadRequest, pcCreateScenario)
+	if err != nil {
+		t.Errorf(err.Error())
+	}
+}
+
+func testValidateScenario(t *testing.T) {
+	vars := make(map[string]string)
+
+	// valid
+	vars["name"] = scenario1Name
+	err := sendRequest(http.MethodPost, "/scenarios", bytes.NewBuffer([]byte(testScenario1)), vars, nil, http.StatusOK, pcValidateScenario)
+	if err != nil {
+		t.Errorf(err.Error())
+	}
+	// invalid scenario is reported in response
+	err = sendRequest(http.MethodPost, "/scenarios", bytes.NewBuffer([]byte(invalidTestScenario())), vars, nil, http.StatusOK, pcValidateScenario)
+	if err != nil {
+		t.Errorf(err.Error())
+	}
+	// bad request
+	err = sendRequest(http.MethodPost, "/scenarios", nil, vars, nil, http.StatusBadRequest, pcValidateScenario)
+	if err != nil {
+		t.Errorf(err.Error())
+	}
+	// validation errors
+	_, _, err = mod.ValidateScenario([]byte(invalidTestScenario()))
+	if err == nil {
+		t.Errorf("Scenario should be invalid")
+	}
+	apiErrors := convertValidationErrorsToApiModel(err)
+	if len(apiErrors) != 1 || !strings.HasSuffix(apiErrors[0].Path, ".name") {
+		t.Errorf("Invalid validation errors")
+	}
+	apiErrors = convertValidationErrorsToApiModel(errors.New("Invalid JSON"))
+	if len(apiErrors) != 1 || apiErrors[0].Path != "$" {
+		t.Errorf("Invalid validation errors")
+	}
+}
+
+// invalidTestScenario - Return test scenario with a duplicate zone name
+func invalidTestScenario() string {
+	return strings.Replace(testScenario1, `"name":"zone2"`, `"name":"zone1"`, 1)
 }
 
 func testDeleteScenario(t *testing.T) {
@@ -189,6 +240,93 @@ func testSetScenario(t *testing.T) {
 	}
 }
 
+func testScenarioHistory(t *testing.T) {
+	vars := make(map[string]string)
+
+	// history
+	vars["name"] = scenario1Name
+	err := sendRequest(http.MethodGet, "/scenarios", nil, vars, nil, http.StatusOK, pcGetScenarioHistory)
+	if err != nil {
+		t.Errorf(err.Error())
+	}
+	//---- inexistent
+	vars["name"] = "this-should-fail"
+	err = sendRequest(http.MethodGet, "/scenarios", nil, vars, nil, http.StatusNotFound, pcGetScenarioHistory)
+	if err != nil {
+		t.Errorf(err.Error())
+	}
+
+	// get versions
+	vars["name"] = scenario1Name
+	vars["version"] = "1"
+	err = sendRequest(http.MethodGet, "/scenarios", nil, vars, nil, http.StatusOK, pcGetScenarioVersion)
+	if err != nil {
+		t.Errorf(err.Error())
+	}
+	vars["version"] = "latest"
+	err = sendRequest(http.MethodGet, "/scenarios", nil, vars, nil, http.StatusOK, pcGetScenarioVersion)
+	if err != nil {
+		t.Errorf(err.Error())
+	}
+	//---- inexistent
+	vars["version"] = "100"
+	err = sendRequest(http.MethodGet, "/scenarios", nil, vars, nil, http.StatusNotFound, pcGetScenarioVersion)
+	if err != nil {
+		t.Errorf(err.Error())
+	}
+
+	// tag versions
+	vars["version"] = "1"
+	err = sendRequest(http.MethodPut, "/scenarios", bytes.NewBuffer([]byte(`{"tag":"baseline"}`)), vars, nil, http.StatusOK, pcSetScenarioVersion)
+	if err != nil {
+		t.Errorf(err.Error())
+	}
+	vars["version"] = "baseline"
+	err = sendRequest(http.MethodGet, "/scenarios", nil, vars, nil, http.StatusOK, pcGetScenarioVersion)
+	if err != nil {
+		t.Errorf(err.Error())
+	}
+	//---- duplicate tag
+	vars["version"] = "2"
+	err = sendRequest(http.MethodPut, "/scenarios", bytes.NewBuffer([]byte(`{"tag":"baseline"}`)), vars, nil, http.StatusConflict, pcSetScenarioVersion)
+	if err != nil {
+		t.Errorf(err.Error())
+	}
+	//---- invalid tag
+	err = sendRequest(http.MethodPut, "/scenarios", bytes.NewBuffer([]byte(`{"tag":"5"}`)), vars, nil, http.StatusBadRequest, pcSetScenarioVersion)
+	if err != nil {
+		t.Errorf(err.Error())
+	}
+
+	// diff
+	err = sendRequest(http.MethodGet, "/scenarios", nil, vars, nil, http.StatusOK, pcDiffScenario)
+	if err != nil {
+		t.Errorf(err.Error())
+	}
+	err = sendRequest(http.MethodGet, "/scenarios", nil, vars, map[string]string{"from": "baseline", "to": "2"}, http.StatusOK, pcDiffScenario)
+	if err != nil {
+		t.Errorf(err.Error())
+	}
+	//---- inexistent
+	err = sendRequest(http.MethodGet, "/scenarios", nil, vars, map[string]string{"from": "100"}, http.StatusNotFound, pcDiffScenario)
+	if err != nil {
+		t.Errorf(err.Error())
+	}
+
+	// rollback
+	vars["version"] = "baseline"
+	err = sendRequest(http.MethodPost, "/scenarios", nil, vars, nil, http.StatusOK, pcRollbackScenario)
+	if err != nil {
+		t.Errorf(err.Error())
+	}
+	//---- inexistent
+	vars["version"] = "100"
+	err = sendRequest(http.MethodPost, "/scenarios", nil, vars, nil, http.StatusNotFound, pcRollbackScenario)
+	if err != nil {
+		t.Errorf(err.Error())
+	}
+}
+
 func sendRequest(method string, url string, body io.Reader, vars map[string]string, query map[string]string, code int, f http.HandlerFunc) error {
 	req, err := http.NewRequest(method, url, body)
 	if err != nil || req == nil {
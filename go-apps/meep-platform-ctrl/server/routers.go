@@ -46,7 +46,7 @@ func NewRouter(feDir string, swDir string) *mux.Router {
 
 	// subrouter := router.PathPrefix("/platform-ctrl/").Subrouter()
 	for _, route := range routes {
-		var handler http.Handler = Logger(route.HandlerFunc, route.Name)
+		var handler http.Handler = Logger(authenticate(route.HandlerFunc), route.Name)
 
 		router.
 			Methods(route.Method).
@@ -106,6 +106,13 @@ var routes = Routes{
 		DeleteSandboxList,
 	},
 
+	Route{
+		"ExtendSandboxLease",
+		strings.ToUpper("Put"),
+		"/platform-ctrl/v1/sandboxes/{name}/lease",
+		ExtendSandboxLease,
+	},
+
 	Route{
 		"GetSandbox",
 		strings.ToUpper("Get"),
@@ -161,4 +168,46 @@ var routes = Routes{
 		"/platform-ctrl/v1/scenarios/{name}",
 		SetScenario,
 	},
+
+	Route{
+		"DiffScenario",
+		strings.ToUpper("Get"),
+		"/platform-ctrl/v1/scenarios/{name}/diff",
+		DiffScenario,
+	},
+
+	Route{
+		"GetScenarioHistory",
+		strings.ToUpper("Get"),
+		"/platform-ctrl/v1/scenarios/{name}/versions",
+		GetScenarioHistory,
+	},
+
+	Route{
+		"GetScenarioVersion",
+		strings.ToUpper("Get"),
+		"/platform-ctrl/v1/scenarios/{name}/versions/{version}",
+		GetScenarioVersion,
+	},
+
+	Route{
+		"RollbackScenario",
+		strings.ToUpper("Post"),
+		"/platform-ctrl/v1/scenarios/{name}/versions/{version}/rollback",
+		RollbackScenario,
+	},
+
+	Route{
+		"SetScenarioVersion",
+		strings.ToUpper("Put"),
+		"/platform-ctrl/v1/scenarios/{name}/versions/{version}",
+		SetScenarioVersion,
+	},
+
+	Route{
+		"ValidateScenario",
+		strings.ToUpper("Post"),
+		"/platform-ctrl/v1/scenarios/{name}/validate",
+		ValidateScenario,
+	},
 }
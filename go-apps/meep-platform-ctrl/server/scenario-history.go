@@ -0,0 +1,454 @@
+/*
+ * Copyright (c) 2020  InterDigital Communications, Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	auth "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-auth"
+	dataModel "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-data-model"
+	log "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-logger"
+	mod "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-model"
+)
+
+// Scenario versions are stored as separate documents named <scenario>:<version>.
+// CouchDB revisions are not used as history since compaction discards them.
+const scenarioHistoryDBName = "scenario-history"
+const versionSeparator = ":"
+
+// Version reference to the most recent scenario version
+const versionLatest = "latest"
+
+// scenarioVersionDoc - Scenario history document
+type scenarioVersionDoc struct {
+	ScenarioName string `json:"scenarioName"`
+	dataModel.ScenarioVersion
+	Scenario json.RawMessage `json:"scenario"`
+}
+
+func getVersionDocName(scenarioName string, version int32) string {
+	return scenarioName + versionSeparator + strconv.Itoa(int(version))
+}
+
+// getScenarioVersions - Return scenario versions, oldest first
+func getScenarioVersions(scenarioName string) (versions []*scenarioVersionDoc, err error) {
+	_, docList, err := pfmCtrl.historyStore.GetDocListByPrefix(scenarioName + versionSeparator)
+	if err != nil {
+		return nil, err
+	}
+	for _, doc := range docList {
+		versionDoc := new(scenarioVersionDoc)
+		err = json.Unmarshal(doc, versionDoc)
+		if err != nil {
+			return nil, err
+		}
+		if versionDoc.ScenarioName == scenarioName {
+			versions = append(versions, versionDoc)
+		}
+	}
+	sort.Slice(versions, func(i, j int) bool {
+		return versions[i].Version < versions[j].Version
+	})
+	return versions, nil
+}
+
+// findScenarioVersion - Return the version matching the provided version number, tag or 'latest'
+func findScenarioVersion(versions []*scenarioVersionDoc, ref string) *scenarioVersionDoc {
+	if len(versions) == 0 {
+		return nil
+	}
+	if ref == versionLatest {
+		return versions[len(versions)-1]
+	}
+	number, err := strconv.Atoi(ref)
+	for _, version := range versions {
+		if (err == nil && int(version.Version) == number) || (err != nil && version.Tag == ref) {
+			return version
+		}
+	}
+	return nil
+}
+
+// addScenarioVersion - Record a new scenario version in the scenario history
+func addScenarioVersion(scenarioName string, scenario []byte, author string, comment string) (*scenarioVersionDoc, error) {
+	versions, err := getScenarioVersions(scenarioName)
+	if err != nil {
+		return nil, err
+	}
+	versionDoc := new(scenarioVersionDoc)
+	versionDoc.ScenarioName = scenarioName
+	versionDoc.Version = 1
+	if len(versions) != 0 {
+		versionDoc.Version = versions[len(versions)-1].Version + 1
+	}
+	versionDoc.Author = author
+	versionDoc.Comment = comment
+	versionDoc.Time = time.Now().Format(time.RFC3339)
+	versionDoc.Scenario = scenario
+
+	doc, err := json.Marshal(versionDoc)
+	if err != nil {
+		return nil, err
+	}
+	_, err = pfmCtrl.historyStore.AddDoc(getVersionDocName(scenarioName, versionDoc.Version), doc)
+	if err != nil {
+		return nil, err
+	}
+	return versionDoc, nil
+}
+
+// deleteScenarioHistory - Remove all versions of the provided scenario from the scenario history
+func deleteScenarioHistory(scenarioName string) {
+	versions, err := getScenarioVersions(scenarioName)
+	if err != nil {
+		log.Error("Failed to get scenario versions: ", err.Error())
+		return
+	}
+	for _, version := range versions {
+		err = pfmCtrl.historyStore.DeleteDoc(getVersionDocName(scenarioName, version.Version))
+		if err != nil {
+			log.Error("Failed to delete scenario version: ", err.Error())
+		}
+	}
+}
+
+// initScenarioHistory - Record an initial version of stored scenarios without history
+func initScenarioHistory() error {
+	scenarioNameList, scenarioList, err := pfmCtrl.scenarioStore.GetDocList()
+	if err != nil {
+		return err
+	}
+	for i, scenarioName := range scenarioNameList {
+		versions, err := getScenarioVersions(scenarioName)
+		if err != nil {
+			return err
+		}
+		if len(versions) != 0 {
+			continue
+		}
+
+		// Remove DB document fields from stored scenario
+		scenario := new(dataModel.Scenario)
+		err = json.Unmarshal(scenarioList[i], scenario)
+		if err != nil {
+			return err
+		}
+		doc, err := json.Marshal(scenario)
+		if err != nil {
+			return err
+		}
+		_, err = addScenarioVersion(scenarioName, doc, "", "Initial version")
+		if err != nil {
+			return err
+		}
+		log.Info("Created scenario history for: ", scenarioName)
+	}
+	return nil
+}
+
+// getVersionParams - Return request scenario name & scenario version
+// Writes the error response if the version is not found.
+func getVersionParams(w http.ResponseWriter, r *http.Request) (scenarioName string, versions []*scenarioVersionDoc, version *scenarioVersionDoc) {
+	vars := mux.Vars(r)
+	scenarioName = vars["name"]
+	versionRef := vars["version"]
+	log.Debug("Scenario name: ", scenarioName, " version: ", versionRef)
+
+	versions, err := getScenarioVersions(scenarioName)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return scenarioName, nil, nil
+	}
+	version = findScenarioVersion(versions, versionRef)
+	if version == nil {
+		err = errors.New("Scenario version not found: " + scenarioName + " " + versionRef)
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusNotFound)
+	}
+	return scenarioName, versions, version
+}
+
+// Retrieve scenario version history
+// GET /scenarios/{name}/versions
+func pcGetScenarioHistory(w http.ResponseWriter, r *http.Request) {
+	log.Debug("pcGetScenarioHistory")
+
+	// Get scenario name from request parameters
+	vars := mux.Vars(r)
+	scenarioName := vars["name"]
+	log.Debug("Scenario name: ", scenarioName)
+
+	// Retrieve scenario versions
+	versions, err := getScenarioVersions(scenarioName)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if len(versions) == 0 {
+		err = errors.New("Scenario history not found: " + scenarioName)
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	// Format response
+	var history dataModel.ScenarioHistory
+	for _, version := range versions {
+		history.Versions = append(history.Versions, version.ScenarioVersion)
+	}
+	jsonResponse, err := json.Marshal(history)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	// Send response
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprint(w, string(jsonResponse))
+}
+
+// Retrieve scenario version
+// GET /scenarios/{name}/versions/{version}
+func pcGetScenarioVersion(w http.ResponseWriter, r *http.Request) {
+	log.Debug("pcGetScenarioVersion")
+
+	_, _, version := getVersionParams(w, r)
+	if version == nil {
+		return
+	}
+
+	s, err := mod.JSONMarshallScenario(version.Scenario)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	// Send response
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprint(w, s)
+}
+
+// Update scenario version tag & comment
+// PUT /scenarios/{name}/versions/{version}
+func pcSetScenarioVersion(w http.ResponseWriter, r *http.Request) {
+	log.Debug("pcSetScenarioVersion")
+
+	// Retrieve version information from request body
+	var versionInfo dataModel.ScenarioVersion
+	decoder := json.NewDecoder(r.Body)
+	err := decoder.Decode(&versionInfo)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	scenarioName, versions, version := getVersionParams(w, r)
+	if version == nil {
+		return
+	}
+
+	// Validate tag
+	if versionInfo.Tag != "" {
+		if _, err := strconv.Atoi(versionInfo.Tag); err == nil || versionInfo.Tag == versionLatest ||
+			strings.TrimSpace(versionInfo.Tag) != versionInfo.Tag {
+			err = errors.New("Invalid scenario version tag: " + versionInfo.Tag)
+			log.Error(err.Error())
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if tagged := findScenarioVersion(versions, versionInfo.Tag); tagged != nil && tagged != version {
+			err = errors.New("Scenario version tag already exists: " + versionInfo.Tag)
+			log.Error(err.Error())
+			http.Error(w, err.Error(), http.StatusConflict)
+			return
+		}
+	}
+
+	// Update version in DB
+	version.Tag = versionInfo.Tag
+	version.Comment = versionInfo.Comment
+	doc, err := json.Marshal(version)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	_, err = pfmCtrl.historyStore.UpdateDoc(getVersionDocName(scenarioName, version.Version), doc)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	// Format response
+	jsonResponse, err := json.Marshal(version.ScenarioVersion)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	// Send response
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprint(w, string(jsonResponse))
+}
+
+// Restore scenario version as the current scenario
+// POST /scenarios/{name}/versions/{version}/rollback
+func pcRollbackScenario(w http.ResponseWriter, r *http.Request) {
+	log.Debug("pcRollbackScenario")
+
+	scenarioName, _, version := getVersionParams(w, r)
+	if version == nil {
+		return
+	}
+
+	// Validate scenario; older versions may require an upgrade
+	validScenario, _, err := mod.ValidateScenario(version.Scenario)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// Record rollback as a new version & update scenario in DB
+	comment := "Rollback to version " + strconv.Itoa(int(version.Version))
+	newVersion, code, err := storeScenario(scenarioName, validScenario, auth.GetUserName(r), comment)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), code)
+		return
+	}
+
+	// Format response
+	jsonResponse, err := json.Marshal(newVersion.ScenarioVersion)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	// Send response
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprint(w, string(jsonResponse))
+}
+
+// Compare scenario versions
+// GET /scenarios/{name}/diff?from={version}&to={version}
+func pcDiffScenario(w http.ResponseWriter, r *http.Request) {
+	log.Debug("pcDiffScenario")
+
+	// Get scenario name from request parameters
+	vars := mux.Vars(r)
+	scenarioName := vars["name"]
+	query := r.URL.Query()
+	fromRef := query.Get("from")
+	toRef := query.Get("to")
+	if toRef == "" {
+		toRef = versionLatest
+	}
+	log.Debug("Scenario name: ", scenarioName, " from: ", fromRef, " to: ", toRef)
+
+	// Retrieve versions to compare
+	versions, err := getScenarioVersions(scenarioName)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	to := findScenarioVersion(versions, toRef)
+	if to == nil {
+		err = errors.New("Scenario version not found: " + scenarioName + " " + toRef)
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	// Compare with previous version by default
+	if fromRef == "" {
+		if to.Version == versions[0].Version {
+			err = errors.New("No scenario version before version " + strconv.Itoa(int(to.Version)))
+			log.Error(err.Error())
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		fromRef = strconv.Itoa(int(to.Version) - 1)
+	}
+	from := findScenarioVersion(versions, fromRef)
+	if from == nil {
+		err = errors.New("Scenario version not found: " + scenarioName + " " + fromRef)
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	// Compute diff
+	diff, err := mod.DiffScenarios(from.Scenario, to.Scenario)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	diff.From = strconv.Itoa(int(from.Version))
+	diff.To = strconv.Itoa(int(to.Version))
+
+	// Format response
+	jsonResponse, err := json.Marshal(diff)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	// Send response
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprint(w, string(jsonResponse))
+}
+
+// storeScenario - Record a new scenario version & update the scenario in the scenario store
+// Returns the HTTP status code to use on failure.
+func storeScenario(scenarioName string, scenario []byte, author string, comment string) (version *scenarioVersionDoc, code int, err error) {
+	version, err = addScenarioVersion(scenarioName, scenario, author, comment)
+	if err != nil {
+		return nil, http.StatusConflict, err
+	}
+	rev, err := pfmCtrl.scenarioStore.UpdateDoc(scenarioName, scenario)
+	if err != nil {
+		_ = pfmCtrl.historyStore.DeleteDoc(getVersionDocName(scenarioName, version.Version))
+		return nil, http.StatusNotFound, err
+	}
+	log.Debug("Scenario updated with rev: ", rev)
+	return version, http.StatusOK, nil
+}
@@ -17,6 +17,12 @@
 package sbi
 
 import (
+	"fmt"
+	"hash/fnv"
+	"math"
+	"sort"
+	"strconv"
+
 	dataModel "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-data-model"
 	log "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-logger"
 	mod "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-model"
@@ -29,6 +35,82 @@ const geModuleName string = "meep-gis-engine"
 const postgisUser string = "postgres"
 const postgisPwd string = "pwd"
 
+// Synthetic radio measurement range at POA location & at POA radius
+const (
+	rsrpMaxDbm = -60
+	rsrpMinDbm = -100
+	rsrqMaxDb  = -3
+	rsrqMinDb  = -15
+)
+
+// Timing advance step (16 Ts) expressed as a one-way distance in meters & maximum
+// timing advance index (ETSI TS 136 213)
+const (
+	taStepMeters = 78.125
+	taMax        = 1282
+)
+
+// CellMeas - Synthetic measurements of a cellular POA in range of a UE
+// Rsrp & Rsrq are reported as ETSI TS 136 133 measurement indices
+// Ta is reported as an ETSI TS 136 213 timing advance index
+type CellMeas struct {
+	Poa    string
+	Mnc    string
+	Mcc    string
+	CellId string
+	Rsrp   int32
+	Rsrq   int32
+	Ta     int32
+}
+
+// E-RAB events
+const (
+	ErabEventEstablish = "ESTABLISH"
+	ErabEventModify    = "MODIFY"
+	ErabEventRelease   = "RELEASE"
+	ErabEventHandover  = "HANDOVER"
+)
+
+// Default EPS bearer E-RAB ID & QoS Class Identifier (non-GBR)
+const (
+	defaultErabId = 5
+	defaultQci    = 9
+)
+
+// S1 bearer SGW address & UE MME code
+const (
+	sgwIpAddress = "10.0.0.1"
+	defaultMmec  = "1"
+)
+
+// ErabInfo - E-RAB of a UE attached to a cellular POA & its S1 bearer
+// Bit rates are in kbps; the eNB ID is the 20 leftmost bits of the 28-bit cell ID
+type ErabInfo struct {
+	ErabId  int32
+	Poa     string
+	Mnc     string
+	Mcc     string
+	CellId  string
+	Qci     int32
+	MbrDl   int32
+	MbrUl   int32
+	GbrDl   int32
+	GbrUl   int32
+	EnbId   string
+	EnbAddr string
+	EnbTeid string
+	SgwAddr string
+	SgwTeid string
+	Mmec    string
+	Mtmsi   string
+}
+
+type ecgiInfo struct {
+	mnc    string
+	mcc    string
+	cellId string
+}
+
 type SbiCfg struct {
 	SandboxName    string
 	RedisAddr      string
@@ -36,6 +118,8 @@ type SbiCfg struct {
 	PostgisPort    string
 	UeEcgiInfoCb   func(string, string, string, string)
 	AppEcgiInfoCb  func(string, string, string, string)
+	MeasInfoCb     func(string, string, []CellMeas)
+	ErabInfoCb     func(string, string, *ErabInfo)
 	ScenarioNameCb func(string)
 	CleanUpCb      func()
 }
@@ -48,8 +132,14 @@ type RnisSbi struct {
 	pc                   *postgis.Connector
 	updateUeEcgiInfoCB   func(string, string, string, string)
 	updateAppEcgiInfoCB  func(string, string, string, string)
+	updateMeasInfoCB     func(string, string, []CellMeas)
+	updateErabInfoCB     func(string, string, *ErabInfo)
 	updateScenarioNameCB func(string)
 	cleanUpCB            func()
+	poaEcgiMap           map[string]*ecgiInfo
+	ueErabMap            map[string]*ErabInfo
+	nextTeid             uint32
+	nextMtmsi            uint32
 }
 
 var sbi *RnisSbi
@@ -65,8 +155,12 @@ func Init(cfg SbiCfg) (err error) {
 	sbi.sandboxName = cfg.SandboxName
 	sbi.updateUeEcgiInfoCB = cfg.UeEcgiInfoCb
 	sbi.updateAppEcgiInfoCB = cfg.AppEcgiInfoCb
+	sbi.updateMeasInfoCB = cfg.MeasInfoCb
+	sbi.updateErabInfoCB = cfg.ErabInfoCb
 	sbi.updateScenarioNameCB = cfg.ScenarioNameCb
 	sbi.cleanUpCB = cfg.CleanUpCb
+	sbi.poaEcgiMap = make(map[string]*ecgiInfo)
+	sbi.ueErabMap = make(map[string]*ErabInfo)
 
 	// Create message queue
 	sbi.mqLocal, err = mq.NewMsgQueue(mq.GetLocalName(sbi.sandboxName), moduleName, sbi.sandboxName, cfg.RedisAddr)
@@ -148,6 +242,8 @@ func processActiveScenarioTerminate() {
 
 	// Sync with active scenario store
 	sbi.activeModel.UpdateScenario()
+	sbi.poaEcgiMap = make(map[string]*ecgiInfo)
+	sbi.ueErabMap = make(map[string]*ErabInfo)
 
 	sbi.cleanUpCB()
 }
@@ -208,10 +304,41 @@ func processActiveScenarioUpdate() {
 		}
 		if !found {
 			sbi.updateUeEcgiInfoCB(oldUe, "", "", "")
+			if sbi.updateMeasInfoCB != nil {
+				sbi.updateMeasInfoCB(oldUe, "", nil)
+			}
 			log.Info("Ue removed : ", oldUe)
 		}
 	}
 
+	// Update cellular POA ECGI info used for UE measurements
+	poaEcgiMap := make(map[string]*ecgiInfo)
+	poaNameList := sbi.activeModel.GetNodeNames(mod.NodeTypePoaCell)
+	for _, name := range poaNameList {
+		if poa, ok := sbi.activeModel.GetNode(name).(*dataModel.NetworkLocation); ok {
+			poaParent := sbi.activeModel.GetNodeParent(poa.Name)
+			if zone, ok := poaParent.(*dataModel.Zone); ok {
+				zoneParent := sbi.activeModel.GetNodeParent(zone.Name)
+				if domain, ok := zoneParent.(*dataModel.Domain); ok {
+					info := new(ecgiInfo)
+					if domain.CellularDomainConfig != nil {
+						info.mnc = domain.CellularDomainConfig.Mnc
+						info.mcc = domain.CellularDomainConfig.Mcc
+						info.cellId = domain.CellularDomainConfig.DefaultCellId
+					}
+					if poa.CellularPoaConfig != nil && poa.CellularPoaConfig.CellId != "" {
+						info.cellId = poa.CellularPoaConfig.CellId
+					}
+					poaEcgiMap[name] = info
+				}
+			}
+		}
+	}
+	sbi.poaEcgiMap = poaEcgiMap
+
+	// Update UE E-RABs
+	updateErabs(ueNameList)
+
 	// Update Edge App info
 	meAppNameList := sbi.activeModel.GetNodeNames("EDGE-APP")
 	ueAppNameList := sbi.activeModel.GetNodeNames("UE-APP")
@@ -256,25 +383,166 @@ func processActiveScenarioUpdate() {
 	}
 }
 
+// Establish E-RABs for UEs attached to a cellular POA & release them on detach or move to a non-cellular POA
+func updateErabs(ueNameList []string) {
+	ueErabMap := make(map[string]*ErabInfo)
+	for _, name := range ueNameList {
+		poa, ok := sbi.activeModel.GetNodeParent(name).(*dataModel.NetworkLocation)
+		if !ok {
+			continue
+		}
+		info, found := sbi.poaEcgiMap[poa.Name]
+		if !found {
+			continue
+		}
+
+		erab := new(ErabInfo)
+		erab.ErabId = defaultErabId
+		erab.Poa = poa.Name
+		erab.Mnc = info.mnc
+		erab.Mcc = info.mcc
+		erab.CellId = info.cellId
+		erab.Qci = defaultQci
+		if ue, ok := sbi.activeModel.GetNode(name).(*dataModel.PhysicalLocation); ok && ue.NetChar != nil {
+			erab.MbrDl = ue.NetChar.ThroughputDl * 1000
+			erab.MbrUl = ue.NetChar.ThroughputUl * 1000
+		}
+		erab.EnbId = getEnbId(erab.CellId)
+		erab.EnbAddr = getEnbAddr(erab.EnbId)
+		ueErabMap[name] = erab
+
+		// Keep S1 bearer tunnel endpoints & UE temporary ID while attached to the same eNB
+		oldErab, found := sbi.ueErabMap[name]
+		if found {
+			erab.SgwAddr = oldErab.SgwAddr
+			erab.SgwTeid = oldErab.SgwTeid
+			erab.Mmec = oldErab.Mmec
+			erab.Mtmsi = oldErab.Mtmsi
+			if erab.EnbId == oldErab.EnbId {
+				erab.EnbTeid = oldErab.EnbTeid
+			} else {
+				erab.EnbTeid = allocateTeid()
+			}
+		} else {
+			erab.SgwAddr = sgwIpAddress
+			erab.SgwTeid = allocateTeid()
+			erab.EnbTeid = allocateTeid()
+			erab.Mmec = defaultMmec
+			sbi.nextMtmsi++
+			erab.Mtmsi = strconv.FormatUint(uint64(sbi.nextMtmsi), 10)
+		}
+
+		if !found {
+			sbi.updateErabInfoCB(name, ErabEventEstablish, erab)
+		} else if erab.Qci != oldErab.Qci || erab.MbrDl != oldErab.MbrDl || erab.MbrUl != oldErab.MbrUl ||
+			erab.GbrDl != oldErab.GbrDl || erab.GbrUl != oldErab.GbrUl {
+			sbi.updateErabInfoCB(name, ErabEventModify, erab)
+		} else if erab.Poa != oldErab.Poa || erab.EnbId != oldErab.EnbId {
+			sbi.updateErabInfoCB(name, ErabEventHandover, erab)
+		}
+	}
+
+	for name, oldErab := range sbi.ueErabMap {
+		if _, found := ueErabMap[name]; !found {
+			sbi.updateErabInfoCB(name, ErabEventRelease, oldErab)
+		}
+	}
+	sbi.ueErabMap = ueErabMap
+}
+
 func processGisEngineUpdate(assetMap map[string]string) {
 	for assetName, assetType := range assetMap {
 		// Only process UE updates
-		// NOTE: RNIS requires distance measurements to calculate Timing Advance. Because timing advance
-		//       is not yet implemented, the distance measurements are simply logged here for now.
+		// NOTE: UE measurements are derived from the distance between the UE and each cellular POA in range
 		if assetType == postgis.TypeUe {
 			if assetName == postgis.AllAssets {
-				ueMap, err := sbi.pc.GetAllUe()
+				uePoaInfoMap, err := sbi.pc.GetAllUePoaInfo()
 				if err == nil {
-					for _, ue := range ueMap {
-						log.Trace("UE[", ue.Name, "] POA [", ue.Poa, "] distance[", ue.PoaDistance, "]")
+					for ueName, uePoaInfo := range uePoaInfoMap {
+						updateUeMeas(ueName, uePoaInfo)
 					}
 				}
 			} else {
-				ue, err := sbi.pc.GetUe(assetName)
+				uePoaInfo, err := sbi.pc.GetUePoaInfo(assetName)
 				if err == nil {
-					log.Trace("UE[", ue.Name, "] POA [", ue.Poa, "] distance[", ue.PoaDistance, "]")
+					updateUeMeas(assetName, uePoaInfo)
 				}
 			}
 		}
 	}
 }
+
+func updateUeMeas(ueName string, uePoaInfo *postgis.UePoaInfo) {
+	log.Trace("UE[", ueName, "] POA [", uePoaInfo.CurrentPoa, "] in range[", uePoaInfo.PoaInRange, "]")
+
+	// Calculate measurements for each cellular POA in range
+	measList := []CellMeas{}
+	for _, poaName := range uePoaInfo.PoaInRange {
+		info, found := sbi.poaEcgiMap[poaName]
+		if !found {
+			continue
+		}
+		poaInfo := uePoaInfo.PoaInfoMap[poaName]
+		var meas CellMeas
+		meas.Poa = poaName
+		meas.Mnc = info.mnc
+		meas.Mcc = info.mcc
+		meas.CellId = info.cellId
+		meas.Rsrp, meas.Rsrq = calculateMeas(poaInfo.Distance, poaInfo.Radius)
+		meas.Ta = calculateTa(poaInfo.Distance)
+		measList = append(measList, meas)
+	}
+
+	// Order measurements from strongest to weakest cell
+	sort.Slice(measList, func(i, j int) bool {
+		return measList[i].Rsrp > measList[j].Rsrp
+	})
+
+	if sbi.updateMeasInfoCB != nil {
+		sbi.updateMeasInfoCB(ueName, uePoaInfo.CurrentPoa, measList)
+	}
+}
+
+// Calculate RSRP & RSRQ measurement indices using a log-distance attenuation
+// from the maximum value at the POA location to the minimum value at the POA radius
+func calculateMeas(distance float32, radius float32) (rsrp int32, rsrq int32) {
+	ratio := float64(1)
+	if radius > 0 {
+		ratio = math.Min(math.Max(float64(distance/radius), 0), 1)
+	}
+	factor := math.Log10(1 + 9*ratio)
+	rsrpDbm := rsrpMaxDbm - (rsrpMaxDbm-rsrpMinDbm)*factor
+	rsrqDb := rsrqMaxDb - (rsrqMaxDb-rsrqMinDb)*factor
+
+	// Convert to measurement indices (ETSI TS 136 133)
+	rsrp = int32(math.Max(math.Min(math.Floor(rsrpDbm+141), 97), 0))
+	rsrq = int32(math.Max(math.Min(math.Floor((rsrqDb+20)*2), 34), 0))
+	return rsrp, rsrq
+}
+
+// Calculate timing advance index from the UE-POA distance using the round-trip propagation delay
+func calculateTa(distance float32) int32 {
+	return int32(math.Max(math.Min(math.Round(float64(distance)/taStepMeters), taMax), 0))
+}
+
+// Get eNB ID from the 28-bit cell ID hex string
+func getEnbId(cellId string) string {
+	if len(cellId) > 2 {
+		return cellId[:len(cellId)-2]
+	}
+	return cellId
+}
+
+// Get synthetic eNB transport layer address from the eNB ID
+func getEnbAddr(enbId string) string {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(enbId))
+	sum := h.Sum32()
+	return fmt.Sprintf("10.%d.%d.%d", (sum>>16)&0xff, (sum>>8)&0xff, (sum&0xfe)+1)
+}
+
+// Allocate a GTP-U tunnel endpoint ID
+func allocateTeid() string {
+	sbi.nextTeid++
+	return strconv.FormatUint(uint64(sbi.nextTeid), 10)
+}
@@ -28,19 +28,19 @@ import (
 )
 
 func CaReConfSubscriptionSubscriptionsGET(w http.ResponseWriter, r *http.Request) {
-	notImplemented(w, r)
+	caReConfSubscriptionsGET(w, r)
 }
 
 func CaReConfSubscriptionSubscriptionsPOST(w http.ResponseWriter, r *http.Request) {
-	notImplemented(w, r)
+	caReConfSubscriptionsPOST(w, r)
 }
 
 func CaReConfSubscriptionSubscriptionsPUT(w http.ResponseWriter, r *http.Request) {
-	notImplemented(w, r)
+	caReConfSubscriptionsPUT(w, r)
 }
 
 func CaReConfSubscriptionsSubscrIdDELETE(w http.ResponseWriter, r *http.Request) {
-	notImplemented(w, r)
+	caReConfSubscriptionsDELETE(w, r)
 }
 
 func CellChangeSubscriptionsGET(w http.ResponseWriter, r *http.Request) {
@@ -76,19 +76,19 @@ func MeasRepUeSubscriptionsSubscrIdDELETE(w http.ResponseWriter, r *http.Request
 }
 
 func MeasTaSubscriptionsGET(w http.ResponseWriter, r *http.Request) {
-	notImplemented(w, r)
+	measTaSubscriptionsGET(w, r)
 }
 
 func MeasTaSubscriptionsPOST(w http.ResponseWriter, r *http.Request) {
-	notImplemented(w, r)
+	measTaSubscriptionsPOST(w, r)
 }
 
 func MeasTaSubscriptionsPUT(w http.ResponseWriter, r *http.Request) {
-	notImplemented(w, r)
+	measTaSubscriptionsPUT(w, r)
 }
 
 func MeasTaSubscriptionsSubscrIdDELETE(w http.ResponseWriter, r *http.Request) {
-	notImplemented(w, r)
+	measTaSubscriptionsDELETE(w, r)
 }
 
 func PlmnInfoGET(w http.ResponseWriter, r *http.Request) {
@@ -96,75 +96,75 @@ func PlmnInfoGET(w http.ResponseWriter, r *http.Request) {
 }
 
 func RabEstSubscriptionSubscriptionsGET(w http.ResponseWriter, r *http.Request) {
-	notImplemented(w, r)
+	rabEstSubscriptionsGET(w, r)
 }
 
 func RabEstSubscriptionSubscriptionsPOST(w http.ResponseWriter, r *http.Request) {
-	notImplemented(w, r)
+	rabEstSubscriptionsPOST(w, r)
 }
 
 func RabEstSubscriptionSubscriptionsPUT(w http.ResponseWriter, r *http.Request) {
-	notImplemented(w, r)
+	rabEstSubscriptionsPUT(w, r)
 }
 
 func RabEstSubscriptionsSubscrIdDELETE(w http.ResponseWriter, r *http.Request) {
-	notImplemented(w, r)
+	rabEstSubscriptionsDELETE(w, r)
 }
 
 func RabInfoGET(w http.ResponseWriter, r *http.Request) {
-	notImplemented(w, r)
+	rabInfoGET(w, r)
 }
 
 func RabModSubscriptionSubscriptionsGET(w http.ResponseWriter, r *http.Request) {
-	notImplemented(w, r)
+	rabModSubscriptionsGET(w, r)
 }
 
 func RabModSubscriptionSubscriptionsPOST(w http.ResponseWriter, r *http.Request) {
-	notImplemented(w, r)
+	rabModSubscriptionsPOST(w, r)
 }
 
 func RabModSubscriptionSubscriptionsPUT(w http.ResponseWriter, r *http.Request) {
-	notImplemented(w, r)
+	rabModSubscriptionsPUT(w, r)
 }
 
 func RabModSubscriptionsSubscrIdDELETE(w http.ResponseWriter, r *http.Request) {
-	notImplemented(w, r)
+	rabModSubscriptionsDELETE(w, r)
 }
 
 func RabRelSubscriptionSubscriptionsGET(w http.ResponseWriter, r *http.Request) {
-	notImplemented(w, r)
+	rabRelSubscriptionsGET(w, r)
 }
 
 func RabRelSubscriptionSubscriptionsPOST(w http.ResponseWriter, r *http.Request) {
-	notImplemented(w, r)
+	rabRelSubscriptionsPOST(w, r)
 }
 
 func RabRelSubscriptionSubscriptionsPUT(w http.ResponseWriter, r *http.Request) {
-	notImplemented(w, r)
+	rabRelSubscriptionsPUT(w, r)
 }
 
 func RabRelSubscriptionsSubscrIdDELETE(w http.ResponseWriter, r *http.Request) {
-	notImplemented(w, r)
+	rabRelSubscriptionsDELETE(w, r)
 }
 
 func S1BearerInfoGET(w http.ResponseWriter, r *http.Request) {
-	notImplemented(w, r)
+	s1BearerInfoGET(w, r)
 }
 
 func S1BearerSubscriptionSubscriptionsGET(w http.ResponseWriter, r *http.Request) {
-	notImplemented(w, r)
+	s1BearerSubscriptionsGET(w, r)
 }
 
 func S1BearerSubscriptionSubscriptionsPOST(w http.ResponseWriter, r *http.Request) {
-	notImplemented(w, r)
+	s1BearerSubscriptionsPOST(w, r)
 }
 
 func S1BearerSubscriptionSubscriptionsPUT(w http.ResponseWriter, r *http.Request) {
-	notImplemented(w, r)
+	s1BearerSubscriptionsPUT(w, r)
 }
 
 func S1BearerSubscriptionsSubscrIdDELETE(w http.ResponseWriter, r *http.Request) {
-	notImplemented(w, r)
+	s1BearerSubscriptionsDELETE(w, r)
 }
 
 func SubscriptionLinkListSubscriptionsCcGET(w http.ResponseWriter, r *http.Request) {
@@ -172,7 +172,7 @@ func SubscriptionLinkListSubscriptionsCcGET(w http.ResponseWriter, r *http.Reque
 }
 
 func SubscriptionLinkListSubscriptionsCrGET(w http.ResponseWriter, r *http.Request) {
-	notImplemented(w, r)
+	subscriptionLinkListSubscriptionsCrGET(w, r)
 }
 
 func SubscriptionLinkListSubscriptionsGET(w http.ResponseWriter, r *http.Request) {
@@ -184,21 +184,21 @@ func SubscriptionLinkListSubscriptionsMrGET(w http.ResponseWriter, r *http.Reque
 }
 
 func SubscriptionLinkListSubscriptionsReGET(w http.ResponseWriter, r *http.Request) {
-	notImplemented(w, r)
+	subscriptionLinkListSubscriptionsReGET(w, r)
 }
 
 func SubscriptionLinkListSubscriptionsRmGET(w http.ResponseWriter, r *http.Request) {
-	notImplemented(w, r)
+	subscriptionLinkListSubscriptionsRmGET(w, r)
 }
 
 func SubscriptionLinkListSubscriptionsRrGET(w http.ResponseWriter, r *http.Request) {
-	notImplemented(w, r)
+	subscriptionLinkListSubscriptionsRrGET(w, r)
 }
 
 func SubscriptionLinkListSubscriptionsS1GET(w http.ResponseWriter, r *http.Request) {
-	notImplemented(w, r)
+	subscriptionLinkListSubscriptionsS1GET(w, r)
 }
 
 func SubscriptionLinkListSubscriptionsTaGET(w http.ResponseWriter, r *http.Request) {
-	notImplemented(w, r)
+	subscriptionLinkListSubscriptionsTaGET(w, r)
 }
@@ -20,6 +20,7 @@ import (
 	"encoding/json"
 
 	log "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-logger"
+	clientNotif "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-rnis-notification-client"
 )
 
 func convertJsonToEcgi(jsonInfo string) *Ecgi {
@@ -65,3 +66,119 @@ func convertCellChangeSubscriptionToJson(obj *CellChangeSubscription) string {
 
 	return string(jsonInfo)
 }
+
+func convertMeasRepUeSubscriptionToJson(obj *MeasRepUeSubscription) string {
+
+	jsonInfo, err := json.Marshal(*obj)
+	if err != nil {
+		log.Error(err.Error())
+		return ""
+	}
+
+	return string(jsonInfo)
+}
+
+func convertRabEstSubscriptionToJson(obj *RabEstSubscription) string {
+
+	jsonInfo, err := json.Marshal(*obj)
+	if err != nil {
+		log.Error(err.Error())
+		return ""
+	}
+
+	return string(jsonInfo)
+}
+
+func convertRabModSubscriptionToJson(obj *RabModSubscription) string {
+
+	jsonInfo, err := json.Marshal(*obj)
+	if err != nil {
+		log.Error(err.Error())
+		return ""
+	}
+
+	return string(jsonInfo)
+}
+
+func convertRabRelSubscriptionToJson(obj *RabRelSubscription) string {
+
+	jsonInfo, err := json.Marshal(*obj)
+	if err != nil {
+		log.Error(err.Error())
+		return ""
+	}
+
+	return string(jsonInfo)
+}
+
+func convertS1BearerSubscriptionToJson(obj *S1BearerSubscription) string {
+
+	jsonInfo, err := json.Marshal(*obj)
+	if err != nil {
+		log.Error(err.Error())
+		return ""
+	}
+
+	return string(jsonInfo)
+}
+
+func convertMeasTaSubscriptionToJson(obj *MeasTaSubscription) string {
+
+	jsonInfo, err := json.Marshal(*obj)
+	if err != nil {
+		log.Error(err.Error())
+		return ""
+	}
+
+	return string(jsonInfo)
+}
+
+func convertCaReConfSubscriptionToJson(obj *CaReConfSubscription) string {
+
+	jsonInfo, err := json.Marshal(*obj)
+	if err != nil {
+		log.Error(err.Error())
+		return ""
+	}
+
+	return string(jsonInfo)
+}
+
+func convertEcgiToNotifEcgi(obj *Ecgi) *clientNotif.Ecgi {
+
+	var notifEcgi clientNotif.Ecgi
+	var notifPlmn clientNotif.Plmn
+	if obj.Plmn != nil {
+		notifPlmn.Mnc = obj.Plmn.Mnc
+		notifPlmn.Mcc = obj.Plmn.Mcc
+	}
+	notifEcgi.Plmn = &notifPlmn
+	notifEcgi.CellId = obj.CellId
+	return &notifEcgi
+}
+
+func convertS1UeInfoToNotifS1UeInfo(obj *S1UeInfo) *clientNotif.S1UeInfo {
+
+	var notifS1UeInfo clientNotif.S1UeInfo
+	for _, tempUeId := range obj.TempUeId {
+		notifS1UeInfo.TempUeId = append(notifS1UeInfo.TempUeId, clientNotif.TempUeId{Mmec: tempUeId.Mmec, Mtmsi: tempUeId.Mtmsi})
+	}
+	for _, assocId := range obj.AssociateId {
+		notifS1UeInfo.AssociateId = append(notifS1UeInfo.AssociateId, clientNotif.AssociateId{Type_: assocId.Type_, Value: assocId.Value})
+	}
+	for i := range obj.Ecgi {
+		notifS1UeInfo.Ecgi = append(notifS1UeInfo.Ecgi, *convertEcgiToNotifEcgi(&obj.Ecgi[i]))
+	}
+	for _, s1BearerInfo := range obj.S1BearerInfoDetailed {
+		var notifS1BearerInfo clientNotif.S1BearerInfoDetailed
+		notifS1BearerInfo.ErabId = s1BearerInfo.ErabId
+		if s1BearerInfo.S1EnbInfo != nil {
+			notifS1BearerInfo.S1EnbInfo = &clientNotif.S1EnbInfo{IpAddress: s1BearerInfo.S1EnbInfo.IpAddress, TunnelId: s1BearerInfo.S1EnbInfo.TunnelId}
+		}
+		if s1BearerInfo.SGwInfo != nil {
+			notifS1BearerInfo.SGwInfo = &clientNotif.SGwInfo{IpAddress: s1BearerInfo.SGwInfo.IpAddress, TunnelId: s1BearerInfo.SGwInfo.TunnelId}
+		}
+		notifS1UeInfo.S1BearerInfoDetailed = append(notifS1UeInfo.S1BearerInfoDetailed, notifS1BearerInfo)
+	}
+	return &notifS1UeInfo
+}
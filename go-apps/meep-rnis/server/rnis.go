@@ -24,8 +24,10 @@ import (
 	"net/http"
 	"net/url"
 	"os"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	sbi "github.com/InterDigitalInc/AdvantEDGE/go-apps/meep-rnis/sbi"
@@ -34,6 +36,7 @@ import (
 	log "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-logger"
 	redis "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-redis"
 	clientNotif "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-rnis-notification-client"
+	subs "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-subscriptions"
 	"github.com/gorilla/mux"
 )
 
@@ -46,29 +49,88 @@ var redisAddr string = "meep-redis-master.default.svc.cluster.local:6379"
 var influxAddr string = "http://meep-influxdb.default.svc.cluster.local:8086"
 var postgisHost string = "meep-postgis.default.svc.cluster.local"
 var postgisPort string = "5432"
+var notifRetries int = 3
+var notifBackoff time.Duration = time.Second
+var measRepUeInterval time.Duration = 10 * time.Second
 
 const cellChangeSubscriptionType = "cell_change"
+const measRepUeSubscriptionType = "meas_rep_ue"
+const rabEstSubscriptionType = "rab_est"
+const rabModSubscriptionType = "rab_mod"
+const rabRelSubscriptionType = "rab_rel"
+const s1BearerSubscriptionType = "s1_bearer"
+const measTaSubscriptionType = "ta"
+const caReConfSubscriptionType = "ca_reconf"
+
+var subscriptionTypeMap = map[string]SubscriptionType{
+	cellChangeSubscriptionType: CELL_CHANGE,
+	measRepUeSubscriptionType:  MEAS_REPORT_UE,
+	rabEstSubscriptionType:     RAB_ESTABLISHMENT,
+	rabModSubscriptionType:     RAB_MODIFICATION,
+	rabRelSubscriptionType:     RAB_RELEASE,
+	s1BearerSubscriptionType:   S1_BEARE,
+	measTaSubscriptionType:     MEAS_TIMING_ADVANCE,
+	caReConfSubscriptionType:   CA_RECONF,
+}
+
+// Measurement report event thresholds (RSRP in dBm) & offset (dB)
+type mrEventConfig struct {
+	a1Threshold  int32
+	a2Threshold  int32
+	a3Offset     int32
+	a4Threshold  int32
+	a5Threshold1 int32
+	a5Threshold2 int32
+}
+
+var defaultMrEventCfg = mrEventConfig{
+	a1Threshold:  -80,
+	a2Threshold:  -95,
+	a3Offset:     3,
+	a4Threshold:  -90,
+	a5Threshold1: -95,
+	a5Threshold2: -90,
+}
+var mrEventCfg = defaultMrEventCfg
+
+// RSRP measurement index offset (ETSI TS 136 133)
+const rsrpIndexOffset = 141
+
+// Maximum number of carrier aggregation secondary cells
+const maxSecondaryCells = 4
+
+type ueMeasInfo struct {
+	ecgi           *Ecgi
+	rsrp           int32
+	rsrq           int32
+	ta             int32
+	neighbours     []cellMeasInfo
+	secondaryCells []*Ecgi
+}
+
+type cellMeasInfo struct {
+	ecgi *Ecgi
+	rsrp int32
+	rsrq int32
+}
 
-var ccSubscriptionMap = map[int]*CellChangeSubscription{}
-var subscriptionExpiryMap = map[int][]int{}
+var ueMeasInfoMap = map[string]*ueMeasInfo{}
+var ueErabInfoMap = map[string]*sbi.ErabInfo{}
+var mrEventStateMap = map[int]map[string]bool{}
+var mutex sync.Mutex
 var currentStoreName = ""
 
 var RNIS_DB = 5
 
 var rc *redis.Connector
+var subMgr *subs.SubscriptionMgr
 var hostUrl *url.URL
 var sandboxName string
 var basePath string
 var baseKey string
 
-var expiryTicker *time.Ticker
-
-var nextSubscriptionIdAvailable int
-
-func notImplemented(w http.ResponseWriter, r *http.Request) {
-	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
-	w.WriteHeader(http.StatusNotImplemented)
-}
+var measRepUeTicker *time.Ticker
+var measRepUeStop chan struct{}
 
 // Init - RNI Service initialization
 func Init() (err error) {
@@ -92,6 +154,27 @@ func Init() (err error) {
 	}
 	log.Info("MEEP_HOST_URL: ", hostUrl)
 
+	// Retrieve periodic UE measurement report interval (seconds) from environment variable
+	measRepUeIntervalEnv := strings.TrimSpace(os.Getenv("MEEP_MEAS_REP_UE_INTERVAL"))
+	if measRepUeIntervalEnv != "" {
+		interval, err := strconv.Atoi(measRepUeIntervalEnv)
+		if err != nil || interval <= 0 {
+			log.Error("Invalid MEEP_MEAS_REP_UE_INTERVAL: ", measRepUeIntervalEnv)
+		} else {
+			measRepUeInterval = time.Duration(interval) * time.Second
+		}
+	}
+	log.Info("MEEP_MEAS_REP_UE_INTERVAL: ", measRepUeInterval)
+
+	// Retrieve measurement report event thresholds & offset from environment variables
+	mrEventCfg = defaultMrEventCfg
+	setMrEventParam("MEEP_MEAS_REP_A1_THRESHOLD", &mrEventCfg.a1Threshold)
+	setMrEventParam("MEEP_MEAS_REP_A2_THRESHOLD", &mrEventCfg.a2Threshold)
+	setMrEventParam("MEEP_MEAS_REP_A3_OFFSET", &mrEventCfg.a3Offset)
+	setMrEventParam("MEEP_MEAS_REP_A4_THRESHOLD", &mrEventCfg.a4Threshold)
+	setMrEventParam("MEEP_MEAS_REP_A5_THRESHOLD1", &mrEventCfg.a5Threshold1)
+	setMrEventParam("MEEP_MEAS_REP_A5_THRESHOLD2", &mrEventCfg.a5Threshold2)
+
 	// Set base path
 	basePath = "/" + sandboxName + rnisBasePath
 
@@ -106,14 +189,32 @@ func Init() (err error) {
 	}
 	log.Info("Connected to Redis DB, RNI service table")
 
-	reInit()
+	// Create subscription manager
+	if subMgr != nil {
+		subMgr.Stop()
+	}
+	subMgrCfg := &subs.SubscriptionMgrCfg{
+		Module:       logModuleRNIS,
+		Basekey:      baseKey,
+		RedisTable:   RNIS_DB,
+		ExpiredSubCb: expiredSubscriptionCb,
+		NotifRetries: notifRetries,
+		NotifBackoff: notifBackoff,
+		SharedIds:    true,
+		LegacyTypes: []string{cellChangeSubscriptionType, measRepUeSubscriptionType, rabEstSubscriptionType, rabModSubscriptionType,
+			rabRelSubscriptionType, s1BearerSubscriptionType, measTaSubscriptionType, caReConfSubscriptionType},
+		LegacySubCb: legacySubscriptionCb,
+	}
+	subMgr, err = subs.NewSubscriptionMgr(subMgrCfg, redisAddr)
+	if err != nil {
+		log.Error("Failed to create subscription manager. Error: ", err)
+		return err
+	}
+	log.Info("Subscription manager created")
 
-	expiryTicker = time.NewTicker(time.Second)
-	go func() {
-		for range expiryTicker.C {
-			checkForExpiredSubscriptions()
-		}
-	}()
+	// Start periodic UE measurement reports
+	stopMeasRepUeTicker()
+	startMeasRepUeTicker()
 
 	// Initialize SBI
 	sbiCfg := sbi.SbiCfg{
@@ -123,6 +224,8 @@ func Init() (err error) {
 		PostgisPort:    postgisPort,
 		UeEcgiInfoCb:   updateUeEcgiInfo,
 		AppEcgiInfoCb:  updateAppEcgiInfo,
+		MeasInfoCb:     updateMeasInfo,
+		ErabInfoCb:     updateErabInfo,
 		ScenarioNameCb: updateStoreName,
 		CleanUpCb:      cleanUp,
 	}
@@ -136,12 +239,6 @@ func Init() (err error) {
 	return nil
 }
 
-// reInit - finds the value already in the DB to repopulate local stored info
-func reInit() {
-	keyName := baseKey + cellChangeSubscriptionType + "*"
-	_ = rc.ForEachJSONEntry(keyName, repopulateCcSubscriptionMap, nil)
-}
-
 // Run - Start RNIS
 func Run() (err error) {
 	return sbi.Run()
@@ -149,9 +246,52 @@ func Run() (err error) {
 
 // Stop - Stop RNIS
 func Stop() (err error) {
+	stopMeasRepUeTicker()
 	return sbi.Stop()
 }
 
+// setMrEventParam - Set measurement report event parameter from environment variable, if valid
+func setMrEventParam(envName string, param *int32) {
+	env := strings.TrimSpace(os.Getenv(envName))
+	if env != "" {
+		value, err := strconv.ParseInt(env, 10, 32)
+		if err != nil {
+			log.Error("Invalid ", envName, ": ", env)
+		} else {
+			*param = int32(value)
+		}
+	}
+	log.Info(envName, ": ", *param)
+}
+
+// startMeasRepUeTicker - Start periodic UE measurement report ticker
+func startMeasRepUeTicker() {
+	ticker := time.NewTicker(measRepUeInterval)
+	stop := make(chan struct{})
+	measRepUeTicker = ticker
+	measRepUeStop = stop
+	go func() {
+		for {
+			select {
+			case <-ticker.C:
+				checkPeriodicMeasRepUeSubscriptions()
+			case <-stop:
+				return
+			}
+		}
+	}()
+}
+
+// stopMeasRepUeTicker - Stop periodic UE measurement report ticker & goroutine
+func stopMeasRepUeTicker() {
+	if measRepUeTicker != nil {
+		measRepUeTicker.Stop()
+		close(measRepUeStop)
+		measRepUeTicker = nil
+		measRepUeStop = nil
+	}
+}
+
 func updateUeEcgiInfo(name string, mnc string, mcc string, cellId string) {
 
 	var plmn Plmn
@@ -226,6 +366,8 @@ func createClient(notifyPath string) (*clientNotif.APIClient, error) {
 	// Create & store client for App REST API
 	subsAppClientCfg := clientNotif.NewConfiguration()
 	subsAppClientCfg.BasePath = notifyPath
+	// Correlation ID of the notification context allows matching notifications with the subscription request
+	subsAppClientCfg.HTTPClient = httpLog.NewCorrelationIdClient()
 	subsAppClient := clientNotif.NewAPIClient(subsAppClientCfg)
 	if subsAppClient == nil {
 		log.Error("Failed to create Subscription App REST API client: ", subsAppClientCfg.BasePath)
@@ -235,75 +377,225 @@ func createClient(notifyPath string) (*clientNotif.APIClient, error) {
 	return subsAppClient, nil
 }
 
-func checkForExpiredSubscriptions() {
+func expiredSubscriptionCb(sub *subs.Subscription) {
+	deregisterSubscription(sub.Cfg.Type, sub.Cfg.Id)
 
-	nowTime := int(time.Now().Unix())
-	for expiryTime, subsIndexList := range subscriptionExpiryMap {
-		if expiryTime <= nowTime {
-			subscriptionExpiryMap[expiryTime] = nil
-			for _, subsId := range subsIndexList {
-				if ccSubscriptionMap[subsId] != nil {
+	var notif clientNotif.ExpiryNotification
 
-					subsIdStr := strconv.Itoa(subsId)
+	seconds := time.Now().Unix()
+	var timeStamp clientNotif.TimeStamp
+	timeStamp.Seconds = int32(seconds)
 
-					var notif clientNotif.ExpiryNotification
+	var expiryTimeStamp clientNotif.TimeStamp
+	if sub.Cfg.ExpiryTime != nil {
+		expiryTimeStamp.Seconds = int32(sub.Cfg.ExpiryTime.Unix())
+	}
 
-					seconds := time.Now().Unix()
-					var timeStamp clientNotif.TimeStamp
-					timeStamp.Seconds = int32(seconds)
+	link := new(clientNotif.Link)
+	link.Self = sub.Cfg.NotifyUrl
+	notif.Links = link
 
-					var expiryTimeStamp clientNotif.TimeStamp
-					expiryTimeStamp.Seconds = int32(expiryTime)
+	notif.Timestamp = &timeStamp
+	notif.ExpiryDeadline = &expiryTimeStamp
 
-					link := new(clientNotif.Link)
-					link.Self = ccSubscriptionMap[subsId].CallbackReference
-					notif.Links = link
+	go sendExpiryNotification(link.Self, httpLog.WithCorrelationId(context.TODO(), sub.Cfg.CorrelationId), sub.Cfg.Type, sub.Cfg.Id, notif)
+}
 
-					notif.Timestamp = &timeStamp
-					notif.ExpiryDeadline = &expiryTimeStamp
+// legacySubscriptionCb - converts a subscription stored by a previous RNIS version
+func legacySubscriptionCb(subType string, subsIdStr string, jsonSub string) (*subs.SubscriptionCfg, error) {
+	// All RNIS subscription types share the same link, callback & expiry fields
+	var subscription struct {
+		CallbackReference string     `json:"callbackReference"`
+		Links             *Link      `json:"_links"`
+		ExpiryDeadline    *TimeStamp `json:"expiryDeadline,omitempty"`
+	}
+	err := json.Unmarshal([]byte(jsonSub), &subscription)
+	if err != nil {
+		return nil, err
+	}
+	subCfg := &subs.SubscriptionCfg{
+		Id:        subsIdStr,
+		Type:      subType,
+		NotifyUrl: subscription.CallbackReference,
+	}
+	if subscription.Links != nil {
+		subCfg.Self = subscription.Links.Self
+	}
+	if subscription.ExpiryDeadline != nil {
+		expiryTime := time.Unix(int64(subscription.ExpiryDeadline.Seconds), int64(subscription.ExpiryDeadline.NanoSeconds))
+		subCfg.ExpiryTime = &expiryTime
+	}
+	return subCfg, nil
+}
 
-					go sendExpiryNotification(link.Self, context.TODO(), subsIdStr, notif)
-					_ = delSubscription(baseKey+cellChangeSubscriptionType, subsIdStr)
-				}
-			}
-		}
+func setSubscription(ctx context.Context, subType string, subsIdStr string, self string, callbackReference string, expiryDeadline *TimeStamp, jsonSub string) error {
+	subCfg := &subs.SubscriptionCfg{
+		Id:            subsIdStr,
+		Type:          subType,
+		Self:          self,
+		NotifyUrl:     callbackReference,
+		CorrelationId: httpLog.GetCorrelationId(ctx),
+	}
+	if expiryDeadline != nil {
+		expiryTime := time.Unix(int64(expiryDeadline.Seconds), int64(expiryDeadline.NanoSeconds))
+		subCfg.ExpiryTime = &expiryTime
+	}
+	_, err := subMgr.SetSubscription(subCfg, jsonSub)
+	if err != nil {
+		log.Error(err.Error())
+	}
+	return err
+}
+
+// getNotifContext - Get notification context holding the correlation ID of the subscription request
+func getNotifContext(subType string, subsIdStr string) context.Context {
+	ctx := context.TODO()
+	if sub := subMgr.GetSubscription(subType, subsIdStr); sub != nil {
+		ctx = httpLog.WithCorrelationId(ctx, sub.Cfg.CorrelationId)
 	}
+	return ctx
+}
 
+func getSubscriptionJson(subType string, subsIdStr string) string {
+	sub := subMgr.GetSubscription(subType, subsIdStr)
+	if sub == nil {
+		return ""
+	}
+	return sub.JsonSubOrig
 }
 
-func repopulateCcSubscriptionMap(key string, jsonInfo string, userData interface{}) error {
+// getCcSubscriptionMap - returns registered subscriptions indexed by subscription ID
+func getCcSubscriptionMap() map[int]*CellChangeSubscription {
+	subscriptionMap := make(map[int]*CellChangeSubscription)
+	for _, sub := range subMgr.GetSubscriptions(cellChangeSubscriptionType) {
+		var subscription CellChangeSubscription
+		err := json.Unmarshal([]byte(sub.JsonSubOrig), &subscription)
+		if err != nil {
+			log.Error("Failed to decode subscription: ", sub.Cfg.Id, " Error: ", err)
+			continue
+		}
+		subsId, _ := strconv.Atoi(sub.Cfg.Id)
+		subscriptionMap[subsId] = &subscription
+	}
+	return subscriptionMap
+}
 
-	var subscription CellChangeSubscription
+// getMrSubscriptionMap - returns registered subscriptions indexed by subscription ID
+func getMrSubscriptionMap() map[int]*MeasRepUeSubscription {
+	subscriptionMap := make(map[int]*MeasRepUeSubscription)
+	for _, sub := range subMgr.GetSubscriptions(measRepUeSubscriptionType) {
+		var subscription MeasRepUeSubscription
+		err := json.Unmarshal([]byte(sub.JsonSubOrig), &subscription)
+		if err != nil {
+			log.Error("Failed to decode subscription: ", sub.Cfg.Id, " Error: ", err)
+			continue
+		}
+		subsId, _ := strconv.Atoi(sub.Cfg.Id)
+		subscriptionMap[subsId] = &subscription
+	}
+	return subscriptionMap
+}
 
-	// Format response
-	err := json.Unmarshal([]byte(jsonInfo), &subscription)
-	if err != nil {
-		return err
+// getReSubscriptionMap - returns registered subscriptions indexed by subscription ID
+func getReSubscriptionMap() map[int]*RabEstSubscription {
+	subscriptionMap := make(map[int]*RabEstSubscription)
+	for _, sub := range subMgr.GetSubscriptions(rabEstSubscriptionType) {
+		var subscription RabEstSubscription
+		err := json.Unmarshal([]byte(sub.JsonSubOrig), &subscription)
+		if err != nil {
+			log.Error("Failed to decode subscription: ", sub.Cfg.Id, " Error: ", err)
+			continue
+		}
+		subsId, _ := strconv.Atoi(sub.Cfg.Id)
+		subscriptionMap[subsId] = &subscription
 	}
+	return subscriptionMap
+}
 
-	selfUrl := strings.Split(subscription.Links.Self, "/")
-	subsIdStr := selfUrl[len(selfUrl)-1]
-	subsId, _ := strconv.Atoi(subsIdStr)
+// getRmSubscriptionMap - returns registered subscriptions indexed by subscription ID
+func getRmSubscriptionMap() map[int]*RabModSubscription {
+	subscriptionMap := make(map[int]*RabModSubscription)
+	for _, sub := range subMgr.GetSubscriptions(rabModSubscriptionType) {
+		var subscription RabModSubscription
+		err := json.Unmarshal([]byte(sub.JsonSubOrig), &subscription)
+		if err != nil {
+			log.Error("Failed to decode subscription: ", sub.Cfg.Id, " Error: ", err)
+			continue
+		}
+		subsId, _ := strconv.Atoi(sub.Cfg.Id)
+		subscriptionMap[subsId] = &subscription
+	}
+	return subscriptionMap
+}
 
-	ccSubscriptionMap[subsId] = &subscription
-	if subscription.ExpiryDeadline != nil {
-		intList := subscriptionExpiryMap[int(subscription.ExpiryDeadline.Seconds)]
-		intList = append(intList, subsId)
-		subscriptionExpiryMap[int(subscription.ExpiryDeadline.Seconds)] = intList
+// getRrSubscriptionMap - returns registered subscriptions indexed by subscription ID
+func getRrSubscriptionMap() map[int]*RabRelSubscription {
+	subscriptionMap := make(map[int]*RabRelSubscription)
+	for _, sub := range subMgr.GetSubscriptions(rabRelSubscriptionType) {
+		var subscription RabRelSubscription
+		err := json.Unmarshal([]byte(sub.JsonSubOrig), &subscription)
+		if err != nil {
+			log.Error("Failed to decode subscription: ", sub.Cfg.Id, " Error: ", err)
+			continue
+		}
+		subsId, _ := strconv.Atoi(sub.Cfg.Id)
+		subscriptionMap[subsId] = &subscription
 	}
+	return subscriptionMap
+}
 
-	//reinitialisation of next available Id for future subscription request
-	if subsId >= nextSubscriptionIdAvailable {
-		nextSubscriptionIdAvailable = subsId + 1
+// getS1SubscriptionMap - returns registered subscriptions indexed by subscription ID
+func getS1SubscriptionMap() map[int]*S1BearerSubscription {
+	subscriptionMap := make(map[int]*S1BearerSubscription)
+	for _, sub := range subMgr.GetSubscriptions(s1BearerSubscriptionType) {
+		var subscription S1BearerSubscription
+		err := json.Unmarshal([]byte(sub.JsonSubOrig), &subscription)
+		if err != nil {
+			log.Error("Failed to decode subscription: ", sub.Cfg.Id, " Error: ", err)
+			continue
+		}
+		subsId, _ := strconv.Atoi(sub.Cfg.Id)
+		subscriptionMap[subsId] = &subscription
 	}
+	return subscriptionMap
+}
 
-	return nil
+// getTaSubscriptionMap - returns registered subscriptions indexed by subscription ID
+func getTaSubscriptionMap() map[int]*MeasTaSubscription {
+	subscriptionMap := make(map[int]*MeasTaSubscription)
+	for _, sub := range subMgr.GetSubscriptions(measTaSubscriptionType) {
+		var subscription MeasTaSubscription
+		err := json.Unmarshal([]byte(sub.JsonSubOrig), &subscription)
+		if err != nil {
+			log.Error("Failed to decode subscription: ", sub.Cfg.Id, " Error: ", err)
+			continue
+		}
+		subsId, _ := strconv.Atoi(sub.Cfg.Id)
+		subscriptionMap[subsId] = &subscription
+	}
+	return subscriptionMap
+}
+
+// getCrSubscriptionMap - returns registered subscriptions indexed by subscription ID
+func getCrSubscriptionMap() map[int]*CaReConfSubscription {
+	subscriptionMap := make(map[int]*CaReConfSubscription)
+	for _, sub := range subMgr.GetSubscriptions(caReConfSubscriptionType) {
+		var subscription CaReConfSubscription
+		err := json.Unmarshal([]byte(sub.JsonSubOrig), &subscription)
+		if err != nil {
+			log.Error("Failed to decode subscription: ", sub.Cfg.Id, " Error: ", err)
+			continue
+		}
+		subsId, _ := strconv.Atoi(sub.Cfg.Id)
+		subscriptionMap[subsId] = &subscription
+	}
+	return subscriptionMap
 }
 
 func checkNotificationRegisteredSubscriptions(appId string, assocId *AssociateId, newPlmn *Plmn, oldPlmn *Plmn, hoStatus string, newCellId string, oldCellId string) {
 
 	//check all that applies
-	for subsId, sub := range ccSubscriptionMap {
+	for subsId, sub := range getCcSubscriptionMap() {
 
 		match := false
 
@@ -356,7 +648,7 @@ func checkNotificationRegisteredSubscriptions(appId string, assocId *AssociateId
 
 			if match {
 				subsIdStr := strconv.Itoa(subsId)
-				jsonInfo, _ := rc.JSONGetEntry(baseKey+cellChangeSubscriptionType+":"+subsIdStr, ".")
+				jsonInfo := getSubscriptionJson(cellChangeSubscriptionType, subsIdStr)
 				if jsonInfo == "" {
 					return
 				}
@@ -399,7 +691,7 @@ func checkNotificationRegisteredSubscriptions(appId string, assocId *AssociateId
 				notif.SrcEcgi = &oldEcgi
 				notif.TrgEcgi = []clientNotif.Ecgi{newEcgi}
 
-				go sendCcNotification(subscription.CallbackReference, context.TODO(), subsIdStr, notif)
+				go sendCcNotification(subscription.CallbackReference, getNotifContext(cellChangeSubscriptionType, subsIdStr), subsIdStr, notif)
 				log.Info("Cell_change Notification" + "(" + subsIdStr + ")")
 			}
 		}
@@ -408,8 +700,6 @@ func checkNotificationRegisteredSubscriptions(appId string, assocId *AssociateId
 
 func sendCcNotification(notifyUrl string, ctx context.Context, subscriptionId string, notification clientNotif.CellChangeNotification) {
 
-	startTime := time.Now()
-
 	client, err := createClient(notifyUrl)
 	if err != nil {
 		log.Error(err)
@@ -421,18 +711,15 @@ func sendCcNotification(notifyUrl string, ctx context.Context, subscriptionId st
 		log.Error(err.Error())
 	}
 
-	resp, err := client.NotificationsApi.PostCellChangeNotification(ctx, subscriptionId, notification)
-	_ = httpLog.LogTx(notifyUrl, "POST", string(jsonNotif), resp, startTime)
-	if err != nil {
-		log.Error(err)
-		return
-	}
-	defer resp.Body.Close()
+	_ = subMgr.SendNotification(cellChangeSubscriptionType, subscriptionId, notifyUrl, func() (*http.Response, error) {
+		startTime := time.Now()
+		resp, err := client.NotificationsApi.PostCellChangeNotification(ctx, subscriptionId, notification)
+		_ = httpLog.LogTx(notifyUrl, "POST", string(jsonNotif), resp, startTime)
+		return resp, err
+	})
 }
 
-func sendExpiryNotification(notifyUrl string, ctx context.Context, subscriptionId string, notification clientNotif.ExpiryNotification) {
-
-	startTime := time.Now()
+func sendExpiryNotification(notifyUrl string, ctx context.Context, subType string, subscriptionId string, notification clientNotif.ExpiryNotification) {
 
 	client, err := createClient(notifyUrl)
 	if err != nil {
@@ -445,13 +732,12 @@ func sendExpiryNotification(notifyUrl string, ctx context.Context, subscriptionI
 		log.Error(err.Error())
 	}
 
-	resp, err := client.NotificationsApi.PostExpiryNotification(ctx, subscriptionId, notification)
-	_ = httpLog.LogTx(notifyUrl, "POST", string(jsonNotif), resp, startTime)
-	if err != nil {
-		log.Error(err)
-		return
-	}
-	defer resp.Body.Close()
+	_ = subMgr.SendNotification(subType, subscriptionId, notifyUrl, func() (*http.Response, error) {
+		startTime := time.Now()
+		resp, err := client.NotificationsApi.PostExpiryNotification(ctx, subscriptionId, notification)
+		_ = httpLog.LogTx(notifyUrl, "POST", string(jsonNotif), resp, startTime)
+		return resp, err
+	})
 }
 
 func cellChangeSubscriptionsGET(w http.ResponseWriter, r *http.Request) {
@@ -463,7 +749,7 @@ func cellChangeSubscriptionsGET(w http.ResponseWriter, r *http.Request) {
 	var cellChangeSubscription CellChangeSubscription
 	response.CellChangeSubscription = &cellChangeSubscription
 
-	jsonRespDB, _ := rc.JSONGetEntry(baseKey+cellChangeSubscriptionType+":"+subIdParamStr, ".")
+	jsonRespDB := getSubscriptionJson(cellChangeSubscriptionType, subIdParamStr)
 
 	if jsonRespDB == "" {
 		w.WriteHeader(http.StatusNotFound)
@@ -489,31 +775,15 @@ func cellChangeSubscriptionsGET(w http.ResponseWriter, r *http.Request) {
 }
 
 func isSubscriptionIdRegisteredCc(subsIdStr string) bool {
-	subsId, _ := strconv.Atoi(subsIdStr)
-	if ccSubscriptionMap[subsId] != nil {
-		return true
-	} else {
-		return false
-	}
+	return subMgr.GetSubscription(cellChangeSubscriptionType, subsIdStr) != nil
 }
 
-func registerCc(cellChangeSubscription *CellChangeSubscription, subsIdStr string) {
-	subsId, _ := strconv.Atoi(subsIdStr)
-	ccSubscriptionMap[subsId] = cellChangeSubscription
-	if cellChangeSubscription.ExpiryDeadline != nil {
-		//get current list of subscription meant to expire at this time
-		intList := subscriptionExpiryMap[int(cellChangeSubscription.ExpiryDeadline.Seconds)]
-		intList = append(intList, subsId)
-		subscriptionExpiryMap[int(cellChangeSubscription.ExpiryDeadline.Seconds)] = intList
-	}
-
-	log.Info("New registration: ", subsId, " type: ", cellChangeSubscriptionType)
+func registerCc(subsIdStr string) {
+	log.Info("New registration: ", subsIdStr, " type: ", cellChangeSubscriptionType)
 }
 
 func deregisterCc(subsIdStr string) {
-	subsId, _ := strconv.Atoi(subsIdStr)
-	ccSubscriptionMap[subsId] = nil
-	log.Info("Deregistration: ", subsId, " type: ", cellChangeSubscriptionType)
+	log.Info("Deregistration: ", subsIdStr, " type: ", cellChangeSubscriptionType)
 }
 
 func cellChangeSubscriptionsPOST(w http.ResponseWriter, r *http.Request) {
@@ -534,9 +804,7 @@ func cellChangeSubscriptionsPOST(w http.ResponseWriter, r *http.Request) {
 	}
 
 	cellChangeSubscriptionPost := cellChangeSubscriptionPost1.CellChangeSubscription
-	newSubsId := nextSubscriptionIdAvailable
-	nextSubscriptionIdAvailable++
-	subsIdStr := strconv.Itoa(newSubsId)
+	subsIdStr := subMgr.AllocateId(cellChangeSubscriptionType)
 
 	cellChangeSubscription.CallbackReference = cellChangeSubscriptionPost.CallbackReference
 	cellChangeSubscription.FilterCriteria = cellChangeSubscriptionPost.FilterCriteria
@@ -550,8 +818,8 @@ func cellChangeSubscriptionsPOST(w http.ResponseWriter, r *http.Request) {
 	link.Self = hostUrl.String() + basePath + "subscriptions/" + cellChangeSubscriptionType + "/" + subsIdStr
 	cellChangeSubscription.Links = link
 
-	_ = rc.JSONSetEntry(baseKey+cellChangeSubscriptionType+":"+subsIdStr, ".", convertCellChangeSubscriptionToJson(cellChangeSubscription))
-	registerCc(cellChangeSubscription, subsIdStr)
+	_ = setSubscription(r.Context(), cellChangeSubscriptionType, subsIdStr, cellChangeSubscription.Links.Self, cellChangeSubscription.CallbackReference, cellChangeSubscription.ExpiryDeadline, convertCellChangeSubscriptionToJson(cellChangeSubscription))
+	registerCc(subsIdStr)
 
 	jsonResponse, err := json.Marshal(response)
 	if err != nil {
@@ -592,9 +860,9 @@ func cellChangeSubscriptionsPUT(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if isSubscriptionIdRegisteredCc(subsIdStr) {
-		registerCc(cellChangeSubscription, subsIdStr)
+		registerCc(subsIdStr)
 
-		_ = rc.JSONSetEntry(baseKey+cellChangeSubscriptionType+":"+subsIdStr, ".", convertCellChangeSubscriptionToJson(cellChangeSubscription))
+		_ = setSubscription(r.Context(), cellChangeSubscriptionType, subsIdStr, cellChangeSubscription.Links.Self, cellChangeSubscription.CallbackReference, cellChangeSubscription.ExpiryDeadline, convertCellChangeSubscriptionToJson(cellChangeSubscription))
 
 		response.CellChangeSubscription = cellChangeSubscription
 		jsonResponse, err := json.Marshal(response)
@@ -610,18 +878,42 @@ func cellChangeSubscriptionsPUT(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-func delSubscription(keyPrefix string, subsId string) error {
-
-	err := rc.JSONDelEntry(keyPrefix+":"+subsId, ".")
-	deregisterCc(subsId)
+func delSubscription(subType string, subsId string) error {
+	var err error
+	sub := subMgr.GetSubscription(subType, subsId)
+	if sub != nil {
+		err = subMgr.DeleteSubscription(sub)
+	}
+	deregisterSubscription(subType, subsId)
 	return err
 }
 
+func deregisterSubscription(subType string, subsId string) {
+	switch subType {
+	case cellChangeSubscriptionType:
+		deregisterCc(subsId)
+	case measRepUeSubscriptionType:
+		deregisterMr(subsId)
+	case rabEstSubscriptionType:
+		deregisterRe(subsId)
+	case rabModSubscriptionType:
+		deregisterRm(subsId)
+	case rabRelSubscriptionType:
+		deregisterRr(subsId)
+	case s1BearerSubscriptionType:
+		deregisterS1(subsId)
+	case measTaSubscriptionType:
+		deregisterTa(subsId)
+	case caReConfSubscriptionType:
+		deregisterCr(subsId)
+	}
+}
+
 func cellChangeSubscriptionsDELETE(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
 	vars := mux.Vars(r)
 
-	err := delSubscription(baseKey+cellChangeSubscriptionType, vars["subscriptionId"])
+	err := delSubscription(cellChangeSubscriptionType, vars["subscriptionId"])
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
@@ -630,137 +922,2237 @@ func cellChangeSubscriptionsDELETE(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNoContent)
 }
 
-func measRepUeReportSubscriptionsPUT(w http.ResponseWriter, r *http.Request) {
-	notImplemented(w, r)
-}
+func updateMeasInfo(name string, poaName string, measList []sbi.CellMeas) {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	// Measurements are only available for UEs served by a cellular POA
+	oldMeasInfo := ueMeasInfoMap[name]
+	var measInfo *ueMeasInfo
+	for _, meas := range measList {
+		if meas.Poa == poaName {
+			measInfo = new(ueMeasInfo)
+			measInfo.ecgi = newEcgi(meas.Mnc, meas.Mcc, meas.CellId)
+			measInfo.rsrp = meas.Rsrp
+			measInfo.rsrq = meas.Rsrq
+			measInfo.ta = meas.Ta
+			break
+		}
+	}
+	if measInfo == nil {
+		delete(ueMeasInfoMap, name)
+		for _, ueEventState := range mrEventStateMap {
+			delete(ueEventState, name)
+		}
+		if oldMeasInfo != nil {
+			checkCaReConfNotificationRegisteredSubscriptions(name, oldMeasInfo, nil)
+		}
+		return
+	}
 
-func measRepUeReportSubscriptionsPOST(w http.ResponseWriter, r *http.Request) {
-	notImplemented(w, r)
-}
+	// Neighbour cells are ordered from strongest to weakest
+	for _, meas := range measList {
+		if meas.Poa != poaName {
+			var cellMeas cellMeasInfo
+			cellMeas.ecgi = newEcgi(meas.Mnc, meas.Mcc, meas.CellId)
+			cellMeas.rsrp = meas.Rsrp
+			cellMeas.rsrq = meas.Rsrq
+			measInfo.neighbours = append(measInfo.neighbours, cellMeas)
+		}
+	}
+	measInfo.secondaryCells = getSecondaryCells(measInfo)
+	ueMeasInfoMap[name] = measInfo
 
-func measRepUeReportSubscriptionsGET(w http.ResponseWriter, r *http.Request) {
-	notImplemented(w, r)
+	checkMrEventSubscriptions(name, measInfo)
+	checkTaNotificationRegisteredSubscriptions(name, oldMeasInfo, measInfo)
+	checkCaReConfNotificationRegisteredSubscriptions(name, oldMeasInfo, measInfo)
 }
 
-func measRepUeReportSubscriptionsDELETE(w http.ResponseWriter, r *http.Request) {
-	notImplemented(w, r)
+func newEcgi(mnc string, mcc string, cellId string) *Ecgi {
+	ecgi := new(Ecgi)
+	ecgi.Plmn = &Plmn{Mnc: mnc, Mcc: mcc}
+	ecgi.CellId = []string{cellId}
+	return ecgi
 }
 
-func plmnInfoGET(w http.ResponseWriter, r *http.Request) {
-
-	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
-
-	u, _ := url.Parse(r.URL.String())
-	log.Info("url: ", u.RequestURI())
-	q := u.Query()
-	appInsId := q.Get("app_ins_id")
-	appInsIdArray := strings.Split(appInsId, ",")
-
-	var response InlineResponse2001
-	atLeastOne := false
-
-	//same for all plmnInfo
-	seconds := time.Now().Unix()
-	var timeStamp TimeStamp
-	timeStamp.Seconds = int32(seconds)
-
-	for _, meAppName := range appInsIdArray {
-		meAppName = strings.TrimSpace(meAppName)
+func isMrPeriodicTrigger(trigger *Trigger) bool {
+	if trigger == nil {
+		return true
+	}
+	switch *trigger {
+	case PERIODICAL_REPORT_STRONGEST_CELLS, PERIODICAL_REPORT_STRONGEST_CELLS_FOR_SON, PERIODICAL_REPORT_CGI:
+		return true
+	}
+	return false
+}
 
-		//get from DB
-		jsonAppEcgiInfo, _ := rc.JSONGetEntry(baseKey+"APP:"+meAppName, ".")
+func isMrEventTrigger(trigger *Trigger) bool {
+	if trigger == nil {
+		return false
+	}
+	switch *trigger {
+	case EVENT_A1, EVENT_A2, EVENT_A3, EVENT_A4, EVENT_A5:
+		return true
+	}
+	return false
+}
 
-		if jsonAppEcgiInfo != "" {
+func isMrFilterMatch(sub *MeasRepUeSubscription, name string, measInfo *ueMeasInfo) bool {
+	filter := sub.FilterCriteria
+	if filter == nil {
+		return true
+	}
 
-			ecgi := convertJsonToEcgi(jsonAppEcgiInfo)
-			if ecgi != nil {
-				if ecgi.Plmn.Mnc != "" && ecgi.Plmn.Mcc != "" && ecgi.CellId[0] != "" {
-					var plmnInfo PlmnInfo
-					plmnInfo.Ecgi = ecgi
-					plmnInfo.AppInsId = meAppName
-					plmnInfo.TimeStamp = &timeStamp
-					response.PlmnInfo = append(response.PlmnInfo, plmnInfo)
-					atLeastOne = true
-				}
+	// UE measurements are not associated with an app instance
+	if filter.AppInsId != "" {
+		return false
+	}
+	if filter.AssociateId != nil && filter.AssociateId.Value != name {
+		return false
+	}
+	if filter.Plmn != nil && (filter.Plmn.Mnc != measInfo.ecgi.Plmn.Mnc || filter.Plmn.Mcc != measInfo.ecgi.Plmn.Mcc) {
+		return false
+	}
+	if len(filter.CellId) != 0 {
+		for _, cellId := range filter.CellId {
+			if cellId == measInfo.ecgi.CellId[0] {
+				return true
 			}
 		}
+		return false
 	}
+	return true
+}
 
-	if atLeastOne {
-		jsonResponse, err := json.Marshal(response)
-		if err != nil {
-			log.Error(err.Error())
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+// Evaluate event trigger condition using serving & strongest neighbour cell RSRP
+func isMrEventConditionMet(trigger Trigger, measInfo *ueMeasInfo) bool {
+	servingRsrp := measInfo.rsrp - rsrpIndexOffset
+	hasNeighbour := len(measInfo.neighbours) != 0
+	neighbourRsrp := int32(0)
+	if hasNeighbour {
+		neighbourRsrp = measInfo.neighbours[0].rsrp - rsrpIndexOffset
+	}
 
-			return
-		}
-		w.WriteHeader(http.StatusOK)
-		fmt.Fprintf(w, string(jsonResponse))
-	} else {
-		w.WriteHeader(http.StatusNotFound)
+	switch trigger {
+	case EVENT_A1:
+		return servingRsrp > mrEventCfg.a1Threshold
+	case EVENT_A2:
+		return servingRsrp < mrEventCfg.a2Threshold
+	case EVENT_A3:
+		return hasNeighbour && neighbourRsrp > servingRsrp+mrEventCfg.a3Offset
+	case EVENT_A4:
+		return hasNeighbour && neighbourRsrp > mrEventCfg.a4Threshold
+	case EVENT_A5:
+		return servingRsrp < mrEventCfg.a5Threshold1 && hasNeighbour && neighbourRsrp > mrEventCfg.a5Threshold2
 	}
+	return false
 }
 
-func createSubscriptionLinkList(subType string) *SubscriptionLinkList {
-
-	subscriptionLinkList := new(SubscriptionLinkList)
-
-	link := new(Link)
-	link.Self = hostUrl.String() + basePath + "subscriptions"
+// Notify event triggered subscriptions when UE enters the event condition
+func checkMrEventSubscriptions(name string, measInfo *ueMeasInfo) {
+	for subsId, sub := range getMrSubscriptionMap() {
+		if sub == nil || sub.FilterCriteria == nil || !isMrEventTrigger(sub.FilterCriteria.Trigger) {
+			continue
+		}
+		if !isMrFilterMatch(sub, name, measInfo) {
+			continue
+		}
 
-	if subType != "" {
-		link.Self = link.Self + "/" + subType
+		ueEventState, found := mrEventStateMap[subsId]
+		if !found {
+			ueEventState = make(map[string]bool)
+			mrEventStateMap[subsId] = ueEventState
+		}
+		conditionMet := isMrEventConditionMet(*sub.FilterCriteria.Trigger, measInfo)
+		if conditionMet && !ueEventState[name] {
+			notifyMeasRepUe(subsId, sub, name, measInfo, *sub.FilterCriteria.Trigger)
+		}
+		ueEventState[name] = conditionMet
 	}
+}
 
-	subscriptionLinkList.Links = link
+func checkPeriodicMeasRepUeSubscriptions() {
+	mutex.Lock()
+	defer mutex.Unlock()
 
-	//loop through all different types of subscription
-
-	if subType == "" || subType == cellChangeSubscriptionType {
-		//loop through cell_change map
-		for _, ccSubscription := range ccSubscriptionMap {
-			if ccSubscription != nil {
-				var subscription Subscription
-				subscription.Href = ccSubscription.Links.Self
-				subscriptionTypeStr := CELL_CHANGE
-				subscription.SubscriptionType = &subscriptionTypeStr
-				subscriptionLinkList.Subscription = append(subscriptionLinkList.Subscription, subscription)
+	for subsId, sub := range getMrSubscriptionMap() {
+		if sub == nil {
+			continue
+		}
+		trigger := PERIODICAL_REPORT_STRONGEST_CELLS
+		if sub.FilterCriteria != nil {
+			if !isMrPeriodicTrigger(sub.FilterCriteria.Trigger) {
+				continue
+			}
+			if sub.FilterCriteria.Trigger != nil {
+				trigger = *sub.FilterCriteria.Trigger
+			}
+		}
+		for name, measInfo := range ueMeasInfoMap {
+			if isMrFilterMatch(sub, name, measInfo) {
+				notifyMeasRepUe(subsId, sub, name, measInfo, trigger)
 			}
 		}
 	}
+}
 
-	//no other maps to go through
+func notifyMeasRepUe(subsId int, sub *MeasRepUeSubscription, name string, measInfo *ueMeasInfo, trigger Trigger) {
+	subsIdStr := strconv.Itoa(subsId)
 
-	return subscriptionLinkList
-}
+	var notif clientNotif.MeasRepUeNotification
 
-func subscriptionLinkListSubscriptionsGET(w http.ResponseWriter, r *http.Request) {
-	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	seconds := time.Now().Unix()
+	var timeStamp clientNotif.TimeStamp
+	timeStamp.Seconds = int32(seconds)
+	notif.Timestamp = &timeStamp
+
+	var assocId clientNotif.AssociateId
+	assocId.Type_ = "UE_IPv4_ADDRESS"
+	assocId.Value = name
+	notif.AssociateId = &assocId
+
+	notif.Ecgi = convertEcgiToNotifEcgi(measInfo.ecgi)
+	notifTrigger := clientNotif.Trigger(trigger)
+	notif.Trigger = &notifTrigger
+	notif.Rsrp = measInfo.rsrp
+	notif.Rsrq = measInfo.rsrq
+
+	// Cell global identity reports only include the serving cell
+	if trigger != PERIODICAL_REPORT_CGI {
+		for _, neighbour := range measInfo.neighbours {
+			var neighbourMeasInfo clientNotif.MeasRepUeNotificationEutranNeighbourCellMeasInfo
+			neighbourMeasInfo.Ecgi = convertEcgiToNotifEcgi(neighbour.ecgi)
+			neighbourMeasInfo.Rsrp = neighbour.rsrp
+			neighbourMeasInfo.Rsrq = neighbour.rsrq
+			notif.EutranNeighbourCellMeasInfo = append(notif.EutranNeighbourCellMeasInfo, neighbourMeasInfo)
+		}
+	}
 
-	var response InlineResponse2003
+	go sendMrNotification(sub.CallbackReference, getNotifContext(measRepUeSubscriptionType, subsIdStr), subsIdStr, notif)
+	log.Debug("Meas_rep_ue Notification" + "(" + subsIdStr + ")")
+}
 
-	subscriptionLinkList := createSubscriptionLinkList("")
+func sendMrNotification(notifyUrl string, ctx context.Context, subscriptionId string, notification clientNotif.MeasRepUeNotification) {
 
-	response.SubscriptionLinkList = subscriptionLinkList
-	jsonResponse, err := json.Marshal(response)
+	client, err := createClient(notifyUrl)
 	if err != nil {
-		log.Error(err.Error())
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		log.Error(err)
 		return
 	}
-	w.WriteHeader(http.StatusOK)
-	fmt.Fprintf(w, string(jsonResponse))
-}
-
-func subscriptionLinkListSubscriptionsCcGET(w http.ResponseWriter, r *http.Request) {
-	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
-
-	var response InlineResponse2003
-
-	subscriptionLinkList := createSubscriptionLinkList(cellChangeSubscriptionType)
 
-	response.SubscriptionLinkList = subscriptionLinkList
-	jsonResponse, err := json.Marshal(response)
+	jsonNotif, err := json.Marshal(notification)
+	if err != nil {
+		log.Error(err.Error())
+	}
+
+	_ = subMgr.SendNotification(measRepUeSubscriptionType, subscriptionId, notifyUrl, func() (*http.Response, error) {
+		startTime := time.Now()
+		resp, err := client.NotificationsApi.PostMeasRepUeNotification(ctx, subscriptionId, notification)
+		_ = httpLog.LogTx(notifyUrl, "POST", string(jsonNotif), resp, startTime)
+		return resp, err
+	})
+}
+
+func validateMrFilterCriteria(filterCriteria *FilterCriteriaAssocTri) error {
+	if filterCriteria == nil {
+		return errors.New("Missing filter criteria")
+	}
+	if !isMrPeriodicTrigger(filterCriteria.Trigger) && !isMrEventTrigger(filterCriteria.Trigger) {
+		return errors.New("Unsupported trigger: " + string(*filterCriteria.Trigger))
+	}
+	return nil
+}
+
+func measRepUeReportSubscriptionsGET(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	vars := mux.Vars(r)
+	subIdParamStr := vars["subscriptionId"]
+
+	var response InlineResponse2006
+	var measRepUeSubscription MeasRepUeSubscription
+	response.MeasRepUeSubscription = &measRepUeSubscription
+
+	jsonRespDB := getSubscriptionJson(measRepUeSubscriptionType, subIdParamStr)
+
+	if jsonRespDB == "" {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	err := json.Unmarshal([]byte(jsonRespDB), &measRepUeSubscription)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	jsonResponse, err := json.Marshal(response)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprintf(w, string(jsonResponse))
+}
+
+func isSubscriptionIdRegisteredMr(subsIdStr string) bool {
+	return subMgr.GetSubscription(measRepUeSubscriptionType, subsIdStr) != nil
+}
+
+func registerMr(subsIdStr string) {
+	subsId, _ := strconv.Atoi(subsIdStr)
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	delete(mrEventStateMap, subsId)
+
+	log.Info("New registration: ", subsId, " type: ", measRepUeSubscriptionType)
+}
+
+func deregisterMr(subsIdStr string) {
+	subsId, _ := strconv.Atoi(subsIdStr)
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	delete(mrEventStateMap, subsId)
+	log.Info("Deregistration: ", subsId, " type: ", measRepUeSubscriptionType)
+}
+
+func measRepUeReportSubscriptionsPOST(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+
+	var response InlineResponse2013
+	measRepUeSubscription := new(MeasRepUeSubscription)
+	response.MeasRepUeSubscription = measRepUeSubscription
+
+	measRepUeSubscriptionPost1 := new(MeasRepUeSubscriptionPost1)
+
+	decoder := json.NewDecoder(r.Body)
+	err := decoder.Decode(&measRepUeSubscriptionPost1)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	measRepUeSubscriptionPost := measRepUeSubscriptionPost1.MeasRepUeSubscription
+	if measRepUeSubscriptionPost == nil {
+		log.Error("Missing subscription")
+		http.Error(w, "Missing subscription", http.StatusBadRequest)
+		return
+	}
+	err = validateMrFilterCriteria(measRepUeSubscriptionPost.FilterCriteria)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	subsIdStr := subMgr.AllocateId(measRepUeSubscriptionType)
+
+	measRepUeSubscription.CallbackReference = measRepUeSubscriptionPost.CallbackReference
+	measRepUeSubscription.FilterCriteria = measRepUeSubscriptionPost.FilterCriteria
+	measRepUeSubscription.ExpiryDeadline = measRepUeSubscriptionPost.ExpiryDeadline
+	link := new(Link)
+	link.Self = hostUrl.String() + basePath + "subscriptions/" + measRepUeSubscriptionType + "/" + subsIdStr
+	measRepUeSubscription.Links = link
+
+	_ = setSubscription(r.Context(), measRepUeSubscriptionType, subsIdStr, measRepUeSubscription.Links.Self, measRepUeSubscription.CallbackReference, measRepUeSubscription.ExpiryDeadline, convertMeasRepUeSubscriptionToJson(measRepUeSubscription))
+	registerMr(subsIdStr)
+
+	jsonResponse, err := json.Marshal(response)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusCreated)
+	fmt.Fprintf(w, string(jsonResponse))
+}
+
+func measRepUeReportSubscriptionsPUT(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+
+	vars := mux.Vars(r)
+	subIdParamStr := vars["subscriptionId"]
+	var response InlineResponse2006
+	measRepUeSubscription1 := new(MeasRepUeSubscription1)
+
+	decoder := json.NewDecoder(r.Body)
+	err := decoder.Decode(&measRepUeSubscription1)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	measRepUeSubscription := measRepUeSubscription1.MeasRepUeSubscription
+	if measRepUeSubscription == nil || measRepUeSubscription.Links == nil {
+		log.Error("Missing subscription")
+		http.Error(w, "Missing subscription", http.StatusBadRequest)
+		return
+	}
+	err = validateMrFilterCriteria(measRepUeSubscription.FilterCriteria)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	selfUrl := strings.Split(measRepUeSubscription.Links.Self, "/")
+	subsIdStr := selfUrl[len(selfUrl)-1]
+
+	if subsIdStr != subIdParamStr {
+		http.Error(w, "Body content not matching parameter", http.StatusInternalServerError)
+		return
+	}
+
+	if isSubscriptionIdRegisteredMr(subsIdStr) {
+		registerMr(subsIdStr)
+
+		_ = setSubscription(r.Context(), measRepUeSubscriptionType, subsIdStr, measRepUeSubscription.Links.Self, measRepUeSubscription.CallbackReference, measRepUeSubscription.ExpiryDeadline, convertMeasRepUeSubscriptionToJson(measRepUeSubscription))
+
+		response.MeasRepUeSubscription = measRepUeSubscription
+		jsonResponse, err := json.Marshal(response)
+		if err != nil {
+			log.Error(err.Error())
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, string(jsonResponse))
+	} else {
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func measRepUeReportSubscriptionsDELETE(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	vars := mux.Vars(r)
+
+	err := delSubscription(measRepUeSubscriptionType, vars["subscriptionId"])
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func updateErabInfo(name string, event string, erab *sbi.ErabInfo) {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	oldErab := ueErabInfoMap[name]
+	if event == sbi.ErabEventRelease {
+		delete(ueErabInfoMap, name)
+	} else {
+		ueErabInfoMap[name] = erab
+	}
+
+	switch event {
+	case sbi.ErabEventEstablish:
+		checkRabEstNotificationRegisteredSubscriptions(name, erab)
+	case sbi.ErabEventModify:
+		checkRabModNotificationRegisteredSubscriptions(name, erab)
+	case sbi.ErabEventRelease:
+		checkRabRelNotificationRegisteredSubscriptions(name, erab)
+	}
+
+	// S1 bearer is established & released with the E-RAB and modified when the UE moves to another eNB
+	switch event {
+	case sbi.ErabEventEstablish:
+		checkS1BearerNotificationRegisteredSubscriptions(name, S1_BEARER_ESTABLISH, erab)
+	case sbi.ErabEventModify, sbi.ErabEventHandover:
+		if oldErab != nil && oldErab.EnbId != erab.EnbId {
+			checkS1BearerNotificationRegisteredSubscriptions(name, S1_BEARER_MODIFY, erab)
+		}
+	case sbi.ErabEventRelease:
+		checkS1BearerNotificationRegisteredSubscriptions(name, S1_BEARER_RELEASE, erab)
+	}
+}
+
+func validateQciFilterCriteria(filterCriteria *FilterCriteriaAssocQci) error {
+	if filterCriteria == nil {
+		return errors.New("Missing filter criteria")
+	}
+	return nil
+}
+
+func isQciFilterMatch(filter *FilterCriteriaAssocQci, name string, erab *sbi.ErabInfo) bool {
+	if filter == nil {
+		return true
+	}
+
+	// E-RABs are not associated with an app instance
+	if filter.AppInsId != "" {
+		return false
+	}
+	if filter.AssociateId != nil && filter.AssociateId.Value != name {
+		return false
+	}
+	if filter.Plmn != nil && (filter.Plmn.Mnc != erab.Mnc || filter.Plmn.Mcc != erab.Mcc) {
+		return false
+	}
+	if filter.Qci != 0 && filter.Qci != erab.Qci {
+		return false
+	}
+	if len(filter.CellId) != 0 {
+		for _, cellId := range filter.CellId {
+			if cellId == erab.CellId {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+func getNotifErabInfo(name string, erab *sbi.ErabInfo) (*clientNotif.TimeStamp, *clientNotif.AssociateId, *clientNotif.Ecgi) {
+	seconds := time.Now().Unix()
+	var timeStamp clientNotif.TimeStamp
+	timeStamp.Seconds = int32(seconds)
+
+	var assocId clientNotif.AssociateId
+	assocId.Type_ = "UE_IPv4_ADDRESS"
+	assocId.Value = name
+
+	ecgi := convertEcgiToNotifEcgi(newEcgi(erab.Mnc, erab.Mcc, erab.CellId))
+	return &timeStamp, &assocId, ecgi
+}
+
+func getNotifErabQosParameters(erab *sbi.ErabInfo) *clientNotif.ErabQosParameters {
+	var qosInfo clientNotif.QosInformation
+	qosInfo.ErabMbrDl = erab.MbrDl
+	qosInfo.ErabMbrUl = erab.MbrUl
+	qosInfo.ErabGbrDl = erab.GbrDl
+	qosInfo.ErabGbrUl = erab.GbrUl
+
+	var qosParams clientNotif.ErabQosParameters
+	qosParams.Qci = erab.Qci
+	qosParams.QosInformation = &qosInfo
+	return &qosParams
+}
+
+func checkRabEstNotificationRegisteredSubscriptions(name string, erab *sbi.ErabInfo) {
+	for subsId, sub := range getReSubscriptionMap() {
+		if sub != nil && isQciFilterMatch(sub.FilterCriteria, name, erab) {
+			subsIdStr := strconv.Itoa(subsId)
+			log.Info("Sending RNIS notification ", sub.CallbackReference)
+
+			var notif clientNotif.RabEstNotification
+			notif.Timestamp, notif.AssociateId, notif.Ecgi = getNotifErabInfo(name, erab)
+			notif.ErabId = erab.ErabId
+			notif.ErabQosParameters = getNotifErabQosParameters(erab)
+
+			go sendReNotification(sub.CallbackReference, getNotifContext(rabEstSubscriptionType, subsIdStr), subsIdStr, notif)
+			log.Info("Rab_est Notification" + "(" + subsIdStr + ")")
+		}
+	}
+}
+
+func checkRabModNotificationRegisteredSubscriptions(name string, erab *sbi.ErabInfo) {
+	for subsId, sub := range getRmSubscriptionMap() {
+		if sub != nil && isQciFilterMatch(sub.FilterCriteria, name, erab) {
+			subsIdStr := strconv.Itoa(subsId)
+			log.Info("Sending RNIS notification ", sub.CallbackReference)
+
+			var notif clientNotif.RabModNotification
+			notif.Timestamp, notif.AssociateId, notif.Ecgi = getNotifErabInfo(name, erab)
+			notif.ErabId = erab.ErabId
+			notif.ErabQosParameters = getNotifErabQosParameters(erab)
+
+			go sendRmNotification(sub.CallbackReference, getNotifContext(rabModSubscriptionType, subsIdStr), subsIdStr, notif)
+			log.Info("Rab_mod Notification" + "(" + subsIdStr + ")")
+		}
+	}
+}
+
+func checkRabRelNotificationRegisteredSubscriptions(name string, erab *sbi.ErabInfo) {
+	for subsId, sub := range getRrSubscriptionMap() {
+		if sub != nil && isQciFilterMatch(sub.FilterCriteria, name, erab) {
+			subsIdStr := strconv.Itoa(subsId)
+			log.Info("Sending RNIS notification ", sub.CallbackReference)
+
+			var notif clientNotif.RabRelNotification
+			notif.Timestamp, notif.AssociateId, notif.Ecgi = getNotifErabInfo(name, erab)
+			var erabReleaseInfo clientNotif.RabRelNotificationErabReleaseInfo
+			erabReleaseInfo.ErabId = erab.ErabId
+			notif.ErabReleaseInfo = &erabReleaseInfo
+
+			go sendRrNotification(sub.CallbackReference, getNotifContext(rabRelSubscriptionType, subsIdStr), subsIdStr, notif)
+			log.Info("Rab_rel Notification" + "(" + subsIdStr + ")")
+		}
+	}
+}
+
+func sendReNotification(notifyUrl string, ctx context.Context, subscriptionId string, notification clientNotif.RabEstNotification) {
+
+	client, err := createClient(notifyUrl)
+	if err != nil {
+		log.Error(err)
+		return
+	}
+
+	jsonNotif, err := json.Marshal(notification)
+	if err != nil {
+		log.Error(err.Error())
+	}
+
+	_ = subMgr.SendNotification(rabEstSubscriptionType, subscriptionId, notifyUrl, func() (*http.Response, error) {
+		startTime := time.Now()
+		resp, err := client.NotificationsApi.PostRabEstNotification(ctx, subscriptionId, notification)
+		_ = httpLog.LogTx(notifyUrl, "POST", string(jsonNotif), resp, startTime)
+		return resp, err
+	})
+}
+
+func sendRmNotification(notifyUrl string, ctx context.Context, subscriptionId string, notification clientNotif.RabModNotification) {
+
+	client, err := createClient(notifyUrl)
+	if err != nil {
+		log.Error(err)
+		return
+	}
+
+	jsonNotif, err := json.Marshal(notification)
+	if err != nil {
+		log.Error(err.Error())
+	}
+
+	_ = subMgr.SendNotification(rabModSubscriptionType, subscriptionId, notifyUrl, func() (*http.Response, error) {
+		startTime := time.Now()
+		resp, err := client.NotificationsApi.PostRabModNotification(ctx, subscriptionId, notification)
+		_ = httpLog.LogTx(notifyUrl, "POST", string(jsonNotif), resp, startTime)
+		return resp, err
+	})
+}
+
+func sendRrNotification(notifyUrl string, ctx context.Context, subscriptionId string, notification clientNotif.RabRelNotification) {
+
+	client, err := createClient(notifyUrl)
+	if err != nil {
+		log.Error(err)
+		return
+	}
+
+	jsonNotif, err := json.Marshal(notification)
+	if err != nil {
+		log.Error(err.Error())
+	}
+
+	_ = subMgr.SendNotification(rabRelSubscriptionType, subscriptionId, notifyUrl, func() (*http.Response, error) {
+		startTime := time.Now()
+		resp, err := client.NotificationsApi.PostRabRelNotification(ctx, subscriptionId, notification)
+		_ = httpLog.LogTx(notifyUrl, "POST", string(jsonNotif), resp, startTime)
+		return resp, err
+	})
+}
+
+func rabInfoGET(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+
+	u, _ := url.Parse(r.URL.String())
+	log.Info("url: ", u.RequestURI())
+	q := u.Query()
+
+	// Parse query filters
+	appInsId := q.Get("app_ins_id")
+	cellIdList := getQueryList(q.Get("cell_id"))
+	ueList := getQueryList(q.Get("ue_ipv4_address"))
+	var intFilters = map[string]int32{}
+	for _, param := range []string{"erab_id", "qci", "erab_mbr_dl", "erab_mbr_ul", "erab_gbr_dl", "erab_gbr_ul"} {
+		if valueStr := q.Get(param); valueStr != "" {
+			value, err := strconv.Atoi(valueStr)
+			if err != nil {
+				log.Error("Invalid query parameter: ", param)
+				http.Error(w, "Invalid query parameter: "+param, http.StatusBadRequest)
+				return
+			}
+			intFilters[param] = int32(value)
+		}
+	}
+
+	var response InlineResponse200
+	rabInfo := new(RabInfo)
+	response.RabInfo = rabInfo
+
+	seconds := time.Now().Unix()
+	var timeStamp TimeStamp
+	timeStamp.Seconds = int32(seconds)
+	rabInfo.TimeStamp = &timeStamp
+	rabInfo.AppInsId = appInsId
+
+	// Group matching UE E-RABs per cell
+	mutex.Lock()
+	ueNameList := make([]string, 0, len(ueErabInfoMap))
+	for name := range ueErabInfoMap {
+		ueNameList = append(ueNameList, name)
+	}
+	sort.Strings(ueNameList)
+
+	cellUserInfoMap := make(map[string]*CellUserInfo)
+	cellList := []string{}
+	for _, name := range ueNameList {
+		erab := ueErabInfoMap[name]
+		if len(cellIdList) != 0 && !isInList(erab.CellId, cellIdList) {
+			continue
+		}
+		if len(ueList) != 0 && !isInList(name, ueList) {
+			continue
+		}
+		if !isErabQueryMatch(erab, intFilters) {
+			continue
+		}
+
+		cellKey := erab.Mnc + ":" + erab.Mcc + ":" + erab.CellId
+		cellUserInfo, found := cellUserInfoMap[cellKey]
+		if !found {
+			cellUserInfo = new(CellUserInfo)
+			cellUserInfo.Ecgi = newEcgi(erab.Mnc, erab.Mcc, erab.CellId)
+			cellUserInfoMap[cellKey] = cellUserInfo
+			cellList = append(cellList, cellKey)
+		}
+
+		var ueInfo UeInfo
+		ueInfo.AssociateId = []AssociateId{{Type_: "UE_IPv4_ADDRESS", Value: name}}
+		var qosInfo QosInformation
+		qosInfo.ErabMbrDl = erab.MbrDl
+		qosInfo.ErabMbrUl = erab.MbrUl
+		qosInfo.ErabGbrDl = erab.GbrDl
+		qosInfo.ErabGbrUl = erab.GbrUl
+		ueInfo.ErabInfo = []ErabQosParameters{{Qci: erab.Qci, QosInformation: &qosInfo}}
+		cellUserInfo.UeInfo = append(cellUserInfo.UeInfo, ueInfo)
+	}
+	mutex.Unlock()
+
+	for _, cellKey := range cellList {
+		rabInfo.CellUserInfo = append(rabInfo.CellUserInfo, *cellUserInfoMap[cellKey])
+	}
+
+	if len(rabInfo.CellUserInfo) == 0 {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	jsonResponse, err := json.Marshal(response)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprintf(w, string(jsonResponse))
+}
+
+func isErabQueryMatch(erab *sbi.ErabInfo, intFilters map[string]int32) bool {
+	for param, value := range intFilters {
+		match := true
+		switch param {
+		case "erab_id":
+			match = erab.ErabId == value
+		case "qci":
+			match = erab.Qci == value
+		case "erab_mbr_dl":
+			match = erab.MbrDl == value
+		case "erab_mbr_ul":
+			match = erab.MbrUl == value
+		case "erab_gbr_dl":
+			match = erab.GbrDl == value
+		case "erab_gbr_ul":
+			match = erab.GbrUl == value
+		}
+		if !match {
+			return false
+		}
+	}
+	return true
+}
+
+// Split comma-separated query parameter list
+func getQueryList(param string) []string {
+	list := []string{}
+	for _, value := range strings.Split(param, ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			list = append(list, value)
+		}
+	}
+	return list
+}
+
+func isInList(value string, list []string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
+
+func rabEstSubscriptionsGET(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	vars := mux.Vars(r)
+	subIdParamStr := vars["subscriptionId"]
+
+	var response InlineResponse2007
+	var rabEstSubscription RabEstSubscription
+	response.RabEstSubscription = &rabEstSubscription
+
+	jsonRespDB := getSubscriptionJson(rabEstSubscriptionType, subIdParamStr)
+
+	if jsonRespDB == "" {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	err := json.Unmarshal([]byte(jsonRespDB), &rabEstSubscription)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	jsonResponse, err := json.Marshal(response)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprintf(w, string(jsonResponse))
+}
+
+func isSubscriptionIdRegisteredRe(subsIdStr string) bool {
+	return subMgr.GetSubscription(rabEstSubscriptionType, subsIdStr) != nil
+}
+
+func registerRe(subsIdStr string) {
+	log.Info("New registration: ", subsIdStr, " type: ", rabEstSubscriptionType)
+}
+
+func deregisterRe(subsIdStr string) {
+	log.Info("Deregistration: ", subsIdStr, " type: ", rabEstSubscriptionType)
+}
+
+func rabEstSubscriptionsPOST(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+
+	var response InlineResponse2014
+	rabEstSubscription := new(RabEstSubscription)
+	response.RabEstSubscription = rabEstSubscription
+
+	rabEstSubscriptionPost1 := new(RabEstSubscriptionPost1)
+
+	decoder := json.NewDecoder(r.Body)
+	err := decoder.Decode(&rabEstSubscriptionPost1)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	rabEstSubscriptionPost := rabEstSubscriptionPost1.RabEstSubscription
+	if rabEstSubscriptionPost == nil {
+		log.Error("Missing subscription")
+		http.Error(w, "Missing subscription", http.StatusBadRequest)
+		return
+	}
+	err = validateQciFilterCriteria(rabEstSubscriptionPost.FilterCriteria)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	subsIdStr := subMgr.AllocateId(rabEstSubscriptionType)
+
+	rabEstSubscription.CallbackReference = rabEstSubscriptionPost.CallbackReference
+	rabEstSubscription.FilterCriteria = rabEstSubscriptionPost.FilterCriteria
+	rabEstSubscription.ExpiryDeadline = rabEstSubscriptionPost.ExpiryDeadline
+	link := new(Link)
+	link.Self = hostUrl.String() + basePath + "subscriptions/" + rabEstSubscriptionType + "/" + subsIdStr
+	rabEstSubscription.Links = link
+
+	_ = setSubscription(r.Context(), rabEstSubscriptionType, subsIdStr, rabEstSubscription.Links.Self, rabEstSubscription.CallbackReference, rabEstSubscription.ExpiryDeadline, convertRabEstSubscriptionToJson(rabEstSubscription))
+	registerRe(subsIdStr)
+
+	jsonResponse, err := json.Marshal(response)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusCreated)
+	fmt.Fprintf(w, string(jsonResponse))
+}
+
+func rabEstSubscriptionsPUT(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+
+	vars := mux.Vars(r)
+	subIdParamStr := vars["subscriptionId"]
+	var response InlineResponse2007
+	rabEstSubscription1 := new(RabEstSubscription1)
+
+	decoder := json.NewDecoder(r.Body)
+	err := decoder.Decode(&rabEstSubscription1)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	rabEstSubscription := rabEstSubscription1.RabEstSubscription
+	if rabEstSubscription == nil || rabEstSubscription.Links == nil {
+		log.Error("Missing subscription")
+		http.Error(w, "Missing subscription", http.StatusBadRequest)
+		return
+	}
+	err = validateQciFilterCriteria(rabEstSubscription.FilterCriteria)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	selfUrl := strings.Split(rabEstSubscription.Links.Self, "/")
+	subsIdStr := selfUrl[len(selfUrl)-1]
+
+	if subsIdStr != subIdParamStr {
+		http.Error(w, "Body content not matching parameter", http.StatusInternalServerError)
+		return
+	}
+
+	if isSubscriptionIdRegisteredRe(subsIdStr) {
+		registerRe(subsIdStr)
+
+		_ = setSubscription(r.Context(), rabEstSubscriptionType, subsIdStr, rabEstSubscription.Links.Self, rabEstSubscription.CallbackReference, rabEstSubscription.ExpiryDeadline, convertRabEstSubscriptionToJson(rabEstSubscription))
+
+		response.RabEstSubscription = rabEstSubscription
+		jsonResponse, err := json.Marshal(response)
+		if err != nil {
+			log.Error(err.Error())
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, string(jsonResponse))
+	} else {
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func rabEstSubscriptionsDELETE(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	vars := mux.Vars(r)
+
+	err := delSubscription(rabEstSubscriptionType, vars["subscriptionId"])
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func rabModSubscriptionsGET(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	vars := mux.Vars(r)
+	subIdParamStr := vars["subscriptionId"]
+
+	var response InlineResponse2008
+	var rabModSubscription RabModSubscription
+	response.RabModSubscription = &rabModSubscription
+
+	jsonRespDB := getSubscriptionJson(rabModSubscriptionType, subIdParamStr)
+
+	if jsonRespDB == "" {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	err := json.Unmarshal([]byte(jsonRespDB), &rabModSubscription)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	jsonResponse, err := json.Marshal(response)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprintf(w, string(jsonResponse))
+}
+
+func isSubscriptionIdRegisteredRm(subsIdStr string) bool {
+	return subMgr.GetSubscription(rabModSubscriptionType, subsIdStr) != nil
+}
+
+func registerRm(subsIdStr string) {
+	log.Info("New registration: ", subsIdStr, " type: ", rabModSubscriptionType)
+}
+
+func deregisterRm(subsIdStr string) {
+	log.Info("Deregistration: ", subsIdStr, " type: ", rabModSubscriptionType)
+}
+
+func rabModSubscriptionsPOST(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+
+	var response InlineResponse2015
+	rabModSubscription := new(RabModSubscription)
+	response.RabModSubscription = rabModSubscription
+
+	rabModSubscriptionPost1 := new(RabModSubscriptionPost1)
+
+	decoder := json.NewDecoder(r.Body)
+	err := decoder.Decode(&rabModSubscriptionPost1)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	rabModSubscriptionPost := rabModSubscriptionPost1.RabModSubscription
+	if rabModSubscriptionPost == nil {
+		log.Error("Missing subscription")
+		http.Error(w, "Missing subscription", http.StatusBadRequest)
+		return
+	}
+	err = validateQciFilterCriteria(rabModSubscriptionPost.FilterCriteria)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	subsIdStr := subMgr.AllocateId(rabModSubscriptionType)
+
+	rabModSubscription.CallbackReference = rabModSubscriptionPost.CallbackReference
+	rabModSubscription.FilterCriteria = rabModSubscriptionPost.FilterCriteria
+	rabModSubscription.ExpiryDeadline = rabModSubscriptionPost.ExpiryDeadline
+	link := new(Link)
+	link.Self = hostUrl.String() + basePath + "subscriptions/" + rabModSubscriptionType + "/" + subsIdStr
+	rabModSubscription.Links = link
+
+	_ = setSubscription(r.Context(), rabModSubscriptionType, subsIdStr, rabModSubscription.Links.Self, rabModSubscription.CallbackReference, rabModSubscription.ExpiryDeadline, convertRabModSubscriptionToJson(rabModSubscription))
+	registerRm(subsIdStr)
+
+	jsonResponse, err := json.Marshal(response)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusCreated)
+	fmt.Fprintf(w, string(jsonResponse))
+}
+
+func rabModSubscriptionsPUT(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+
+	vars := mux.Vars(r)
+	subIdParamStr := vars["subscriptionId"]
+	var response InlineResponse2008
+	rabModSubscription1 := new(RabModSubscription1)
+
+	decoder := json.NewDecoder(r.Body)
+	err := decoder.Decode(&rabModSubscription1)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	rabModSubscription := rabModSubscription1.RabModSubscription
+	if rabModSubscription == nil || rabModSubscription.Links == nil {
+		log.Error("Missing subscription")
+		http.Error(w, "Missing subscription", http.StatusBadRequest)
+		return
+	}
+	err = validateQciFilterCriteria(rabModSubscription.FilterCriteria)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	selfUrl := strings.Split(rabModSubscription.Links.Self, "/")
+	subsIdStr := selfUrl[len(selfUrl)-1]
+
+	if subsIdStr != subIdParamStr {
+		http.Error(w, "Body content not matching parameter", http.StatusInternalServerError)
+		return
+	}
+
+	if isSubscriptionIdRegisteredRm(subsIdStr) {
+		registerRm(subsIdStr)
+
+		_ = setSubscription(r.Context(), rabModSubscriptionType, subsIdStr, rabModSubscription.Links.Self, rabModSubscription.CallbackReference, rabModSubscription.ExpiryDeadline, convertRabModSubscriptionToJson(rabModSubscription))
+
+		response.RabModSubscription = rabModSubscription
+		jsonResponse, err := json.Marshal(response)
+		if err != nil {
+			log.Error(err.Error())
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, string(jsonResponse))
+	} else {
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func rabModSubscriptionsDELETE(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	vars := mux.Vars(r)
+
+	err := delSubscription(rabModSubscriptionType, vars["subscriptionId"])
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func rabRelSubscriptionsGET(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	vars := mux.Vars(r)
+	subIdParamStr := vars["subscriptionId"]
+
+	var response InlineResponse2009
+	var rabRelSubscription RabRelSubscription
+	response.RabRelSubscription = &rabRelSubscription
+
+	jsonRespDB := getSubscriptionJson(rabRelSubscriptionType, subIdParamStr)
+
+	if jsonRespDB == "" {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	err := json.Unmarshal([]byte(jsonRespDB), &rabRelSubscription)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	jsonResponse, err := json.Marshal(response)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprintf(w, string(jsonResponse))
+}
+
+func isSubscriptionIdRegisteredRr(subsIdStr string) bool {
+	return subMgr.GetSubscription(rabRelSubscriptionType, subsIdStr) != nil
+}
+
+func registerRr(subsIdStr string) {
+	log.Info("New registration: ", subsIdStr, " type: ", rabRelSubscriptionType)
+}
+
+func deregisterRr(subsIdStr string) {
+	log.Info("Deregistration: ", subsIdStr, " type: ", rabRelSubscriptionType)
+}
+
+func rabRelSubscriptionsPOST(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+
+	var response InlineResponse2016
+	rabRelSubscription := new(RabRelSubscription)
+	response.RabRelSubscription = rabRelSubscription
+
+	rabRelSubscriptionPost1 := new(RabRelSubscriptionPost1)
+
+	decoder := json.NewDecoder(r.Body)
+	err := decoder.Decode(&rabRelSubscriptionPost1)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	rabRelSubscriptionPost := rabRelSubscriptionPost1.RabRelSubscription
+	if rabRelSubscriptionPost == nil {
+		log.Error("Missing subscription")
+		http.Error(w, "Missing subscription", http.StatusBadRequest)
+		return
+	}
+	err = validateQciFilterCriteria(rabRelSubscriptionPost.FilterCriteria)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	subsIdStr := subMgr.AllocateId(rabRelSubscriptionType)
+
+	rabRelSubscription.CallbackReference = rabRelSubscriptionPost.CallbackReference
+	rabRelSubscription.FilterCriteria = rabRelSubscriptionPost.FilterCriteria
+	rabRelSubscription.ExpiryDeadline = rabRelSubscriptionPost.ExpiryDeadline
+	link := new(Link)
+	link.Self = hostUrl.String() + basePath + "subscriptions/" + rabRelSubscriptionType + "/" + subsIdStr
+	rabRelSubscription.Links = link
+
+	_ = setSubscription(r.Context(), rabRelSubscriptionType, subsIdStr, rabRelSubscription.Links.Self, rabRelSubscription.CallbackReference, rabRelSubscription.ExpiryDeadline, convertRabRelSubscriptionToJson(rabRelSubscription))
+	registerRr(subsIdStr)
+
+	jsonResponse, err := json.Marshal(response)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusCreated)
+	fmt.Fprintf(w, string(jsonResponse))
+}
+
+func rabRelSubscriptionsPUT(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+
+	vars := mux.Vars(r)
+	subIdParamStr := vars["subscriptionId"]
+	var response InlineResponse2009
+	rabRelSubscription1 := new(RabRelSubscription1)
+
+	decoder := json.NewDecoder(r.Body)
+	err := decoder.Decode(&rabRelSubscription1)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	rabRelSubscription := rabRelSubscription1.RabRelSubscription
+	if rabRelSubscription == nil || rabRelSubscription.Links == nil {
+		log.Error("Missing subscription")
+		http.Error(w, "Missing subscription", http.StatusBadRequest)
+		return
+	}
+	err = validateQciFilterCriteria(rabRelSubscription.FilterCriteria)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	selfUrl := strings.Split(rabRelSubscription.Links.Self, "/")
+	subsIdStr := selfUrl[len(selfUrl)-1]
+
+	if subsIdStr != subIdParamStr {
+		http.Error(w, "Body content not matching parameter", http.StatusInternalServerError)
+		return
+	}
+
+	if isSubscriptionIdRegisteredRr(subsIdStr) {
+		registerRr(subsIdStr)
+
+		_ = setSubscription(r.Context(), rabRelSubscriptionType, subsIdStr, rabRelSubscription.Links.Self, rabRelSubscription.CallbackReference, rabRelSubscription.ExpiryDeadline, convertRabRelSubscriptionToJson(rabRelSubscription))
+
+		response.RabRelSubscription = rabRelSubscription
+		jsonResponse, err := json.Marshal(response)
+		if err != nil {
+			log.Error(err.Error())
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, string(jsonResponse))
+	} else {
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func rabRelSubscriptionsDELETE(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	vars := mux.Vars(r)
+
+	err := delSubscription(rabRelSubscriptionType, vars["subscriptionId"])
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func validateS1BearerSubscriptionCriteria(criteria *S1BearerSubscriptionCriteria) error {
+	if criteria == nil {
+		return errors.New("Missing S1 bearer subscription criteria")
+	}
+	return nil
+}
+
+func isS1BearerFilterMatch(sub *S1BearerSubscription, name string, event EventType, erab *sbi.ErabInfo) bool {
+	if sub.EventType != nil && *sub.EventType != event {
+		return false
+	}
+
+	criteria := sub.S1BearerSubscriptionCriteria
+	if criteria == nil {
+		return true
+	}
+	if criteria.AssociateId != nil && criteria.AssociateId.Value != name {
+		return false
+	}
+	if criteria.Plmn != nil && (criteria.Plmn.Mnc != erab.Mnc || criteria.Plmn.Mcc != erab.Mcc) {
+		return false
+	}
+	if criteria.ErabId != 0 && criteria.ErabId != erab.ErabId {
+		return false
+	}
+	if len(criteria.CellId) != 0 && !isInList(erab.CellId, criteria.CellId) {
+		return false
+	}
+	return true
+}
+
+func getS1UeInfo(name string, erab *sbi.ErabInfo) *S1UeInfo {
+	s1UeInfo := new(S1UeInfo)
+	s1UeInfo.TempUeId = []TempUeId{{Mmec: erab.Mmec, Mtmsi: erab.Mtmsi}}
+	s1UeInfo.AssociateId = []AssociateId{{Type_: "UE_IPv4_ADDRESS", Value: name}}
+	s1UeInfo.Ecgi = []Ecgi{*newEcgi(erab.Mnc, erab.Mcc, erab.CellId)}
+
+	var s1BearerInfo S1BearerInfoDetailed
+	s1BearerInfo.ErabId = erab.ErabId
+	s1BearerInfo.S1EnbInfo = &S1EnbInfo{IpAddress: erab.EnbAddr, TunnelId: erab.EnbTeid}
+	s1BearerInfo.SGwInfo = &SGwInfo{IpAddress: erab.SgwAddr, TunnelId: erab.SgwTeid}
+	s1UeInfo.S1BearerInfoDetailed = []S1BearerInfoDetailed{s1BearerInfo}
+	return s1UeInfo
+}
+
+func checkS1BearerNotificationRegisteredSubscriptions(name string, event EventType, erab *sbi.ErabInfo) {
+	for subsId, sub := range getS1SubscriptionMap() {
+		if sub != nil && isS1BearerFilterMatch(sub, name, event, erab) {
+			subsIdStr := strconv.Itoa(subsId)
+			log.Info("Sending RNIS notification ", sub.CallbackReference)
+
+			var notif clientNotif.S1BearerNotification
+			seconds := time.Now().Unix()
+			var timeStamp clientNotif.TimeStamp
+			timeStamp.Seconds = int32(seconds)
+			notif.Timestamp = &timeStamp
+			notifEvent := clientNotif.EventType(event)
+			notif.S1Event = &notifEvent
+			notif.S1UeInfo = convertS1UeInfoToNotifS1UeInfo(getS1UeInfo(name, erab))
+
+			go sendS1Notification(sub.CallbackReference, getNotifContext(s1BearerSubscriptionType, subsIdStr), subsIdStr, notif)
+			log.Info("S1_bearer Notification" + "(" + subsIdStr + ")")
+		}
+	}
+}
+
+func sendS1Notification(notifyUrl string, ctx context.Context, subscriptionId string, notification clientNotif.S1BearerNotification) {
+
+	client, err := createClient(notifyUrl)
+	if err != nil {
+		log.Error(err)
+		return
+	}
+
+	jsonNotif, err := json.Marshal(notification)
+	if err != nil {
+		log.Error(err.Error())
+	}
+
+	_ = subMgr.SendNotification(s1BearerSubscriptionType, subscriptionId, notifyUrl, func() (*http.Response, error) {
+		startTime := time.Now()
+		resp, err := client.NotificationsApi.PostS1BearerNotification(ctx, subscriptionId, notification)
+		_ = httpLog.LogTx(notifyUrl, "POST", string(jsonNotif), resp, startTime)
+		return resp, err
+	})
+}
+
+func s1BearerInfoGET(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+
+	u, _ := url.Parse(r.URL.String())
+	log.Info("url: ", u.RequestURI())
+	q := u.Query()
+
+	// Parse query filters
+	tempUeIdList := getQueryList(q.Get("temp_ue_id"))
+	ueList := getQueryList(q.Get("ue_ipv4_address"))
+	cellIdList := getQueryList(q.Get("cell_id"))
+	erabIdList := []int32{}
+	for _, valueStr := range getQueryList(q.Get("erab_id")) {
+		value, err := strconv.Atoi(valueStr)
+		if err != nil {
+			log.Error("Invalid query parameter: erab_id")
+			http.Error(w, "Invalid query parameter: erab_id", http.StatusBadRequest)
+			return
+		}
+		erabIdList = append(erabIdList, int32(value))
+	}
+
+	var response InlineResponse2002
+	s1BearerInfo := new(S1BearerInfo)
+	response.S1BearerInfo = s1BearerInfo
+
+	seconds := time.Now().Unix()
+	var timeStamp TimeStamp
+	timeStamp.Seconds = int32(seconds)
+	s1BearerInfo.TimeStamp = &timeStamp
+
+	// Get S1 bearers of matching UEs
+	mutex.Lock()
+	ueNameList := make([]string, 0, len(ueErabInfoMap))
+	for name := range ueErabInfoMap {
+		ueNameList = append(ueNameList, name)
+	}
+	sort.Strings(ueNameList)
+
+	for _, name := range ueNameList {
+		erab := ueErabInfoMap[name]
+		if len(tempUeIdList) != 0 && !isInList(erab.Mtmsi, tempUeIdList) {
+			continue
+		}
+		if len(ueList) != 0 && !isInList(name, ueList) {
+			continue
+		}
+		if len(cellIdList) != 0 && !isInList(erab.CellId, cellIdList) {
+			continue
+		}
+		if len(erabIdList) != 0 {
+			found := false
+			for _, erabId := range erabIdList {
+				if erabId == erab.ErabId {
+					found = true
+					break
+				}
+			}
+			if !found {
+				continue
+			}
+		}
+		s1BearerInfo.S1UeInfo = append(s1BearerInfo.S1UeInfo, *getS1UeInfo(name, erab))
+	}
+	mutex.Unlock()
+
+	if len(s1BearerInfo.S1UeInfo) == 0 {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	jsonResponse, err := json.Marshal(response)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprintf(w, string(jsonResponse))
+}
+
+func s1BearerSubscriptionsGET(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	vars := mux.Vars(r)
+	subIdParamStr := vars["subscriptionId"]
+
+	var response InlineResponse2011
+	var s1BearerSubscription S1BearerSubscription
+	response.S1BearerSubscription = &s1BearerSubscription
+
+	jsonRespDB := getSubscriptionJson(s1BearerSubscriptionType, subIdParamStr)
+
+	if jsonRespDB == "" {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	err := json.Unmarshal([]byte(jsonRespDB), &s1BearerSubscription)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	jsonResponse, err := json.Marshal(response)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprintf(w, string(jsonResponse))
+}
+
+func isSubscriptionIdRegisteredS1(subsIdStr string) bool {
+	return subMgr.GetSubscription(s1BearerSubscriptionType, subsIdStr) != nil
+}
+
+func registerS1(subsIdStr string) {
+	log.Info("New registration: ", subsIdStr, " type: ", s1BearerSubscriptionType)
+}
+
+func deregisterS1(subsIdStr string) {
+	log.Info("Deregistration: ", subsIdStr, " type: ", s1BearerSubscriptionType)
+}
+
+func s1BearerSubscriptionsPOST(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+
+	var response InlineResponse2011
+	s1BearerSubscription := new(S1BearerSubscription)
+	response.S1BearerSubscription = s1BearerSubscription
+
+	s1BearerSubscriptionPost1 := new(S1BearerSubscriptionPost1)
+
+	decoder := json.NewDecoder(r.Body)
+	err := decoder.Decode(&s1BearerSubscriptionPost1)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	s1BearerSubscriptionPost := s1BearerSubscriptionPost1.S1BearerSubscription
+	if s1BearerSubscriptionPost == nil {
+		log.Error("Missing subscription")
+		http.Error(w, "Missing subscription", http.StatusBadRequest)
+		return
+	}
+	err = validateS1BearerSubscriptionCriteria(s1BearerSubscriptionPost.S1BearerSubscriptionCriteria)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	subsIdStr := subMgr.AllocateId(s1BearerSubscriptionType)
+
+	s1BearerSubscription.CallbackReference = s1BearerSubscriptionPost.CallbackReference
+	s1BearerSubscription.EventType = s1BearerSubscriptionPost.EventType
+	s1BearerSubscription.S1BearerSubscriptionCriteria = s1BearerSubscriptionPost.S1BearerSubscriptionCriteria
+	s1BearerSubscription.ExpiryDeadline = s1BearerSubscriptionPost.ExpiryDeadline
+	link := new(Link)
+	link.Self = hostUrl.String() + basePath + "subscriptions/" + s1BearerSubscriptionType + "/" + subsIdStr
+	s1BearerSubscription.Links = link
+
+	_ = setSubscription(r.Context(), s1BearerSubscriptionType, subsIdStr, s1BearerSubscription.Links.Self, s1BearerSubscription.CallbackReference, s1BearerSubscription.ExpiryDeadline, convertS1BearerSubscriptionToJson(s1BearerSubscription))
+	registerS1(subsIdStr)
+
+	jsonResponse, err := json.Marshal(response)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusCreated)
+	fmt.Fprintf(w, string(jsonResponse))
+}
+
+func s1BearerSubscriptionsPUT(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+
+	vars := mux.Vars(r)
+	subIdParamStr := vars["subscriptionId"]
+	var response InlineResponse2011
+	s1BearerSubscription1 := new(S1BearerSubscription1)
+
+	decoder := json.NewDecoder(r.Body)
+	err := decoder.Decode(&s1BearerSubscription1)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	s1BearerSubscription := s1BearerSubscription1.S1BearerSubscription
+	if s1BearerSubscription == nil || s1BearerSubscription.Links == nil {
+		log.Error("Missing subscription")
+		http.Error(w, "Missing subscription", http.StatusBadRequest)
+		return
+	}
+	err = validateS1BearerSubscriptionCriteria(s1BearerSubscription.S1BearerSubscriptionCriteria)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	selfUrl := strings.Split(s1BearerSubscription.Links.Self, "/")
+	subsIdStr := selfUrl[len(selfUrl)-1]
+
+	if subsIdStr != subIdParamStr {
+		http.Error(w, "Body content not matching parameter", http.StatusInternalServerError)
+		return
+	}
+
+	if isSubscriptionIdRegisteredS1(subsIdStr) {
+		registerS1(subsIdStr)
+
+		_ = setSubscription(r.Context(), s1BearerSubscriptionType, subsIdStr, s1BearerSubscription.Links.Self, s1BearerSubscription.CallbackReference, s1BearerSubscription.ExpiryDeadline, convertS1BearerSubscriptionToJson(s1BearerSubscription))
+
+		response.S1BearerSubscription = s1BearerSubscription
+		jsonResponse, err := json.Marshal(response)
+		if err != nil {
+			log.Error(err.Error())
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, string(jsonResponse))
+	} else {
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func s1BearerSubscriptionsDELETE(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	vars := mux.Vars(r)
+
+	err := delSubscription(s1BearerSubscriptionType, vars["subscriptionId"])
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func validateAssocFilterCriteria(filterCriteria *FilterCriteriaAssoc) error {
+	if filterCriteria == nil {
+		return errors.New("Missing filter criteria")
+	}
+	return nil
+}
+
+func isAssocFilterMatch(filter *FilterCriteriaAssoc, name string, ecgi *Ecgi) bool {
+	if filter == nil {
+		return true
+	}
+
+	// Timing advance & carrier aggregation are only available for UEs
+	if filter.AppInsId != "" {
+		return false
+	}
+	if filter.AssociateId != nil && filter.AssociateId.Value != name {
+		return false
+	}
+	if filter.Plmn != nil && (ecgi.Plmn == nil || filter.Plmn.Mnc != ecgi.Plmn.Mnc || filter.Plmn.Mcc != ecgi.Plmn.Mcc) {
+		return false
+	}
+	if len(filter.CellId) != 0 && (len(ecgi.CellId) == 0 || !isInList(ecgi.CellId[0], filter.CellId)) {
+		return false
+	}
+	return true
+}
+
+func getEcgiKey(ecgi *Ecgi) string {
+	key := ""
+	if ecgi.Plmn != nil {
+		key = ecgi.Plmn.Mnc + ":" + ecgi.Plmn.Mcc
+	}
+	if len(ecgi.CellId) != 0 {
+		key += ":" + ecgi.CellId[0]
+	}
+	return key
+}
+
+// Secondary cells are the strongest neighbour cells of the serving cell operator
+func getSecondaryCells(measInfo *ueMeasInfo) []*Ecgi {
+	secondaryCells := []*Ecgi{}
+	for _, cellMeas := range measInfo.neighbours {
+		if len(secondaryCells) >= maxSecondaryCells {
+			break
+		}
+		if cellMeas.ecgi.Plmn.Mnc == measInfo.ecgi.Plmn.Mnc && cellMeas.ecgi.Plmn.Mcc == measInfo.ecgi.Plmn.Mcc {
+			secondaryCells = append(secondaryCells, cellMeas.ecgi)
+		}
+	}
+	return secondaryCells
+}
+
+func checkTaNotificationRegisteredSubscriptions(name string, oldMeasInfo *ueMeasInfo, measInfo *ueMeasInfo) {
+	// Notify on timing advance or serving cell change
+	if measInfo == nil {
+		return
+	}
+	if oldMeasInfo != nil && oldMeasInfo.ta == measInfo.ta && getEcgiKey(oldMeasInfo.ecgi) == getEcgiKey(measInfo.ecgi) {
+		return
+	}
+
+	for subsId, sub := range getTaSubscriptionMap() {
+		if sub != nil && isAssocFilterMatch(sub.FilterCriteria, name, measInfo.ecgi) {
+			subsIdStr := strconv.Itoa(subsId)
+			log.Info("Sending RNIS notification ", sub.CallbackReference)
+
+			var notif clientNotif.MeasTaNotification
+			seconds := time.Now().Unix()
+			var timeStamp clientNotif.TimeStamp
+			timeStamp.Seconds = int32(seconds)
+			notif.Timestamp = &timeStamp
+			var assocId clientNotif.AssociateId
+			assocId.Type_ = "UE_IPv4_ADDRESS"
+			assocId.Value = name
+			notif.AssociateId = &assocId
+			notif.Ecgi = convertEcgiToNotifEcgi(measInfo.ecgi)
+			notif.TimingAdvance = measInfo.ta
+
+			go sendTaNotification(sub.CallbackReference, getNotifContext(measTaSubscriptionType, subsIdStr), subsIdStr, notif)
+			log.Info("Meas_ta Notification" + "(" + subsIdStr + ")")
+		}
+	}
+}
+
+func checkCaReConfNotificationRegisteredSubscriptions(name string, oldMeasInfo *ueMeasInfo, measInfo *ueMeasInfo) {
+	// Find added & removed secondary cells
+	oldCellMap := make(map[string]*Ecgi)
+	if oldMeasInfo != nil {
+		for _, ecgi := range oldMeasInfo.secondaryCells {
+			oldCellMap[getEcgiKey(ecgi)] = ecgi
+		}
+	}
+	newCellMap := make(map[string]*Ecgi)
+	if measInfo != nil {
+		for _, ecgi := range measInfo.secondaryCells {
+			newCellMap[getEcgiKey(ecgi)] = ecgi
+		}
+	}
+	var addedCells []clientNotif.CaReConfNotificationSecondaryCell
+	var removedCells []clientNotif.CaReConfNotificationSecondaryCell
+	if measInfo != nil {
+		for _, ecgi := range measInfo.secondaryCells {
+			if _, found := oldCellMap[getEcgiKey(ecgi)]; !found {
+				addedCells = append(addedCells, clientNotif.CaReConfNotificationSecondaryCell{Ecgi: convertEcgiToNotifEcgi(ecgi)})
+			}
+		}
+	}
+	if oldMeasInfo != nil {
+		for _, ecgi := range oldMeasInfo.secondaryCells {
+			if _, found := newCellMap[getEcgiKey(ecgi)]; !found {
+				removedCells = append(removedCells, clientNotif.CaReConfNotificationSecondaryCell{Ecgi: convertEcgiToNotifEcgi(ecgi)})
+			}
+		}
+	}
+	if len(addedCells) == 0 && len(removedCells) == 0 {
+		return
+	}
+
+	// Report the serving cell, or the last serving cell if the UE left cellular coverage
+	ecgi := oldMeasInfo.ecgi
+	if measInfo != nil {
+		ecgi = measInfo.ecgi
+	}
+
+	for subsId, sub := range getCrSubscriptionMap() {
+		if sub != nil && isAssocFilterMatch(sub.FilterCriteria, name, ecgi) {
+			subsIdStr := strconv.Itoa(subsId)
+			log.Info("Sending RNIS notification ", sub.CallbackReference)
+
+			var notif clientNotif.CaReConfNotification
+			seconds := time.Now().Unix()
+			var timeStamp clientNotif.TimeStamp
+			timeStamp.Seconds = int32(seconds)
+			notif.Timestamp = &timeStamp
+			var assocId clientNotif.AssociateId
+			assocId.Type_ = "UE_IPv4_ADDRESS"
+			assocId.Value = name
+			notif.AssociateId = &assocId
+			notif.Ecgi = convertEcgiToNotifEcgi(ecgi)
+			notif.SecondaryCellAdd = addedCells
+			notif.SecondaryCellRemove = removedCells
+
+			go sendCrNotification(sub.CallbackReference, getNotifContext(caReConfSubscriptionType, subsIdStr), subsIdStr, notif)
+			log.Info("Ca_reconf Notification" + "(" + subsIdStr + ")")
+		}
+	}
+}
+
+func sendTaNotification(notifyUrl string, ctx context.Context, subscriptionId string, notification clientNotif.MeasTaNotification) {
+
+	client, err := createClient(notifyUrl)
+	if err != nil {
+		log.Error(err)
+		return
+	}
+
+	jsonNotif, err := json.Marshal(notification)
+	if err != nil {
+		log.Error(err.Error())
+	}
+
+	_ = subMgr.SendNotification(measTaSubscriptionType, subscriptionId, notifyUrl, func() (*http.Response, error) {
+		startTime := time.Now()
+		resp, err := client.NotificationsApi.PostMeasTaNotification(ctx, subscriptionId, notification)
+		_ = httpLog.LogTx(notifyUrl, "POST", string(jsonNotif), resp, startTime)
+		return resp, err
+	})
+}
+
+func sendCrNotification(notifyUrl string, ctx context.Context, subscriptionId string, notification clientNotif.CaReConfNotification) {
+
+	client, err := createClient(notifyUrl)
+	if err != nil {
+		log.Error(err)
+		return
+	}
+
+	jsonNotif, err := json.Marshal(notification)
+	if err != nil {
+		log.Error(err.Error())
+	}
+
+	_ = subMgr.SendNotification(caReConfSubscriptionType, subscriptionId, notifyUrl, func() (*http.Response, error) {
+		startTime := time.Now()
+		resp, err := client.NotificationsApi.PostCaReConfNotification(ctx, subscriptionId, notification)
+		_ = httpLog.LogTx(notifyUrl, "POST", string(jsonNotif), resp, startTime)
+		return resp, err
+	})
+}
+
+func measTaSubscriptionsGET(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	vars := mux.Vars(r)
+	subIdParamStr := vars["subscriptionId"]
+
+	var response InlineResponse2005
+	var measTaSubscription MeasTaSubscription
+	response.MeasTaSubscription = &measTaSubscription
+
+	jsonRespDB := getSubscriptionJson(measTaSubscriptionType, subIdParamStr)
+
+	if jsonRespDB == "" {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	err := json.Unmarshal([]byte(jsonRespDB), &measTaSubscription)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	jsonResponse, err := json.Marshal(response)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprintf(w, string(jsonResponse))
+}
+
+func isSubscriptionIdRegisteredTa(subsIdStr string) bool {
+	return subMgr.GetSubscription(measTaSubscriptionType, subsIdStr) != nil
+}
+
+func registerTa(subsIdStr string) {
+	log.Info("New registration: ", subsIdStr, " type: ", measTaSubscriptionType)
+}
+
+func deregisterTa(subsIdStr string) {
+	log.Info("Deregistration: ", subsIdStr, " type: ", measTaSubscriptionType)
+}
+
+func measTaSubscriptionsPOST(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+
+	var response InlineResponse2012
+	measTaSubscription := new(MeasTaSubscription)
+	response.MeasTaSubscription = measTaSubscription
+
+	measTaSubscriptionPost1 := new(MeasTaSubscriptionPost1)
+
+	decoder := json.NewDecoder(r.Body)
+	err := decoder.Decode(&measTaSubscriptionPost1)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	measTaSubscriptionPost := measTaSubscriptionPost1.MeasTaSubscription
+	if measTaSubscriptionPost == nil {
+		log.Error("Missing subscription")
+		http.Error(w, "Missing subscription", http.StatusBadRequest)
+		return
+	}
+	err = validateAssocFilterCriteria(measTaSubscriptionPost.FilterCriteria)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	subsIdStr := subMgr.AllocateId(measTaSubscriptionType)
+
+	measTaSubscription.CallbackReference = measTaSubscriptionPost.CallbackReference
+	measTaSubscription.FilterCriteria = measTaSubscriptionPost.FilterCriteria
+	measTaSubscription.ExpiryDeadline = measTaSubscriptionPost.ExpiryDeadline
+	link := new(Link)
+	link.Self = hostUrl.String() + basePath + "subscriptions/" + measTaSubscriptionType + "/" + subsIdStr
+	measTaSubscription.Links = link
+
+	_ = setSubscription(r.Context(), measTaSubscriptionType, subsIdStr, measTaSubscription.Links.Self, measTaSubscription.CallbackReference, measTaSubscription.ExpiryDeadline, convertMeasTaSubscriptionToJson(measTaSubscription))
+	registerTa(subsIdStr)
+
+	jsonResponse, err := json.Marshal(response)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusCreated)
+	fmt.Fprintf(w, string(jsonResponse))
+}
+
+func measTaSubscriptionsPUT(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+
+	vars := mux.Vars(r)
+	subIdParamStr := vars["subscriptionId"]
+	var response InlineResponse2005
+	measTaSubscription1 := new(MeasTaSubscription1)
+
+	decoder := json.NewDecoder(r.Body)
+	err := decoder.Decode(&measTaSubscription1)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	measTaSubscription := measTaSubscription1.MeasTaSubscription
+	if measTaSubscription == nil || measTaSubscription.Links == nil {
+		log.Error("Missing subscription")
+		http.Error(w, "Missing subscription", http.StatusBadRequest)
+		return
+	}
+	err = validateAssocFilterCriteria(measTaSubscription.FilterCriteria)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	selfUrl := strings.Split(measTaSubscription.Links.Self, "/")
+	subsIdStr := selfUrl[len(selfUrl)-1]
+
+	if subsIdStr != subIdParamStr {
+		http.Error(w, "Body content not matching parameter", http.StatusInternalServerError)
+		return
+	}
+
+	if isSubscriptionIdRegisteredTa(subsIdStr) {
+		registerTa(subsIdStr)
+
+		_ = setSubscription(r.Context(), measTaSubscriptionType, subsIdStr, measTaSubscription.Links.Self, measTaSubscription.CallbackReference, measTaSubscription.ExpiryDeadline, convertMeasTaSubscriptionToJson(measTaSubscription))
+
+		response.MeasTaSubscription = measTaSubscription
+		jsonResponse, err := json.Marshal(response)
+		if err != nil {
+			log.Error(err.Error())
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, string(jsonResponse))
+	} else {
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func measTaSubscriptionsDELETE(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	vars := mux.Vars(r)
+
+	err := delSubscription(measTaSubscriptionType, vars["subscriptionId"])
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func caReConfSubscriptionsGET(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	vars := mux.Vars(r)
+	subIdParamStr := vars["subscriptionId"]
+
+	var response InlineResponse20010
+	var caReConfSubscription CaReConfSubscription
+	response.CaReConfSubscription = &caReConfSubscription
+
+	jsonRespDB := getSubscriptionJson(caReConfSubscriptionType, subIdParamStr)
+
+	if jsonRespDB == "" {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	err := json.Unmarshal([]byte(jsonRespDB), &caReConfSubscription)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	jsonResponse, err := json.Marshal(response)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprintf(w, string(jsonResponse))
+}
+
+func isSubscriptionIdRegisteredCr(subsIdStr string) bool {
+	return subMgr.GetSubscription(caReConfSubscriptionType, subsIdStr) != nil
+}
+
+func registerCr(subsIdStr string) {
+	log.Info("New registration: ", subsIdStr, " type: ", caReConfSubscriptionType)
+}
+
+func deregisterCr(subsIdStr string) {
+	log.Info("Deregistration: ", subsIdStr, " type: ", caReConfSubscriptionType)
+}
+
+func caReConfSubscriptionsPOST(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+
+	var response InlineResponse2017
+	caReConfSubscription := new(CaReConfSubscription)
+	response.CaReConfSubscription = caReConfSubscription
+
+	caReConfSubscriptionPost1 := new(CaReConfSubscriptionPost1)
+
+	decoder := json.NewDecoder(r.Body)
+	err := decoder.Decode(&caReConfSubscriptionPost1)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	caReConfSubscriptionPost := caReConfSubscriptionPost1.CaReConfSubscription
+	if caReConfSubscriptionPost == nil {
+		log.Error("Missing subscription")
+		http.Error(w, "Missing subscription", http.StatusBadRequest)
+		return
+	}
+	err = validateAssocFilterCriteria(caReConfSubscriptionPost.FilterCriteria)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	subsIdStr := subMgr.AllocateId(caReConfSubscriptionType)
+
+	caReConfSubscription.CallbackReference = caReConfSubscriptionPost.CallbackReference
+	caReConfSubscription.FilterCriteria = caReConfSubscriptionPost.FilterCriteria
+	caReConfSubscription.ExpiryDeadline = caReConfSubscriptionPost.ExpiryDeadline
+	link := new(Link)
+	link.Self = hostUrl.String() + basePath + "subscriptions/" + caReConfSubscriptionType + "/" + subsIdStr
+	caReConfSubscription.Links = link
+
+	_ = setSubscription(r.Context(), caReConfSubscriptionType, subsIdStr, caReConfSubscription.Links.Self, caReConfSubscription.CallbackReference, caReConfSubscription.ExpiryDeadline, convertCaReConfSubscriptionToJson(caReConfSubscription))
+	registerCr(subsIdStr)
+
+	jsonResponse, err := json.Marshal(response)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusCreated)
+	fmt.Fprintf(w, string(jsonResponse))
+}
+
+func caReConfSubscriptionsPUT(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+
+	vars := mux.Vars(r)
+	subIdParamStr := vars["subscriptionId"]
+	var response InlineResponse20010
+	caReConfSubscription1 := new(CaReConfSubscription1)
+
+	decoder := json.NewDecoder(r.Body)
+	err := decoder.Decode(&caReConfSubscription1)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	caReConfSubscription := caReConfSubscription1.CaReConfSubscription
+	if caReConfSubscription == nil || caReConfSubscription.Links == nil {
+		log.Error("Missing subscription")
+		http.Error(w, "Missing subscription", http.StatusBadRequest)
+		return
+	}
+	err = validateAssocFilterCriteria(caReConfSubscription.FilterCriteria)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	selfUrl := strings.Split(caReConfSubscription.Links.Self, "/")
+	subsIdStr := selfUrl[len(selfUrl)-1]
+
+	if subsIdStr != subIdParamStr {
+		http.Error(w, "Body content not matching parameter", http.StatusInternalServerError)
+		return
+	}
+
+	if isSubscriptionIdRegisteredCr(subsIdStr) {
+		registerCr(subsIdStr)
+
+		_ = setSubscription(r.Context(), caReConfSubscriptionType, subsIdStr, caReConfSubscription.Links.Self, caReConfSubscription.CallbackReference, caReConfSubscription.ExpiryDeadline, convertCaReConfSubscriptionToJson(caReConfSubscription))
+
+		response.CaReConfSubscription = caReConfSubscription
+		jsonResponse, err := json.Marshal(response)
+		if err != nil {
+			log.Error(err.Error())
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, string(jsonResponse))
+	} else {
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func caReConfSubscriptionsDELETE(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	vars := mux.Vars(r)
+
+	err := delSubscription(caReConfSubscriptionType, vars["subscriptionId"])
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func plmnInfoGET(w http.ResponseWriter, r *http.Request) {
+
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+
+	u, _ := url.Parse(r.URL.String())
+	log.Info("url: ", u.RequestURI())
+	q := u.Query()
+	appInsId := q.Get("app_ins_id")
+	appInsIdArray := strings.Split(appInsId, ",")
+
+	var response InlineResponse2001
+	atLeastOne := false
+
+	//same for all plmnInfo
+	seconds := time.Now().Unix()
+	var timeStamp TimeStamp
+	timeStamp.Seconds = int32(seconds)
+
+	for _, meAppName := range appInsIdArray {
+		meAppName = strings.TrimSpace(meAppName)
+
+		//get from DB
+		jsonAppEcgiInfo, _ := rc.JSONGetEntry(baseKey+"APP:"+meAppName, ".")
+
+		if jsonAppEcgiInfo != "" {
+
+			ecgi := convertJsonToEcgi(jsonAppEcgiInfo)
+			if ecgi != nil {
+				if ecgi.Plmn.Mnc != "" && ecgi.Plmn.Mcc != "" && ecgi.CellId[0] != "" {
+					var plmnInfo PlmnInfo
+					plmnInfo.Ecgi = ecgi
+					plmnInfo.AppInsId = meAppName
+					plmnInfo.TimeStamp = &timeStamp
+					response.PlmnInfo = append(response.PlmnInfo, plmnInfo)
+					atLeastOne = true
+				}
+			}
+		}
+	}
+
+	if atLeastOne {
+		jsonResponse, err := json.Marshal(response)
+		if err != nil {
+			log.Error(err.Error())
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, string(jsonResponse))
+	} else {
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func createSubscriptionLinkList(subType string) *SubscriptionLinkList {
+
+	subscriptionLinkList := new(SubscriptionLinkList)
+
+	link := new(Link)
+	link.Self = hostUrl.String() + basePath + "subscriptions"
+
+	if subType != "" {
+		link.Self = link.Self + "/" + subType
+	}
+
+	subscriptionLinkList.Links = link
+
+	//loop through all subscriptions of the requested type
+	for _, subLink := range subMgr.GetLinkList(subType) {
+		var subscription Subscription
+		subscription.Href = subLink.Href
+		subscriptionTypeStr := subscriptionTypeMap[subLink.Type]
+		subscription.SubscriptionType = &subscriptionTypeStr
+		subscriptionLinkList.Subscription = append(subscriptionLinkList.Subscription, subscription)
+	}
+
+	return subscriptionLinkList
+}
+
+func subscriptionLinkListSubscriptionsGET(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+
+	var response InlineResponse2003
+
+	subscriptionLinkList := createSubscriptionLinkList("")
+
+	response.SubscriptionLinkList = subscriptionLinkList
+	jsonResponse, err := json.Marshal(response)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprintf(w, string(jsonResponse))
+}
+
+func subscriptionLinkListSubscriptionsCcGET(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+
+	var response InlineResponse2003
+
+	subscriptionLinkList := createSubscriptionLinkList(cellChangeSubscriptionType)
+
+	response.SubscriptionLinkList = subscriptionLinkList
+	jsonResponse, err := json.Marshal(response)
 	if err != nil {
 		log.Error(err.Error())
 		http.Error(w, err.Error(), http.StatusInternalServerError)
@@ -771,16 +3163,142 @@ func subscriptionLinkListSubscriptionsCcGET(w http.ResponseWriter, r *http.Reque
 }
 
 func subscriptionLinkListSubscriptionsMrGET(w http.ResponseWriter, r *http.Request) {
-	notImplemented(w, r)
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+
+	var response InlineResponse2003
+
+	subscriptionLinkList := createSubscriptionLinkList(measRepUeSubscriptionType)
+
+	response.SubscriptionLinkList = subscriptionLinkList
+	jsonResponse, err := json.Marshal(response)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprintf(w, string(jsonResponse))
+}
+
+func subscriptionLinkListSubscriptionsReGET(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+
+	var response InlineResponse2003
+
+	subscriptionLinkList := createSubscriptionLinkList(rabEstSubscriptionType)
+
+	response.SubscriptionLinkList = subscriptionLinkList
+	jsonResponse, err := json.Marshal(response)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprintf(w, string(jsonResponse))
+}
+
+func subscriptionLinkListSubscriptionsRmGET(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+
+	var response InlineResponse2003
+
+	subscriptionLinkList := createSubscriptionLinkList(rabModSubscriptionType)
+
+	response.SubscriptionLinkList = subscriptionLinkList
+	jsonResponse, err := json.Marshal(response)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprintf(w, string(jsonResponse))
+}
+
+func subscriptionLinkListSubscriptionsRrGET(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+
+	var response InlineResponse2003
+
+	subscriptionLinkList := createSubscriptionLinkList(rabRelSubscriptionType)
+
+	response.SubscriptionLinkList = subscriptionLinkList
+	jsonResponse, err := json.Marshal(response)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprintf(w, string(jsonResponse))
+}
+
+func subscriptionLinkListSubscriptionsS1GET(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+
+	var response InlineResponse2003
+
+	subscriptionLinkList := createSubscriptionLinkList(s1BearerSubscriptionType)
+
+	response.SubscriptionLinkList = subscriptionLinkList
+	jsonResponse, err := json.Marshal(response)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprintf(w, string(jsonResponse))
+}
+
+func subscriptionLinkListSubscriptionsTaGET(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+
+	var response InlineResponse2003
+
+	subscriptionLinkList := createSubscriptionLinkList(measTaSubscriptionType)
+
+	response.SubscriptionLinkList = subscriptionLinkList
+	jsonResponse, err := json.Marshal(response)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprintf(w, string(jsonResponse))
+}
+
+func subscriptionLinkListSubscriptionsCrGET(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+
+	var response InlineResponse2003
+
+	subscriptionLinkList := createSubscriptionLinkList(caReConfSubscriptionType)
+
+	response.SubscriptionLinkList = subscriptionLinkList
+	jsonResponse, err := json.Marshal(response)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprintf(w, string(jsonResponse))
 }
 
 func cleanUp() {
 	log.Info("Terminate all")
 	rc.DBFlush(baseKey)
-	nextSubscriptionIdAvailable = 1
+	subMgr.DeleteAllSubscriptions()
+
+	mutex.Lock()
+	ueMeasInfoMap = map[string]*ueMeasInfo{}
+	ueErabInfoMap = map[string]*sbi.ErabInfo{}
+	mrEventStateMap = map[int]map[string]bool{}
+	mutex.Unlock()
 
-	ccSubscriptionMap = map[int]*CellChangeSubscription{}
-	subscriptionExpiryMap = map[int][]int{}
 	updateStoreName("")
 }
 
@@ -24,10 +24,12 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"os"
 	"strconv"
 	"testing"
 	"time"
 
+	sbi "github.com/InterDigitalInc/AdvantEDGE/go-apps/meep-rnis/sbi"
 	ms "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-metric-store"
 
 	log "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-logger"
@@ -520,177 +522,6 @@ const testScenarioName = "testScenario"
 var m *mod.Model
 var mqLocal *mq.MsgQueue
 
-func TestNotImplemented(t *testing.T) {
-	fmt.Println("--- ", t.Name())
-	log.MeepTextLogInit(t.Name())
-
-	//s1_bearer_info
-	_, err := sendRequest(http.MethodGet, "/queries/s1_bearer_info", nil, nil, nil, http.StatusNotImplemented, S1BearerInfoGET)
-	if err != nil {
-		t.Fatalf("Failed to get expected response")
-	}
-	//rab_info
-	_, err = sendRequest(http.MethodGet, "/queries/rab_info", nil, nil, nil, http.StatusNotImplemented, RabInfoGET)
-	if err != nil {
-		t.Fatalf("Failed to get expected response")
-	}
-
-	//subscriptions s1_bearer
-	_, err = sendRequest(http.MethodGet, "/subscriptions/s1_bearer", nil, nil, nil, http.StatusNotImplemented, SubscriptionLinkListSubscriptionsS1GET)
-	if err != nil {
-		t.Fatalf("Failed to get expected response")
-	}
-	_, err = sendRequest(http.MethodGet, "/subscriptions/s1_bearer", nil, nil, nil, http.StatusNotImplemented, S1BearerSubscriptionSubscriptionsGET)
-	if err != nil {
-		t.Fatalf("Failed to get expected response")
-	}
-	_, err = sendRequest(http.MethodPost, "/subscriptions/s1_bearer", nil, nil, nil, http.StatusNotImplemented, S1BearerSubscriptionSubscriptionsPOST)
-	if err != nil {
-		t.Fatalf("Failed to get expected response")
-	}
-	_, err = sendRequest(http.MethodPut, "/subscriptions/s1_bearer", nil, nil, nil, http.StatusNotImplemented, S1BearerSubscriptionSubscriptionsPUT)
-	if err != nil {
-		t.Fatalf("Failed to get expected response")
-	}
-	_, err = sendRequest(http.MethodDelete, "/subscriptions/s1_bearer", nil, nil, nil, http.StatusNotImplemented, S1BearerSubscriptionsSubscrIdDELETE)
-	if err != nil {
-		t.Fatalf("Failed to get expected response")
-	}
-
-	//subscriptions ta
-	_, err = sendRequest(http.MethodGet, "/subscriptions/ta", nil, nil, nil, http.StatusNotImplemented, SubscriptionLinkListSubscriptionsTaGET)
-	if err != nil {
-		t.Fatalf("Failed to get expected response")
-	}
-	_, err = sendRequest(http.MethodGet, "/subscriptions/ta", nil, nil, nil, http.StatusNotImplemented, MeasTaSubscriptionsGET)
-	if err != nil {
-		t.Fatalf("Failed to get expected response")
-	}
-	_, err = sendRequest(http.MethodPost, "/subscriptions/ta", nil, nil, nil, http.StatusNotImplemented, MeasTaSubscriptionsPOST)
-	if err != nil {
-		t.Fatalf("Failed to get expected response")
-	}
-	_, err = sendRequest(http.MethodPut, "/subscriptions/ta", nil, nil, nil, http.StatusNotImplemented, MeasTaSubscriptionsPUT)
-	if err != nil {
-		t.Fatalf("Failed to get expected response")
-	}
-	_, err = sendRequest(http.MethodDelete, "/subscriptions/ta", nil, nil, nil, http.StatusNotImplemented, MeasTaSubscriptionsSubscrIdDELETE)
-	if err != nil {
-		t.Fatalf("Failed to get expected response")
-	}
-
-	//subscriptions meas rep ue
-	_, err = sendRequest(http.MethodGet, "/subscriptions/meas_rep_ue", nil, nil, nil, http.StatusNotImplemented, SubscriptionLinkListSubscriptionsMrGET)
-	if err != nil {
-		t.Fatalf("Failed to get expected response")
-	}
-	_, err = sendRequest(http.MethodGet, "/subscriptions/meas_rep_ue", nil, nil, nil, http.StatusNotImplemented, MeasRepUeSubscriptionsGET)
-	if err != nil {
-		t.Fatalf("Failed to get expected response")
-	}
-	_, err = sendRequest(http.MethodPost, "/subscriptions/meas_rep_ue", nil, nil, nil, http.StatusNotImplemented, MeasRepUeSubscriptionsPOST)
-	if err != nil {
-		t.Fatalf("Failed to get expected response")
-	}
-	_, err = sendRequest(http.MethodPut, "/subscriptions/meas_rep_ue", nil, nil, nil, http.StatusNotImplemented, MeasRepUeReportSubscriptionsPUT)
-	if err != nil {
-		t.Fatalf("Failed to get expected response")
-	}
-	_, err = sendRequest(http.MethodDelete, "/subscriptions/meas_rep_ue", nil, nil, nil, http.StatusNotImplemented, MeasRepUeSubscriptionsSubscrIdDELETE)
-	if err != nil {
-		t.Fatalf("Failed to get expected response")
-	}
-
-	//subscriptions rab est
-	_, err = sendRequest(http.MethodGet, "/subscriptions/rab_est", nil, nil, nil, http.StatusNotImplemented, SubscriptionLinkListSubscriptionsReGET)
-	if err != nil {
-		t.Fatalf("Failed to get expected response")
-	}
-	_, err = sendRequest(http.MethodGet, "/subscriptions/rab_est", nil, nil, nil, http.StatusNotImplemented, RabEstSubscriptionSubscriptionsGET)
-	if err != nil {
-		t.Fatalf("Failed to get expected response")
-	}
-	_, err = sendRequest(http.MethodPost, "/subscriptions/rab_est", nil, nil, nil, http.StatusNotImplemented, RabEstSubscriptionSubscriptionsPOST)
-	if err != nil {
-		t.Fatalf("Failed to get expected response")
-	}
-	_, err = sendRequest(http.MethodPut, "/subscriptions/rab_est", nil, nil, nil, http.StatusNotImplemented, RabEstSubscriptionSubscriptionsPUT)
-	if err != nil {
-		t.Fatalf("Failed to get expected response")
-	}
-	_, err = sendRequest(http.MethodDelete, "/subscriptions/rab_est", nil, nil, nil, http.StatusNotImplemented, RabEstSubscriptionsSubscrIdDELETE)
-	if err != nil {
-		t.Fatalf("Failed to get expected response")
-	}
-
-	//subscriptions rab mod
-	_, err = sendRequest(http.MethodGet, "/subscriptions/rab_mod", nil, nil, nil, http.StatusNotImplemented, SubscriptionLinkListSubscriptionsRmGET)
-	if err != nil {
-		t.Fatalf("Failed to get expected response")
-	}
-	_, err = sendRequest(http.MethodGet, "/subscriptions/rab_mod", nil, nil, nil, http.StatusNotImplemented, RabModSubscriptionSubscriptionsGET)
-	if err != nil {
-		t.Fatalf("Failed to get expected response")
-	}
-	_, err = sendRequest(http.MethodPost, "/subscriptions/rab_mod", nil, nil, nil, http.StatusNotImplemented, RabModSubscriptionSubscriptionsPOST)
-	if err != nil {
-		t.Fatalf("Failed to get expected response")
-	}
-	_, err = sendRequest(http.MethodPut, "/subscriptions/rab_mod", nil, nil, nil, http.StatusNotImplemented, RabModSubscriptionSubscriptionsPUT)
-	if err != nil {
-		t.Fatalf("Failed to get expected response")
-	}
-	_, err = sendRequest(http.MethodDelete, "/subscriptions/rab_mod", nil, nil, nil, http.StatusNotImplemented, RabModSubscriptionsSubscrIdDELETE)
-	if err != nil {
-		t.Fatalf("Failed to get expected response")
-	}
-
-	//subscriptions rab rel
-	_, err = sendRequest(http.MethodGet, "/subscriptions/rab_rel", nil, nil, nil, http.StatusNotImplemented, SubscriptionLinkListSubscriptionsRrGET)
-	if err != nil {
-		t.Fatalf("Failed to get expected response")
-	}
-	_, err = sendRequest(http.MethodGet, "/subscriptions/rab_rel", nil, nil, nil, http.StatusNotImplemented, RabRelSubscriptionSubscriptionsGET)
-	if err != nil {
-		t.Fatalf("Failed to get expected response")
-	}
-	_, err = sendRequest(http.MethodPost, "/subscriptions/rab_rel", nil, nil, nil, http.StatusNotImplemented, RabRelSubscriptionSubscriptionsPOST)
-	if err != nil {
-		t.Fatalf("Failed to get expected response")
-	}
-	_, err = sendRequest(http.MethodPut, "/subscriptions/rab_rel", nil, nil, nil, http.StatusNotImplemented, RabRelSubscriptionSubscriptionsPUT)
-	if err != nil {
-		t.Fatalf("Failed to get expected response")
-	}
-	_, err = sendRequest(http.MethodDelete, "/subscriptions/rab_rel", nil, nil, nil, http.StatusNotImplemented, RabRelSubscriptionsSubscrIdDELETE)
-	if err != nil {
-		t.Fatalf("Failed to get expected response")
-	}
-
-	//subscriptions ca reconf
-	_, err = sendRequest(http.MethodGet, "/subscriptions/ca_reconf", nil, nil, nil, http.StatusNotImplemented, SubscriptionLinkListSubscriptionsCrGET)
-	if err != nil {
-		t.Fatalf("Failed to get expected response")
-	}
-	_, err = sendRequest(http.MethodGet, "/subscriptions/ca_reconf", nil, nil, nil, http.StatusNotImplemented, CaReConfSubscriptionSubscriptionsGET)
-	if err != nil {
-		t.Fatalf("Failed to get expected response")
-	}
-	_, err = sendRequest(http.MethodPost, "/subscriptions/ca_reconf", nil, nil, nil, http.StatusNotImplemented, CaReConfSubscriptionSubscriptionsPOST)
-	if err != nil {
-		t.Fatalf("Failed to get expected response")
-	}
-	_, err = sendRequest(http.MethodPut, "/subscriptions/ca_reconf", nil, nil, nil, http.StatusNotImplemented, CaReConfSubscriptionSubscriptionsPUT)
-	if err != nil {
-		t.Fatalf("Failed to get expected response")
-	}
-	_, err = sendRequest(http.MethodDelete, "/subscriptions/ca_reconf", nil, nil, nil, http.StatusNotImplemented, CaReConfSubscriptionsSubscrIdDELETE)
-	if err != nil {
-		t.Fatalf("Failed to get expected response")
-	}
-
-}
-
 func TestSuccessSubscriptionCellChange(t *testing.T) {
 	fmt.Println("--- ", t.Name())
 	log.MeepTextLogInit(t.Name())
@@ -713,16 +544,16 @@ func TestSuccessSubscriptionCellChange(t *testing.T) {
 	expectedGetResp := testSubscriptionCellChangePost(t)
 
 	//get
-	testSubscriptionCellChangeGet(t, strconv.Itoa(nextSubscriptionIdAvailable-1), expectedGetResp)
+	testSubscriptionCellChangeGet(t, strconv.Itoa(subMgr.NextId(cellChangeSubscriptionType)-1), expectedGetResp)
 
 	//put
-	expectedGetResp = testSubscriptionCellChangePut(t, strconv.Itoa(nextSubscriptionIdAvailable-1), true)
+	expectedGetResp = testSubscriptionCellChangePut(t, strconv.Itoa(subMgr.NextId(cellChangeSubscriptionType)-1), true)
 
 	//get
-	testSubscriptionCellChangeGet(t, strconv.Itoa(nextSubscriptionIdAvailable-1), expectedGetResp)
+	testSubscriptionCellChangeGet(t, strconv.Itoa(subMgr.NextId(cellChangeSubscriptionType)-1), expectedGetResp)
 
 	//delete
-	testSubscriptionCellChangeDelete(t, strconv.Itoa(nextSubscriptionIdAvailable-1))
+	testSubscriptionCellChangeDelete(t, strconv.Itoa(subMgr.NextId(cellChangeSubscriptionType)-1))
 
 	terminateScenario()
 }
@@ -746,13 +577,13 @@ func TestFailSubscriptionCellChange(t *testing.T) {
 	initialiseScenario(testScenario)
 
 	//get
-	testSubscriptionCellChangeGet(t, strconv.Itoa(nextSubscriptionIdAvailable), "")
+	testSubscriptionCellChangeGet(t, strconv.Itoa(subMgr.NextId(cellChangeSubscriptionType)), "")
 
 	//put
-	_ = testSubscriptionCellChangePut(t, strconv.Itoa(nextSubscriptionIdAvailable), false)
+	_ = testSubscriptionCellChangePut(t, strconv.Itoa(subMgr.NextId(cellChangeSubscriptionType)), false)
 
 	//delete
-	testSubscriptionCellChangeDelete(t, strconv.Itoa(nextSubscriptionIdAvailable))
+	testSubscriptionCellChangeDelete(t, strconv.Itoa(subMgr.NextId(cellChangeSubscriptionType)))
 
 	terminateScenario()
 }
@@ -786,8 +617,8 @@ func TestSubscriptionsListGet(t *testing.T) {
 	testSubscriptionListGet(t)
 
 	//delete
-	testSubscriptionCellChangeDelete(t, strconv.Itoa(nextSubscriptionIdAvailable-1))
-	testSubscriptionCellChangeDelete(t, strconv.Itoa(nextSubscriptionIdAvailable-2))
+	testSubscriptionCellChangeDelete(t, strconv.Itoa(subMgr.NextId(cellChangeSubscriptionType)-1))
+	testSubscriptionCellChangeDelete(t, strconv.Itoa(subMgr.NextId(cellChangeSubscriptionType)-2))
 
 	terminateScenario()
 }
@@ -886,7 +717,7 @@ func testSubscriptionCellChangePost(t *testing.T) string {
 	hostatus := COMPLETED
 	expectedFilter := FilterCriteriaAssocHo{"myApp", &AssociateId{"UE_IPV4_ADDRESS", "1.1.1.1"}, &Plmn{"111", "222"}, []string{"1234567"}, &hostatus}
 	expectedCallBackRef := "myCallbakRef"
-	expectedLink := Link{"/" + testScenarioName + "/rni/v1/subscriptions/cell_change/" + strconv.Itoa(nextSubscriptionIdAvailable)}
+	expectedLink := Link{"/" + testScenarioName + "/rni/v1/subscriptions/cell_change/" + strconv.Itoa(subMgr.NextId(cellChangeSubscriptionType))}
 	expectedExpiry := TimeStamp{1988599770, 0}
 	expectedResponse := InlineResponse201{&CellChangeSubscription{expectedCallBackRef, &expectedLink, &expectedFilter, &expectedExpiry}}
 
@@ -1079,6 +910,2174 @@ func testSubscriptionCellChangeDelete(t *testing.T, subscriptionId string) {
 	}
 }
 
+func TestSuccessSubscriptionMeasRepUe(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	initializeVars()
+
+	err := Init()
+	if err != nil {
+		t.Fatalf("Error initializing test basic procedure")
+	}
+	err = Run()
+	if err != nil {
+		t.Fatalf("Error running test basic procedure")
+	}
+
+	fmt.Println("Set a scenario")
+	initialiseScenario(testScenario)
+
+	//post
+	expectedGetResp := testSubscriptionMeasRepUePost(t)
+
+	//get
+	testSubscriptionMeasRepUeGet(t, strconv.Itoa(subMgr.NextId(measRepUeSubscriptionType)-1), expectedGetResp)
+
+	//put
+	expectedGetResp = testSubscriptionMeasRepUePut(t, strconv.Itoa(subMgr.NextId(measRepUeSubscriptionType)-1), true)
+
+	//get
+	testSubscriptionMeasRepUeGet(t, strconv.Itoa(subMgr.NextId(measRepUeSubscriptionType)-1), expectedGetResp)
+
+	//delete
+	testSubscriptionMeasRepUeDelete(t, strconv.Itoa(subMgr.NextId(measRepUeSubscriptionType)-1))
+
+	terminateScenario()
+}
+
+func TestFailSubscriptionMeasRepUe(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	initializeVars()
+
+	err := Init()
+	if err != nil {
+		t.Fatalf("Error initializing test basic procedure")
+	}
+	err = Run()
+	if err != nil {
+		t.Fatalf("Error running test basic procedure")
+	}
+
+	fmt.Println("Set a scenario")
+	initialiseScenario(testScenario)
+
+	//get
+	testSubscriptionMeasRepUeGet(t, strconv.Itoa(subMgr.NextId(measRepUeSubscriptionType)), "")
+
+	//put
+	_ = testSubscriptionMeasRepUePut(t, strconv.Itoa(subMgr.NextId(measRepUeSubscriptionType)), false)
+
+	//delete
+	testSubscriptionMeasRepUeDelete(t, strconv.Itoa(subMgr.NextId(measRepUeSubscriptionType)))
+
+	//post with unsupported trigger
+	trigger := EVENT_B1
+	measRepUeSubscriptionPost1 := MeasRepUeSubscriptionPost1{&MeasRepUeSubscriptionPost{"myCallbakRef", &FilterCriteriaAssocTri{Trigger: &trigger}, nil}}
+	body, err := json.Marshal(measRepUeSubscriptionPost1)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	_, err = sendRequest(http.MethodPost, "/subscriptions/meas_rep_ue", bytes.NewBuffer(body), nil, nil, http.StatusBadRequest, MeasRepUeSubscriptionsPOST)
+	if err != nil {
+		t.Fatalf("Failed to get expected response")
+	}
+
+	terminateScenario()
+}
+
+func testSubscriptionMeasRepUePost(t *testing.T) string {
+
+	/******************************
+	         * expected response section
+		 ******************************/
+	trigger := PERIODICAL_REPORT_STRONGEST_CELLS
+	expectedFilter := FilterCriteriaAssocTri{"", &AssociateId{"UE_IPV4_ADDRESS", "1.1.1.1"}, &Plmn{"111", "222"}, []string{"1234567"}, &trigger}
+	expectedCallBackRef := "myCallbakRef"
+	expectedLink := Link{"/" + testScenarioName + "/rni/v1/subscriptions/meas_rep_ue/" + strconv.Itoa(subMgr.NextId(measRepUeSubscriptionType))}
+	expectedExpiry := TimeStamp{1988599770, 0}
+	expectedResponse := InlineResponse2013{&MeasRepUeSubscription{expectedCallBackRef, &expectedLink, &expectedFilter, &expectedExpiry}}
+
+	expectedResponseStr, err := json.Marshal(expectedResponse)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+
+	/******************************
+	 * request vars section
+	 ******************************/
+
+	/******************************
+	 * request body section
+	 ******************************/
+
+	measRepUeSubscriptionPost1 := MeasRepUeSubscriptionPost1{&MeasRepUeSubscriptionPost{expectedCallBackRef, &expectedFilter, &expectedExpiry}}
+
+	body, err := json.Marshal(measRepUeSubscriptionPost1)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+
+	/******************************
+	 * request queries section
+	 ******************************/
+
+	/******************************
+	 * request execution section
+	 ******************************/
+
+	rr, err := sendRequest(http.MethodPost, "/subscriptions/meas_rep_ue", bytes.NewBuffer(body), nil, nil, http.StatusCreated, MeasRepUeSubscriptionsPOST)
+	if err != nil {
+		t.Fatalf("Failed to get expected response")
+	}
+
+	var respBody InlineResponse2013
+	err = json.Unmarshal([]byte(rr), &respBody)
+	if err != nil {
+		t.Fatalf("Failed to get expected response")
+	}
+	if rr != string(expectedResponseStr) {
+		t.Fatalf("Failed to get expected response")
+	}
+	return string(expectedResponseStr)
+}
+
+func testSubscriptionMeasRepUePut(t *testing.T, subscriptionId string, expectSuccess bool) string {
+
+	/******************************
+	 * expected response section
+	 ******************************/
+	trigger := EVENT_A3
+	expectedFilter := FilterCriteriaAssocTri{"", &AssociateId{"UE_IPV4_ADDRESS", "2.2.2.2"}, &Plmn{"111", "222"}, []string{"1234567"}, &trigger}
+	expectedCallBackRef := "myCallbakRef"
+	expectedLink := Link{"/" + testScenarioName + "/rni/v1/subscriptions/meas_rep_ue/" + subscriptionId}
+	expectedExpiry := TimeStamp{1988599770, 0}
+	expectedResponse := InlineResponse2006{&MeasRepUeSubscription{expectedCallBackRef, &expectedLink, &expectedFilter, &expectedExpiry}}
+
+	expectedResponseStr, err := json.Marshal(expectedResponse)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+
+	/******************************
+	 * request vars section
+	 ******************************/
+	vars := make(map[string]string)
+	vars["subscriptionId"] = subscriptionId
+
+	/******************************
+	 * request body section
+	 ******************************/
+	measRepUeSubscription1 := MeasRepUeSubscription1{&MeasRepUeSubscription{expectedCallBackRef, &expectedLink, &expectedFilter, &expectedExpiry}}
+
+	body, err := json.Marshal(measRepUeSubscription1)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+
+	/******************************
+	 * request queries section
+	 ******************************/
+
+	/******************************
+	 * request execution section
+	 ******************************/
+
+	if expectSuccess {
+		rr, err := sendRequest(http.MethodPost, "/subscriptions/meas_rep_ue", bytes.NewBuffer(body), vars, nil, http.StatusOK, MeasRepUeReportSubscriptionsPUT)
+		if err != nil {
+			t.Fatalf("Failed to get expected response")
+		}
+
+		var respBody InlineResponse2006
+		err = json.Unmarshal([]byte(rr), &respBody)
+		if err != nil {
+			t.Fatalf("Failed to get expected response")
+		}
+		if rr != string(expectedResponseStr) {
+			t.Fatalf("Failed to get expected response")
+		}
+		return string(expectedResponseStr)
+	} else {
+		_, err = sendRequest(http.MethodPost, "/subscriptions/meas_rep_ue", bytes.NewBuffer(body), vars, nil, http.StatusNotFound, MeasRepUeReportSubscriptionsPUT)
+		if err != nil {
+			t.Fatalf("Failed to get expected response")
+		}
+		return ""
+	}
+}
+
+func testSubscriptionMeasRepUeGet(t *testing.T, subscriptionId string, expectedResponse string) {
+
+	/******************************
+	 * expected response section
+	 ******************************/
+	//passed as a parameter since a POST had to be sent first
+
+	/******************************
+	 * request vars section
+	 ******************************/
+	vars := make(map[string]string)
+	vars["subscriptionId"] = subscriptionId
+
+	/******************************
+	 * request body section
+	 ******************************/
+
+	/******************************
+	 * request queries section
+	 ******************************/
+
+	/******************************
+	 * request execution section
+	 ******************************/
+	var err error
+	if expectedResponse == "" {
+		_, err = sendRequest(http.MethodGet, "/subscriptions/meas_rep_ue", nil, vars, nil, http.StatusNotFound, MeasRepUeSubscriptionsGET)
+		if err != nil {
+			t.Fatalf("Failed to get expected response")
+		}
+	} else {
+		rr, err := sendRequest(http.MethodGet, "/subscriptions/meas_rep_ue", nil, vars, nil, http.StatusOK, MeasRepUeSubscriptionsGET)
+		if err != nil {
+			t.Fatalf("Failed to get expected response")
+		}
+
+		var respBody InlineResponse2006
+		err = json.Unmarshal([]byte(rr), &respBody)
+		if err != nil {
+			t.Fatalf("Failed to get expected response")
+		}
+		if rr != expectedResponse {
+			t.Fatalf("Failed to get expected response")
+		}
+	}
+}
+
+func testSubscriptionMeasRepUeDelete(t *testing.T, subscriptionId string) {
+
+	/******************************
+	 * expected response section
+	 ******************************/
+
+	/******************************
+	 * request vars section
+	 ******************************/
+	vars := make(map[string]string)
+	vars["subscriptionId"] = subscriptionId
+
+	/******************************
+	 * request body section
+	 ******************************/
+
+	/******************************
+	 * request queries section
+	 ******************************/
+
+	/******************************
+	 * request execution section
+	 ******************************/
+
+	_, err := sendRequest(http.MethodDelete, "/subscriptions/meas_rep_ue", nil, vars, nil, http.StatusNoContent, MeasRepUeSubscriptionsSubscrIdDELETE)
+	if err != nil {
+		t.Fatalf("Failed to get expected response")
+	}
+}
+
+func TestMeasRepUeEventTrigger(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	ecgi := newEcgi("111", "222", "1234567")
+	neighbourEcgi := newEcgi("111", "222", "7654321")
+
+	fmt.Println("Strong serving cell only")
+	measInfo := &ueMeasInfo{ecgi: ecgi, rsrp: 70, rsrq: 30}
+	if !isMrEventConditionMet(EVENT_A1, measInfo) || isMrEventConditionMet(EVENT_A2, measInfo) ||
+		isMrEventConditionMet(EVENT_A3, measInfo) || isMrEventConditionMet(EVENT_A4, measInfo) ||
+		isMrEventConditionMet(EVENT_A5, measInfo) {
+		t.Fatalf("Wrong event condition")
+	}
+
+	fmt.Println("Weak serving cell & strong neighbour cell")
+	measInfo = &ueMeasInfo{ecgi: ecgi, rsrp: 42, rsrq: 10, neighbours: []cellMeasInfo{{neighbourEcgi, 60, 25}}}
+	if isMrEventConditionMet(EVENT_A1, measInfo) || !isMrEventConditionMet(EVENT_A2, measInfo) ||
+		!isMrEventConditionMet(EVENT_A3, measInfo) || !isMrEventConditionMet(EVENT_A4, measInfo) ||
+		!isMrEventConditionMet(EVENT_A5, measInfo) {
+		t.Fatalf("Wrong event condition")
+	}
+
+	fmt.Println("Filter criteria")
+	trigger := EVENT_A3
+	sub := &MeasRepUeSubscription{FilterCriteria: &FilterCriteriaAssocTri{"", &AssociateId{"UE_IPV4_ADDRESS", "ue1"}, &Plmn{"222", "111"}, []string{"1234567"}, &trigger}}
+	if !isMrFilterMatch(sub, "ue1", measInfo) {
+		t.Fatalf("Filter should match")
+	}
+	if isMrFilterMatch(sub, "ue2", measInfo) {
+		t.Fatalf("Filter should not match")
+	}
+	sub.FilterCriteria.CellId = []string{"7654321"}
+	if isMrFilterMatch(sub, "ue1", measInfo) {
+		t.Fatalf("Filter should not match")
+	}
+
+	fmt.Println("Configured thresholds & offset")
+	os.Setenv("MEEP_MEAS_REP_A1_THRESHOLD", "-60")
+	os.Setenv("MEEP_MEAS_REP_A3_OFFSET", "invalid")
+	defer os.Unsetenv("MEEP_MEAS_REP_A1_THRESHOLD")
+	defer os.Unsetenv("MEEP_MEAS_REP_A3_OFFSET")
+	defer func() { mrEventCfg = defaultMrEventCfg }()
+	setMrEventParam("MEEP_MEAS_REP_A1_THRESHOLD", &mrEventCfg.a1Threshold)
+	setMrEventParam("MEEP_MEAS_REP_A3_OFFSET", &mrEventCfg.a3Offset)
+	if mrEventCfg.a1Threshold != -60 || mrEventCfg.a3Offset != defaultMrEventCfg.a3Offset {
+		t.Fatalf("Wrong event configuration")
+	}
+	measInfo = &ueMeasInfo{ecgi: ecgi, rsrp: 70, rsrq: 30}
+	if isMrEventConditionMet(EVENT_A1, measInfo) {
+		t.Fatalf("Wrong event condition")
+	}
+	mrEventCfg.a3Offset = 20
+	measInfo = &ueMeasInfo{ecgi: ecgi, rsrp: 42, rsrq: 10, neighbours: []cellMeasInfo{{neighbourEcgi, 60, 25}}}
+	if isMrEventConditionMet(EVENT_A3, measInfo) {
+		t.Fatalf("Wrong event condition")
+	}
+}
+
+func TestSuccessSubscriptionRabEst(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	initializeVars()
+
+	err := Init()
+	if err != nil {
+		t.Fatalf("Error initializing test basic procedure")
+	}
+	err = Run()
+	if err != nil {
+		t.Fatalf("Error running test basic procedure")
+	}
+
+	fmt.Println("Set a scenario")
+	initialiseScenario(testScenario)
+
+	//post
+	expectedGetResp := testSubscriptionRabEstPost(t)
+
+	//get
+	testSubscriptionRabEstGet(t, strconv.Itoa(subMgr.NextId(rabEstSubscriptionType)-1), expectedGetResp)
+
+	//put
+	expectedGetResp = testSubscriptionRabEstPut(t, strconv.Itoa(subMgr.NextId(rabEstSubscriptionType)-1), true)
+
+	//get
+	testSubscriptionRabEstGet(t, strconv.Itoa(subMgr.NextId(rabEstSubscriptionType)-1), expectedGetResp)
+
+	//delete
+	testSubscriptionRabEstDelete(t, strconv.Itoa(subMgr.NextId(rabEstSubscriptionType)-1))
+
+	terminateScenario()
+}
+
+func TestFailSubscriptionRabEst(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	initializeVars()
+
+	err := Init()
+	if err != nil {
+		t.Fatalf("Error initializing test basic procedure")
+	}
+	err = Run()
+	if err != nil {
+		t.Fatalf("Error running test basic procedure")
+	}
+
+	fmt.Println("Set a scenario")
+	initialiseScenario(testScenario)
+
+	//get
+	testSubscriptionRabEstGet(t, strconv.Itoa(subMgr.NextId(rabEstSubscriptionType)), "")
+
+	//put
+	_ = testSubscriptionRabEstPut(t, strconv.Itoa(subMgr.NextId(rabEstSubscriptionType)), false)
+
+	//delete
+	testSubscriptionRabEstDelete(t, strconv.Itoa(subMgr.NextId(rabEstSubscriptionType)))
+
+	terminateScenario()
+}
+
+func testSubscriptionRabEstPost(t *testing.T) string {
+
+	/******************************
+	         * expected response section
+		 ******************************/
+	expectedFilter := FilterCriteriaAssocQci{"", &AssociateId{"UE_IPV4_ADDRESS", "1.1.1.1"}, &Plmn{"111", "222"}, []string{"1234567"}, 9}
+	expectedCallBackRef := "myCallbakRef"
+	expectedLink := Link{"/" + testScenarioName + "/rni/v1/subscriptions/rab_est/" + strconv.Itoa(subMgr.NextId(rabEstSubscriptionType))}
+	expectedExpiry := TimeStamp{1988599770, 0}
+	expectedResponse := InlineResponse2014{&RabEstSubscription{expectedCallBackRef, &expectedLink, &expectedFilter, &expectedExpiry}}
+
+	expectedResponseStr, err := json.Marshal(expectedResponse)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+
+	/******************************
+	 * request vars section
+	 ******************************/
+
+	/******************************
+	 * request body section
+	 ******************************/
+
+	rabEstSubscriptionPost1 := RabEstSubscriptionPost1{&RabEstSubscriptionPost{expectedCallBackRef, &expectedFilter, &expectedExpiry}}
+
+	body, err := json.Marshal(rabEstSubscriptionPost1)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+
+	/******************************
+	 * request queries section
+	 ******************************/
+
+	/******************************
+	 * request execution section
+	 ******************************/
+
+	rr, err := sendRequest(http.MethodPost, "/subscriptions/rab_est", bytes.NewBuffer(body), nil, nil, http.StatusCreated, RabEstSubscriptionSubscriptionsPOST)
+	if err != nil {
+		t.Fatalf("Failed to get expected response")
+	}
+
+	var respBody InlineResponse2014
+	err = json.Unmarshal([]byte(rr), &respBody)
+	if err != nil {
+		t.Fatalf("Failed to get expected response")
+	}
+	if rr != string(expectedResponseStr) {
+		t.Fatalf("Failed to get expected response")
+	}
+	return string(expectedResponseStr)
+}
+
+func testSubscriptionRabEstPut(t *testing.T, subscriptionId string, expectSuccess bool) string {
+
+	/******************************
+	 * expected response section
+	 ******************************/
+	expectedFilter := FilterCriteriaAssocQci{"", &AssociateId{"UE_IPV4_ADDRESS", "2.2.2.2"}, &Plmn{"111", "222"}, []string{"1234567"}, 9}
+	expectedCallBackRef := "myCallbakRef"
+	expectedLink := Link{"/" + testScenarioName + "/rni/v1/subscriptions/rab_est/" + subscriptionId}
+	expectedExpiry := TimeStamp{1988599770, 0}
+	expectedResponse := InlineResponse2007{&RabEstSubscription{expectedCallBackRef, &expectedLink, &expectedFilter, &expectedExpiry}}
+
+	expectedResponseStr, err := json.Marshal(expectedResponse)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+
+	/******************************
+	 * request vars section
+	 ******************************/
+	vars := make(map[string]string)
+	vars["subscriptionId"] = subscriptionId
+
+	/******************************
+	 * request body section
+	 ******************************/
+	rabEstSubscription1 := RabEstSubscription1{&RabEstSubscription{expectedCallBackRef, &expectedLink, &expectedFilter, &expectedExpiry}}
+
+	body, err := json.Marshal(rabEstSubscription1)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+
+	/******************************
+	 * request queries section
+	 ******************************/
+
+	/******************************
+	 * request execution section
+	 ******************************/
+
+	if expectSuccess {
+		rr, err := sendRequest(http.MethodPost, "/subscriptions/rab_est", bytes.NewBuffer(body), vars, nil, http.StatusOK, RabEstSubscriptionSubscriptionsPUT)
+		if err != nil {
+			t.Fatalf("Failed to get expected response")
+		}
+
+		var respBody InlineResponse2007
+		err = json.Unmarshal([]byte(rr), &respBody)
+		if err != nil {
+			t.Fatalf("Failed to get expected response")
+		}
+		if rr != string(expectedResponseStr) {
+			t.Fatalf("Failed to get expected response")
+		}
+		return string(expectedResponseStr)
+	} else {
+		_, err = sendRequest(http.MethodPost, "/subscriptions/rab_est", bytes.NewBuffer(body), vars, nil, http.StatusNotFound, RabEstSubscriptionSubscriptionsPUT)
+		if err != nil {
+			t.Fatalf("Failed to get expected response")
+		}
+		return ""
+	}
+}
+
+func testSubscriptionRabEstGet(t *testing.T, subscriptionId string, expectedResponse string) {
+
+	/******************************
+	 * expected response section
+	 ******************************/
+	//passed as a parameter since a POST had to be sent first
+
+	/******************************
+	 * request vars section
+	 ******************************/
+	vars := make(map[string]string)
+	vars["subscriptionId"] = subscriptionId
+
+	/******************************
+	 * request body section
+	 ******************************/
+
+	/******************************
+	 * request queries section
+	 ******************************/
+
+	/******************************
+	 * request execution section
+	 ******************************/
+	var err error
+	if expectedResponse == "" {
+		_, err = sendRequest(http.MethodGet, "/subscriptions/rab_est", nil, vars, nil, http.StatusNotFound, RabEstSubscriptionSubscriptionsGET)
+		if err != nil {
+			t.Fatalf("Failed to get expected response")
+		}
+	} else {
+		rr, err := sendRequest(http.MethodGet, "/subscriptions/rab_est", nil, vars, nil, http.StatusOK, RabEstSubscriptionSubscriptionsGET)
+		if err != nil {
+			t.Fatalf("Failed to get expected response")
+		}
+
+		var respBody InlineResponse2007
+		err = json.Unmarshal([]byte(rr), &respBody)
+		if err != nil {
+			t.Fatalf("Failed to get expected response")
+		}
+		if rr != expectedResponse {
+			t.Fatalf("Failed to get expected response")
+		}
+	}
+}
+
+func testSubscriptionRabEstDelete(t *testing.T, subscriptionId string) {
+
+	/******************************
+	 * expected response section
+	 ******************************/
+
+	/******************************
+	 * request vars section
+	 ******************************/
+	vars := make(map[string]string)
+	vars["subscriptionId"] = subscriptionId
+
+	/******************************
+	 * request body section
+	 ******************************/
+
+	/******************************
+	 * request queries section
+	 ******************************/
+
+	/******************************
+	 * request execution section
+	 ******************************/
+
+	_, err := sendRequest(http.MethodDelete, "/subscriptions/rab_est", nil, vars, nil, http.StatusNoContent, RabEstSubscriptionsSubscrIdDELETE)
+	if err != nil {
+		t.Fatalf("Failed to get expected response")
+	}
+}
+
+func TestSuccessSubscriptionRabMod(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	initializeVars()
+
+	err := Init()
+	if err != nil {
+		t.Fatalf("Error initializing test basic procedure")
+	}
+	err = Run()
+	if err != nil {
+		t.Fatalf("Error running test basic procedure")
+	}
+
+	fmt.Println("Set a scenario")
+	initialiseScenario(testScenario)
+
+	//post
+	expectedGetResp := testSubscriptionRabModPost(t)
+
+	//get
+	testSubscriptionRabModGet(t, strconv.Itoa(subMgr.NextId(rabModSubscriptionType)-1), expectedGetResp)
+
+	//put
+	expectedGetResp = testSubscriptionRabModPut(t, strconv.Itoa(subMgr.NextId(rabModSubscriptionType)-1), true)
+
+	//get
+	testSubscriptionRabModGet(t, strconv.Itoa(subMgr.NextId(rabModSubscriptionType)-1), expectedGetResp)
+
+	//delete
+	testSubscriptionRabModDelete(t, strconv.Itoa(subMgr.NextId(rabModSubscriptionType)-1))
+
+	terminateScenario()
+}
+
+func TestFailSubscriptionRabMod(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	initializeVars()
+
+	err := Init()
+	if err != nil {
+		t.Fatalf("Error initializing test basic procedure")
+	}
+	err = Run()
+	if err != nil {
+		t.Fatalf("Error running test basic procedure")
+	}
+
+	fmt.Println("Set a scenario")
+	initialiseScenario(testScenario)
+
+	//get
+	testSubscriptionRabModGet(t, strconv.Itoa(subMgr.NextId(rabModSubscriptionType)), "")
+
+	//put
+	_ = testSubscriptionRabModPut(t, strconv.Itoa(subMgr.NextId(rabModSubscriptionType)), false)
+
+	//delete
+	testSubscriptionRabModDelete(t, strconv.Itoa(subMgr.NextId(rabModSubscriptionType)))
+
+	terminateScenario()
+}
+
+func testSubscriptionRabModPost(t *testing.T) string {
+
+	/******************************
+	         * expected response section
+		 ******************************/
+	expectedFilter := FilterCriteriaAssocQci{"", &AssociateId{"UE_IPV4_ADDRESS", "1.1.1.1"}, &Plmn{"111", "222"}, []string{"1234567"}, 9}
+	expectedCallBackRef := "myCallbakRef"
+	expectedLink := Link{"/" + testScenarioName + "/rni/v1/subscriptions/rab_mod/" + strconv.Itoa(subMgr.NextId(rabModSubscriptionType))}
+	expectedExpiry := TimeStamp{1988599770, 0}
+	expectedResponse := InlineResponse2015{&RabModSubscription{expectedCallBackRef, &expectedLink, &expectedFilter, &expectedExpiry}}
+
+	expectedResponseStr, err := json.Marshal(expectedResponse)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+
+	/******************************
+	 * request vars section
+	 ******************************/
+
+	/******************************
+	 * request body section
+	 ******************************/
+
+	rabModSubscriptionPost1 := RabModSubscriptionPost1{&RabModSubscriptionPost{expectedCallBackRef, &expectedFilter, &expectedExpiry}}
+
+	body, err := json.Marshal(rabModSubscriptionPost1)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+
+	/******************************
+	 * request queries section
+	 ******************************/
+
+	/******************************
+	 * request execution section
+	 ******************************/
+
+	rr, err := sendRequest(http.MethodPost, "/subscriptions/rab_mod", bytes.NewBuffer(body), nil, nil, http.StatusCreated, RabModSubscriptionSubscriptionsPOST)
+	if err != nil {
+		t.Fatalf("Failed to get expected response")
+	}
+
+	var respBody InlineResponse2015
+	err = json.Unmarshal([]byte(rr), &respBody)
+	if err != nil {
+		t.Fatalf("Failed to get expected response")
+	}
+	if rr != string(expectedResponseStr) {
+		t.Fatalf("Failed to get expected response")
+	}
+	return string(expectedResponseStr)
+}
+
+func testSubscriptionRabModPut(t *testing.T, subscriptionId string, expectSuccess bool) string {
+
+	/******************************
+	 * expected response section
+	 ******************************/
+	expectedFilter := FilterCriteriaAssocQci{"", &AssociateId{"UE_IPV4_ADDRESS", "2.2.2.2"}, &Plmn{"111", "222"}, []string{"1234567"}, 9}
+	expectedCallBackRef := "myCallbakRef"
+	expectedLink := Link{"/" + testScenarioName + "/rni/v1/subscriptions/rab_mod/" + subscriptionId}
+	expectedExpiry := TimeStamp{1988599770, 0}
+	expectedResponse := InlineResponse2008{&RabModSubscription{expectedCallBackRef, &expectedLink, &expectedFilter, &expectedExpiry}}
+
+	expectedResponseStr, err := json.Marshal(expectedResponse)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+
+	/******************************
+	 * request vars section
+	 ******************************/
+	vars := make(map[string]string)
+	vars["subscriptionId"] = subscriptionId
+
+	/******************************
+	 * request body section
+	 ******************************/
+	rabModSubscription1 := RabModSubscription1{&RabModSubscription{expectedCallBackRef, &expectedLink, &expectedFilter, &expectedExpiry}}
+
+	body, err := json.Marshal(rabModSubscription1)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+
+	/******************************
+	 * request queries section
+	 ******************************/
+
+	/******************************
+	 * request execution section
+	 ******************************/
+
+	if expectSuccess {
+		rr, err := sendRequest(http.MethodPost, "/subscriptions/rab_mod", bytes.NewBuffer(body), vars, nil, http.StatusOK, RabModSubscriptionSubscriptionsPUT)
+		if err != nil {
+			t.Fatalf("Failed to get expected response")
+		}
+
+		var respBody InlineResponse2008
+		err = json.Unmarshal([]byte(rr), &respBody)
+		if err != nil {
+			t.Fatalf("Failed to get expected response")
+		}
+		if rr != string(expectedResponseStr) {
+			t.Fatalf("Failed to get expected response")
+		}
+		return string(expectedResponseStr)
+	} else {
+		_, err = sendRequest(http.MethodPost, "/subscriptions/rab_mod", bytes.NewBuffer(body), vars, nil, http.StatusNotFound, RabModSubscriptionSubscriptionsPUT)
+		if err != nil {
+			t.Fatalf("Failed to get expected response")
+		}
+		return ""
+	}
+}
+
+func testSubscriptionRabModGet(t *testing.T, subscriptionId string, expectedResponse string) {
+
+	/******************************
+	 * expected response section
+	 ******************************/
+	//passed as a parameter since a POST had to be sent first
+
+	/******************************
+	 * request vars section
+	 ******************************/
+	vars := make(map[string]string)
+	vars["subscriptionId"] = subscriptionId
+
+	/******************************
+	 * request body section
+	 ******************************/
+
+	/******************************
+	 * request queries section
+	 ******************************/
+
+	/******************************
+	 * request execution section
+	 ******************************/
+	var err error
+	if expectedResponse == "" {
+		_, err = sendRequest(http.MethodGet, "/subscriptions/rab_mod", nil, vars, nil, http.StatusNotFound, RabModSubscriptionSubscriptionsGET)
+		if err != nil {
+			t.Fatalf("Failed to get expected response")
+		}
+	} else {
+		rr, err := sendRequest(http.MethodGet, "/subscriptions/rab_mod", nil, vars, nil, http.StatusOK, RabModSubscriptionSubscriptionsGET)
+		if err != nil {
+			t.Fatalf("Failed to get expected response")
+		}
+
+		var respBody InlineResponse2008
+		err = json.Unmarshal([]byte(rr), &respBody)
+		if err != nil {
+			t.Fatalf("Failed to get expected response")
+		}
+		if rr != expectedResponse {
+			t.Fatalf("Failed to get expected response")
+		}
+	}
+}
+
+func testSubscriptionRabModDelete(t *testing.T, subscriptionId string) {
+
+	/******************************
+	 * expected response section
+	 ******************************/
+
+	/******************************
+	 * request vars section
+	 ******************************/
+	vars := make(map[string]string)
+	vars["subscriptionId"] = subscriptionId
+
+	/******************************
+	 * request body section
+	 ******************************/
+
+	/******************************
+	 * request queries section
+	 ******************************/
+
+	/******************************
+	 * request execution section
+	 ******************************/
+
+	_, err := sendRequest(http.MethodDelete, "/subscriptions/rab_mod", nil, vars, nil, http.StatusNoContent, RabModSubscriptionsSubscrIdDELETE)
+	if err != nil {
+		t.Fatalf("Failed to get expected response")
+	}
+}
+
+func TestSuccessSubscriptionRabRel(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	initializeVars()
+
+	err := Init()
+	if err != nil {
+		t.Fatalf("Error initializing test basic procedure")
+	}
+	err = Run()
+	if err != nil {
+		t.Fatalf("Error running test basic procedure")
+	}
+
+	fmt.Println("Set a scenario")
+	initialiseScenario(testScenario)
+
+	//post
+	expectedGetResp := testSubscriptionRabRelPost(t)
+
+	//get
+	testSubscriptionRabRelGet(t, strconv.Itoa(subMgr.NextId(rabRelSubscriptionType)-1), expectedGetResp)
+
+	//put
+	expectedGetResp = testSubscriptionRabRelPut(t, strconv.Itoa(subMgr.NextId(rabRelSubscriptionType)-1), true)
+
+	//get
+	testSubscriptionRabRelGet(t, strconv.Itoa(subMgr.NextId(rabRelSubscriptionType)-1), expectedGetResp)
+
+	//delete
+	testSubscriptionRabRelDelete(t, strconv.Itoa(subMgr.NextId(rabRelSubscriptionType)-1))
+
+	terminateScenario()
+}
+
+func TestFailSubscriptionRabRel(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	initializeVars()
+
+	err := Init()
+	if err != nil {
+		t.Fatalf("Error initializing test basic procedure")
+	}
+	err = Run()
+	if err != nil {
+		t.Fatalf("Error running test basic procedure")
+	}
+
+	fmt.Println("Set a scenario")
+	initialiseScenario(testScenario)
+
+	//get
+	testSubscriptionRabRelGet(t, strconv.Itoa(subMgr.NextId(rabRelSubscriptionType)), "")
+
+	//put
+	_ = testSubscriptionRabRelPut(t, strconv.Itoa(subMgr.NextId(rabRelSubscriptionType)), false)
+
+	//delete
+	testSubscriptionRabRelDelete(t, strconv.Itoa(subMgr.NextId(rabRelSubscriptionType)))
+
+	terminateScenario()
+}
+
+func testSubscriptionRabRelPost(t *testing.T) string {
+
+	/******************************
+	         * expected response section
+		 ******************************/
+	expectedFilter := FilterCriteriaAssocQci{"", &AssociateId{"UE_IPV4_ADDRESS", "1.1.1.1"}, &Plmn{"111", "222"}, []string{"1234567"}, 9}
+	expectedCallBackRef := "myCallbakRef"
+	expectedLink := Link{"/" + testScenarioName + "/rni/v1/subscriptions/rab_rel/" + strconv.Itoa(subMgr.NextId(rabRelSubscriptionType))}
+	expectedExpiry := TimeStamp{1988599770, 0}
+	expectedResponse := InlineResponse2016{&RabRelSubscription{expectedCallBackRef, &expectedLink, &expectedFilter, &expectedExpiry}}
+
+	expectedResponseStr, err := json.Marshal(expectedResponse)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+
+	/******************************
+	 * request vars section
+	 ******************************/
+
+	/******************************
+	 * request body section
+	 ******************************/
+
+	rabRelSubscriptionPost1 := RabRelSubscriptionPost1{&RabRelSubscriptionPost{expectedCallBackRef, &expectedFilter, &expectedExpiry}}
+
+	body, err := json.Marshal(rabRelSubscriptionPost1)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+
+	/******************************
+	 * request queries section
+	 ******************************/
+
+	/******************************
+	 * request execution section
+	 ******************************/
+
+	rr, err := sendRequest(http.MethodPost, "/subscriptions/rab_rel", bytes.NewBuffer(body), nil, nil, http.StatusCreated, RabRelSubscriptionSubscriptionsPOST)
+	if err != nil {
+		t.Fatalf("Failed to get expected response")
+	}
+
+	var respBody InlineResponse2016
+	err = json.Unmarshal([]byte(rr), &respBody)
+	if err != nil {
+		t.Fatalf("Failed to get expected response")
+	}
+	if rr != string(expectedResponseStr) {
+		t.Fatalf("Failed to get expected response")
+	}
+	return string(expectedResponseStr)
+}
+
+func testSubscriptionRabRelPut(t *testing.T, subscriptionId string, expectSuccess bool) string {
+
+	/******************************
+	 * expected response section
+	 ******************************/
+	expectedFilter := FilterCriteriaAssocQci{"", &AssociateId{"UE_IPV4_ADDRESS", "2.2.2.2"}, &Plmn{"111", "222"}, []string{"1234567"}, 9}
+	expectedCallBackRef := "myCallbakRef"
+	expectedLink := Link{"/" + testScenarioName + "/rni/v1/subscriptions/rab_rel/" + subscriptionId}
+	expectedExpiry := TimeStamp{1988599770, 0}
+	expectedResponse := InlineResponse2009{&RabRelSubscription{expectedCallBackRef, &expectedLink, &expectedFilter, &expectedExpiry}}
+
+	expectedResponseStr, err := json.Marshal(expectedResponse)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+
+	/******************************
+	 * request vars section
+	 ******************************/
+	vars := make(map[string]string)
+	vars["subscriptionId"] = subscriptionId
+
+	/******************************
+	 * request body section
+	 ******************************/
+	rabRelSubscription1 := RabRelSubscription1{&RabRelSubscription{expectedCallBackRef, &expectedLink, &expectedFilter, &expectedExpiry}}
+
+	body, err := json.Marshal(rabRelSubscription1)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+
+	/******************************
+	 * request queries section
+	 ******************************/
+
+	/******************************
+	 * request execution section
+	 ******************************/
+
+	if expectSuccess {
+		rr, err := sendRequest(http.MethodPost, "/subscriptions/rab_rel", bytes.NewBuffer(body), vars, nil, http.StatusOK, RabRelSubscriptionSubscriptionsPUT)
+		if err != nil {
+			t.Fatalf("Failed to get expected response")
+		}
+
+		var respBody InlineResponse2009
+		err = json.Unmarshal([]byte(rr), &respBody)
+		if err != nil {
+			t.Fatalf("Failed to get expected response")
+		}
+		if rr != string(expectedResponseStr) {
+			t.Fatalf("Failed to get expected response")
+		}
+		return string(expectedResponseStr)
+	} else {
+		_, err = sendRequest(http.MethodPost, "/subscriptions/rab_rel", bytes.NewBuffer(body), vars, nil, http.StatusNotFound, RabRelSubscriptionSubscriptionsPUT)
+		if err != nil {
+			t.Fatalf("Failed to get expected response")
+		}
+		return ""
+	}
+}
+
+func testSubscriptionRabRelGet(t *testing.T, subscriptionId string, expectedResponse string) {
+
+	/******************************
+	 * expected response section
+	 ******************************/
+	//passed as a parameter since a POST had to be sent first
+
+	/******************************
+	 * request vars section
+	 ******************************/
+	vars := make(map[string]string)
+	vars["subscriptionId"] = subscriptionId
+
+	/******************************
+	 * request body section
+	 ******************************/
+
+	/******************************
+	 * request queries section
+	 ******************************/
+
+	/******************************
+	 * request execution section
+	 ******************************/
+	var err error
+	if expectedResponse == "" {
+		_, err = sendRequest(http.MethodGet, "/subscriptions/rab_rel", nil, vars, nil, http.StatusNotFound, RabRelSubscriptionSubscriptionsGET)
+		if err != nil {
+			t.Fatalf("Failed to get expected response")
+		}
+	} else {
+		rr, err := sendRequest(http.MethodGet, "/subscriptions/rab_rel", nil, vars, nil, http.StatusOK, RabRelSubscriptionSubscriptionsGET)
+		if err != nil {
+			t.Fatalf("Failed to get expected response")
+		}
+
+		var respBody InlineResponse2009
+		err = json.Unmarshal([]byte(rr), &respBody)
+		if err != nil {
+			t.Fatalf("Failed to get expected response")
+		}
+		if rr != expectedResponse {
+			t.Fatalf("Failed to get expected response")
+		}
+	}
+}
+
+func testSubscriptionRabRelDelete(t *testing.T, subscriptionId string) {
+
+	/******************************
+	 * expected response section
+	 ******************************/
+
+	/******************************
+	 * request vars section
+	 ******************************/
+	vars := make(map[string]string)
+	vars["subscriptionId"] = subscriptionId
+
+	/******************************
+	 * request body section
+	 ******************************/
+
+	/******************************
+	 * request queries section
+	 ******************************/
+
+	/******************************
+	 * request execution section
+	 ******************************/
+
+	_, err := sendRequest(http.MethodDelete, "/subscriptions/rab_rel", nil, vars, nil, http.StatusNoContent, RabRelSubscriptionsSubscrIdDELETE)
+	if err != nil {
+		t.Fatalf("Failed to get expected response")
+	}
+}
+
+func TestRabInfoGet(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	initializeVars()
+
+	err := Init()
+	if err != nil {
+		t.Fatalf("Error initializing test basic procedure")
+	}
+	err = Run()
+	if err != nil {
+		t.Fatalf("Error running test basic procedure")
+	}
+
+	fmt.Println("Set a scenario")
+	initialiseScenario(testScenario)
+
+	/******************************
+	 * expected response section
+	 ******************************/
+	expectedMcc := "123"
+	expectedCellId := "2345678"
+	expectedAssocId := "ue1"
+	expectedQci := int32(9)
+
+	/******************************
+	 * request vars section
+	 ******************************/
+
+	/******************************
+	 * request body section
+	 ******************************/
+
+	/******************************
+	 * request queries section
+	 ******************************/
+
+	queries := make(map[string]string)
+	queries["app_ins_id"] = "myApp"
+	queries["ue_ipv4_address"] = "ue1"
+
+	/******************************
+	 * request execution section
+	 ******************************/
+
+	rr, err := sendRequest(http.MethodGet, "/queries/rab_info", nil, nil, queries, http.StatusOK, RabInfoGET)
+	if err != nil {
+		t.Fatalf("Failed to get expected response")
+	}
+
+	var respBody InlineResponse200
+	err = json.Unmarshal([]byte(rr), &respBody)
+	if err != nil {
+		t.Fatalf("Failed to get expected response")
+	}
+	if respBody.RabInfo == nil || len(respBody.RabInfo.CellUserInfo) != 1 {
+		t.Fatalf("Failed to get expected response")
+	}
+	cellUserInfo := respBody.RabInfo.CellUserInfo[0]
+	if cellUserInfo.Ecgi.Plmn.Mcc != expectedMcc || cellUserInfo.Ecgi.CellId[0] != expectedCellId {
+		t.Fatalf("Failed to get expected response")
+	}
+	if len(cellUserInfo.UeInfo) != 1 || cellUserInfo.UeInfo[0].AssociateId[0].Value != expectedAssocId {
+		t.Fatalf("Failed to get expected response")
+	}
+	if len(cellUserInfo.UeInfo[0].ErabInfo) != 1 || cellUserInfo.UeInfo[0].ErabInfo[0].Qci != expectedQci {
+		t.Fatalf("Failed to get expected response")
+	}
+
+	//invalid query
+	queries["qci"] = "invalid"
+	_, err = sendRequest(http.MethodGet, "/queries/rab_info", nil, nil, queries, http.StatusBadRequest, RabInfoGET)
+	if err != nil {
+		t.Fatalf("Failed to get expected response")
+	}
+	delete(queries, "qci")
+
+	//E-RAB released after move to non-cellular POA
+	updateScenario("mobility1")
+
+	_, err = sendRequest(http.MethodGet, "/queries/rab_info", nil, nil, queries, http.StatusNotFound, RabInfoGET)
+	if err != nil {
+		t.Fatalf("Failed to get expected response")
+	}
+
+	/******************************
+	 * back to initial state section
+	 ******************************/
+
+	terminateScenario()
+}
+
+func TestSuccessSubscriptionS1Bearer(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	initializeVars()
+
+	err := Init()
+	if err != nil {
+		t.Fatalf("Error initializing test basic procedure")
+	}
+	err = Run()
+	if err != nil {
+		t.Fatalf("Error running test basic procedure")
+	}
+
+	fmt.Println("Set a scenario")
+	initialiseScenario(testScenario)
+
+	//post
+	expectedGetResp := testSubscriptionS1BearerPost(t)
+
+	//get
+	testSubscriptionS1BearerGet(t, strconv.Itoa(subMgr.NextId(s1BearerSubscriptionType)-1), expectedGetResp)
+
+	//put
+	expectedGetResp = testSubscriptionS1BearerPut(t, strconv.Itoa(subMgr.NextId(s1BearerSubscriptionType)-1), true)
+
+	//get
+	testSubscriptionS1BearerGet(t, strconv.Itoa(subMgr.NextId(s1BearerSubscriptionType)-1), expectedGetResp)
+
+	//delete
+	testSubscriptionS1BearerDelete(t, strconv.Itoa(subMgr.NextId(s1BearerSubscriptionType)-1))
+
+	terminateScenario()
+}
+
+func TestFailSubscriptionS1Bearer(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	initializeVars()
+
+	err := Init()
+	if err != nil {
+		t.Fatalf("Error initializing test basic procedure")
+	}
+	err = Run()
+	if err != nil {
+		t.Fatalf("Error running test basic procedure")
+	}
+
+	fmt.Println("Set a scenario")
+	initialiseScenario(testScenario)
+
+	//get
+	testSubscriptionS1BearerGet(t, strconv.Itoa(subMgr.NextId(s1BearerSubscriptionType)), "")
+
+	//put
+	_ = testSubscriptionS1BearerPut(t, strconv.Itoa(subMgr.NextId(s1BearerSubscriptionType)), false)
+
+	//delete
+	testSubscriptionS1BearerDelete(t, strconv.Itoa(subMgr.NextId(s1BearerSubscriptionType)))
+
+	terminateScenario()
+}
+
+func testSubscriptionS1BearerPost(t *testing.T) string {
+
+	/******************************
+	         * expected response section
+		 ******************************/
+	expectedEventType := S1_BEARER_MODIFY
+	expectedFilter := S1BearerSubscriptionCriteria{&AssociateId{"UE_IPV4_ADDRESS", "1.1.1.1"}, &Plmn{"111", "222"}, []string{"1234567"}, 5}
+	expectedCallBackRef := "myCallbakRef"
+	expectedLink := Link{"/" + testScenarioName + "/rni/v1/subscriptions/s1_bearer/" + strconv.Itoa(subMgr.NextId(s1BearerSubscriptionType))}
+	expectedExpiry := TimeStamp{1988599770, 0}
+	expectedResponse := InlineResponse2011{&S1BearerSubscription{expectedCallBackRef, &expectedLink, &expectedEventType, &expectedFilter, &expectedExpiry}}
+
+	expectedResponseStr, err := json.Marshal(expectedResponse)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+
+	/******************************
+	 * request vars section
+	 ******************************/
+
+	/******************************
+	 * request body section
+	 ******************************/
+
+	s1BearerSubscriptionPost1 := S1BearerSubscriptionPost1{&S1BearerSubscriptionPost{expectedCallBackRef, &expectedEventType, &expectedFilter, &expectedExpiry}}
+
+	body, err := json.Marshal(s1BearerSubscriptionPost1)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+
+	/******************************
+	 * request queries section
+	 ******************************/
+
+	/******************************
+	 * request execution section
+	 ******************************/
+
+	rr, err := sendRequest(http.MethodPost, "/subscriptions/s1_bearer", bytes.NewBuffer(body), nil, nil, http.StatusCreated, S1BearerSubscriptionSubscriptionsPOST)
+	if err != nil {
+		t.Fatalf("Failed to get expected response")
+	}
+
+	var respBody InlineResponse2011
+	err = json.Unmarshal([]byte(rr), &respBody)
+	if err != nil {
+		t.Fatalf("Failed to get expected response")
+	}
+	if rr != string(expectedResponseStr) {
+		t.Fatalf("Failed to get expected response")
+	}
+	return string(expectedResponseStr)
+}
+
+func testSubscriptionS1BearerPut(t *testing.T, subscriptionId string, expectSuccess bool) string {
+
+	/******************************
+	 * expected response section
+	 ******************************/
+	expectedEventType := S1_BEARER_MODIFY
+	expectedFilter := S1BearerSubscriptionCriteria{&AssociateId{"UE_IPV4_ADDRESS", "2.2.2.2"}, &Plmn{"111", "222"}, []string{"1234567"}, 5}
+	expectedCallBackRef := "myCallbakRef"
+	expectedLink := Link{"/" + testScenarioName + "/rni/v1/subscriptions/s1_bearer/" + subscriptionId}
+	expectedExpiry := TimeStamp{1988599770, 0}
+	expectedResponse := InlineResponse2011{&S1BearerSubscription{expectedCallBackRef, &expectedLink, &expectedEventType, &expectedFilter, &expectedExpiry}}
+
+	expectedResponseStr, err := json.Marshal(expectedResponse)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+
+	/******************************
+	 * request vars section
+	 ******************************/
+	vars := make(map[string]string)
+	vars["subscriptionId"] = subscriptionId
+
+	/******************************
+	 * request body section
+	 ******************************/
+	s1BearerSubscription1 := S1BearerSubscription1{&S1BearerSubscription{expectedCallBackRef, &expectedLink, &expectedEventType, &expectedFilter, &expectedExpiry}}
+
+	body, err := json.Marshal(s1BearerSubscription1)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+
+	/******************************
+	 * request queries section
+	 ******************************/
+
+	/******************************
+	 * request execution section
+	 ******************************/
+
+	if expectSuccess {
+		rr, err := sendRequest(http.MethodPost, "/subscriptions/s1_bearer", bytes.NewBuffer(body), vars, nil, http.StatusOK, S1BearerSubscriptionSubscriptionsPUT)
+		if err != nil {
+			t.Fatalf("Failed to get expected response")
+		}
+
+		var respBody InlineResponse2011
+		err = json.Unmarshal([]byte(rr), &respBody)
+		if err != nil {
+			t.Fatalf("Failed to get expected response")
+		}
+		if rr != string(expectedResponseStr) {
+			t.Fatalf("Failed to get expected response")
+		}
+		return string(expectedResponseStr)
+	} else {
+		_, err = sendRequest(http.MethodPost, "/subscriptions/s1_bearer", bytes.NewBuffer(body), vars, nil, http.StatusNotFound, S1BearerSubscriptionSubscriptionsPUT)
+		if err != nil {
+			t.Fatalf("Failed to get expected response")
+		}
+		return ""
+	}
+}
+
+func testSubscriptionS1BearerGet(t *testing.T, subscriptionId string, expectedResponse string) {
+
+	/******************************
+	 * expected response section
+	 ******************************/
+	//passed as a parameter since a POST had to be sent first
+
+	/******************************
+	 * request vars section
+	 ******************************/
+	vars := make(map[string]string)
+	vars["subscriptionId"] = subscriptionId
+
+	/******************************
+	 * request body section
+	 ******************************/
+
+	/******************************
+	 * request queries section
+	 ******************************/
+
+	/******************************
+	 * request execution section
+	 ******************************/
+	var err error
+	if expectedResponse == "" {
+		_, err = sendRequest(http.MethodGet, "/subscriptions/s1_bearer", nil, vars, nil, http.StatusNotFound, S1BearerSubscriptionSubscriptionsGET)
+		if err != nil {
+			t.Fatalf("Failed to get expected response")
+		}
+	} else {
+		rr, err := sendRequest(http.MethodGet, "/subscriptions/s1_bearer", nil, vars, nil, http.StatusOK, S1BearerSubscriptionSubscriptionsGET)
+		if err != nil {
+			t.Fatalf("Failed to get expected response")
+		}
+
+		var respBody InlineResponse2011
+		err = json.Unmarshal([]byte(rr), &respBody)
+		if err != nil {
+			t.Fatalf("Failed to get expected response")
+		}
+		if rr != expectedResponse {
+			t.Fatalf("Failed to get expected response")
+		}
+	}
+}
+
+func testSubscriptionS1BearerDelete(t *testing.T, subscriptionId string) {
+
+	/******************************
+	 * expected response section
+	 ******************************/
+
+	/******************************
+	 * request vars section
+	 ******************************/
+	vars := make(map[string]string)
+	vars["subscriptionId"] = subscriptionId
+
+	/******************************
+	 * request body section
+	 ******************************/
+
+	/******************************
+	 * request queries section
+	 ******************************/
+
+	/******************************
+	 * request execution section
+	 ******************************/
+
+	_, err := sendRequest(http.MethodDelete, "/subscriptions/s1_bearer", nil, vars, nil, http.StatusNoContent, S1BearerSubscriptionsSubscrIdDELETE)
+	if err != nil {
+		t.Fatalf("Failed to get expected response")
+	}
+}
+
+func TestS1BearerInfoGet(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	initializeVars()
+
+	err := Init()
+	if err != nil {
+		t.Fatalf("Error initializing test basic procedure")
+	}
+	err = Run()
+	if err != nil {
+		t.Fatalf("Error running test basic procedure")
+	}
+
+	fmt.Println("Set a scenario")
+	initialiseScenario(testScenario)
+
+	/******************************
+	 * expected response section
+	 ******************************/
+	expectedMcc := "123"
+	expectedCellId := "2345678"
+	expectedAssocId := "ue1"
+	expectedErabId := int32(5)
+
+	/******************************
+	 * request vars section
+	 ******************************/
+
+	/******************************
+	 * request body section
+	 ******************************/
+
+	/******************************
+	 * request queries section
+	 ******************************/
+
+	queries := make(map[string]string)
+	queries["ue_ipv4_address"] = "ue1"
+
+	/******************************
+	 * request execution section
+	 ******************************/
+
+	rr, err := sendRequest(http.MethodGet, "/queries/s1_bearer_info", nil, nil, queries, http.StatusOK, S1BearerInfoGET)
+	if err != nil {
+		t.Fatalf("Failed to get expected response")
+	}
+
+	var respBody InlineResponse2002
+	err = json.Unmarshal([]byte(rr), &respBody)
+	if err != nil {
+		t.Fatalf("Failed to get expected response")
+	}
+	if respBody.S1BearerInfo == nil || len(respBody.S1BearerInfo.S1UeInfo) != 1 {
+		t.Fatalf("Failed to get expected response")
+	}
+	s1UeInfo := respBody.S1BearerInfo.S1UeInfo[0]
+	if len(s1UeInfo.AssociateId) != 1 || s1UeInfo.AssociateId[0].Value != expectedAssocId {
+		t.Fatalf("Failed to get expected response")
+	}
+	if len(s1UeInfo.Ecgi) != 1 || s1UeInfo.Ecgi[0].Plmn.Mcc != expectedMcc || s1UeInfo.Ecgi[0].CellId[0] != expectedCellId {
+		t.Fatalf("Failed to get expected response")
+	}
+	if len(s1UeInfo.TempUeId) != 1 || len(s1UeInfo.S1BearerInfoDetailed) != 1 {
+		t.Fatalf("Failed to get expected response")
+	}
+	s1BearerInfo := s1UeInfo.S1BearerInfoDetailed[0]
+	if s1BearerInfo.ErabId != expectedErabId || s1BearerInfo.S1EnbInfo == nil || s1BearerInfo.S1EnbInfo.IpAddress == "" || s1BearerInfo.SGwInfo == nil {
+		t.Fatalf("Failed to get expected response")
+	}
+
+	//invalid query
+	queries["erab_id"] = "invalid"
+	_, err = sendRequest(http.MethodGet, "/queries/s1_bearer_info", nil, nil, queries, http.StatusBadRequest, S1BearerInfoGET)
+	if err != nil {
+		t.Fatalf("Failed to get expected response")
+	}
+	delete(queries, "erab_id")
+
+	//S1 bearer released after move to non-cellular POA
+	updateScenario("mobility1")
+
+	_, err = sendRequest(http.MethodGet, "/queries/s1_bearer_info", nil, nil, queries, http.StatusNotFound, S1BearerInfoGET)
+	if err != nil {
+		t.Fatalf("Failed to get expected response")
+	}
+
+	/******************************
+	 * back to initial state section
+	 ******************************/
+
+	terminateScenario()
+}
+
+func TestS1BearerFilterMatch(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	erab := &sbi.ErabInfo{ErabId: 5, Mnc: "456", Mcc: "123", CellId: "2345678"}
+	eventType := S1_BEARER_MODIFY
+	sub := &S1BearerSubscription{EventType: &eventType}
+	sub.S1BearerSubscriptionCriteria = &S1BearerSubscriptionCriteria{&AssociateId{"UE_IPV4_ADDRESS", "ue1"}, &Plmn{"123", "456"}, []string{"2345678"}, 5}
+
+	if !isS1BearerFilterMatch(sub, "ue1", S1_BEARER_MODIFY, erab) {
+		t.Fatalf("Filter should match")
+	}
+	if isS1BearerFilterMatch(sub, "ue1", S1_BEARER_ESTABLISH, erab) {
+		t.Fatalf("Filter should not match")
+	}
+	if isS1BearerFilterMatch(sub, "ue2", S1_BEARER_MODIFY, erab) {
+		t.Fatalf("Filter should not match")
+	}
+	sub.S1BearerSubscriptionCriteria.ErabId = 6
+	if isS1BearerFilterMatch(sub, "ue1", S1_BEARER_MODIFY, erab) {
+		t.Fatalf("Filter should not match")
+	}
+	sub.S1BearerSubscriptionCriteria.ErabId = 0
+	sub.S1BearerSubscriptionCriteria.CellId = []string{"3456789"}
+	if isS1BearerFilterMatch(sub, "ue1", S1_BEARER_MODIFY, erab) {
+		t.Fatalf("Filter should not match")
+	}
+	sub.EventType = nil
+	sub.S1BearerSubscriptionCriteria = nil
+	if !isS1BearerFilterMatch(sub, "ue2", S1_BEARER_RELEASE, erab) {
+		t.Fatalf("Filter should match")
+	}
+}
+
+func TestSuccessSubscriptionMeasTa(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	initializeVars()
+
+	err := Init()
+	if err != nil {
+		t.Fatalf("Error initializing test basic procedure")
+	}
+	err = Run()
+	if err != nil {
+		t.Fatalf("Error running test basic procedure")
+	}
+
+	fmt.Println("Set a scenario")
+	initialiseScenario(testScenario)
+
+	//post
+	expectedGetResp := testSubscriptionMeasTaPost(t)
+
+	//get
+	testSubscriptionMeasTaGet(t, strconv.Itoa(subMgr.NextId(measTaSubscriptionType)-1), expectedGetResp)
+
+	//put
+	expectedGetResp = testSubscriptionMeasTaPut(t, strconv.Itoa(subMgr.NextId(measTaSubscriptionType)-1), true)
+
+	//get
+	testSubscriptionMeasTaGet(t, strconv.Itoa(subMgr.NextId(measTaSubscriptionType)-1), expectedGetResp)
+
+	//delete
+	testSubscriptionMeasTaDelete(t, strconv.Itoa(subMgr.NextId(measTaSubscriptionType)-1))
+
+	terminateScenario()
+}
+
+func TestFailSubscriptionMeasTa(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	initializeVars()
+
+	err := Init()
+	if err != nil {
+		t.Fatalf("Error initializing test basic procedure")
+	}
+	err = Run()
+	if err != nil {
+		t.Fatalf("Error running test basic procedure")
+	}
+
+	fmt.Println("Set a scenario")
+	initialiseScenario(testScenario)
+
+	//get
+	testSubscriptionMeasTaGet(t, strconv.Itoa(subMgr.NextId(measTaSubscriptionType)), "")
+
+	//put
+	_ = testSubscriptionMeasTaPut(t, strconv.Itoa(subMgr.NextId(measTaSubscriptionType)), false)
+
+	//delete
+	testSubscriptionMeasTaDelete(t, strconv.Itoa(subMgr.NextId(measTaSubscriptionType)))
+
+	terminateScenario()
+}
+
+func testSubscriptionMeasTaPost(t *testing.T) string {
+
+	/******************************
+	         * expected response section
+		 ******************************/
+	expectedFilter := FilterCriteriaAssoc{"", &AssociateId{"UE_IPV4_ADDRESS", "1.1.1.1"}, &Plmn{"111", "222"}, []string{"1234567"}}
+	expectedCallBackRef := "myCallbakRef"
+	expectedLink := Link{"/" + testScenarioName + "/rni/v1/subscriptions/ta/" + strconv.Itoa(subMgr.NextId(measTaSubscriptionType))}
+	expectedExpiry := TimeStamp{1988599770, 0}
+	expectedResponse := InlineResponse2012{&MeasTaSubscription{expectedCallBackRef, &expectedLink, &expectedFilter, &expectedExpiry}}
+
+	expectedResponseStr, err := json.Marshal(expectedResponse)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+
+	/******************************
+	 * request vars section
+	 ******************************/
+
+	/******************************
+	 * request body section
+	 ******************************/
+
+	measTaSubscriptionPost1 := MeasTaSubscriptionPost1{&MeasTaSubscriptionPost{expectedCallBackRef, &expectedFilter, &expectedExpiry}}
+
+	body, err := json.Marshal(measTaSubscriptionPost1)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+
+	/******************************
+	 * request queries section
+	 ******************************/
+
+	/******************************
+	 * request execution section
+	 ******************************/
+
+	rr, err := sendRequest(http.MethodPost, "/subscriptions/ta", bytes.NewBuffer(body), nil, nil, http.StatusCreated, MeasTaSubscriptionsPOST)
+	if err != nil {
+		t.Fatalf("Failed to get expected response")
+	}
+
+	var respBody InlineResponse2012
+	err = json.Unmarshal([]byte(rr), &respBody)
+	if err != nil {
+		t.Fatalf("Failed to get expected response")
+	}
+	if rr != string(expectedResponseStr) {
+		t.Fatalf("Failed to get expected response")
+	}
+	return string(expectedResponseStr)
+}
+
+func testSubscriptionMeasTaPut(t *testing.T, subscriptionId string, expectSuccess bool) string {
+
+	/******************************
+	 * expected response section
+	 ******************************/
+	expectedFilter := FilterCriteriaAssoc{"", &AssociateId{"UE_IPV4_ADDRESS", "2.2.2.2"}, &Plmn{"111", "222"}, []string{"1234567"}}
+	expectedCallBackRef := "myCallbakRef"
+	expectedLink := Link{"/" + testScenarioName + "/rni/v1/subscriptions/ta/" + subscriptionId}
+	expectedExpiry := TimeStamp{1988599770, 0}
+	expectedResponse := InlineResponse2005{&MeasTaSubscription{expectedCallBackRef, &expectedLink, &expectedFilter, &expectedExpiry}}
+
+	expectedResponseStr, err := json.Marshal(expectedResponse)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+
+	/******************************
+	 * request vars section
+	 ******************************/
+	vars := make(map[string]string)
+	vars["subscriptionId"] = subscriptionId
+
+	/******************************
+	 * request body section
+	 ******************************/
+	measTaSubscription1 := MeasTaSubscription1{&MeasTaSubscription{expectedCallBackRef, &expectedLink, &expectedFilter, &expectedExpiry}}
+
+	body, err := json.Marshal(measTaSubscription1)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+
+	/******************************
+	 * request queries section
+	 ******************************/
+
+	/******************************
+	 * request execution section
+	 ******************************/
+
+	if expectSuccess {
+		rr, err := sendRequest(http.MethodPost, "/subscriptions/ta", bytes.NewBuffer(body), vars, nil, http.StatusOK, MeasTaSubscriptionsPUT)
+		if err != nil {
+			t.Fatalf("Failed to get expected response")
+		}
+
+		var respBody InlineResponse2005
+		err = json.Unmarshal([]byte(rr), &respBody)
+		if err != nil {
+			t.Fatalf("Failed to get expected response")
+		}
+		if rr != string(expectedResponseStr) {
+			t.Fatalf("Failed to get expected response")
+		}
+		return string(expectedResponseStr)
+	} else {
+		_, err = sendRequest(http.MethodPost, "/subscriptions/ta", bytes.NewBuffer(body), vars, nil, http.StatusNotFound, MeasTaSubscriptionsPUT)
+		if err != nil {
+			t.Fatalf("Failed to get expected response")
+		}
+		return ""
+	}
+}
+
+func testSubscriptionMeasTaGet(t *testing.T, subscriptionId string, expectedResponse string) {
+
+	/******************************
+	 * expected response section
+	 ******************************/
+	//passed as a parameter since a POST had to be sent first
+
+	/******************************
+	 * request vars section
+	 ******************************/
+	vars := make(map[string]string)
+	vars["subscriptionId"] = subscriptionId
+
+	/******************************
+	 * request body section
+	 ******************************/
+
+	/******************************
+	 * request queries section
+	 ******************************/
+
+	/******************************
+	 * request execution section
+	 ******************************/
+	var err error
+	if expectedResponse == "" {
+		_, err = sendRequest(http.MethodGet, "/subscriptions/ta", nil, vars, nil, http.StatusNotFound, MeasTaSubscriptionsGET)
+		if err != nil {
+			t.Fatalf("Failed to get expected response")
+		}
+	} else {
+		rr, err := sendRequest(http.MethodGet, "/subscriptions/ta", nil, vars, nil, http.StatusOK, MeasTaSubscriptionsGET)
+		if err != nil {
+			t.Fatalf("Failed to get expected response")
+		}
+
+		var respBody InlineResponse2005
+		err = json.Unmarshal([]byte(rr), &respBody)
+		if err != nil {
+			t.Fatalf("Failed to get expected response")
+		}
+		if rr != expectedResponse {
+			t.Fatalf("Failed to get expected response")
+		}
+	}
+}
+
+func testSubscriptionMeasTaDelete(t *testing.T, subscriptionId string) {
+
+	/******************************
+	 * expected response section
+	 ******************************/
+
+	/******************************
+	 * request vars section
+	 ******************************/
+	vars := make(map[string]string)
+	vars["subscriptionId"] = subscriptionId
+
+	/******************************
+	 * request body section
+	 ******************************/
+
+	/******************************
+	 * request queries section
+	 ******************************/
+
+	/******************************
+	 * request execution section
+	 ******************************/
+
+	_, err := sendRequest(http.MethodDelete, "/subscriptions/ta", nil, vars, nil, http.StatusNoContent, MeasTaSubscriptionsSubscrIdDELETE)
+	if err != nil {
+		t.Fatalf("Failed to get expected response")
+	}
+}
+
+func TestSuccessSubscriptionCaReConf(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	initializeVars()
+
+	err := Init()
+	if err != nil {
+		t.Fatalf("Error initializing test basic procedure")
+	}
+	err = Run()
+	if err != nil {
+		t.Fatalf("Error running test basic procedure")
+	}
+
+	fmt.Println("Set a scenario")
+	initialiseScenario(testScenario)
+
+	//post
+	expectedGetResp := testSubscriptionCaReConfPost(t)
+
+	//get
+	testSubscriptionCaReConfGet(t, strconv.Itoa(subMgr.NextId(caReConfSubscriptionType)-1), expectedGetResp)
+
+	//put
+	expectedGetResp = testSubscriptionCaReConfPut(t, strconv.Itoa(subMgr.NextId(caReConfSubscriptionType)-1), true)
+
+	//get
+	testSubscriptionCaReConfGet(t, strconv.Itoa(subMgr.NextId(caReConfSubscriptionType)-1), expectedGetResp)
+
+	//delete
+	testSubscriptionCaReConfDelete(t, strconv.Itoa(subMgr.NextId(caReConfSubscriptionType)-1))
+
+	terminateScenario()
+}
+
+func TestFailSubscriptionCaReConf(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	initializeVars()
+
+	err := Init()
+	if err != nil {
+		t.Fatalf("Error initializing test basic procedure")
+	}
+	err = Run()
+	if err != nil {
+		t.Fatalf("Error running test basic procedure")
+	}
+
+	fmt.Println("Set a scenario")
+	initialiseScenario(testScenario)
+
+	//get
+	testSubscriptionCaReConfGet(t, strconv.Itoa(subMgr.NextId(caReConfSubscriptionType)), "")
+
+	//put
+	_ = testSubscriptionCaReConfPut(t, strconv.Itoa(subMgr.NextId(caReConfSubscriptionType)), false)
+
+	//delete
+	testSubscriptionCaReConfDelete(t, strconv.Itoa(subMgr.NextId(caReConfSubscriptionType)))
+
+	terminateScenario()
+}
+
+func testSubscriptionCaReConfPost(t *testing.T) string {
+
+	/******************************
+	         * expected response section
+		 ******************************/
+	expectedFilter := FilterCriteriaAssoc{"", &AssociateId{"UE_IPV4_ADDRESS", "1.1.1.1"}, &Plmn{"111", "222"}, []string{"1234567"}}
+	expectedCallBackRef := "myCallbakRef"
+	expectedLink := Link{"/" + testScenarioName + "/rni/v1/subscriptions/ca_reconf/" + strconv.Itoa(subMgr.NextId(caReConfSubscriptionType))}
+	expectedExpiry := TimeStamp{1988599770, 0}
+	expectedResponse := InlineResponse2017{&CaReConfSubscription{expectedCallBackRef, &expectedLink, &expectedFilter, &expectedExpiry}}
+
+	expectedResponseStr, err := json.Marshal(expectedResponse)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+
+	/******************************
+	 * request vars section
+	 ******************************/
+
+	/******************************
+	 * request body section
+	 ******************************/
+
+	caReConfSubscriptionPost1 := CaReConfSubscriptionPost1{&CaReConfSubscriptionPost{expectedCallBackRef, &expectedFilter, &expectedExpiry}}
+
+	body, err := json.Marshal(caReConfSubscriptionPost1)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+
+	/******************************
+	 * request queries section
+	 ******************************/
+
+	/******************************
+	 * request execution section
+	 ******************************/
+
+	rr, err := sendRequest(http.MethodPost, "/subscriptions/ca_reconf", bytes.NewBuffer(body), nil, nil, http.StatusCreated, CaReConfSubscriptionSubscriptionsPOST)
+	if err != nil {
+		t.Fatalf("Failed to get expected response")
+	}
+
+	var respBody InlineResponse2017
+	err = json.Unmarshal([]byte(rr), &respBody)
+	if err != nil {
+		t.Fatalf("Failed to get expected response")
+	}
+	if rr != string(expectedResponseStr) {
+		t.Fatalf("Failed to get expected response")
+	}
+	return string(expectedResponseStr)
+}
+
+func testSubscriptionCaReConfPut(t *testing.T, subscriptionId string, expectSuccess bool) string {
+
+	/******************************
+	 * expected response section
+	 ******************************/
+	expectedFilter := FilterCriteriaAssoc{"", &AssociateId{"UE_IPV4_ADDRESS", "2.2.2.2"}, &Plmn{"111", "222"}, []string{"1234567"}}
+	expectedCallBackRef := "myCallbakRef"
+	expectedLink := Link{"/" + testScenarioName + "/rni/v1/subscriptions/ca_reconf/" + subscriptionId}
+	expectedExpiry := TimeStamp{1988599770, 0}
+	expectedResponse := InlineResponse20010{&CaReConfSubscription{expectedCallBackRef, &expectedLink, &expectedFilter, &expectedExpiry}}
+
+	expectedResponseStr, err := json.Marshal(expectedResponse)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+
+	/******************************
+	 * request vars section
+	 ******************************/
+	vars := make(map[string]string)
+	vars["subscriptionId"] = subscriptionId
+
+	/******************************
+	 * request body section
+	 ******************************/
+	caReConfSubscription1 := CaReConfSubscription1{&CaReConfSubscription{expectedCallBackRef, &expectedLink, &expectedFilter, &expectedExpiry}}
+
+	body, err := json.Marshal(caReConfSubscription1)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+
+	/******************************
+	 * request queries section
+	 ******************************/
+
+	/******************************
+	 * request execution section
+	 ******************************/
+
+	if expectSuccess {
+		rr, err := sendRequest(http.MethodPost, "/subscriptions/ca_reconf", bytes.NewBuffer(body), vars, nil, http.StatusOK, CaReConfSubscriptionSubscriptionsPUT)
+		if err != nil {
+			t.Fatalf("Failed to get expected response")
+		}
+
+		var respBody InlineResponse20010
+		err = json.Unmarshal([]byte(rr), &respBody)
+		if err != nil {
+			t.Fatalf("Failed to get expected response")
+		}
+		if rr != string(expectedResponseStr) {
+			t.Fatalf("Failed to get expected response")
+		}
+		return string(expectedResponseStr)
+	} else {
+		_, err = sendRequest(http.MethodPost, "/subscriptions/ca_reconf", bytes.NewBuffer(body), vars, nil, http.StatusNotFound, CaReConfSubscriptionSubscriptionsPUT)
+		if err != nil {
+			t.Fatalf("Failed to get expected response")
+		}
+		return ""
+	}
+}
+
+func testSubscriptionCaReConfGet(t *testing.T, subscriptionId string, expectedResponse string) {
+
+	/******************************
+	 * expected response section
+	 ******************************/
+	//passed as a parameter since a POST had to be sent first
+
+	/******************************
+	 * request vars section
+	 ******************************/
+	vars := make(map[string]string)
+	vars["subscriptionId"] = subscriptionId
+
+	/******************************
+	 * request body section
+	 ******************************/
+
+	/******************************
+	 * request queries section
+	 ******************************/
+
+	/******************************
+	 * request execution section
+	 ******************************/
+	var err error
+	if expectedResponse == "" {
+		_, err = sendRequest(http.MethodGet, "/subscriptions/ca_reconf", nil, vars, nil, http.StatusNotFound, CaReConfSubscriptionSubscriptionsGET)
+		if err != nil {
+			t.Fatalf("Failed to get expected response")
+		}
+	} else {
+		rr, err := sendRequest(http.MethodGet, "/subscriptions/ca_reconf", nil, vars, nil, http.StatusOK, CaReConfSubscriptionSubscriptionsGET)
+		if err != nil {
+			t.Fatalf("Failed to get expected response")
+		}
+
+		var respBody InlineResponse20010
+		err = json.Unmarshal([]byte(rr), &respBody)
+		if err != nil {
+			t.Fatalf("Failed to get expected response")
+		}
+		if rr != expectedResponse {
+			t.Fatalf("Failed to get expected response")
+		}
+	}
+}
+
+func testSubscriptionCaReConfDelete(t *testing.T, subscriptionId string) {
+
+	/******************************
+	 * expected response section
+	 ******************************/
+
+	/******************************
+	 * request vars section
+	 ******************************/
+	vars := make(map[string]string)
+	vars["subscriptionId"] = subscriptionId
+
+	/******************************
+	 * request body section
+	 ******************************/
+
+	/******************************
+	 * request queries section
+	 ******************************/
+
+	/******************************
+	 * request execution section
+	 ******************************/
+
+	_, err := sendRequest(http.MethodDelete, "/subscriptions/ca_reconf", nil, vars, nil, http.StatusNoContent, CaReConfSubscriptionsSubscrIdDELETE)
+	if err != nil {
+		t.Fatalf("Failed to get expected response")
+	}
+}
+
+func TestTaCaReConfNotification(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	ecgi := newEcgi("456", "123", "2345678")
+	neighbourEcgi := newEcgi("456", "123", "3456789")
+	otherOperatorEcgi := newEcgi("789", "123", "4567890")
+
+	fmt.Println("Secondary cells of the serving cell operator")
+	measInfo := &ueMeasInfo{ecgi: ecgi, rsrp: 70, rsrq: 30, ta: 2}
+	measInfo.neighbours = []cellMeasInfo{{otherOperatorEcgi, 65, 28}, {neighbourEcgi, 60, 25}}
+	secondaryCells := getSecondaryCells(measInfo)
+	if len(secondaryCells) != 1 || getEcgiKey(secondaryCells[0]) != getEcgiKey(neighbourEcgi) {
+		t.Fatalf("Wrong secondary cells")
+	}
+
+	fmt.Println("Filter match")
+	filter := &FilterCriteriaAssoc{"", &AssociateId{"UE_IPV4_ADDRESS", "ue1"}, &Plmn{"123", "456"}, []string{"2345678"}}
+	if !isAssocFilterMatch(filter, "ue1", ecgi) {
+		t.Fatalf("Filter should match")
+	}
+	if isAssocFilterMatch(filter, "ue2", ecgi) {
+		t.Fatalf("Filter should not match")
+	}
+	if isAssocFilterMatch(filter, "ue1", neighbourEcgi) {
+		t.Fatalf("Filter should not match")
+	}
+	if isAssocFilterMatch(filter, "ue1", otherOperatorEcgi) {
+		t.Fatalf("Filter should not match")
+	}
+	filter.AppInsId = "myApp"
+	if isAssocFilterMatch(filter, "ue1", ecgi) {
+		t.Fatalf("Filter should not match")
+	}
+}
+
 func TestExpiryNotification(t *testing.T) {
 
 	fmt.Println("--- ", t.Name())
@@ -1284,7 +3283,7 @@ func TestSubscriptionNotification(t *testing.T) {
 	}
 
 	//cleanup allocated subscription
-	testSubscriptionCellChangeDelete(t, strconv.Itoa(nextSubscriptionIdAvailable-1))
+	testSubscriptionCellChangeDelete(t, strconv.Itoa(subMgr.NextId(cellChangeSubscriptionType)-1))
 
 	/******************************
 	 * back to initial state section
@@ -1525,6 +3524,7 @@ func initializeVars() {
 	postgisHost = postgisTestHost
 	postgisPort = postgisTestPort
 	sandboxName = testScenarioName
+	notifRetries = 0
 }
 
 func initialiseScenario(testScenario string) {
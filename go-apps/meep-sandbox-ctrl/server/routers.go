@@ -44,7 +44,7 @@ type Routes []Route
 func NewRouter() *mux.Router {
 	router := mux.NewRouter().StrictSlash(true)
 	for _, route := range routes {
-		var handler http.Handler = route.HandlerFunc
+		var handler http.Handler = authenticate(trackActivity(route.HandlerFunc))
 		handler = Logger(handler, route.Name)
 		// handler = httpLog.LogRx(handler, "")
 
@@ -101,6 +101,20 @@ var routes = Routes{
 		TerminateScenario,
 	},
 
+	Route{
+		"GetNetCharAlgorithm",
+		strings.ToUpper("Get"),
+		"/sandbox-ctrl/v1/netchar/algorithm",
+		GetNetCharAlgorithm,
+	},
+
+	Route{
+		"SetNetCharAlgorithm",
+		strings.ToUpper("Put"),
+		"/sandbox-ctrl/v1/netchar/algorithm",
+		SetNetCharAlgorithm,
+	},
+
 	Route{
 		"CreateReplayFile",
 		strings.ToUpper("Post"),
@@ -29,6 +29,7 @@ import (
 
 	"github.com/gorilla/mux"
 
+	auth "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-auth"
 	couch "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-couch"
 	dataModel "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-data-model"
 	httpLog "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-http-logger"
@@ -36,6 +37,8 @@ import (
 	ms "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-metric-store"
 	mod "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-model"
 	mq "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-mq"
+	ncm "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-net-char-mgr"
+	redis "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-redis"
 	replay "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-replay-manager"
 	ss "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-sandbox-store"
 )
@@ -55,6 +58,10 @@ type SandboxCtrl struct {
 	metricStore   *ms.MetricStore
 	replayMgr     *replay.ReplayMgr
 	sandboxStore  *ss.SandboxStore
+	activity      *ss.ActivityTracker
+	netCharCtrl   *redis.Connector
+	auth          *auth.Authenticator
+	handlerId     int
 }
 
 const scenarioDBName = "scenarios"
@@ -64,6 +71,8 @@ const moduleName = "meep-sandbox-ctrl"
 // MQ payload fields
 const fieldSandboxName = "sandbox-name"
 const fieldScenarioName = "scenario-name"
+const fieldExpiryReason = "reason"
+const fieldExpiryTime = "expiry-time"
 
 // Event types
 const (
@@ -71,12 +80,14 @@ const (
 	eventTypeNetCharUpdate  = "NETWORK-CHARACTERISTICS-UPDATE"
 	eventTypePoasInRange    = "POAS-IN-RANGE"
 	eventTypeScenarioUpdate = "SCENARIO-UPDATE"
+	eventTypeExpiryWarning  = "SANDBOX-EXPIRY-WARNING"
 )
 
 // Declare as variables to enable overwrite in test
 var couchDBAddr string = "http://meep-couchdb-svc-couchdb.default.svc.cluster.local:5984/"
 var redisDBAddr string = "meep-redis-master.default.svc.cluster.local:6379"
 var influxDBAddr string = "http://meep-influxdb.default.svc.cluster.local:8086"
+var authConfigFile string = "/auth/auth.yaml"
 
 // Sandbox Controller
 var sbxCtrl *SandboxCtrl
@@ -165,6 +176,24 @@ func Init() (err error) {
 	}
 	log.Info("Connected to Sandbox Store")
 
+	// Connect to Network Characteristics controls DB
+	sbxCtrl.netCharCtrl, err = redis.NewConnector(redisDBAddr, ncm.NetCharControlDb)
+	if err != nil {
+		log.Error("Failed connection to Network Characteristics controls DB: ", err.Error())
+		return err
+	}
+	log.Info("Connected to Network Characteristics controls DB")
+
+	// Track API activity to prevent idle sandbox expiry
+	sbxCtrl.activity = ss.NewActivityTracker(sbxCtrl.sandboxStore, sbxCtrl.sandboxName)
+
+	// Load API authentication config
+	sbxCtrl.auth, err = auth.NewAuthenticator(authConfigFile)
+	if err != nil {
+		log.Error("Failed to initialize authentication. Error: ", err)
+		return err
+	}
+
 	return nil
 }
 
@@ -179,14 +208,59 @@ func Run() (err error) {
 				log.Error("Failed to activate scenario with err: ", err.Error())
 			} else {
 				log.Info("Successfully activated scenario: ", sbox.ScenarioName)
+				updateSandboxPods()
 				_ = httpLog.ReInit(moduleName, sbxCtrl.sandboxName, sbox.ScenarioName, redisDBAddr, influxDBAddr)
 			}
 		}
 	}
 
+	// Register Message Queue handler
+	handler := mq.MsgHandler{Handler: msgHandler, UserData: nil}
+	sbxCtrl.handlerId, err = sbxCtrl.mqGlobal.RegisterHandler(handler)
+	if err != nil {
+		log.Error("Failed to register MsgQueue handler: ", err.Error())
+		return err
+	}
+
 	return nil
 }
 
+// Message Queue handler
+func msgHandler(msg *mq.Msg, userData interface{}) {
+	switch msg.Message {
+	case mq.MsgSandboxExpiryWarning:
+		log.Debug("RX MSG: ", mq.PrintMsg(msg))
+		processExpiryWarning(msg.Payload[fieldExpiryReason], msg.Payload[fieldExpiryTime])
+	default:
+		log.Trace("Ignoring unsupported message: ", mq.PrintMsg(msg))
+	}
+}
+
+// processExpiryWarning - Notify sandbox users that the sandbox is about to expire
+// The warning is logged as an event metric so that event subscribers are notified.
+func processExpiryWarning(reason string, expiryTime string) {
+	description := "Sandbox " + sbxCtrl.sandboxName + " expires at " + expiryTime + " (" + reason + ")"
+	log.Warn(description)
+
+	if sbxCtrl.activeModel == nil || !sbxCtrl.activeModel.Active {
+		return
+	}
+	eventJSONStr, err := json.Marshal(map[string]string{
+		fieldSandboxName:  sbxCtrl.sandboxName,
+		fieldExpiryReason: reason,
+		fieldExpiryTime:   expiryTime,
+	})
+	if err == nil {
+		var metric ms.EventMetric
+		metric.Event = string(eventJSONStr)
+		metric.Description = description
+		err = sbxCtrl.metricStore.SetEventMetric(eventTypeExpiryWarning, metric)
+	}
+	if err != nil {
+		log.Error("Failed to set event metric")
+	}
+}
+
 // Activate the provided scenario
 func activateScenario(scenarioName string) (err error) {
 	// Verify scenario name
@@ -224,6 +298,7 @@ func activateScenario(scenarioName string) (err error) {
 		log.Error("Failed to set scenario metrics store with err: ", err.Error())
 		return err
 	}
+	setMetricRunInfo(map[string]interface{}{ms.RunInfoScenario: scenarioName, ms.RunInfoReplayFile: ""})
 
 	// Activate scenario
 	err = sbxCtrl.activeModel.SetScenario(scenario)
@@ -296,6 +371,14 @@ func ceActivateScenario(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// Make sure owner pod quota is not exceeded
+	code, err := checkPodQuota(auth.GetUser(r), scenario)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), code)
+		return
+	}
+
 	// Set Metrics Store
 	err = sbxCtrl.metricStore.SetStore(scenarioName)
 	if err != nil {
@@ -303,6 +386,7 @@ func ceActivateScenario(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
+	setMetricRunInfo(map[string]interface{}{ms.RunInfoScenario: scenarioName, ms.RunInfoReplayFile: ""})
 
 	// Activate scenario & publish
 	err = sbxCtrl.activeModel.SetScenario(scenario)
@@ -319,6 +403,7 @@ func ceActivateScenario(w http.ResponseWriter, r *http.Request) {
 	}
 
 	_ = httpLog.ReInit(moduleName, sbxCtrl.sandboxName, scenarioName, redisDBAddr, influxDBAddr)
+	updateSandboxPods()
 
 	// Send Activation message to Virt Engine on Global Message Queue
 	msg := sbxCtrl.mqGlobal.CreateMsg(mq.MsgScenarioActivate, mq.TargetAll, mq.TargetAll)
@@ -361,6 +446,7 @@ func ceActivateScenario(w http.ResponseWriter, r *http.Request) {
 				http.Error(w, err.Error(), http.StatusInternalServerError)
 				return
 			}
+			setMetricRunInfo(map[string]interface{}{ms.RunInfoReplayFile: actInfo.ReplayFileName})
 		}
 	}
 
@@ -526,6 +612,9 @@ func ceTerminateScenario(w http.ResponseWriter, r *http.Request) {
 		log.Error(err.Error())
 	}
 
+	// Scenario pods are no longer deployed
+	_ = sbxCtrl.sandboxStore.SetPods(sbxCtrl.sandboxName, 0)
+
 	//force stop replay manager
 	if sbxCtrl.replayMgr.IsStarted() {
 		_ = sbxCtrl.replayMgr.ForceStop()
@@ -622,6 +711,18 @@ func sendEventNetworkCharacteristics(event dataModel.Event) (error, int, string)
 		"throughputDl=" + strconv.Itoa(int(netCharEvent.NetChar.ThroughputDl)) + "Mbps " +
 		"throughputUl=" + strconv.Itoa(int(netCharEvent.NetChar.ThroughputUl)) + "Mbps " +
 		"packet-loss=" + strconv.FormatFloat(netCharEvent.NetChar.PacketLoss, 'f', -1, 64) + "% "
+	if netCharEvent.NetChar.PacketLossModel != "" {
+		description += "packet-loss-model=" + netCharEvent.NetChar.PacketLossModel + " "
+	}
+	if netCharEvent.NetChar.PacketDuplication != 0 {
+		description += "duplication=" + strconv.FormatFloat(netCharEvent.NetChar.PacketDuplication, 'f', -1, 64) + "% "
+	}
+	if netCharEvent.NetChar.PacketCorruption != 0 {
+		description += "corruption=" + strconv.FormatFloat(netCharEvent.NetChar.PacketCorruption, 'f', -1, 64) + "% "
+	}
+	if netCharEvent.NetChar.PacketReordering != 0 {
+		description += "reordering=" + strconv.FormatFloat(netCharEvent.NetChar.PacketReordering, 'f', -1, 64) + "% "
+	}
 
 	err := sbxCtrl.activeModel.UpdateNetChar(netCharEvent)
 	if err != nil {
@@ -1049,6 +1150,77 @@ func ceGetReplayStatus(w http.ResponseWriter, r *http.Request) {
 	fmt.Fprint(w, string(jsonResponse))
 }
 
+// ceGetNetCharAlgorithm - Get network characteristics algorithm selected in the sandbox
+func ceGetNetCharAlgorithm(w http.ResponseWriter, r *http.Request) {
+	log.Debug("ceGetNetCharAlgorithm")
+
+	algoName, err := ncm.GetNetCharAlgo(sbxCtrl.netCharCtrl, sbxCtrl.sandboxName)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	jsonResponse, err := json.Marshal(dataModel.NetCharAlgorithm{Algorithm: algoName})
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	// Send response
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprint(w, string(jsonResponse))
+}
+
+// ceSetNetCharAlgorithm - Select network characteristics algorithm used in the sandbox
+func ceSetNetCharAlgorithm(w http.ResponseWriter, r *http.Request) {
+	log.Debug("ceSetNetCharAlgorithm")
+
+	// Retrieve algorithm from request body
+	if r.Body == nil {
+		err := errors.New("Request body is missing")
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	var algorithm dataModel.NetCharAlgorithm
+	decoder := json.NewDecoder(r.Body)
+	err := decoder.Decode(&algorithm)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if !ncm.IsValidNetCharAlgo(algorithm.Algorithm) {
+		err = errors.New("Unsupported network characteristics algorithm: " + algorithm.Algorithm)
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// Update NetChar controls
+	err = ncm.SetNetCharAlgo(sbxCtrl.netCharCtrl, sbxCtrl.sandboxName, algorithm.Algorithm)
+	if err != nil {
+		log.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	// Send response
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	w.WriteHeader(http.StatusOK)
+}
+
+// setMetricRunInfo - Record run information in the active metrics store for metric exports
+func setMetricRunInfo(fields map[string]interface{}) {
+	err := sbxCtrl.metricStore.SetRunInfo(fields)
+	if err != nil {
+		log.Error("Failed to set metrics run info: ", err.Error())
+	}
+}
+
 func ceLoopReplay(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
 	replayFileName := vars["name"]
@@ -1062,6 +1234,7 @@ func ceLoopReplay(w http.ResponseWriter, r *http.Request) {
 
 	err = sbxCtrl.replayMgr.Start(replayFileName, events, true, true)
 	if err == nil {
+		setMetricRunInfo(map[string]interface{}{ms.RunInfoReplayFile: replayFileName})
 		w.WriteHeader(http.StatusOK)
 	} else {
 		w.WriteHeader(http.StatusConflict)
@@ -1084,6 +1257,7 @@ func cePlayReplayFile(w http.ResponseWriter, r *http.Request) {
 	err = sbxCtrl.replayMgr.Start(replayFileName, events, false, true)
 
 	if err == nil {
+		setMetricRunInfo(map[string]interface{}{ms.RunInfoReplayFile: replayFileName})
 		w.WriteHeader(http.StatusOK)
 	} else {
 		w.WriteHeader(http.StatusConflict)
@@ -1108,6 +1282,9 @@ func ceStopReplayFile(w http.ResponseWriter, r *http.Request) {
 
 func activeScenarioUpdateCb() {
 
+	// Scenario updates may add or remove pods
+	updateSandboxPods()
+
 	// Send Update message on local Message Queue
 	msg := sbxCtrl.mqLocal.CreateMsg(mq.MsgScenarioUpdate, mq.TargetAll, sbxCtrl.sandboxName)
 	log.Debug("TX MSG: ", mq.PrintMsg(msg))
@@ -1116,3 +1293,88 @@ func activeScenarioUpdateCb() {
 		log.Error("Failed to send message. Error: ", err.Error())
 	}
 }
+
+// authenticate - Authenticate API requests & make sure user is allowed to control this sandbox
+// Only the sandbox owner or an admin may use the sandbox controller API.
+func authenticate(inner http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if sbxCtrl == nil {
+			inner(w, r)
+			return
+		}
+		sbxCtrl.auth.Handler(func(w http.ResponseWriter, r *http.Request) {
+			user := auth.GetUser(r)
+			if user != nil && !user.Admin {
+				sbox, err := sbxCtrl.sandboxStore.Get(sbxCtrl.sandboxName)
+				if err != nil || !auth.IsAuthorized(user, sbxCtrl.sandboxName, sbox.Owner) {
+					err = errors.New("Not authorized to control sandbox " + sbxCtrl.sandboxName)
+					log.Error(err.Error())
+					http.Error(w, err.Error(), http.StatusForbidden)
+					return
+				}
+			}
+			inner(w, r)
+		})(w, r)
+	}
+}
+
+// trackActivity - Record sandbox API activity to restart the sandbox idle timeout
+func trackActivity(inner http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if sbxCtrl != nil {
+			sbxCtrl.activity.Touch()
+		}
+		inner(w, r)
+	}
+}
+
+// checkPodQuota - Make sure deploying the provided scenario does not exceed the sandbox owner pod quota
+// The quota & usage are those of the sandbox owner, whoever makes the request. Returns the HTTP
+// status code to use on failure. Sandboxes without owner and admins deploying in their own
+// sandboxes are not subject to quotas.
+func checkPodQuota(user *auth.User, scenario []byte) (code int, err error) {
+	if user == nil {
+		return http.StatusOK, nil
+	}
+	sbox, err := sbxCtrl.sandboxStore.Get(sbxCtrl.sandboxName)
+	if err != nil {
+		return http.StatusInternalServerError, err
+	}
+	owner := sbox.Owner
+	if owner == "" || (user.Admin && user.Name == owner) {
+		return http.StatusOK, nil
+	}
+	quota := sbxCtrl.auth.GetQuota(owner)
+
+	// Get pods deployed in the owner's other sandboxes
+	_, pods, err := sbxCtrl.sandboxStore.GetOwnerUsage(owner, sbxCtrl.sandboxName)
+	if err != nil {
+		return http.StatusInternalServerError, err
+	}
+	scenarioPods, err := mod.GetScenarioPodCount(scenario)
+	if err != nil {
+		return http.StatusBadRequest, err
+	}
+	if err = quota.CheckPods(pods + scenarioPods); err != nil {
+		return http.StatusForbidden, err
+	}
+	return http.StatusOK, nil
+}
+
+// updateSandboxPods - Update number of scenario pods deployed in sandbox from the active scenario
+func updateSandboxPods() {
+	if sbxCtrl.activeModel == nil || !sbxCtrl.activeModel.Active {
+		return
+	}
+	scenario, err := sbxCtrl.activeModel.GetScenario()
+	if err != nil {
+		log.Error("Failed to get active scenario: ", err.Error())
+		return
+	}
+	pods, err := mod.GetScenarioPodCount(scenario)
+	if err != nil {
+		log.Error("Failed to get scenario pod count: ", err.Error())
+		return
+	}
+	_ = sbxCtrl.sandboxStore.SetPods(sbxCtrl.sandboxName, pods)
+}
@@ -60,9 +60,12 @@ const fieldSvcPort string = "svc-port"
 const fieldLbSvcName string = "lb-svc-name"
 const fieldLbSvcIp string = "lb-svc-ip"
 const fieldLbSvcPort string = "lb-svc-port"
+const fieldSessionTransferMode string = "session-transfer-mode"
+const fieldSessionDrainTimeout string = "session-drain-timeout"
 
 const COMMON_CORRELATION = 50
 const DEFAULT_DISTRIBUTION = "normal"
+const DEFAULT_LOSS_MODEL = "random"
 
 const THROUGHPUT_UNIT = 1000000 //convert from Mbps to bps
 
@@ -103,6 +106,17 @@ type FilterInfo struct {
 	Distribution       string
 	PacketLoss         int
 	DataRate           int
+
+	// Packet impairments, in hundredths of percent
+	LossModel          string
+	LossCorrelation    int
+	BurstLossGoodToBad int
+	BurstLossBadToGood int
+	BurstLossBad       int
+	BurstLossGood      int
+	Duplication        int
+	Corruption         int
+	Reordering         int
 }
 
 // PortInfo -
@@ -142,10 +156,17 @@ type EgressSvcMap struct {
 	Protocol string
 }
 
+// SessionTransferInfo -
+type SessionTransferInfo struct {
+	Mode         string
+	DrainTimeout int32
+}
+
 // PodInfo -
 type PodInfo struct {
 	Name              string
 	MgSvcMap          map[string]*ServiceInfo
+	MgSvcSessionMap   map[string]*SessionTransferInfo
 	IngressSvcMapList map[int32]*IngressSvcMap
 	EgressSvcMapList  map[string]*EgressSvcMap
 }
@@ -377,6 +398,10 @@ func processMgSvcMapUpdate() {
 		// Set load balanced MG Service instance
 		for _, svcMap := range netElem.ServiceMaps {
 			podInfo.MgSvcMap[svcMap.MgSvcName] = svcInfoMap[svcMap.LbSvcName]
+			podInfo.MgSvcSessionMap[svcMap.MgSvcName] = &SessionTransferInfo{
+				Mode:         svcMap.SessionTransferMode,
+				DrainTimeout: svcMap.SessionDrainTimeout,
+			}
 		}
 	}
 
@@ -479,6 +504,7 @@ func processScenario(model *mod.Model) error {
 		podInfo := new(PodInfo)
 		podInfo.Name = proc.Name
 		podInfo.MgSvcMap = make(map[string]*ServiceInfo)
+		podInfo.MgSvcSessionMap = make(map[string]*SessionTransferInfo)
 		podInfo.IngressSvcMapList = make(map[int32]*IngressSvcMap)
 		podInfo.EgressSvcMapList = make(map[string]*EgressSvcMap)
 		podInfoMap[proc.Name] = podInfo
@@ -591,7 +617,7 @@ func updateDbState(transactionId int) {
 	_ = tce.netCharStore.rc.SetEntry(keyName, dbState)
 }
 
-func netCharUpdate(dstName string, srcName string, rate float64, latency float64, latencyVariation float64, distribution string, packetLoss float64) {
+func netCharUpdate(dstName string, srcName string, rate float64, latency float64, latencyVariation float64, distribution string, packetLoss float64, imp ncm.Impairments) {
 	mutex.Lock()
 	defer mutex.Unlock()
 
@@ -613,6 +639,18 @@ func netCharUpdate(dstName string, srcName string, rate float64, latency float64
 	filterInfo.PacketLoss = int(100 * packetLoss)
 	filterInfo.DataRate = int(THROUGHPUT_UNIT * rate)
 	filterInfo.Distribution = strings.ToLower(distribution)
+	filterInfo.LossModel = DEFAULT_LOSS_MODEL
+	if imp.PacketLossModel != "" {
+		filterInfo.LossModel = strings.ToLower(imp.PacketLossModel)
+	}
+	filterInfo.LossCorrelation = int(100 * imp.PacketLossCorrelation)
+	filterInfo.BurstLossGoodToBad = int(100 * imp.BurstLossGoodToBad)
+	filterInfo.BurstLossBadToGood = int(100 * imp.BurstLossBadToGood)
+	filterInfo.BurstLossBad = int(100 * imp.BurstLossBad)
+	filterInfo.BurstLossGood = int(100 * imp.BurstLossGood)
+	filterInfo.Duplication = int(100 * imp.PacketDuplication)
+	filterInfo.Corruption = int(100 * imp.PacketCorruption)
+	filterInfo.Reordering = int(100 * imp.PacketReordering)
 	_ = setShapingRule(filterInfo)
 }
 
@@ -664,6 +702,7 @@ func setFilterInfoRules() {
 				filterInfo.Distribution = DEFAULT_DISTRIBUTION
 				filterInfo.PacketLoss = 0
 				filterInfo.DataRate = 0
+				filterInfo.LossModel = DEFAULT_LOSS_MODEL
 
 				dstElem.FilterInfoMap[srcElem.Name] = filterInfo
 				dstElem.NextUniqueNumber++
@@ -707,6 +746,15 @@ func setShapingRule(filterInfo *FilterInfo) error {
 	m_shape["distribution"] = filterInfo.Distribution
 	m_shape["packetLoss"] = strconv.FormatInt(int64(filterInfo.PacketLoss), 10)
 	m_shape["dataRate"] = strconv.FormatInt(int64(filterInfo.DataRate), 10)
+	m_shape["lossModel"] = filterInfo.LossModel
+	m_shape["lossCorrelation"] = strconv.FormatInt(int64(filterInfo.LossCorrelation), 10)
+	m_shape["burstLossGoodToBad"] = strconv.FormatInt(int64(filterInfo.BurstLossGoodToBad), 10)
+	m_shape["burstLossBadToGood"] = strconv.FormatInt(int64(filterInfo.BurstLossBadToGood), 10)
+	m_shape["burstLossBad"] = strconv.FormatInt(int64(filterInfo.BurstLossBad), 10)
+	m_shape["burstLossGood"] = strconv.FormatInt(int64(filterInfo.BurstLossGood), 10)
+	m_shape["duplication"] = strconv.FormatInt(int64(filterInfo.Duplication), 10)
+	m_shape["corruption"] = strconv.FormatInt(int64(filterInfo.Corruption), 10)
+	m_shape["reordering"] = strconv.FormatInt(int64(filterInfo.Reordering), 10)
 	m_shape["ifb_uniqueId"] = uniqueId
 
 	keyName := tce.netCharStore.baseKey + typeNet + ":" + filterInfo.PodName + ":shape:" + uniqueId
@@ -726,7 +774,9 @@ func applyMgSvcMapping() {
 	// For each pod, add MG, ingress & egress Service LB rules
 	for _, podInfo := range podInfoMap {
 		// MG Service LB rules
-		for _, svcInfo := range podInfo.MgSvcMap {
+		for mgSvcName, svcInfo := range podInfo.MgSvcMap {
+			sessionInfo := podInfo.MgSvcSessionMap[mgSvcName]
+
 			// Add one rule per port
 			for _, portInfo := range svcInfo.Ports {
 				// Populate rule fields
@@ -739,6 +789,10 @@ func applyMgSvcMapping() {
 				fields[fieldLbSvcName] = svcInfo.Name
 				fields[fieldLbSvcIp] = svcIPMap[svcInfo.Name]
 				fields[fieldLbSvcPort] = portInfo.Port
+				if sessionInfo != nil && sessionInfo.Mode != "" {
+					fields[fieldSessionTransferMode] = sessionInfo.Mode
+					fields[fieldSessionDrainTimeout] = sessionInfo.DrainTimeout
+				}
 
 				// Make unique key
 				key := tce.netCharStore.baseKey + typeLb + ":" + podInfo.Name + ":" +
@@ -0,0 +1,160 @@
+/*
+ * Copyright (c) 2020  InterDigital Communications, Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-logger"
+
+	k8s_ct "k8s.io/kubernetes/pkg/util/conntrack"
+	k8s_exec "k8s.io/utils/exec"
+)
+
+const sessionTransModeGraceful string = "GRACEFUL"
+const anyIp string = "0.0.0.0/0"
+
+// lbRuleChange - LB rule destination change requiring tracked connection updates
+// Tracked connections keep their NAT destination until removed, so established
+// connections remain on the previous LB service instance until they are deleted.
+type lbRuleChange struct {
+	service      string
+	protocol     string
+	svcIp        string
+	svcPort      string
+	prevLbSvcIp  string
+	lbSvcIp      string
+	mode         string
+	drainTimeout time.Duration
+}
+
+// Pending LB rule changes, processed once stale LB rules are removed
+var lbRuleChanges []*lbRuleChange
+
+// Current LB service instance IP per service
+var lbSvcIps = map[string]string{}
+
+// Graceful session transfer drain timers per service & previous LB service instance IP
+var drainTimers = map[string]*time.Timer{}
+var drainMutex sync.Mutex
+
+// addLbRuleChange - Track LB rule update for the provided service
+func addLbRuleChange(service string, fields map[string]string) {
+	change := new(lbRuleChange)
+	change.service = service
+	change.protocol = strings.ToLower(fields[fieldSvcProtocol])
+	change.svcIp = fields[fieldSvcIp]
+	change.svcPort = fields[fieldSvcPort]
+	change.prevLbSvcIp = lbSvcIps[service]
+	change.lbSvcIp = fields[fieldLbSvcIp]
+	change.mode = fields[fieldSessionTransferMode]
+	if timeout, err := strconv.Atoi(fields[fieldSessionDrainTimeout]); err == nil && timeout > 0 {
+		change.drainTimeout = time.Duration(timeout) * time.Second
+	}
+	lbSvcIps[service] = change.lbSvcIp
+	lbRuleChanges = append(lbRuleChanges, change)
+}
+
+// removeLbService - Forget LB service instance of a service whose LB rule was removed
+func removeLbService(service string) {
+	delete(lbSvcIps, service)
+}
+
+// processLbRuleChanges - Update tracked connections of changed LB rules
+// FORCED: connections tracked for the service are deleted so that all traffic hits the new LB rule
+// GRACEFUL: connections to the previous instance are kept until they close or the drain timeout expires
+func processLbRuleChanges() {
+	for _, change := range lbRuleChanges {
+		// Connections to the new instance must not be drained
+		stopDrain(change.service, change.lbSvcIp)
+
+		if change.mode == sessionTransModeGraceful && change.prevLbSvcIp != "" && change.prevLbSvcIp != change.lbSvcIp {
+			startDrain(change)
+		} else {
+			deleteTrackedConnections(change, "")
+		}
+	}
+	lbRuleChanges = nil
+}
+
+// startDrain - Delete connections to the previous instance after the drain timeout
+func startDrain(change *lbRuleChange) {
+	drainMutex.Lock()
+	defer drainMutex.Unlock()
+
+	key := change.service + ":" + change.prevLbSvcIp
+	if timer, found := drainTimers[key]; found {
+		timer.Stop()
+	}
+	log.Info("Draining connections of ", change.service, " to ", change.prevLbSvcIp, " in ", change.drainTimeout)
+	drainTimers[key] = time.AfterFunc(change.drainTimeout, func() {
+		drainMutex.Lock()
+		delete(drainTimers, key)
+		drainMutex.Unlock()
+
+		log.Info("Drain timeout expired for connections of ", change.service, " to ", change.prevLbSvcIp)
+		deleteTrackedConnections(change, change.prevLbSvcIp)
+	})
+}
+
+// stopDrain - Cancel pending drain of connections to the provided instance
+func stopDrain(service string, lbSvcIp string) {
+	drainMutex.Lock()
+	defer drainMutex.Unlock()
+
+	key := service + ":" + lbSvcIp
+	if timer, found := drainTimers[key]; found {
+		timer.Stop()
+		delete(drainTimers, key)
+		log.Info("Cancelled drain of connections of ", service, " to ", lbSvcIp)
+	}
+}
+
+// deleteTrackedConnections - Delete tracked connections of the changed LB rule
+// If provided, only connections translated to the LB service instance IP are deleted.
+func deleteTrackedConnections(change *lbRuleChange, lbSvcIp string) {
+	exec := k8s_exec.New()
+	if !k8s_ct.Exists(exec) {
+		log.Warn("conntrack not available; tracked connections not updated for ", change.service)
+		return
+	}
+	err := k8s_ct.Exec(exec, getConntrackDeleteArgs(change, lbSvcIp)...)
+	if err != nil && !strings.Contains(err.Error(), k8s_ct.NoConnectionToDelete) {
+		log.Error("Failed to delete tracked connections for ", change.service, ". Error: ", err)
+	}
+}
+
+// getConntrackDeleteArgs - Get conntrack arguments to delete tracked connections of the changed LB rule
+func getConntrackDeleteArgs(change *lbRuleChange, lbSvcIp string) []string {
+	args := []string{"-D"}
+	if change.protocol != "" {
+		args = append(args, "-p", change.protocol)
+	}
+	if change.svcIp != "" && change.svcIp != anyIp {
+		args = append(args, "--orig-dst", change.svcIp)
+	}
+	if change.svcPort != "" {
+		args = append(args, "--orig-port-dst", change.svcPort)
+	}
+	if lbSvcIp != "" {
+		args = append(args, "--dst-nat", lbSvcIp)
+	}
+	return args
+}
@@ -0,0 +1,103 @@
+/*
+ * Copyright (c) 2020  InterDigital Communications, Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	log "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-logger"
+)
+
+func TestGetConntrackDeleteArgs(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	tests := []struct {
+		name     string
+		change   lbRuleChange
+		lbSvcIp  string
+		expected []string
+	}{
+		{"empty", lbRuleChange{}, "", []string{"-D"}},
+		{"full rule",
+			lbRuleChange{protocol: "tcp", svcIp: "10.0.0.1", svcPort: "80"}, "",
+			[]string{"-D", "-p", "tcp", "--orig-dst", "10.0.0.1", "--orig-port-dst", "80"}},
+		{"any service IP",
+			lbRuleChange{protocol: "udp", svcIp: anyIp, svcPort: "53"}, "",
+			[]string{"-D", "-p", "udp", "--orig-port-dst", "53"}},
+		{"drained instance",
+			lbRuleChange{protocol: "tcp", svcIp: "10.0.0.1", svcPort: "80", lbSvcIp: "10.0.1.2", prevLbSvcIp: "10.0.1.1"}, "10.0.1.1",
+			[]string{"-D", "-p", "tcp", "--orig-dst", "10.0.0.1", "--orig-port-dst", "80", "--dst-nat", "10.0.1.1"}},
+		{"no protocol",
+			lbRuleChange{svcIp: "10.0.0.1"}, "10.0.1.1",
+			[]string{"-D", "--orig-dst", "10.0.0.1", "--dst-nat", "10.0.1.1"}},
+	}
+
+	for _, test := range tests {
+		args := getConntrackDeleteArgs(&test.change, test.lbSvcIp)
+		if !reflect.DeepEqual(args, test.expected) {
+			t.Fatalf("%s: expected %v got %v", test.name, test.expected, args)
+		}
+	}
+}
+
+func TestLbRuleChanges(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	const service = "ME-SVC-TEST-80"
+	fields := map[string]string{
+		fieldSvcProtocol:         "TCP",
+		fieldSvcIp:               "10.0.0.1",
+		fieldSvcPort:             "80",
+		fieldLbSvcIp:             "10.0.1.1",
+		fieldSessionTransferMode: sessionTransModeGraceful,
+		fieldSessionDrainTimeout: "30",
+	}
+	lbRuleChanges = nil
+	lbSvcIps = map[string]string{}
+
+	fmt.Println("First LB rule has no previous instance")
+	addLbRuleChange(service, fields)
+	if len(lbRuleChanges) != 1 || lbRuleChanges[0].prevLbSvcIp != "" || lbRuleChanges[0].protocol != "tcp" {
+		t.Fatalf("Unexpected LB rule change")
+	}
+
+	fmt.Println("Updated LB rule tracks previous instance")
+	fields[fieldLbSvcIp] = "10.0.1.2"
+	addLbRuleChange(service, fields)
+	change := lbRuleChanges[1]
+	if change.prevLbSvcIp != "10.0.1.1" || change.lbSvcIp != "10.0.1.2" || change.drainTimeout != 30*time.Second {
+		t.Fatalf("Unexpected LB rule change")
+	}
+	lbRuleChanges = nil
+
+	fmt.Println("Removed service forgets its instance")
+	removeLbService(service)
+	if _, found := lbSvcIps[service]; found {
+		t.Fatalf("LB service instance should be removed")
+	}
+	addLbRuleChange(service, fields)
+	if lbRuleChanges[0].prevLbSvcIp != "" {
+		t.Fatalf("Re-added service should not have a previous instance")
+	}
+	lbRuleChanges = nil
+	lbSvcIps = map[string]string{}
+}
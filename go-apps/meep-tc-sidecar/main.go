@@ -23,6 +23,7 @@ import (
 	"os"
 	"os/exec"
 	"os/signal"
+	"strconv"
 	"strings"
 	"sync"
 	"syscall"
@@ -35,8 +36,7 @@ import (
 	redis "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-redis"
 
 	ipt "github.com/coreos/go-iptables/iptables"
-	k8s_ct "k8s.io/kubernetes/pkg/util/conntrack"
-	k8s_exec "k8s.io/utils/exec"
+	"github.com/vishvananda/netlink"
 )
 
 const moduleName string = "meep-tc-sidecar"
@@ -58,6 +58,9 @@ const egressPrefix string = meepPrefix + "EGRESS-"
 const meSvcChain string = mePrefix + "SERVICES"
 const ingressSvcChain string = ingressPrefix + "SERVICES"
 const egressSvcChain string = egressPrefix + "SERVICES"
+
+// Sidecar IFB names use a dedicated prefix to leave other IFBs (e.g. ifb0 from the ifb kernel module) untouched
+const ifbPrefix string = "meep-ifb"
 const maxChainLen int = 25
 const capLetters = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
 
@@ -68,6 +71,12 @@ const fieldSvcProtocol string = "svc-protocol"
 const fieldSvcPort string = "svc-port"
 const fieldLbSvcIp string = "lb-svc-ip"
 const fieldLbSvcPort string = "lb-svc-port"
+const fieldSessionTransferMode string = "session-transfer-mode"
+const fieldSessionDrainTimeout string = "session-drain-timeout"
+
+const lossModelGilbertElliott string = "gilbert-elliott"
+
+const ifaceName string = "eth0"
 
 type podShortElement struct {
 	name      string
@@ -99,29 +108,20 @@ var opts = struct {
 	resolverTimeout: 15000 * time.Millisecond,
 }
 
-// NetChar
-type NetChar struct {
-	Latency      string
-	Jitter       string
-	PacketLoss   string
-	Throughput   string
-	Distribution string
-}
-
 var pinger *Pinger
 var PodName string
 var sandboxName string
 var ipTbl *ipt.IPTables
+var tcClient *TcNetlink
 
 var letters = []rune(capLetters)
 var serviceChains = map[string]string{}
 var ifbs = map[string]string{}
 var filters = map[string]string{}
-var netcharMap = map[string]*NetChar{}
+var netcharMap = map[string]*NetemParams{}
 var latestLatencyResultsMap map[string]int32
 
 var measurementsRunning = false
-var flushRequired = false
 var firstTimePass = true
 
 const redisAddr = "meep-redis-master.default.svc.cluster.local:6379"
@@ -232,6 +232,14 @@ func initMeepSidecar() (err error) {
 	}
 	log.Info("Successfully created new IPTables client")
 
+	// Create netlink traffic control client
+	tcClient, err = NewTcNetlink()
+	if err != nil {
+		log.Error("Failed to create netlink client. Error: ", err)
+		return err
+	}
+	log.Info("Successfully created netlink client")
+
 	// Set base store key
 	baseKey = dkm.GetKeyRoot(sandboxName) + tcEngineKey
 
@@ -294,21 +302,47 @@ func msgHandler(msg *mq.Msg, userData interface{}) {
 }
 
 func refreshNetCharRules() {
-	// Create shape rules
-	_ = initializeOnFirstPass()
-
 	currentTime := time.Now()
 	nbAppliedOperations = 0
 
-	_ = createIfbs()
+	indexes, err := getLinkIndexes()
+	if err != nil {
+		log.Error("Failed to get links: ", err)
+		return
+	}
+
+	// Create shape rules
+	_ = initializeOnFirstPass(indexes)
+
+	// Reconcile with installed rules
+	reconcileNetCharRules(indexes)
+
+	batch := &netCharBatch{links: new(TcBatch), rules: new(TcBatch)}
+
+	// Create ifbs
+	_ = createIfbs(batch)
+	if batch.links.Len() != 0 {
+		_ = tcClient.Apply(batch.links)
+		batch.indexes, err = getLinkIndexes()
+		if err != nil {
+			log.Error("Failed to get links: ", err)
+			return
+		}
+	} else {
+		batch.indexes = indexes
+	}
 
-	_ = createFilters()
+	// Update ifb shaping & filters
+	setIfbs(batch)
+	_ = createFilters(batch)
 
 	// Delete unused filters
-	deleteUnusedFilters()
+	deleteUnusedFilters(batch)
+	_ = tcClient.Apply(batch.rules)
 
 	// Delete unused ifbs
-	deleteUnusedIfbs()
+	deleteUnusedIfbs(batch)
+	_ = tcClient.Apply(batch.links)
 
 	elapsed := time.Since(currentTime)
 	log.Debug("RefreshNetCharRules execution time for ", nbAppliedOperations, " updates, elapsed time: ", elapsed)
@@ -396,7 +430,6 @@ func refreshLbRules() {
 	}
 
 	// Apply pod-specific LB rules stored in DB
-	flushRequired = false
 	keyName := baseKey + typeLb + ":" + PodName + ":*"
 	err = rc.ForEachEntry(keyName, refreshLbRulesHandler, &chainMap)
 	if err != nil {
@@ -435,20 +468,18 @@ func refreshLbRules() {
 			log.Error("Failed to remove chain ", chain, ". Error: ", err)
 			return
 		}
-	}
 
-	// Flush tracked connections to make sure new LB rules are hit
-	if flushRequired {
-		flushTrackedConnections()
+		// Remove service mapping if service chain was not replaced
+		for service, svcChain := range serviceChains {
+			if svcChain == chain {
+				delete(serviceChains, service)
+				removeLbService(service)
+			}
+		}
 	}
-}
 
-func flushTrackedConnections() {
-	exec := k8s_exec.New()
-	if k8s_ct.Exists(exec) {
-		_ = k8s_ct.Exec(exec, "-F")
-	}
-	flushRequired = false
+	// Update tracked connections of changed LB rules now that stale rules are removed
+	processLbRuleChanges()
 }
 
 func refreshLbRulesHandler(key string, fields map[string]string, userData interface{}) error {
@@ -532,7 +563,7 @@ func refreshLbRulesHandler(key string, fields map[string]string, userData interf
 		return err
 	}
 
-	flushRequired = true
+	addLbRuleChange(service, fields)
 	return nil
 }
 
@@ -661,7 +692,7 @@ func workRxTxPackets() {
 			//get the data for all, parse the output and transmit to each
 			//starting 1 thread for getting the rx-tx info and computing the appropriate metrics
 			/*go*/
-			tputStats[u.remoteName] = u.processRxTx(qdiscResults["ifb"+u.ifbNumber])
+			tputStats[u.remoteName] = u.processRxTx(qdiscResults[ifbPrefix+u.ifbNumber])
 		}
 
 		key := metricsBaseKey + PodName + ":throughput"
@@ -711,7 +742,7 @@ func workLogRxTxData() {
 		for _, u := range opts.dests {
 			//starting 1 thread for getting the rx-tx info and computing the appropriate metrics
 			/*go*/
-			u.logRxTx(qdiscResults["ifb"+u.ifbNumber])
+			u.logRxTx(qdiscResults[ifbPrefix+u.ifbNumber])
 		}
 		semOptsDests.Unlock()
 
@@ -741,9 +772,17 @@ func createPingHandler(key string, fields map[string]string, userData interface{
 	return nil
 }
 
-func createIfbs() error {
+// netCharBatch - Netlink operations & link state for a single refresh cycle
+type netCharBatch struct {
+	links   *TcBatch
+	rules   *TcBatch
+	shapes  []map[string]string
+	indexes map[string]int
+}
+
+func createIfbs(batch *netCharBatch) error {
 	keyName := baseKey + typeNet + ":" + PodName + ":shape*"
-	err := rc.ForEachEntry(keyName, createIfbsHandler, nil)
+	err := rc.ForEachEntry(keyName, createIfbsHandler, batch)
 	if err != nil {
 		return err
 	}
@@ -751,22 +790,63 @@ func createIfbs() error {
 }
 
 func createIfbsHandler(key string, fields map[string]string, userData interface{}) error {
+	batch := userData.(*netCharBatch)
 	ifbNumber := fields["ifb_uniqueId"]
 	_, exists := ifbs[ifbNumber]
 	if !exists {
-		_ = cmdCreateIfb(fields)
-		ifbs[ifbNumber] = ifbNumber
-		_, _ = cmdSetIfb(fields)
-	} else {
-		_, _ = cmdSetIfb(fields)
+		batch.links.AddLink(ifbPrefix+ifbNumber, func(err error) {
+			if err == nil {
+				ifbs[ifbNumber] = ifbNumber
+			}
+		})
 	}
+	batch.shapes = append(batch.shapes, fields)
 
 	return nil
 }
 
-func createFilters() error {
+func setIfbs(batch *netCharBatch) {
+	for _, shape := range batch.shapes {
+		setIfb(batch, shape)
+	}
+}
+
+func setIfb(batch *netCharBatch, shape map[string]string) {
+	ifbNumber := shape["ifb_uniqueId"]
+	ifindex, found := batch.indexes[ifbPrefix+ifbNumber]
+	if !found {
+		log.Error(ifbPrefix + ifbNumber + " not found")
+		return
+	}
+
+	//only apply if an update is needed
+	params := getNetemParams(shape)
+	nc := netcharMap[ifbNumber]
+	if nc != nil && nc.Equal(params) && nc.Distribution == params.Distribution {
+		return
+	}
+
+	var distTable []byte
+	if params.Distribution != "" {
+		var err error
+		distTable, err = tcClient.GetDistTable(params.Distribution)
+		if err != nil {
+			log.Error("Failed to load distribution table: ", err)
+		}
+	}
+
+	batch.rules.ReplaceNetem(ifindex, params, distTable, func(err error) {
+		if err == nil {
+			log.Info("Tc log update: ifb", ifbNumber, " netem ", params.String())
+			//store the new values
+			netcharMap[ifbNumber] = params
+		}
+	})
+}
+
+func createFilters(batch *netCharBatch) error {
 	keyName := baseKey + typeNet + ":" + PodName + ":filter*"
-	err := rc.ForEachEntry(keyName, createFiltersHandler, nil)
+	err := rc.ForEachEntry(keyName, createFiltersHandler, batch)
 	if err != nil {
 		return err
 	}
@@ -774,51 +854,135 @@ func createFilters() error {
 }
 
 func createFiltersHandler(key string, fields map[string]string, userData interface{}) error {
+	batch := userData.(*netCharBatch)
 	filterNumber := fields["filter_uniqueId"]
 	_, exists := filters[filterNumber]
 
 	if !exists {
-
 		ipSrc := fields["srcIp"]
 		ipSvcSrc := fields["srcSvcIp"]
-		//              srcName := fields["srcName"]
 		ifbNumber := fields["ifb_uniqueId"]
 
-		err := cmdCreateFilter(filterNumber, ifbNumber, ipSrc)
-		if err == nil {
+		prio, err := strconv.ParseUint(filterNumber, 10, 16)
+		if err != nil {
+			log.Error("Invalid filter number: ", filterNumber)
+			return nil
+		}
+		ifindex, found := batch.indexes[ifbPrefix+ifbNumber]
+		if !found {
+			log.Error(ifbPrefix + ifbNumber + " not found for filter " + filterNumber)
+			return nil
+		}
 
-			if ipSvcSrc != "" {
-				err = cmdCreateFilter(filterNumber, ifbNumber, ipSvcSrc)
+		// Filter is installed once all of its source addresses are redirected
+		ipList := []string{ipSrc}
+		if ipSvcSrc != "" {
+			ipList = append(ipList, ipSvcSrc)
+		}
+		pending := len(ipList)
+		failed := false
+		cb := func(err error) {
+			pending--
+			failed = failed || err != nil
+			if pending == 0 && !failed {
+				filters[filterNumber] = filterNumber
 			}
 		}
-		if err == nil {
-			filters[filterNumber] = filterNumber
+		for _, ip := range ipList {
+			err = batch.rules.AddRedirectFilter(batch.indexes[ifaceName], uint16(prio), ip, ifindex, cb)
+			if err != nil {
+				log.Error(err.Error())
+				return nil
+			}
 		}
 	}
 
 	return nil
 }
 
-func deleteUnusedFilters() {
+func deleteUnusedFilters(batch *netCharBatch) {
 	for index, filterNumber := range filters {
 		keyName := baseKey + typeNet + ":" + PodName + ":filter:" + filterNumber
 		if !rc.EntryExists(keyName) {
 			log.Debug("filter removed: ", filterNumber)
 			// Remove old filter
-			_ = cmdDeleteFilter(filterNumber)
+			prio, _ := strconv.ParseUint(filterNumber, 10, 16)
+			batch.rules.DelFilters(batch.indexes[ifaceName], uint16(prio), nil)
 			delete(filters, index)
 		}
 	}
 }
 
-func deleteUnusedIfbs() {
+func deleteUnusedIfbs(batch *netCharBatch) {
 	for index, ifbNumber := range ifbs {
 		keyName := baseKey + typeNet + ":" + PodName + ":shape:" + ifbNumber
 		if !rc.EntryExists(keyName) {
 			log.Debug("ifb removed: ", ifbNumber)
 			// Remove associated Ifb
-			_ = cmdDeleteIfb(ifbNumber)
+			batch.links.DelLink(ifbPrefix+ifbNumber, nil)
 			delete(ifbs, index)
+			delete(netcharMap, ifbNumber)
+		}
+	}
+}
+
+// reconcileNetCharRules - Align local rule state with the rules currently installed in the kernel
+func reconcileNetCharRules(indexes map[string]int) {
+	// Read back netem qdiscs
+	qdiscs, err := tcClient.ListNetem()
+	if err != nil {
+		log.Error("Failed to read back qdiscs: ", err)
+		return
+	}
+
+	// Adopt existing ifbs & detect removed ones
+	for name, ifindex := range indexes {
+		ifbNumber, ok := getIfbNumber(name)
+		if !ok {
+			continue
+		}
+		if _, found := ifbs[ifbNumber]; !found {
+			log.Debug("Adopting existing ifb: ", ifbNumber)
+			ifbs[ifbNumber] = ifbNumber
+		}
+		params := qdiscs[ifindex]
+		nc := netcharMap[ifbNumber]
+		if params == nil {
+			delete(netcharMap, ifbNumber)
+		} else if nc == nil || !nc.Equal(params) {
+			if nc != nil {
+				log.Info("Netem drift detected on ", ifbPrefix, ifbNumber, ": ", params.String())
+			}
+			// Keep kernel state; distribution is unknown so it gets reapplied if needed
+			netcharMap[ifbNumber] = params
+		}
+	}
+	for index, ifbNumber := range ifbs {
+		if _, found := indexes[ifbPrefix+ifbNumber]; !found {
+			log.Info(ifbPrefix, ifbNumber, " no longer exists")
+			delete(ifbs, index)
+			delete(netcharMap, ifbNumber)
+		}
+	}
+
+	// Read back installed filters
+	prios, err := getFilterPrios(indexes[ifaceName])
+	if err != nil {
+		log.Error("Failed to read back filters: ", err)
+		return
+	}
+	for prio := range prios {
+		filterNumber := strconv.FormatUint(uint64(prio), 10)
+		if _, found := filters[filterNumber]; !found {
+			log.Debug("Adopting existing filter: ", filterNumber)
+			filters[filterNumber] = filterNumber
+		}
+	}
+	for index, filterNumber := range filters {
+		prio, _ := strconv.ParseUint(filterNumber, 10, 16)
+		if !prios[uint16(prio)] {
+			log.Info("filter ", filterNumber, " no longer exists")
+			delete(filters, index)
 		}
 	}
 }
@@ -845,148 +1009,20 @@ func cmdExec(cli string) (string, error) {
 	return out.String(), nil
 }
 
-func cmdCreateIfb(shape map[string]string) error {
-	ifbNumber := shape["ifb_uniqueId"]
-
-	//"ip link add $ifb$ifbnumber type ifb"
-	str := "ip link add ifb" + ifbNumber + " type ifb"
-	nbAppliedOperations++
-	_, err := cmdExec(str)
-	if err != nil {
-		log.Info("ERROR ifb" + ifbNumber + " already exist in sidecar")
-		return err
-	}
-
-	//"ip link set $ifb$ifbnumber up"
-	str = "ip link set ifb" + ifbNumber + " up"
-	nbAppliedOperations++
-	_, err = cmdExec(str)
-	if err != nil {
-		return err
-	}
-
-	//"tc qdisc replace dev $ifb$ifbnumber handle 1:0 root netem"
-	str = "tc qdisc replace dev ifb" + ifbNumber + " handle 1:0 root netem"
-	nbAppliedOperations++
-	_, err = cmdExec(str)
-	if err != nil {
-		return err
-	}
-
-	return nil
-}
-
-func cmdSetIfb(shape map[string]string) (bool, error) {
-	ifbNumber := shape["ifb_uniqueId"]
-	delay := shape["delay"]
-	delayVariation := shape["delayVariation"]
-	delayCorrelation := shape["delayCorrelation"]
-	distribution := shape["distribution"]
-
-	loss := shape["packetLoss"]
-	var lossInteger string
-	var lossFraction string
-
-	if len(loss) > 2 {
-		lossInteger = loss[0 : len(loss)-2]
-		lossFraction = loss[len(loss)-2:]
-	} else if len(loss) > 0 {
-		// length is 1 or 2
-		lossInteger = "0"
-		lossFraction = loss
-	} else {
-		lossInteger = "0"
-		lossFraction = "00"
-	}
-
-	dataRate := shape["dataRate"]
-
-	//tc qdisc change dev $ifb$ifbnumber handle 1:0 root netem delay $delay$ms loss $loss$prcent
-	distributionStr := ""
-	if delayVariation != "0" {
-		if distribution != "" {
-			//special case for uniform, which is not specifying a distribution (respecting netem description of a uniform distribution)
-			if distribution != "uniform" {
-				distributionStr = "distribution " + distribution
-			}
-		} else {
-			distributionStr = "distribution normal"
-			distribution = "normal"
-		}
-	}
-
-	nc := netcharMap[ifbNumber]
-	if nc == nil {
-		nc = new(NetChar)
-		netcharMap[ifbNumber] = nc
-	}
-	//only apply if an update is needed
-	if nc.Latency != delay || nc.Jitter != delayVariation || nc.PacketLoss != loss || nc.Throughput != dataRate || (delayVariation != "0" && nc.Distribution != distribution) {
-		str := "tc qdisc change dev ifb" + ifbNumber + " handle 1:0 root netem delay " + delay + "ms " + delayVariation + "ms " + delayCorrelation + "% " + distributionStr + " loss " + lossInteger + "." + lossFraction + "%"
-		if dataRate != "" && dataRate != "0" {
-			str = str + " rate " + dataRate + "bit"
-		}
-		nbAppliedOperations++
-		_, err := cmdExec(str)
-		if err != nil {
-			return false, err
-		}
-
-		log.Info("Tc log update: ", str)
-		//store the new values
-		nc.Latency = delay
-		nc.Jitter = delayVariation
-		nc.PacketLoss = loss
-		nc.Throughput = dataRate
-		nc.Distribution = distribution
-		return true, nil
-	}
-
-	return false, nil
-}
-
-func cmdDeleteIfb(ifbNumber string) error {
-	//"ip link delete ifb$ifbNumber"
-	str := "ip link delete ifb" + ifbNumber
-	nbAppliedOperations++
-	_, err := cmdExec(str)
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
-// func cmdDeleteAllFilters() error {
-// 	str := "tc filter del dev eth0 parent ffff:"
-// 	_, err := cmdExec(str)
-// 	if err != nil {
-// 		return err
-// 	}
-// 	return nil
-// }
-
-func cmdDeleteFilter(filterNumber string) error {
-	//tc filter del dev eth0 parent ffff: pref $filterNumber
-	str := "tc filter del dev eth0 parent ffff: pref " + filterNumber
-	nbAppliedOperations++
-	_, err := cmdExec(str)
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
-func initializeOnFirstPass() error {
+func initializeOnFirstPass(indexes map[string]int) error {
 
 	if firstTimePass {
-		nbAppliedOperations++
-		_, err := cmdExec("tc qdisc replace dev eth0 root handle 1: netem")
-		if err != nil {
+		ifindex, found := indexes[ifaceName]
+		if !found {
+			err := errors.New(ifaceName + " not found")
 			log.Info("Error: ", err)
 			return err
 		}
-		nbAppliedOperations++
-		_, err = cmdExec("tc qdisc replace dev eth0 handle ffff: ingress")
+
+		batch := new(TcBatch)
+		batch.ReplaceQdisc(ifindex, tcHandleNetem, tcHandleRoot, "netem", nil)
+		batch.ReplaceQdisc(ifindex, tcHandleIngress, netlink.HANDLE_INGRESS, "ingress", nil)
+		err := tcClient.Apply(batch)
 		if err != nil {
 			log.Info("Error: ", err)
 			return err
@@ -996,23 +1032,6 @@ func initializeOnFirstPass() error {
 	return nil
 }
 
-func cmdCreateFilter(filterNumber string, ifbNumber string, ipSrc string) error {
-
-	//"tc filter add dev eth0 parent ffff: protocol ip prio $filterNumber u32 match ip src $ipsrc match u32 0 0 action mirred egress redirect dev $ifb$ifbnumber"
-	str := "tc filter add dev eth0 parent ffff: protocol ip prio " + filterNumber + " u32 match ip src " + ipSrc + " match u32 0 0 action mirred egress redirect dev ifb" + ifbNumber
-
-	//fonction must be a replace... a replace Adds if not there or replace if existing
-	//"tc filter replace dev eth0 parent ffff: protocol ip prio $filterNumber u32 match ip src $ipsrc match u32 0 0 action mirred egress redirect dev $ifb$ifbnumber"
-	//str := "tc filter replace dev eth0 parent ffff: protocol ip prio " + filterNumber + " handle 800::800 u32 match u32 0 0 action mirred egress redirect dev ifb" + ifbNumber
-	nbAppliedOperations++
-	_, err := cmdExec(str)
-	if err != nil {
-		log.Info("Error: ", err)
-		return err
-	}
-	return nil
-}
-
 func randSeq(n int) string {
 	b := make([]rune, n)
 	for i := range b {
@@ -0,0 +1,647 @@
+/*
+ * Copyright (c) 2020  InterDigital Communications, Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"math"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"syscall"
+
+	log "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-logger"
+
+	"github.com/vishvananda/netlink"
+	"github.com/vishvananda/netlink/nl"
+	"golang.org/x/sys/unix"
+)
+
+// Netem attributes not defined by the netlink package
+const (
+	tcaNetemLatency64 = 10
+	tcaNetemJitter64  = 11
+	netemLossGE       = 2
+)
+
+// Traffic control handles
+const (
+	tcHandleRoot    uint32 = 0xFFFFFFFF
+	tcHandleIngress uint32 = 0xFFFF0000
+	tcHandleNetem   uint32 = 0x00010000
+)
+
+const pschedShift = 6
+const netemDefaultLimit = 1000
+const netemMaxPercent = 10000
+const tcMaxBatchSize = 32 * 1024
+const tcRecvTimeoutSec = 5
+
+var netemDistDirs = []string{"/usr/lib/tc", "/usr/lib64/tc", "/lib/tc"}
+
+// NetemParams - Netem qdisc configuration, using kernel units
+type NetemParams struct {
+	Latency      uint64 // ns
+	Jitter       uint64 // ns
+	LatencyCorr  uint32
+	Loss         uint32
+	LossCorr     uint32
+	GeModel      bool
+	GeP          uint32
+	GeR          uint32
+	GeH          uint32
+	GeK1         uint32
+	Duplicate    uint32
+	Corrupt      uint32
+	Reorder      uint32
+	Gap          uint32
+	Rate         uint64 // bytes/s
+	Distribution string
+}
+
+// Equal - Compare netem configurations; distribution tables cannot be read back from the kernel
+func (p *NetemParams) Equal(o *NetemParams) bool {
+	a := *p
+	b := *o
+	a.Distribution = ""
+	b.Distribution = ""
+	return a == b
+}
+
+func (p *NetemParams) String() string {
+	str := fmt.Sprintf("delay %dus %dus corr %d", p.Latency/1000, p.Jitter/1000, p.LatencyCorr)
+	if p.Distribution != "" {
+		str += " distribution " + p.Distribution
+	}
+	if p.GeModel {
+		str += fmt.Sprintf(" loss gemodel %d %d %d %d", p.GeP, p.GeR, p.GeH, p.GeK1)
+	} else {
+		str += fmt.Sprintf(" loss %d corr %d", p.Loss, p.LossCorr)
+	}
+	str += fmt.Sprintf(" duplicate %d corrupt %d reorder %d gap %d rate %dB/s", p.Duplicate, p.Corrupt, p.Reorder, p.Gap, p.Rate)
+	return str
+}
+
+// tcOp - Single netlink request with its completion callback
+type tcOp struct {
+	req  *nl.NetlinkRequest
+	desc string
+	cb   func(err error)
+}
+
+// TcBatch - Set of netlink requests sent to the kernel in as few socket writes as possible
+type TcBatch struct {
+	ops []*tcOp
+}
+
+// TcNetlink - Netlink traffic control client
+type TcNetlink struct {
+	sock       *nl.NetlinkSocket
+	seq        uint32
+	distTables map[string][]byte
+}
+
+// NewTcNetlink - Create a new netlink traffic control client
+func NewTcNetlink() (*TcNetlink, error) {
+	sock, err := nl.Subscribe(unix.NETLINK_ROUTE)
+	if err != nil {
+		return nil, err
+	}
+	// Do not echo request payloads in acks; best effort on older kernels
+	_ = unix.SetsockoptInt(sock.GetFd(), unix.SOL_NETLINK, unix.NETLINK_CAP_ACK, 1)
+	err = sock.SetReceiveTimeout(&unix.Timeval{Sec: tcRecvTimeoutSec})
+	if err != nil {
+		sock.Close()
+		return nil, err
+	}
+
+	tc := new(TcNetlink)
+	tc.sock = sock
+	tc.distTables = make(map[string][]byte)
+	return tc, nil
+}
+
+// Len - Number of queued operations
+func (b *TcBatch) Len() int {
+	return len(b.ops)
+}
+
+func (b *TcBatch) add(req *nl.NetlinkRequest, desc string, cb func(err error)) {
+	b.ops = append(b.ops, &tcOp{req: req, desc: desc, cb: cb})
+	nbAppliedOperations++
+}
+
+// AddLink - Queue creation of an IFB link in the up state
+func (b *TcBatch) AddLink(name string, cb func(err error)) {
+	req := nl.NewNetlinkRequest(unix.RTM_NEWLINK, unix.NLM_F_CREATE|unix.NLM_F_EXCL)
+	msg := nl.NewIfInfomsg(unix.AF_UNSPEC)
+	msg.Flags = unix.IFF_UP
+	msg.Change = unix.IFF_UP
+	req.AddData(msg)
+	req.AddData(nl.NewRtAttr(unix.IFLA_IFNAME, nl.ZeroTerminated(name)))
+	linkInfo := nl.NewRtAttr(unix.IFLA_LINKINFO, nil)
+	linkInfo.AddRtAttr(nl.IFLA_INFO_KIND, nl.NonZeroTerminated("ifb"))
+	req.AddData(linkInfo)
+	b.add(req, "link add "+name, cb)
+}
+
+// DelLink - Queue deletion of a link
+func (b *TcBatch) DelLink(name string, cb func(err error)) {
+	req := nl.NewNetlinkRequest(unix.RTM_DELLINK, 0)
+	req.AddData(nl.NewIfInfomsg(unix.AF_UNSPEC))
+	req.AddData(nl.NewRtAttr(unix.IFLA_IFNAME, nl.ZeroTerminated(name)))
+	b.add(req, "link delete "+name, cb)
+}
+
+// ReplaceQdisc - Queue creation or update of a qdisc with default options
+func (b *TcBatch) ReplaceQdisc(ifindex int, handle uint32, parent uint32, kind string, cb func(err error)) {
+	req := newQdiscRequest(ifindex, handle, parent, kind)
+	if kind == "netem" {
+		qopt := nl.TcNetemQopt{Limit: netemDefaultLimit}
+		req.AddData(nl.NewRtAttr(nl.TCA_OPTIONS, qopt.Serialize()))
+	}
+	b.add(req, fmt.Sprintf("qdisc replace dev %d %s", ifindex, kind), cb)
+}
+
+// ReplaceNetem - Queue creation or update of a root netem qdisc
+func (b *TcBatch) ReplaceNetem(ifindex int, params *NetemParams, distTable []byte, cb func(err error)) {
+	native := nl.NativeEndian()
+	req := newQdiscRequest(ifindex, tcHandleNetem, tcHandleRoot, "netem")
+
+	qopt := nl.TcNetemQopt{
+		Latency:   nsToTicks(params.Latency),
+		Limit:     netemDefaultLimit,
+		Loss:      params.Loss,
+		Gap:       params.Gap,
+		Duplicate: params.Duplicate,
+		Jitter:    nsToTicks(params.Jitter),
+	}
+	options := nl.NewRtAttr(nl.TCA_OPTIONS, qopt.Serialize())
+
+	// Correlation, reordering, corruption & rate are always sent so that previous values get cleared
+	corr := nl.TcNetemCorr{DelayCorr: params.LatencyCorr, LossCorr: params.LossCorr}
+	options.AddRtAttr(nl.TCA_NETEM_CORR, corr.Serialize())
+	reorder := nl.TcNetemReorder{Probability: params.Reorder}
+	options.AddRtAttr(nl.TCA_NETEM_REORDER, reorder.Serialize())
+	corrupt := nl.TcNetemCorrupt{Probability: params.Corrupt}
+	options.AddRtAttr(nl.TCA_NETEM_CORRUPT, corrupt.Serialize())
+
+	rate := make([]byte, 16)
+	if params.Rate >= math.MaxUint32 {
+		native.PutUint32(rate[0:4], math.MaxUint32)
+		options.AddRtAttr(nl.TCA_NETEM_RATE64, nl.Uint64Attr(params.Rate))
+	} else {
+		native.PutUint32(rate[0:4], uint32(params.Rate))
+	}
+	options.AddRtAttr(nl.TCA_NETEM_RATE, rate)
+
+	if params.GeModel {
+		ge := make([]byte, 16)
+		native.PutUint32(ge[0:4], params.GeP)
+		native.PutUint32(ge[4:8], params.GeR)
+		native.PutUint32(ge[8:12], params.GeH)
+		native.PutUint32(ge[12:16], params.GeK1)
+		loss := options.AddRtAttr(nl.TCA_NETEM_LOSS, nil)
+		loss.AddRtAttr(netemLossGE, ge)
+	}
+
+	if distTable != nil {
+		options.AddRtAttr(nl.TCA_NETEM_DELAY_DIST, distTable)
+	}
+
+	latency := make([]byte, 8)
+	native.PutUint64(latency, params.Latency)
+	options.AddRtAttr(tcaNetemLatency64, latency)
+	jitter := make([]byte, 8)
+	native.PutUint64(jitter, params.Jitter)
+	options.AddRtAttr(tcaNetemJitter64, jitter)
+
+	req.AddData(options)
+	b.add(req, fmt.Sprintf("qdisc replace dev %d netem %s", ifindex, params.String()), cb)
+}
+
+// AddRedirectFilter - Queue a u32 ingress filter redirecting traffic from ipSrc to the target link
+func (b *TcBatch) AddRedirectFilter(ifindex int, prio uint16, ipSrc string, targetIndex int, cb func(err error)) error {
+	ip := net.ParseIP(ipSrc).To4()
+	if ip == nil {
+		return errors.New("Invalid IPv4 source address: " + ipSrc)
+	}
+
+	req := nl.NewNetlinkRequest(unix.RTM_NEWTFILTER, unix.NLM_F_CREATE|unix.NLM_F_EXCL)
+	req.AddData(&nl.TcMsg{
+		Family:  unix.AF_UNSPEC,
+		Ifindex: int32(ifindex),
+		Parent:  tcHandleIngress,
+		Info:    netlink.MakeHandle(prio, nl.Swap16(unix.ETH_P_IP)),
+	})
+	req.AddData(nl.NewRtAttr(nl.TCA_KIND, nl.ZeroTerminated("u32")))
+
+	// match ip src <ipSrc>/32 match u32 0 0
+	sel := nl.TcU32Sel{Flags: nl.TC_U32_TERMINAL, Nkeys: 2}
+	sel.Keys = append(sel.Keys, nl.TcU32Key{Mask: math.MaxUint32, Val: nl.NativeEndian().Uint32(ip), Off: 12})
+	sel.Keys = append(sel.Keys, nl.TcU32Key{})
+	options := nl.NewRtAttr(nl.TCA_OPTIONS, nil)
+	options.AddRtAttr(nl.TCA_U32_SEL, sel.Serialize())
+
+	// action mirred egress redirect dev <target>
+	mirred := nl.TcMirred{Eaction: int32(netlink.TCA_EGRESS_REDIR), Ifindex: uint32(targetIndex)}
+	mirred.Action = int32(netlink.TC_ACT_STOLEN)
+	actions := options.AddRtAttr(nl.TCA_U32_ACT, nil)
+	action := actions.AddRtAttr(nl.TCA_ACT_TAB, nil)
+	action.AddRtAttr(nl.TCA_ACT_KIND, nl.ZeroTerminated("mirred"))
+	actionOptions := action.AddRtAttr(nl.TCA_ACT_OPTIONS, nil)
+	actionOptions.AddRtAttr(nl.TCA_MIRRED_PARMS, mirred.Serialize())
+	req.AddData(options)
+
+	b.add(req, fmt.Sprintf("filter add dev %d prio %d src %s redirect %d", ifindex, prio, ipSrc, targetIndex), cb)
+	return nil
+}
+
+// DelFilters - Queue deletion of all ingress filters with the provided priority
+func (b *TcBatch) DelFilters(ifindex int, prio uint16, cb func(err error)) {
+	req := nl.NewNetlinkRequest(unix.RTM_DELTFILTER, 0)
+	req.AddData(&nl.TcMsg{
+		Family:  unix.AF_UNSPEC,
+		Ifindex: int32(ifindex),
+		Parent:  tcHandleIngress,
+		Info:    netlink.MakeHandle(prio, 0),
+	})
+	b.add(req, fmt.Sprintf("filter delete dev %d prio %d", ifindex, prio), cb)
+}
+
+func newQdiscRequest(ifindex int, handle uint32, parent uint32, kind string) *nl.NetlinkRequest {
+	req := nl.NewNetlinkRequest(unix.RTM_NEWQDISC, unix.NLM_F_CREATE|unix.NLM_F_REPLACE)
+	req.AddData(&nl.TcMsg{
+		Family:  unix.AF_UNSPEC,
+		Ifindex: int32(ifindex),
+		Handle:  handle,
+		Parent:  parent,
+	})
+	req.AddData(nl.NewRtAttr(nl.TCA_KIND, nl.ZeroTerminated(kind)))
+	return req
+}
+
+// Apply - Send all batched requests and wait for their acknowledgements
+func (tc *TcNetlink) Apply(b *TcBatch) error {
+	var failed int
+	pending := make(map[uint32]*tcOp)
+	buf := make([]byte, 0, tcMaxBatchSize)
+
+	// Batch is consumed even on socket errors so that stale requests are never resent
+	defer func() { b.ops = nil }()
+
+	for i, op := range b.ops {
+		tc.seq++
+		op.req.Seq = tc.seq
+		op.req.Flags |= unix.NLM_F_ACK
+		pending[op.req.Seq] = op
+		buf = append(buf, op.req.Serialize()...)
+
+		// Flush when buffer is full or on last request
+		if len(buf) < tcMaxBatchSize && i < len(b.ops)-1 {
+			continue
+		}
+		err := unix.Sendto(tc.sock.GetFd(), buf, 0, &unix.SockaddrNetlink{Family: unix.AF_NETLINK})
+		if err != nil {
+			log.Error("Failed to send netlink batch: ", err)
+			failOps(b.ops, pending, i+1, err)
+			return err
+		}
+		buf = buf[:0]
+
+		// Wait for all acks
+		for len(pending) > 0 {
+			msgs, _, err := tc.sock.Receive()
+			if err != nil {
+				log.Error("Failed to receive netlink acks: ", err)
+				failOps(b.ops, pending, i+1, err)
+				return err
+			}
+			for _, m := range msgs {
+				op, found := pending[m.Header.Seq]
+				if !found || m.Header.Type != unix.NLMSG_ERROR {
+					continue
+				}
+				delete(pending, m.Header.Seq)
+				var opErr error
+				if errno := int32(nl.NativeEndian().Uint32(m.Data[0:4])); errno != 0 {
+					opErr = syscall.Errno(-errno)
+					log.Info("Error: ", opErr, " for operation: ", op.desc)
+					failed++
+				}
+				if op.cb != nil {
+					op.cb(opErr)
+				}
+			}
+		}
+	}
+
+	if failed != 0 {
+		return fmt.Errorf("%d netlink operations failed", failed)
+	}
+	return nil
+}
+
+// failOps - Fail unacknowledged & unsent operations with the provided error
+// NOTE: Operations before index sent are only failed if still pending acknowledgement
+func failOps(ops []*tcOp, pending map[uint32]*tcOp, sent int, err error) {
+	for i, op := range ops {
+		if i < sent {
+			if _, found := pending[op.req.Seq]; !found {
+				continue
+			}
+		}
+		if op.cb != nil {
+			op.cb(err)
+		}
+	}
+}
+
+// ListNetem - Read back root netem qdisc configuration per interface index
+func (tc *TcNetlink) ListNetem() (map[int]*NetemParams, error) {
+	tc.seq++
+	req := nl.NewNetlinkRequest(unix.RTM_GETQDISC, unix.NLM_F_DUMP)
+	req.Seq = tc.seq
+	req.AddData(&nl.TcMsg{Family: unix.AF_UNSPEC})
+	err := tc.sock.Send(req)
+	if err != nil {
+		return nil, err
+	}
+
+	qdiscs := make(map[int]*NetemParams)
+	for {
+		msgs, _, err := tc.sock.Receive()
+		if err != nil {
+			return nil, err
+		}
+		for _, m := range msgs {
+			if m.Header.Seq != req.Seq {
+				continue
+			}
+			switch m.Header.Type {
+			case unix.NLMSG_DONE:
+				return qdiscs, nil
+			case unix.NLMSG_ERROR:
+				errno := int32(nl.NativeEndian().Uint32(m.Data[0:4]))
+				return nil, syscall.Errno(-errno)
+			}
+
+			msg := nl.DeserializeTcMsg(m.Data)
+			if msg.Parent != tcHandleRoot {
+				continue
+			}
+			attrs, err := nl.ParseRouteAttr(m.Data[nl.SizeofTcMsg:])
+			if err != nil {
+				return nil, err
+			}
+			var kind string
+			var options []byte
+			for _, attr := range attrs {
+				switch attr.Attr.Type {
+				case nl.TCA_KIND:
+					kind = nl.BytesToString(attr.Value)
+				case nl.TCA_OPTIONS:
+					options = attr.Value
+				}
+			}
+			if kind != "netem" || len(options) < nl.SizeofTcNetemQopt {
+				continue
+			}
+			params, err := parseNetemOptions(options)
+			if err != nil {
+				return nil, err
+			}
+			qdiscs[int(msg.Ifindex)] = params
+		}
+	}
+}
+
+func parseNetemOptions(options []byte) (*NetemParams, error) {
+	native := nl.NativeEndian()
+	params := new(NetemParams)
+
+	qopt := nl.DeserializeTcNetemQopt(options)
+	params.Loss = qopt.Loss
+	params.Gap = qopt.Gap
+	params.Duplicate = qopt.Duplicate
+
+	attrs, err := nl.ParseRouteAttr(options[nl.SizeofTcNetemQopt:])
+	if err != nil {
+		return nil, err
+	}
+	for _, attr := range attrs {
+		switch attr.Attr.Type {
+		case nl.TCA_NETEM_CORR:
+			corr := nl.DeserializeTcNetemCorr(attr.Value)
+			params.LatencyCorr = corr.DelayCorr
+			params.LossCorr = corr.LossCorr
+		case nl.TCA_NETEM_REORDER:
+			params.Reorder = nl.DeserializeTcNetemReorder(attr.Value).Probability
+		case nl.TCA_NETEM_CORRUPT:
+			params.Corrupt = nl.DeserializeTcNetemCorrupt(attr.Value).Probability
+		case nl.TCA_NETEM_RATE:
+			if params.Rate == 0 {
+				params.Rate = uint64(native.Uint32(attr.Value[0:4]))
+			}
+		case nl.TCA_NETEM_RATE64:
+			params.Rate = native.Uint64(attr.Value[0:8])
+		case nl.TCA_NETEM_LOSS:
+			models, err := nl.ParseRouteAttr(attr.Value)
+			if err != nil {
+				return nil, err
+			}
+			for _, model := range models {
+				if model.Attr.Type == netemLossGE && len(model.Value) >= 16 {
+					params.GeModel = true
+					params.GeP = native.Uint32(model.Value[0:4])
+					params.GeR = native.Uint32(model.Value[4:8])
+					params.GeH = native.Uint32(model.Value[8:12])
+					params.GeK1 = native.Uint32(model.Value[12:16])
+				}
+			}
+		case tcaNetemLatency64:
+			params.Latency = native.Uint64(attr.Value[0:8])
+		case tcaNetemJitter64:
+			params.Jitter = native.Uint64(attr.Value[0:8])
+		}
+	}
+	return params, nil
+}
+
+// GetDistTable - Get serialized netem delay distribution table, loaded from the iproute2 tables
+func (tc *TcNetlink) GetDistTable(name string) ([]byte, error) {
+	if table, found := tc.distTables[name]; found {
+		return table, nil
+	}
+
+	var file *os.File
+	var err error
+	for _, dir := range netemDistDirs {
+		file, err = os.Open(dir + "/" + name + ".dist")
+		if err == nil {
+			break
+		}
+	}
+	if err != nil {
+		return nil, errors.New("Distribution table not found: " + name)
+	}
+	defer file.Close()
+
+	var table []byte
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if strings.HasPrefix(line, "#") {
+			continue
+		}
+		for _, field := range strings.Fields(line) {
+			value, err := strconv.ParseInt(field, 10, 16)
+			if err != nil {
+				return nil, err
+			}
+			entry := make([]byte, 2)
+			nl.NativeEndian().PutUint16(entry, uint16(int16(value)))
+			table = append(table, entry...)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	tc.distTables[name] = table
+	return table, nil
+}
+
+// getNetemParams - Convert tc-engine shape entry to netem configuration
+func getNetemParams(shape map[string]string) *NetemParams {
+	params := new(NetemParams)
+	params.Latency = parseUint(shape["delay"]) * 1000000
+	params.Jitter = parseUint(shape["delayVariation"]) * 1000000
+	params.LatencyCorr = percentToProb(parseUint(shape["delayCorrelation"]) * 100)
+
+	if params.Jitter != 0 {
+		distribution := shape["distribution"]
+		if distribution == "" {
+			distribution = "normal"
+		}
+		// Uniform is the netem default and does not use a distribution table
+		if distribution != "uniform" {
+			params.Distribution = distribution
+		}
+	}
+
+	if shape["lossModel"] == lossModelGilbertElliott {
+		params.GeModel = true
+		params.GeP = percentToProb(parseUint(shape["burstLossGoodToBad"]))
+		params.GeR = percentToProb(parseUint(shape["burstLossBadToGood"]))
+		params.GeH = percentToProb(parseUint(shape["burstLossBad"]))
+		params.GeK1 = percentToProb(parseUint(shape["burstLossGood"]))
+	} else {
+		params.Loss = percentToProb(parseUint(shape["packetLoss"]))
+		params.LossCorr = percentToProb(parseUint(shape["lossCorrelation"]))
+	}
+
+	params.Duplicate = percentToProb(parseUint(shape["duplication"]))
+	params.Corrupt = percentToProb(parseUint(shape["corruption"]))
+
+	// Reordering requires a delay
+	if reorder := percentToProb(parseUint(shape["reordering"])); reorder != 0 {
+		if params.Latency != 0 {
+			params.Reorder = reorder
+			params.Gap = 1
+		} else {
+			log.Warn("Ignoring packet reordering on " + ifbPrefix + shape["ifb_uniqueId"] + ": no delay configured")
+		}
+	}
+
+	params.Rate = parseUint(shape["dataRate"]) / 8
+	return params
+}
+
+// percentToProb - Convert value in hundredths of percent to netem probability
+func percentToProb(value uint64) uint32 {
+	if value >= netemMaxPercent {
+		return math.MaxUint32
+	}
+	return uint32(math.Round(float64(value) / netemMaxPercent * math.MaxUint32))
+}
+
+// nsToTicks - Convert ns to kernel packet scheduler ticks; 64-bit ns attributes carry the exact value
+func nsToTicks(value uint64) uint32 {
+	ticks := value >> pschedShift
+	if ticks > math.MaxUint32 {
+		return math.MaxUint32
+	}
+	return uint32(ticks)
+}
+
+func parseUint(value string) uint64 {
+	if value == "" {
+		return 0
+	}
+	v, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		log.Error("Invalid value: ", value)
+		return 0
+	}
+	return v
+}
+
+// getLinkIndexes - Get interface indexes by link name
+func getLinkIndexes() (map[string]int, error) {
+	links, err := netlink.LinkList()
+	if err != nil {
+		return nil, err
+	}
+	indexes := make(map[string]int)
+	for _, link := range links {
+		indexes[link.Attrs().Name] = link.Attrs().Index
+	}
+	return indexes, nil
+}
+
+// getIfbNumber - Get unique number of sidecar IFB from link name; other links are ignored
+func getIfbNumber(name string) (string, bool) {
+	if !strings.HasPrefix(name, ifbPrefix) {
+		return "", false
+	}
+	ifbNumber := strings.TrimPrefix(name, ifbPrefix)
+	if _, err := strconv.ParseUint(ifbNumber, 10, 32); err != nil {
+		return "", false
+	}
+	return ifbNumber, true
+}
+
+// getFilterPrios - Get priorities of installed ingress filters
+func getFilterPrios(ifindex int) (map[uint16]bool, error) {
+	link, err := netlink.LinkByIndex(ifindex)
+	if err != nil {
+		return nil, err
+	}
+	filterList, err := netlink.FilterList(link, tcHandleIngress)
+	if err != nil {
+		return nil, err
+	}
+	prios := make(map[uint16]bool)
+	for _, filter := range filterList {
+		prios[filter.Attrs().Priority] = true
+	}
+	return prios, nil
+}
@@ -0,0 +1,275 @@
+/*
+ * Copyright (c) 2020  InterDigital Communications, Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+
+	log "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-logger"
+
+	"github.com/vishvananda/netlink/nl"
+	"golang.org/x/sys/unix"
+)
+
+func TestGetNetemParams(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	tests := []struct {
+		name     string
+		shape    map[string]string
+		expected NetemParams
+	}{
+		{"empty", map[string]string{}, NetemParams{}},
+		{"invalid values", map[string]string{"delay": "abc", "packetLoss": "-1"}, NetemParams{}},
+		{"delay no jitter",
+			map[string]string{"delay": "10", "delayCorrelation": "50", "distribution": "pareto"},
+			NetemParams{Latency: 10000000, LatencyCorr: percentToProb(5000)}},
+		{"jitter default distribution",
+			map[string]string{"delay": "10", "delayVariation": "2"},
+			NetemParams{Latency: 10000000, Jitter: 2000000, Distribution: "normal"}},
+		{"jitter uniform distribution",
+			map[string]string{"delay": "10", "delayVariation": "2", "distribution": "uniform"},
+			NetemParams{Latency: 10000000, Jitter: 2000000}},
+		{"jitter pareto distribution",
+			map[string]string{"delay": "10", "delayVariation": "2", "distribution": "pareto"},
+			NetemParams{Latency: 10000000, Jitter: 2000000, Distribution: "pareto"}},
+		{"random loss",
+			map[string]string{"packetLoss": "250", "lossCorrelation": "10000", "burstLossBad": "100"},
+			NetemParams{Loss: percentToProb(250), LossCorr: math.MaxUint32}},
+		{"gilbert-elliott loss",
+			map[string]string{"lossModel": lossModelGilbertElliott, "packetLoss": "250",
+				"burstLossGoodToBad": "100", "burstLossBadToGood": "9000", "burstLossBad": "5000", "burstLossGood": "10"},
+			NetemParams{GeModel: true, GeP: percentToProb(100), GeR: percentToProb(9000), GeH: percentToProb(5000), GeK1: percentToProb(10)}},
+		{"duplication & corruption",
+			map[string]string{"duplication": "1", "corruption": "20000"},
+			NetemParams{Duplicate: percentToProb(1), Corrupt: math.MaxUint32}},
+		{"reordering with delay",
+			map[string]string{"delay": "1", "reordering": "2500"},
+			NetemParams{Latency: 1000000, Reorder: percentToProb(2500), Gap: 1}},
+		{"reordering without delay", map[string]string{"reordering": "2500"}, NetemParams{}},
+		{"data rate",
+			map[string]string{"dataRate": "100000000000"},
+			NetemParams{Rate: 12500000000}},
+	}
+
+	for _, test := range tests {
+		params := getNetemParams(test.shape)
+		if *params != test.expected {
+			t.Fatalf("%s: expected [%s] got [%s]", test.name, test.expected.String(), params.String())
+		}
+	}
+}
+
+func TestParseNetemOptions(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	native := nl.NativeEndian()
+	u32 := func(v uint32) []byte {
+		b := make([]byte, 4)
+		native.PutUint32(b, v)
+		return b
+	}
+	u64 := func(v uint64) []byte {
+		b := make([]byte, 8)
+		native.PutUint64(b, v)
+		return b
+	}
+	serialize := func(attrs ...*nl.RtAttr) []byte {
+		qopt := nl.TcNetemQopt{Limit: netemDefaultLimit, Loss: 10, Gap: 1, Duplicate: 20, Latency: 999}
+		options := nl.NewRtAttr(nl.TCA_OPTIONS, qopt.Serialize())
+		for _, attr := range attrs {
+			options.AddChild(attr)
+		}
+		// Strip TCA_OPTIONS attribute header
+		return options.Serialize()[unix.SizeofRtAttr:]
+	}
+	rate32 := append(u32(1000), make([]byte, 12)...)
+
+	// Base options only; 32-bit latency is ignored in favor of 64-bit attributes
+	params, err := parseNetemOptions(serialize())
+	if err != nil {
+		t.Fatalf("Failed to parse options: %s", err.Error())
+	}
+	if *params != (NetemParams{Loss: 10, Gap: 1, Duplicate: 20}) {
+		t.Fatalf("Unexpected params: %s", params.String())
+	}
+
+	// 64-bit rate takes precedence regardless of attribute order
+	for _, options := range [][]byte{
+		serialize(nl.NewRtAttr(nl.TCA_NETEM_RATE, rate32), nl.NewRtAttr(nl.TCA_NETEM_RATE64, u64(1<<33))),
+		serialize(nl.NewRtAttr(nl.TCA_NETEM_RATE64, u64(1<<33)), nl.NewRtAttr(nl.TCA_NETEM_RATE, rate32)),
+	} {
+		params, err = parseNetemOptions(options)
+		if err != nil {
+			t.Fatalf("Failed to parse options: %s", err.Error())
+		}
+		if params.Rate != 1<<33 {
+			t.Fatalf("Unexpected rate: %d", params.Rate)
+		}
+	}
+	params, err = parseNetemOptions(serialize(nl.NewRtAttr(nl.TCA_NETEM_RATE, rate32)))
+	if err != nil {
+		t.Fatalf("Failed to parse options: %s", err.Error())
+	}
+	if params.Rate != 1000 {
+		t.Fatalf("Unexpected rate: %d", params.Rate)
+	}
+
+	// Latency, jitter & Gilbert-Elliott loss model
+	loss := nl.NewRtAttr(nl.TCA_NETEM_LOSS, nil)
+	loss.AddRtAttr(netemLossGE, append(append(append(u32(1), u32(2)...), u32(3)...), u32(4)...))
+	params, err = parseNetemOptions(serialize(
+		nl.NewRtAttr(tcaNetemLatency64, u64(10000000)),
+		nl.NewRtAttr(tcaNetemJitter64, u64(2000000)),
+		loss))
+	if err != nil {
+		t.Fatalf("Failed to parse options: %s", err.Error())
+	}
+	expected := NetemParams{Latency: 10000000, Jitter: 2000000, Loss: 10, Gap: 1, Duplicate: 20,
+		GeModel: true, GeP: 1, GeR: 2, GeH: 3, GeK1: 4}
+	if *params != expected {
+		t.Fatalf("Unexpected params: %s", params.String())
+	}
+
+	// Truncated Gilbert-Elliott parameters are ignored
+	loss = nl.NewRtAttr(nl.TCA_NETEM_LOSS, nil)
+	loss.AddRtAttr(netemLossGE, u32(1))
+	params, err = parseNetemOptions(serialize(loss))
+	if err != nil {
+		t.Fatalf("Failed to parse options: %s", err.Error())
+	}
+	if params.GeModel {
+		t.Fatalf("Unexpected GE model: %s", params.String())
+	}
+}
+
+func TestReplaceNetemRoundTrip(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	tests := []NetemParams{
+		{},
+		{Latency: 10000000, Jitter: 2000000, LatencyCorr: percentToProb(5000), Distribution: "normal",
+			Loss: percentToProb(250), LossCorr: percentToProb(100), Duplicate: percentToProb(1),
+			Corrupt: percentToProb(2), Reorder: percentToProb(2500), Gap: 1, Rate: 125000},
+		{Latency: 1 << 40, Jitter: 1 << 35, GeModel: true, GeP: percentToProb(100),
+			GeR: percentToProb(9000), GeH: percentToProb(5000), GeK1: math.MaxUint32, Rate: 12500000000},
+		{Rate: math.MaxUint32},
+	}
+
+	for i, expected := range tests {
+		var distTable []byte
+		if expected.Distribution != "" {
+			distTable = []byte{1, 0, 2, 0, 3, 0}
+		}
+		batch := new(TcBatch)
+		batch.ReplaceNetem(2, &expected, distTable, nil)
+		if batch.Len() != 1 {
+			t.Fatalf("Unexpected batch length: %d", batch.Len())
+		}
+
+		// Extract netem options from serialized request
+		msg := batch.ops[0].req.Serialize()
+		attrs, err := nl.ParseRouteAttr(msg[unix.SizeofNlMsghdr+nl.SizeofTcMsg:])
+		if err != nil {
+			t.Fatalf("Failed to parse request attributes: %s", err.Error())
+		}
+		var kind string
+		var options []byte
+		for _, attr := range attrs {
+			switch attr.Attr.Type {
+			case nl.TCA_KIND:
+				kind = nl.BytesToString(attr.Value)
+			case nl.TCA_OPTIONS:
+				options = attr.Value
+			}
+		}
+		if kind != "netem" {
+			t.Fatalf("Unexpected qdisc kind: %s", kind)
+		}
+
+		params, err := parseNetemOptions(options)
+		if err != nil {
+			t.Fatalf("Failed to parse options: %s", err.Error())
+		}
+		if !params.Equal(&expected) {
+			t.Fatalf("Test %d: expected [%s] got [%s]", i, expected.String(), params.String())
+		}
+
+		// 32-bit latency & jitter are sent in scheduler ticks
+		qopt := nl.DeserializeTcNetemQopt(options)
+		if qopt.Latency != nsToTicks(expected.Latency) || qopt.Jitter != nsToTicks(expected.Jitter) {
+			t.Fatalf("Test %d: unexpected ticks latency %d jitter %d", i, qopt.Latency, qopt.Jitter)
+		}
+	}
+}
+
+func TestFailOps(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	// First op acknowledged, second op pending ack & third op unsent
+	results := make([]error, 3)
+	ops := make([]*tcOp, 3)
+	pending := make(map[uint32]*tcOp)
+	for i := range ops {
+		index := i
+		ops[i] = &tcOp{req: nl.NewNetlinkRequest(unix.RTM_NEWQDISC, 0), cb: func(err error) { results[index] = err }}
+		ops[i].req.Seq = uint32(i + 1)
+	}
+	pending[ops[1].req.Seq] = ops[1]
+	ops = append(ops, &tcOp{req: nl.NewNetlinkRequest(unix.RTM_NEWQDISC, 0)})
+
+	failErr := errors.New("receive error")
+	failOps(ops, pending, 2, failErr)
+	if results[0] != nil {
+		t.Fatalf("Acknowledged operation should not fail")
+	}
+	if results[1] != failErr || results[2] != failErr {
+		t.Fatalf("Pending & unsent operations should fail: %v", results)
+	}
+}
+
+func TestGetIfbNumber(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	tests := []struct {
+		name      string
+		ifbNumber string
+		valid     bool
+	}{
+		{ifbPrefix + "12", "12", true},
+		{"ifb0", "", false},
+		{"ifb12", "", false},
+		{ifbPrefix, "", false},
+		{ifbPrefix + "x1", "", false},
+		{"eth0", "", false},
+	}
+
+	for _, test := range tests {
+		ifbNumber, valid := getIfbNumber(test.name)
+		if ifbNumber != test.ifbNumber || valid != test.valid {
+			t.Fatalf("%s: unexpected ifb number: %s, valid: %t", test.name, ifbNumber, valid)
+		}
+	}
+}
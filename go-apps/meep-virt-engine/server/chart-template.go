@@ -17,7 +17,9 @@
 package server
 
 import (
+	"encoding/base64"
 	"errors"
+	"io/ioutil"
 	"os"
 	"path/filepath"
 	"strconv"
@@ -35,6 +37,9 @@ const serviceNodePortMax = 32767
 const trueStr = "true"
 const falseStr = "false"
 
+// API authentication config, mounted from the platform meep-auth secret
+const authConfigFile = "/auth/auth.yaml"
+
 // DeploymentTemplate - Deployment Template
 type DeploymentTemplate struct {
 	Enabled                  string
@@ -117,6 +122,7 @@ type SandboxTemplate struct {
 	Namespace   string
 	HostUrl     string
 	AltServer   string
+	AuthConfig  string
 }
 
 // Service map
@@ -507,6 +513,7 @@ func generateSandboxCharts(sandboxName string) (charts []helm.Chart, err error)
 	sandboxTemplate.Namespace = sandboxName
 	sandboxTemplate.HostUrl = ve.hostUrl
 	sandboxTemplate.AltServer = ve.altServer
+	sandboxTemplate.AuthConfig = getAuthConfig()
 
 	// Create sandbox charts
 	for pod := range ve.sboxPods {
@@ -553,3 +560,16 @@ func deploySandbox(name string) error {
 
 	return nil
 }
+
+// getAuthConfig - Return base64-encoded API authentication config to propagate to sandbox controllers
+// Returns an empty string if authentication is not configured.
+func getAuthConfig() string {
+	data, err := ioutil.ReadFile(authConfigFile)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			log.Error("Failed to read authentication config: ", err.Error())
+		}
+		return ""
+	}
+	return base64.StdEncoding.EncodeToString(data)
+}
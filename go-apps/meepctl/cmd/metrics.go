@@ -0,0 +1,46 @@
+/*
+ * Copyright (c) 2020  InterDigital Communications, Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"github.com/roymx/viper"
+	"github.com/spf13/cobra"
+)
+
+// metricsCmd represents the metrics command
+var metricsCmd = &cobra.Command{
+	Use:   "metrics -s <sandbox> <action>",
+	Short: "Retrieve sandbox metrics",
+	Long: `AdvantEDGE collects network, event & HTTP metrics for the scenario deployed in a sandbox.
+
+Metrics of the active scenario or of previous scenario runs can be exported to local files for offline analysis.`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		_ = cmd.Help()
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(metricsCmd)
+}
+
+func getMetricsBasePath(cmd *cobra.Command) string {
+	host := viper.GetString("node.ip")
+	sandbox, _ := cmd.Flags().GetString("sandbox")
+	reqString := "http://" + host + "/" + sandbox + "/metrics/v2"
+	return reqString
+}
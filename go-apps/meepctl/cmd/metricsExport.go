@@ -0,0 +1,117 @@
+/*
+ * Copyright (c) 2020  InterDigital Communications, Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+// metricsExportCmd represents the metrics export command
+var metricsExportCmd = &cobra.Command{
+	Use:   "export <zip-file-name.zip>",
+	Short: "Exports scenario metrics into a local zip file",
+	Long: `Exports scenario metrics into a local zip file.
+
+Metrics of the active scenario are exported unless a previous scenario run store is selected.
+
+The archive contains the network, event & HTTP metrics in the requested format (csv or jsonl)
+along with a manifest.json file recording the scenario name & replay file used.
+Start & end times are in RFC3339 format; all stored metrics are exported if omitted.`,
+	Args:    cobra.ExactValidArgs(1),
+	Example: "meepctl metrics export -s sbx1 --format jsonl --start 2020-07-01T10:00:00Z --end 2020-07-01T11:00:00Z metrics.zip",
+	Run: func(cmd *cobra.Command, args []string) {
+
+		v, _ := cmd.Flags().GetBool("verbose")
+		if v {
+			fmt.Println("Metrics export called")
+			fmt.Println("[flag] verbose:", v)
+		}
+		metricsExport(cmd, args[0])
+	},
+}
+
+func init() {
+	setSandboxFlag(metricsExportCmd)
+	metricsExportCmd.Flags().String("format", "csv", "Export file format (csv or jsonl)")
+	metricsExportCmd.Flags().String("store", "", "Scenario run store to export (active scenario if omitted)")
+	metricsExportCmd.Flags().String("start", "", "Export time range start (RFC3339)")
+	metricsExportCmd.Flags().String("end", "", "Export time range end (RFC3339)")
+	metricsCmd.AddCommand(metricsExportCmd)
+}
+
+func metricsExport(cobraCmd *cobra.Command, zipFilename string) {
+	verbose, _ := cobraCmd.Flags().GetBool("verbose")
+	format, _ := cobraCmd.Flags().GetString("format")
+	store, _ := cobraCmd.Flags().GetString("store")
+	start, _ := cobraCmd.Flags().GetString("start")
+	end, _ := cobraCmd.Flags().GetString("end")
+
+	// Build export request
+	params := url.Values{}
+	params.Set("format", format)
+	if store != "" {
+		params.Set("store", store)
+	}
+	if start != "" {
+		params.Set("start", start)
+	}
+	if end != "" {
+		params.Set("end", end)
+	}
+	reqUrl := getMetricsBasePath(cobraCmd) + "/metrics/export?" + params.Encode()
+	if verbose {
+		fmt.Println("Export request: ", reqUrl)
+	}
+
+	resp, err := http.Get(reqUrl)
+	if err != nil {
+		printError("Error getting metrics export: ", err, verbose)
+		return
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		body, _ := ioutil.ReadAll(resp.Body)
+		printError("Error getting metrics export: ", errors.New(resp.Status+": "+string(body)), verbose)
+		return
+	}
+
+	// Stream export to file
+	file, err := os.Create(zipFilename)
+	if err != nil {
+		printError("Error creating zip file: ", err, verbose)
+		return
+	}
+	defer file.Close()
+
+	_, err = io.Copy(file, resp.Body)
+	if err != nil {
+		printError("Error writing zip file: ", err, verbose)
+		return
+	}
+
+	if verbose {
+		fmt.Println("Command successful")
+	}
+}
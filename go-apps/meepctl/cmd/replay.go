@@ -19,7 +19,9 @@ package cmd
 import (
 	"errors"
 	"fmt"
+	"os"
 	"sort"
+	"strings"
 
 	"github.com/roymx/viper"
 	"github.com/spf13/cobra"
@@ -27,6 +29,9 @@ import (
 	sandbox "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-sandbox-ctrl-client"
 )
 
+// Environment variable holding the token used to authenticate sandbox controller requests
+const authTokenEnv = "MEEP_AUTH_TOKEN"
+
 // replayCmd represents the replay command
 var replayCmd = &cobra.Command{
 	Use:   "replay -s <sandbox> <action>",
@@ -36,7 +41,9 @@ var replayCmd = &cobra.Command{
 Replay files are 'script-like' files that are maintained in AdvantEDGE document store.
 Replay file contain sequence of events that can be automatically replayed following the specific time sequence of events.
 
-Multiple actions can be performed on replay files.`,
+Multiple actions can be performed on replay files.
+
+When platform authentication is enabled, requests are authenticated using the API or OIDC token set in the MEEP_AUTH_TOKEN environment variable.`,
 
 	Run: func(cmd *cobra.Command, args []string) {
 		keys := viper.AllKeys()
@@ -54,6 +61,9 @@ func createClient(path string) (*sandbox.APIClient, error) {
 	// Create & store client for App REST API
 	ceClientCfg := sandbox.NewConfiguration()
 	ceClientCfg.BasePath = path
+	if token := strings.TrimSpace(os.Getenv(authTokenEnv)); token != "" {
+		ceClientCfg.AddDefaultHeader("Authorization", "Bearer "+token)
+	}
 	ceClient := sandbox.NewAPIClient(ceClientCfg)
 	if ceClient == nil {
 		err := errors.New("Failed to create REST API client")
@@ -0,0 +1,47 @@
+/*
+ * Copyright (c) 2020  InterDigital Communications, Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"github.com/roymx/viper"
+	"github.com/spf13/cobra"
+)
+
+// scenarioCmd represents the scenario command
+var scenarioCmd = &cobra.Command{
+	Use:   "scenario <action>",
+	Short: "Manage AdvantEDGE scenarios",
+	Long: `AdvantEDGE scenarios describe the network & application deployment emulated in a sandbox.
+
+Scenario files can be checked by the platform controller before being imported in the scenario store.
+
+When platform authentication is enabled, requests are authenticated using the API or OIDC token set in the MEEP_AUTH_TOKEN environment variable.`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		_ = cmd.Help()
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(scenarioCmd)
+}
+
+func getPlatformBasePath() string {
+	host := viper.GetString("node.ip")
+	reqString := "http://" + host + "/platform-ctrl/v1"
+	return reqString
+}
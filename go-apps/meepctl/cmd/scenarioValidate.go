@@ -0,0 +1,139 @@
+/*
+ * Copyright (c) 2020  InterDigital Communications, Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/spf13/cobra"
+)
+
+// Scenario validation status reported when scenario is invalid
+const scenarioStatusError = "SCENARIO-ERROR"
+
+// scenarioValidation - Platform controller scenario validation result
+type scenarioValidation struct {
+	Status string `json:"status,omitempty"`
+	Errors []struct {
+		Path    string `json:"path,omitempty"`
+		Message string `json:"message,omitempty"`
+	} `json:"errors,omitempty"`
+}
+
+// scenarioValidateCmd represents the scenario validate command
+var scenarioValidateCmd = &cobra.Command{
+	Use:   "validate <scenario-file-name.yaml>",
+	Short: "Validates a local scenario file",
+	Long: `Validates a local scenario file (YAML or JSON) without storing it in the scenario store.
+
+The platform controller checks the scenario version & structure and reports each error
+with the JSON path of the invalid scenario element. Exits with status 1 if the scenario is invalid.`,
+	Args:    cobra.ExactValidArgs(1),
+	Example: "meepctl scenario validate demo1-scenario.yaml",
+	Run: func(cmd *cobra.Command, args []string) {
+
+		v, _ := cmd.Flags().GetBool("verbose")
+		if v {
+			fmt.Println("Scenario validate called")
+			fmt.Println("[flag] verbose:", v)
+		}
+		if !scenarioValidate(cmd, args[0]) {
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	scenarioCmd.AddCommand(scenarioValidateCmd)
+}
+
+// scenarioValidate - Validate scenario file & print validation errors
+// Returns false if the scenario is invalid or could not be validated.
+func scenarioValidate(cobraCmd *cobra.Command, scenarioFilename string) bool {
+	verbose, _ := cobraCmd.Flags().GetBool("verbose")
+
+	b, err := ioutil.ReadFile(scenarioFilename)
+	if err != nil {
+		printError("Error reading file: ", err, verbose)
+		return false
+	}
+	yamltojson, err := yaml.YAMLToJSON(b)
+	if err != nil {
+		printError("Error converting YAML to JSON: ", err, verbose)
+		return false
+	}
+
+	// Use scenario file name if scenario has no name
+	var scenario struct {
+		Name string `json:"name"`
+	}
+	_ = json.Unmarshal(yamltojson, &scenario)
+	scenarioName := scenario.Name
+	if scenarioName == "" {
+		scenarioName = strings.TrimSuffix(filepath.Base(scenarioFilename), filepath.Ext(scenarioFilename))
+	}
+
+	// Send validation request
+	reqUrl := getPlatformBasePath() + "/scenarios/" + url.PathEscape(scenarioName) + "/validate"
+	if verbose {
+		fmt.Println("Validate request: ", reqUrl)
+	}
+	req, err := http.NewRequest(http.MethodPost, reqUrl, bytes.NewBuffer(yamltojson))
+	if err != nil {
+		printError("Error creating validation request: ", err, verbose)
+		return false
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if token := strings.TrimSpace(os.Getenv(authTokenEnv)); token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		printError("Error validating scenario: ", err, verbose)
+		return false
+	}
+	defer resp.Body.Close()
+	body, _ := ioutil.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusOK {
+		printError("Error validating scenario: ", errors.New(resp.Status+": "+string(body)), verbose)
+		return false
+	}
+
+	var validation scenarioValidation
+	err = json.Unmarshal(body, &validation)
+	if err != nil {
+		printError("Error decoding JSON: ", err, verbose)
+		return false
+	}
+
+	// Print validation result
+	fmt.Println(scenarioName + ": " + validation.Status)
+	for _, e := range validation.Errors {
+		fmt.Println("  " + e.Path + ": " + e.Message)
+	}
+	return validation.Status != scenarioStatusError
+}
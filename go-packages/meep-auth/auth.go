@@ -0,0 +1,260 @@
+/*
+ * Copyright (c) 2020  InterDigital Communications, Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package auth
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+
+	log "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-logger"
+	"github.com/ghodss/yaml"
+)
+
+const bearerPrefix = "Bearer "
+
+type contextKey string
+
+const userContextKey contextKey = "meep-auth-user"
+
+// TokenConfig - Static API token assigned to a user
+// Service tokens are shared with in-cluster services calling the platform & sandbox APIs;
+// they only grant access to the listed sandboxes.
+type TokenConfig struct {
+	Token     string   `json:"token"`
+	User      string   `json:"user"`
+	Admin     bool     `json:"admin,omitempty"`
+	Service   bool     `json:"service,omitempty"`
+	Sandboxes []string `json:"sandboxes,omitempty"`
+}
+
+// OidcConfig - OpenID Connect bearer token validation parameters
+type OidcConfig struct {
+	// Token issuer; used for discovery & 'iss' claim validation
+	Issuer string `json:"issuer"`
+	// Expected 'aud' claim; not validated if empty
+	ClientId string `json:"clientId,omitempty"`
+	// JWKS endpoint; discovered from the issuer if empty
+	JwksUrl string `json:"jwksUrl,omitempty"`
+	// Claim used as user name; defaults to 'preferred_username' with fallback to 'sub'
+	UserClaim string `json:"userClaim,omitempty"`
+	// Claim listing user groups; defaults to 'groups'
+	GroupsClaim string `json:"groupsClaim,omitempty"`
+	// Members of this group are admins
+	AdminGroup string `json:"adminGroup,omitempty"`
+}
+
+// Quota - Per-owner resource limits; 0 means unlimited
+type Quota struct {
+	MaxSandboxes int `json:"maxSandboxes,omitempty"`
+	MaxPods      int `json:"maxPods,omitempty"`
+}
+
+// QuotaConfig - Default quota with per-owner overrides
+type QuotaConfig struct {
+	Default Quota            `json:"default,omitempty"`
+	Owners  map[string]Quota `json:"owners,omitempty"`
+}
+
+// Config - Authentication configuration file content
+type Config struct {
+	Tokens []TokenConfig `json:"tokens,omitempty"`
+	Oidc   *OidcConfig   `json:"oidc,omitempty"`
+	Quotas QuotaConfig   `json:"quotas,omitempty"`
+}
+
+// User - Authenticated user
+// Service users may only access their configured sandboxes and, unlike admins, may not
+// perform platform-wide operations.
+type User struct {
+	Name      string
+	Admin     bool
+	Service   bool
+	Sandboxes []string
+}
+
+// Authenticator - Authenticates API requests using static tokens or OIDC bearer tokens
+type Authenticator struct {
+	config *Config
+	oidc   *oidcVerifier
+}
+
+// NewAuthenticator - Create an authenticator from the provided configuration file
+// Authentication is disabled if the file does not exist.
+func NewAuthenticator(configFile string) (*Authenticator, error) {
+	data, err := ioutil.ReadFile(configFile)
+	if err != nil {
+		if os.IsNotExist(err) {
+			log.Info("No authentication config found at ", configFile, "; authentication disabled")
+			return NewAuthenticatorFromConfig(nil)
+		}
+		log.Error("Failed to read authentication config: ", err.Error())
+		return nil, err
+	}
+	config := new(Config)
+	err = yaml.Unmarshal(data, config)
+	if err != nil {
+		log.Error("Failed to parse authentication config: ", err.Error())
+		return nil, err
+	}
+	return NewAuthenticatorFromConfig(config)
+}
+
+// NewAuthenticatorFromConfig - Create an authenticator from the provided configuration
+// Authentication is disabled if config is nil.
+func NewAuthenticatorFromConfig(config *Config) (*Authenticator, error) {
+	a := new(Authenticator)
+	if config == nil {
+		return a, nil
+	}
+	for _, token := range config.Tokens {
+		if token.Token == "" || token.User == "" {
+			return nil, errors.New("Invalid token config: token & user are required")
+		}
+		if token.Admin && token.Service {
+			return nil, errors.New("Invalid token config: " + token.User + " cannot be both admin & service")
+		}
+		if len(token.Sandboxes) != 0 && !token.Service {
+			return nil, errors.New("Invalid token config: sandboxes only apply to service token " + token.User)
+		}
+	}
+	if config.Oidc != nil {
+		if config.Oidc.Issuer == "" {
+			return nil, errors.New("Invalid OIDC config: issuer is required")
+		}
+		a.oidc = newOidcVerifier(config.Oidc)
+	}
+	a.config = config
+	log.Info("Authentication enabled with ", len(config.Tokens), " API tokens, OIDC: ", config.Oidc != nil)
+	return a, nil
+}
+
+// IsEnabled - Check if requests must be authenticated
+func (a *Authenticator) IsEnabled() bool {
+	return a != nil && a.config != nil
+}
+
+// Authenticate - Return the user making the request
+// Returns nil user & no error if authentication is disabled.
+func (a *Authenticator) Authenticate(r *http.Request) (*User, error) {
+	if !a.IsEnabled() {
+		return nil, nil
+	}
+
+	authHeader := r.Header.Get("Authorization")
+	if !strings.HasPrefix(authHeader, bearerPrefix) {
+		return nil, errors.New("Missing bearer token")
+	}
+	token := strings.TrimSpace(authHeader[len(bearerPrefix):])
+	if token == "" {
+		return nil, errors.New("Missing bearer token")
+	}
+
+	// Static API tokens
+	for _, t := range a.config.Tokens {
+		if subtle.ConstantTimeCompare([]byte(token), []byte(t.Token)) == 1 {
+			return &User{Name: t.User, Admin: t.Admin, Service: t.Service, Sandboxes: t.Sandboxes}, nil
+		}
+	}
+
+	// OIDC ID/access tokens
+	if a.oidc != nil && strings.Count(token, ".") == 2 {
+		return a.oidc.verify(token)
+	}
+	return nil, errors.New("Invalid token")
+}
+
+// Handler - Wrap handler with request authentication
+// The authenticated user is available to the wrapped handler through GetUser().
+func (a *Authenticator) Handler(inner http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !a.IsEnabled() {
+			inner(w, r)
+			return
+		}
+		user, err := a.Authenticate(r)
+		if err != nil {
+			log.Error("Authentication failed: ", err.Error())
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			http.Error(w, "Unauthorized: "+err.Error(), http.StatusUnauthorized)
+			return
+		}
+		inner(w, r.WithContext(context.WithValue(r.Context(), userContextKey, user)))
+	}
+}
+
+// GetQuota - Return the quota applicable to the provided owner
+func (a *Authenticator) GetQuota(owner string) Quota {
+	if !a.IsEnabled() {
+		return Quota{}
+	}
+	if quota, found := a.config.Quotas.Owners[owner]; found {
+		return quota
+	}
+	return a.config.Quotas.Default
+}
+
+// GetUser - Return the authenticated user of the request; nil if authentication is disabled
+func GetUser(r *http.Request) *User {
+	user, _ := r.Context().Value(userContextKey).(*User)
+	return user
+}
+
+// GetUserName - Return the authenticated user name of the request; empty if authentication is disabled
+func GetUserName(r *http.Request) string {
+	if user := GetUser(r); user != nil {
+		return user.Name
+	}
+	return ""
+}
+
+// IsAuthorized - Check if user may access the provided sandbox belonging to the provided owner
+// Access is always granted when authentication is disabled (nil user).
+func IsAuthorized(user *User, sandboxName string, owner string) bool {
+	if user == nil || user.Admin || user.Name == owner {
+		return true
+	}
+	if user.Service {
+		for _, name := range user.Sandboxes {
+			if name == sandboxName {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// CheckSandboxes - Check that the provided sandbox count does not exceed the quota
+func (q Quota) CheckSandboxes(count int) error {
+	if q.MaxSandboxes != 0 && count > q.MaxSandboxes {
+		return errors.New("Sandbox quota exceeded: " + strconv.Itoa(count) + " > " + strconv.Itoa(q.MaxSandboxes))
+	}
+	return nil
+}
+
+// CheckPods - Check that the provided pod count does not exceed the quota
+func (q Quota) CheckPods(count int) error {
+	if q.MaxPods != 0 && count > q.MaxPods {
+		return errors.New("Pod quota exceeded: " + strconv.Itoa(count) + " > " + strconv.Itoa(q.MaxPods))
+	}
+	return nil
+}
@@ -0,0 +1,254 @@
+/*
+ * Copyright (c) 2020  InterDigital Communications, Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package auth
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	log "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-logger"
+)
+
+const testAuthConfig = `
+tokens:
+  - token: user1-token
+    user: user1
+  - token: admin-token
+    user: admin
+    admin: true
+  - token: service-token
+    user: meep-service
+    service: true
+    sandboxes:
+      - sbox1
+quotas:
+  default:
+    maxSandboxes: 2
+    maxPods: 10
+  owners:
+    user1:
+      maxSandboxes: 5
+`
+
+func TestAuthTokens(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	fmt.Println("Missing config disables authentication")
+	a, err := NewAuthenticator("/tmp/does-not-exist/auth.yaml")
+	if err != nil || a.IsEnabled() {
+		t.Fatalf("Authentication should be disabled")
+	}
+	user, err := a.Authenticate(httptest.NewRequest("GET", "/", nil))
+	if err != nil || user != nil {
+		t.Fatalf("Unexpected user")
+	}
+	if err := a.GetQuota("user1").CheckSandboxes(100); err != nil {
+		t.Fatalf("Quota should be unlimited")
+	}
+
+	fmt.Println("Load config")
+	dir, err := ioutil.TempDir("", "meep-auth")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir")
+	}
+	defer os.RemoveAll(dir)
+	configFile := filepath.Join(dir, "auth.yaml")
+	_ = ioutil.WriteFile(configFile, []byte(testAuthConfig), 0600)
+	a, err = NewAuthenticator(configFile)
+	if err != nil || !a.IsEnabled() {
+		t.Fatalf("Authentication should be enabled")
+	}
+
+	fmt.Println("Authenticate tokens")
+	user, err = a.Authenticate(newRequest("Bearer user1-token"))
+	if err != nil || user.Name != "user1" || user.Admin {
+		t.Fatalf("Invalid user")
+	}
+	user, err = a.Authenticate(newRequest("Bearer admin-token"))
+	if err != nil || user.Name != "admin" || !user.Admin {
+		t.Fatalf("Invalid admin")
+	}
+	if _, err = a.Authenticate(newRequest("Bearer bad-token")); err == nil {
+		t.Fatalf("Invalid token should fail")
+	}
+	if _, err = a.Authenticate(newRequest("Basic dXNlcjE6cGFzcw==")); err == nil {
+		t.Fatalf("Missing bearer token should fail")
+	}
+
+	fmt.Println("Authenticate service token")
+	user, err = a.Authenticate(newRequest("Bearer service-token"))
+	if err != nil || user.Name != "meep-service" || user.Admin || !user.Service || len(user.Sandboxes) != 1 {
+		t.Fatalf("Invalid service user")
+	}
+	if _, err = a.Authenticate(httptest.NewRequest("GET", "/", nil)); err == nil {
+		t.Fatalf("Request without token should fail")
+	}
+	if _, err = NewAuthenticatorFromConfig(&Config{Tokens: []TokenConfig{{Token: "t", User: "u", Admin: true, Service: true}}}); err == nil {
+		t.Fatalf("Admin service token should be invalid")
+	}
+	if _, err = NewAuthenticatorFromConfig(&Config{Tokens: []TokenConfig{{Token: "t", User: "u", Sandboxes: []string{"sbox1"}}}}); err == nil {
+		t.Fatalf("User token with sandboxes should be invalid")
+	}
+
+	fmt.Println("Wrap handler")
+	var handlerUser *User
+	handler := a.Handler(func(w http.ResponseWriter, r *http.Request) {
+		handlerUser = GetUser(r)
+	})
+	rr := httptest.NewRecorder()
+	handler(rr, newRequest("Bearer bad-token"))
+	if rr.Code != http.StatusUnauthorized || rr.Header().Get("WWW-Authenticate") != "Bearer" {
+		t.Fatalf("Expected unauthorized response")
+	}
+	rr = httptest.NewRecorder()
+	handler(rr, newRequest("Bearer user1-token"))
+	if rr.Code != http.StatusOK || handlerUser == nil || handlerUser.Name != "user1" {
+		t.Fatalf("Expected authenticated request")
+	}
+
+	fmt.Println("Authorize owners")
+	service := &User{Name: "meep-service", Service: true, Sandboxes: []string{"sbox1"}}
+	if !IsAuthorized(nil, "sbox1", "user1") || !IsAuthorized(&User{Name: "user1"}, "sbox1", "user1") ||
+		!IsAuthorized(&User{Name: "admin", Admin: true}, "sbox1", "user1") || !IsAuthorized(service, "sbox1", "user1") ||
+		IsAuthorized(&User{Name: "user2"}, "sbox1", "user1") {
+		t.Fatalf("Invalid authorization")
+	}
+	if IsAuthorized(service, "sbox2", "user1") || IsAuthorized(&User{Name: "meep-service", Service: true}, "sbox1", "user1") {
+		t.Fatalf("Service token should only access configured sandboxes")
+	}
+
+	fmt.Println("Check quotas")
+	if a.GetQuota("user1").CheckSandboxes(5) != nil || a.GetQuota("user1").CheckSandboxes(6) == nil {
+		t.Fatalf("Invalid owner sandbox quota")
+	}
+	if a.GetQuota("user1").CheckPods(1000) != nil {
+		t.Fatalf("Owner pod quota should be unlimited")
+	}
+	if a.GetQuota("user2").CheckSandboxes(3) == nil || a.GetQuota("user2").CheckPods(11) == nil {
+		t.Fatalf("Invalid default quota")
+	}
+}
+
+func TestAuthOidc(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("Failed to generate key")
+	}
+
+	// Fake identity provider
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{"issuer": server.URL, "jwks_uri": server.URL + "/keys"})
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"keys": []map[string]string{{
+			"kty": "RSA",
+			"kid": "key1",
+			"alg": "RS256",
+			"use": "sig",
+			"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}}})
+	})
+
+	a, err := NewAuthenticatorFromConfig(&Config{Oidc: &OidcConfig{Issuer: server.URL, ClientId: "advantedge", AdminGroup: "admins"}})
+	if err != nil {
+		t.Fatalf("Failed to create authenticator")
+	}
+	exp := time.Now().Add(time.Hour).Unix()
+
+	fmt.Println("Valid user token")
+	token := signToken(t, key, "key1", map[string]interface{}{"iss": server.URL, "aud": "advantedge", "exp": exp, "preferred_username": "user1"})
+	user, err := a.Authenticate(newRequest("Bearer " + token))
+	if err != nil || user.Name != "user1" || user.Admin {
+		t.Fatalf("Invalid user: %v", err)
+	}
+
+	fmt.Println("Valid admin token")
+	token = signToken(t, key, "key1", map[string]interface{}{"iss": server.URL, "aud": []string{"other", "advantedge"}, "exp": exp, "sub": "1234", "groups": []string{"admins"}})
+	user, err = a.Authenticate(newRequest("Bearer " + token))
+	if err != nil || user.Name != "1234" || !user.Admin {
+		t.Fatalf("Invalid admin: %v", err)
+	}
+
+	fmt.Println("Invalid tokens")
+	invalidClaims := []map[string]interface{}{
+		{"iss": "https://other", "aud": "advantedge", "exp": exp, "sub": "user1"},
+		{"iss": server.URL, "aud": "other", "exp": exp, "sub": "user1"},
+		{"iss": server.URL, "aud": "advantedge", "exp": time.Now().Add(-time.Hour).Unix(), "sub": "user1"},
+		{"iss": server.URL, "aud": "advantedge", "exp": exp, "nbf": time.Now().Add(time.Hour).Unix(), "sub": "user1"},
+		{"iss": server.URL, "aud": "advantedge", "sub": "user1"},
+		{"iss": server.URL, "aud": "advantedge", "exp": exp},
+	}
+	for i, claims := range invalidClaims {
+		token = signToken(t, key, "key1", claims)
+		if _, err = a.Authenticate(newRequest("Bearer " + token)); err == nil {
+			t.Fatalf("Invalid token %d should fail", i)
+		}
+	}
+
+	fmt.Println("Invalid signature")
+	otherKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	token = signToken(t, otherKey, "key1", map[string]interface{}{"iss": server.URL, "aud": "advantedge", "exp": exp, "sub": "user1"})
+	if _, err = a.Authenticate(newRequest("Bearer " + token)); err == nil {
+		t.Fatalf("Invalid signature should fail")
+	}
+
+	fmt.Println("Unknown key")
+	token = signToken(t, key, "key2", map[string]interface{}{"iss": server.URL, "aud": "advantedge", "exp": exp, "sub": "user1"})
+	if _, err = a.Authenticate(newRequest("Bearer " + token)); err == nil {
+		t.Fatalf("Unknown key should fail")
+	}
+}
+
+func newRequest(authHeader string) *http.Request {
+	r := httptest.NewRequest("GET", "/", nil)
+	r.Header.Set("X-Forwarded-For", "10.0.0.1")
+	r.Header.Set("Authorization", authHeader)
+	return r
+}
+
+func signToken(t *testing.T, key *rsa.PrivateKey, kid string, claims map[string]interface{}) string {
+	header, _ := json.Marshal(map[string]string{"alg": "RS256", "kid": kid, "typ": "JWT"})
+	payload, _ := json.Marshal(claims)
+	signed := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	hash := sha256.Sum256([]byte(signed))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, hash[:])
+	if err != nil {
+		t.Fatalf("Failed to sign token")
+	}
+	return signed + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
@@ -0,0 +1,132 @@
+/*
+ * Copyright (c) 2020  InterDigital Communications, Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package auth
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	log "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-logger"
+	"github.com/coreos/go-oidc/v3/oidc"
+)
+
+const defaultUserClaim = "preferred_username"
+const defaultGroupsClaim = "groups"
+
+// Supported token signing algorithms
+var signingAlgs = []string{oidc.RS256, oidc.RS384, oidc.RS512}
+
+// oidcVerifier - Validate OIDC bearer tokens
+// Token signature & standard claims are validated by go-oidc; the identity provider
+// is discovered on first use so that authentication does not depend on its availability at startup.
+type oidcVerifier struct {
+	config   *OidcConfig
+	client   *http.Client
+	mutex    sync.Mutex
+	verifier *oidc.IDTokenVerifier
+}
+
+func newOidcVerifier(config *OidcConfig) *oidcVerifier {
+	v := new(oidcVerifier)
+	v.config = config
+	v.client = &http.Client{Timeout: 10 * time.Second}
+	return v
+}
+
+// verify - Validate JWT signature & claims and return the token user
+func (v *oidcVerifier) verify(token string) (*User, error) {
+	verifier, err := v.getVerifier()
+	if err != nil {
+		return nil, err
+	}
+	idToken, err := verifier.Verify(v.context(), token)
+	if err != nil {
+		log.Debug("Token verification failed: ", err.Error())
+		return nil, errors.New("Invalid token")
+	}
+	claims := make(map[string]interface{})
+	if err := idToken.Claims(&claims); err != nil {
+		return nil, errors.New("Malformed token claims")
+	}
+	return v.getUser(claims)
+}
+
+// getVerifier - Return the token verifier, discovering the identity provider if required
+func (v *oidcVerifier) getVerifier() (*oidc.IDTokenVerifier, error) {
+	v.mutex.Lock()
+	defer v.mutex.Unlock()
+
+	if v.verifier != nil {
+		return v.verifier, nil
+	}
+	var provider *oidc.Provider
+	if v.config.JwksUrl != "" {
+		providerConfig := &oidc.ProviderConfig{IssuerURL: v.config.Issuer, JWKSURL: v.config.JwksUrl}
+		provider = providerConfig.NewProvider(v.context())
+	} else {
+		var err error
+		provider, err = oidc.NewProvider(v.context(), v.config.Issuer)
+		if err != nil {
+			log.Error("Failed OIDC provider discovery: ", err.Error())
+			return nil, errors.New("Failed to get token signing keys")
+		}
+	}
+	v.verifier = provider.Verifier(&oidc.Config{
+		ClientID:             v.config.ClientId,
+		SkipClientIDCheck:    v.config.ClientId == "",
+		SupportedSigningAlgs: signingAlgs,
+	})
+	return v.verifier, nil
+}
+
+// context - Return the context used for identity provider requests
+func (v *oidcVerifier) context() context.Context {
+	return oidc.ClientContext(context.Background(), v.client)
+}
+
+func (v *oidcVerifier) getUser(claims map[string]interface{}) (*User, error) {
+	userClaim := v.config.UserClaim
+	if userClaim == "" {
+		userClaim = defaultUserClaim
+	}
+	name, _ := claims[userClaim].(string)
+	if name == "" && v.config.UserClaim == "" {
+		name, _ = claims["sub"].(string)
+	}
+	if name == "" {
+		return nil, errors.New("Missing token user claim: " + userClaim)
+	}
+
+	user := &User{Name: name}
+	if v.config.AdminGroup != "" {
+		groupsClaim := v.config.GroupsClaim
+		if groupsClaim == "" {
+			groupsClaim = defaultGroupsClaim
+		}
+		groups, _ := claims[groupsClaim].([]interface{})
+		for _, group := range groups {
+			if group == v.config.AdminGroup {
+				user.Admin = true
+				break
+			}
+		}
+	}
+	return user, nil
+}
@@ -18,6 +18,7 @@ package couchdb
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/flimzy/kivik"
 	_ "github.com/go-kivik/couchdb"
@@ -123,6 +124,32 @@ func (dbCon *Connector) GetDocList() (docNameList []string, docList [][]byte, er
 	return docNameList, docList, nil
 }
 
+// getDocListByPrefix - Get list of documents with a name starting with the provided prefix from DB
+func (dbCon *Connector) GetDocListByPrefix(prefix string) (docNameList []string, docList [][]byte, err error) {
+	log.Debug("Get docs with prefix from DB: " + prefix)
+	startKey, _ := json.Marshal(prefix)
+	endKey, _ := json.Marshal(prefix + "\ufff0")
+	rows, err := dbCon.dbHandle.AllDocs(context.TODO(), kivik.Options{
+		"startkey": string(startKey),
+		"endkey":   string(endKey),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Loop through docs and populate doc list to return
+	for rows.Next() {
+		var doc []byte
+		doc, err = dbCon.GetDoc(false, rows.ID())
+		if err == nil {
+			docList = append(docList, doc)
+			docNameList = append(docNameList, rows.ID())
+		}
+	}
+
+	return docNameList, docList, nil
+}
+
 // addDoc - Add scenario to DB
 func (dbCon *Connector) AddDoc(docName string, doc []byte) (string, error) {
 	log.Debug("Add new doc to DB: " + docName)
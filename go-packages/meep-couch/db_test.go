@@ -129,6 +129,26 @@ func testDb(t *testing.T, c *Connector) {
 		t.Errorf("getDocList should return a list of doc names and docs to be of the same length")
 	}
 
+	// Get doc list by prefix
+	docNameList, docList, err = c.GetDocListByPrefix("doc")
+	if err != nil {
+		t.Errorf("getDocListByPrefix should not return an error")
+	} else if len(docList) != 3 {
+		t.Errorf("getDocListByPrefix should return a 3 document list")
+	}
+	docNameList, docList, err = c.GetDocListByPrefix("doc2")
+	if err != nil {
+		t.Errorf("getDocListByPrefix should not return an error")
+	} else if len(docList) != 1 || docNameList[0] != "doc2" {
+		t.Errorf("getDocListByPrefix should return doc2 only")
+	}
+	docNameList, docList, err = c.GetDocListByPrefix("not-a-doc")
+	if err != nil {
+		t.Errorf("getDocListByPrefix should not return an error")
+	} else if len(docList) != 0 {
+		t.Errorf("getDocListByPrefix should return an empty list")
+	}
+
 	rev1, err = c.UpdateDoc("doc1", doc1Update)
 	if err != nil {
 		log.Debug(err)
@@ -0,0 +1,30 @@
+/*
+ * Copyright (c) 2020  InterDigital Communications, Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the \"License\");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an \"AS IS\" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * MEEP Model
+ *
+ * No description provided (generated by Swagger Codegen https://github.com/swagger-api/swagger-codegen)
+ *
+ * API version: 1.0.0
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+
+package model
+
+// Network characteristics algorithm
+type NetCharAlgorithm struct {
+	// Algorithm used to compute network characteristics in the sandbox: <li>SEGMENT: bandwidth shared per segment based on measured flow activity (default) <li>MAX-MIN-FAIR: closed-form max-min fair share of segment bandwidth over flow paths
+	Algorithm string `json:"algorithm,omitempty"`
+}
@@ -39,4 +39,22 @@ type NetworkCharacteristics struct {
 	ThroughputUl int32 `json:"throughputUl,omitempty"`
 	// Packet loss percentage
 	PacketLoss float64 `json:"packetLoss,omitempty"`
+	// Packet loss correlation percentage. Applies to the 'Random' packet loss model only
+	PacketLossCorrelation float64 `json:"packetLossCorrelation,omitempty"`
+	// Packet loss model. 'Random' applies packetLoss independently to each packet. 'Gilbert-Elliott' applies burst loss using the burstLoss* state transition and loss percentages, ignoring packetLoss. Default value is 'Random' model.
+	PacketLossModel string `json:"packetLossModel,omitempty"`
+	// Gilbert-Elliott burst loss model: probability percentage of transitioning from the good state to the bad state
+	BurstLossGoodToBad float64 `json:"burstLossGoodToBad,omitempty"`
+	// Gilbert-Elliott burst loss model: probability percentage of transitioning from the bad state to the good state
+	BurstLossBadToGood float64 `json:"burstLossBadToGood,omitempty"`
+	// Gilbert-Elliott burst loss model: packet loss percentage in the bad state
+	BurstLossBad float64 `json:"burstLossBad,omitempty"`
+	// Gilbert-Elliott burst loss model: packet loss percentage in the good state
+	BurstLossGood float64 `json:"burstLossGood,omitempty"`
+	// Packet duplication percentage
+	PacketDuplication float64 `json:"packetDuplication,omitempty"`
+	// Packet corruption percentage
+	PacketCorruption float64 `json:"packetCorruption,omitempty"`
+	// Packet reordering percentage. Reordered packets are sent immediately while other packets are delayed; requires a non-zero latency
+	PacketReordering float64 `json:"packetReordering,omitempty"`
 }
@@ -27,4 +27,8 @@ package model
 type Sandbox struct {
 	// Sandbox name
 	Name string `json:"name,omitempty"`
+	// Sandbox owner; set from the authenticated user on sandbox creation
+	Owner string `json:"owner,omitempty"`
+	// Time at which the sandbox expires (RFC3339), considering its lease & idle timeout; not set if sandbox never expires
+	ExpiryTime string `json:"expiryTime,omitempty"`
 }
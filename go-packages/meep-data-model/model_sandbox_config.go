@@ -27,4 +27,8 @@ package model
 type SandboxConfig struct {
 	// Name of scenario to activate in sandbox
 	ScenarioName string `json:"scenarioName,omitempty"`
+	// Sandbox time-to-live in seconds; sandbox never expires if not set
+	Ttl int32 `json:"ttl,omitempty"`
+	// Sandbox expires after this number of seconds without REST requests or events; no idle timeout if not set
+	IdleTimeout int32 `json:"idleTimeout,omitempty"`
 }
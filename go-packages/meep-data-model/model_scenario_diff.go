@@ -0,0 +1,40 @@
+/*
+ * Copyright (c) 2020  InterDigital Communications, Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the \"License\");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an \"AS IS\" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * MEEP Model
+ *
+ * No description provided (generated by Swagger Codegen https://github.com/swagger-api/swagger-codegen)
+ *
+ * API version: 1.0.0
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+
+package model
+
+// Structural differences between scenario versions
+type ScenarioDiff struct {
+	// Version compared from
+	From string `json:"from,omitempty"`
+	// Version compared to
+	To string `json:"to,omitempty"`
+	// Nodes present only in the version compared to
+	NodesAdded []ScenarioNodeDiff `json:"nodesAdded,omitempty"`
+	// Nodes present only in the version compared from
+	NodesRemoved []ScenarioNodeDiff `json:"nodesRemoved,omitempty"`
+	// Nodes with a different parent
+	NodesMoved []ScenarioNodeDiff `json:"nodesMoved,omitempty"`
+	// Nodes with different network characteristics
+	NetCharChanges []ScenarioNetCharDiff `json:"netCharChanges,omitempty"`
+}
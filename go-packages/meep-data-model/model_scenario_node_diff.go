@@ -0,0 +1,36 @@
+/*
+ * Copyright (c) 2020  InterDigital Communications, Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the \"License\");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an \"AS IS\" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * MEEP Model
+ *
+ * No description provided (generated by Swagger Codegen https://github.com/swagger-api/swagger-codegen)
+ *
+ * API version: 1.0.0
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+
+package model
+
+// Scenario node added, removed or moved between scenario versions
+type ScenarioNodeDiff struct {
+	// Node name
+	Name string `json:"name,omitempty"`
+	// Node type
+	Type_ string `json:"type,omitempty"`
+	// Node parent name
+	Parent string `json:"parent,omitempty"`
+	// Node parent name before move; only set for moved nodes
+	PreviousParent string `json:"previousParent,omitempty"`
+}
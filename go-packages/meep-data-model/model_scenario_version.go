@@ -0,0 +1,38 @@
+/*
+ * Copyright (c) 2020  InterDigital Communications, Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the \"License\");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an \"AS IS\" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * MEEP Model
+ *
+ * No description provided (generated by Swagger Codegen https://github.com/swagger-api/swagger-codegen)
+ *
+ * API version: 1.0.0
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+
+package model
+
+// Scenario version information
+type ScenarioVersion struct {
+	// Scenario version number; incremented every time the scenario is stored
+	Version int32 `json:"version,omitempty"`
+	// Unique version name within the scenario history
+	Tag string `json:"tag,omitempty"`
+	// Version comment
+	Comment string `json:"comment,omitempty"`
+	// User that stored the version; empty if authentication is disabled
+	Author string `json:"author,omitempty"`
+	// Time at which the version was stored (RFC3339)
+	Time string `json:"time,omitempty"`
+}
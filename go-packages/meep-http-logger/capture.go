@@ -0,0 +1,228 @@
+/*
+ * Copyright (c) 2020  InterDigital Communications, Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package httpLogger
+
+import (
+	"encoding/json"
+	"math/rand"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	log "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-logger"
+)
+
+// Environment variables used to configure the logger capture
+const EnvMaxBodySize = "MEEP_HTTP_LOG_MAX_BODY_SIZE"
+const EnvSampleRate = "MEEP_HTTP_LOG_SAMPLE_RATE"
+const EnvRedactFields = "MEEP_HTTP_LOG_REDACT_FIELDS"
+const EnvRedactHeaders = "MEEP_HTTP_LOG_REDACT_HEADERS"
+const EnvCaptureHeaders = "MEEP_HTTP_LOG_HEADERS"
+
+const RedactedValue = "[REDACTED]"
+const truncatedMarker = "...[truncated]"
+
+var DefaultRedactedHeaders = []string{"Authorization", "Cookie", "Set-Cookie", "Proxy-Authorization"}
+
+// Config - Http logger capture configuration
+type Config struct {
+	// Maximum number of body bytes stored per request or response; 0 means no limit
+	MaxBodySize int
+	// Fraction of requests & notifications logged, from 0 to 1; error responses are always logged
+	SampleRate float64
+	// JSON body fields with redacted values (case-insensitive)
+	RedactedFields []string
+	// Headers with redacted values (case-insensitive)
+	RedactedHeaders []string
+	// Store request & response headers
+	CaptureHeaders bool
+}
+
+var config Config
+var configMutex sync.RWMutex
+
+func init() {
+	config = GetEnvConfig()
+}
+
+// DefaultConfig - Capture everything except default sensitive headers
+func DefaultConfig() Config {
+	return Config{
+		MaxBodySize:     0,
+		SampleRate:      1,
+		RedactedHeaders: DefaultRedactedHeaders,
+		CaptureHeaders:  true,
+	}
+}
+
+// GetEnvConfig - Default configuration overridden by environment variables
+func GetEnvConfig() Config {
+	cfg := DefaultConfig()
+	if val, found := os.LookupEnv(EnvMaxBodySize); found {
+		if size, err := strconv.Atoi(strings.TrimSpace(val)); err == nil && size >= 0 {
+			cfg.MaxBodySize = size
+		} else {
+			log.Warn("Ignoring invalid ", EnvMaxBodySize, ": ", val)
+		}
+	}
+	if val, found := os.LookupEnv(EnvSampleRate); found {
+		if rate, err := strconv.ParseFloat(strings.TrimSpace(val), 64); err == nil && rate >= 0 && rate <= 1 {
+			cfg.SampleRate = rate
+		} else {
+			log.Warn("Ignoring invalid ", EnvSampleRate, ": ", val)
+		}
+	}
+	if val, found := os.LookupEnv(EnvRedactFields); found {
+		cfg.RedactedFields = splitList(val)
+	}
+	if val, found := os.LookupEnv(EnvRedactHeaders); found {
+		cfg.RedactedHeaders = append(cfg.RedactedHeaders, splitList(val)...)
+	}
+	if val, found := os.LookupEnv(EnvCaptureHeaders); found {
+		if capture, err := strconv.ParseBool(strings.TrimSpace(val)); err == nil {
+			cfg.CaptureHeaders = capture
+		} else {
+			log.Warn("Ignoring invalid ", EnvCaptureHeaders, ": ", val)
+		}
+	}
+	return cfg
+}
+
+// SetConfig - Replace the logger capture configuration
+func SetConfig(cfg Config) {
+	configMutex.Lock()
+	defer configMutex.Unlock()
+	config = cfg
+}
+
+// GetConfig - Return the logger capture configuration
+func GetConfig() Config {
+	configMutex.RLock()
+	defer configMutex.RUnlock()
+	return config
+}
+
+func splitList(val string) (list []string) {
+	for _, item := range strings.Split(val, ",") {
+		item = strings.TrimSpace(item)
+		if item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
+
+// isSampled - Decide if a request with the provided response code is logged
+func (cfg *Config) isSampled(respCode int) bool {
+	if respCode >= http.StatusBadRequest || cfg.SampleRate >= 1 {
+		return true
+	}
+	return cfg.SampleRate > 0 && rand.Float64() < cfg.SampleRate
+}
+
+// formatBody - Redact sensitive fields & truncate body to the maximum size
+func (cfg *Config) formatBody(body []byte) string {
+	return truncate(redactBody(body, cfg.RedactedFields), cfg.MaxBodySize)
+}
+
+// formatHeaders - Return redacted headers as a JSON object, or an empty string if not captured
+func (cfg *Config) formatHeaders(header http.Header) string {
+	if !cfg.CaptureHeaders || len(header) == 0 {
+		return ""
+	}
+	headers := make(map[string]string, len(header))
+	for name, values := range header {
+		if containsFold(cfg.RedactedHeaders, name) {
+			headers[name] = RedactedValue
+		} else {
+			headers[name] = strings.Join(values, ", ")
+		}
+	}
+	jsonHeaders, err := json.Marshal(headers)
+	if err != nil {
+		log.Error(err.Error())
+		return ""
+	}
+	return string(jsonHeaders)
+}
+
+// truncate - Truncate string to maxSize bytes without splitting UTF-8 characters
+func truncate(s string, maxSize int) string {
+	if maxSize <= 0 || len(s) <= maxSize {
+		return s
+	}
+	end := maxSize
+	for end > 0 && !utf8.RuneStart(s[end]) {
+		end--
+	}
+	return s[:end] + truncatedMarker
+}
+
+// redactBody - Replace values of sensitive fields in a JSON body
+// Non-JSON bodies and bodies without sensitive fields are returned unchanged.
+func redactBody(body []byte, fields []string) string {
+	if len(fields) == 0 || len(body) == 0 {
+		return string(body)
+	}
+	var value interface{}
+	if err := json.Unmarshal(body, &value); err != nil {
+		return string(body)
+	}
+	if !redactValue(value, fields) {
+		return string(body)
+	}
+	redacted, err := json.Marshal(value)
+	if err != nil {
+		log.Error(err.Error())
+		return string(body)
+	}
+	return string(redacted)
+}
+
+// redactValue - Recursively redact sensitive fields; returns true if a field was redacted
+func redactValue(value interface{}, fields []string) (redacted bool) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, val := range v {
+			if containsFold(fields, key) {
+				v[key] = RedactedValue
+				redacted = true
+			} else if redactValue(val, fields) {
+				redacted = true
+			}
+		}
+	case []interface{}:
+		for _, val := range v {
+			if redactValue(val, fields) {
+				redacted = true
+			}
+		}
+	}
+	return redacted
+}
+
+func containsFold(list []string, s string) bool {
+	for _, item := range list {
+		if strings.EqualFold(item, s) {
+			return true
+		}
+	}
+	return false
+}
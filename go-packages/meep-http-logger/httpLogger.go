@@ -18,103 +18,231 @@ package httpLogger
 
 import (
 	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"errors"
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	log "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-logger"
 	ms "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-metric-store"
+	ss "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-sandbox-store"
 )
 
-var nextUniqueId int32 = 1
+var lastUniqueId int32 = 0
 var redisDBAddr string = "meep-redis-master.default.svc.cluster.local:6379"
 var influxDBAddr string = "http://meep-influxdb.default.svc.cluster.local:8086"
+
+// Logger state set by ReInit
+var stateMutex sync.RWMutex
 var metricStore *ms.MetricStore
+var activity *ss.ActivityTracker
+var activityNamespace = ""
 var logComponent = ""
 
 const DirectionRX = "RX"
 const DirectionTX = "TX"
 
+// CorrelationIdHeader - Header propagating the correlation ID between services
+const CorrelationIdHeader = "X-Correlation-Id"
+
+type contextKey string
+
+const correlationIdKey contextKey = "correlationId"
+
 func ReInit(loggerName string, namespace string, currentStoreName string, redisAddr string, influxAddr string) error {
 
 	if redisAddr == "" {
 		redisAddr = redisDBAddr
 	}
 	if influxAddr == "" {
-		redisAddr = influxDBAddr
+		influxAddr = influxDBAddr
 	}
 	log.Info("Reinitialisation of http logger with: ", currentStoreName, " for ", loggerName)
-	logComponent = loggerName
+	var store *ms.MetricStore
 	if currentStoreName != "" {
 		//currentStoreName located in NBI of RNIS populated by SBI upon new activation
 		var err error
-		metricStore, err = ms.NewMetricStore(currentStoreName, namespace, influxAddr, redisAddr)
+		store, err = ms.NewMetricStore(currentStoreName, namespace, influxAddr, redisAddr)
 		if err != nil {
 			log.Error("Failed connection to Redis: ", err)
 			return err
 		}
-	} else {
-		metricStore = nil
 	}
 
+	// Received requests keep the sandbox from expiring when idle
+	stateMutex.RLock()
+	tracker := activity
+	trackerNamespace := activityNamespace
+	stateMutex.RUnlock()
+	if namespace != "" && (tracker == nil || namespace != trackerNamespace) {
+		sandboxStore, err := ss.NewSandboxStore(redisAddr)
+		if err != nil {
+			log.Error("Failed connection to Sandbox Store: ", err)
+			return err
+		}
+		tracker = ss.NewActivityTracker(sandboxStore, namespace)
+		trackerNamespace = namespace
+	}
+
+	// Update logger state
+	stateMutex.Lock()
+	defer stateMutex.Unlock()
+	logComponent = loggerName
+	metricStore = store
+	activity = tracker
+	activityNamespace = trackerNamespace
 	return nil
 }
 
+// getState - Return logger state set by ReInit; safe for concurrent use
+func getState() (*ms.MetricStore, *ss.ActivityTracker, string) {
+	stateMutex.RLock()
+	defer stateMutex.RUnlock()
+	return metricStore, activity, logComponent
+}
+
+// NewCorrelationId - Generate a new correlation ID
+func NewCorrelationId() string {
+	id := make([]byte, 16)
+	_, err := rand.Read(id)
+	if err != nil {
+		log.Error("Failed to generate correlation ID: ", err)
+		return ""
+	}
+	return hex.EncodeToString(id)
+}
+
+// GetCorrelationId - Return the correlation ID of the request being handled, if any
+func GetCorrelationId(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	if id, ok := ctx.Value(correlationIdKey).(string); ok {
+		return id
+	}
+	return ""
+}
+
+// WithCorrelationId - Return a copy of the context holding the provided correlation ID
+func WithCorrelationId(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, correlationIdKey, id)
+}
+
+// NewCorrelationIdClient - Create an HTTP client setting the correlation ID header of each sent request
+// The correlation ID is taken from the request context (see WithCorrelationId) so that sent requests
+// can be linked with the received request that caused them; a new ID is generated otherwise.
+func NewCorrelationIdClient() *http.Client {
+	return &http.Client{Transport: &correlationIdTransport{base: http.DefaultTransport}}
+}
+
+type correlationIdTransport struct {
+	base http.RoundTripper
+}
+
+func (t *correlationIdTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Header.Get(CorrelationIdHeader) != "" {
+		return t.base.RoundTrip(req)
+	}
+	correlationId := GetCorrelationId(req.Context())
+	if correlationId == "" {
+		correlationId = NewCorrelationId()
+	}
+
+	// Round trippers must not modify the provided request
+	r := new(http.Request)
+	*r = *req
+	r.Header = make(http.Header, len(req.Header)+1)
+	for name, values := range req.Header {
+		r.Header[name] = values
+	}
+	r.Header.Set(CorrelationIdHeader, correlationId)
+	return t.base.RoundTrip(r)
+}
+
+// getUniqueId - Return the next http metric identifier; safe for concurrent use
+func getUniqueId() int32 {
+	return atomic.AddInt32(&lastUniqueId, 1)
+}
+
+// LogTx - Log a sent request with the received response
+// Request headers & correlation ID are obtained from the response request.
 func LogTx(url string, method string, body string, resp *http.Response, startTime time.Time) error {
 
-	if metricStore == nil {
+	store, _, component := getState()
+	if store == nil {
 		err := errors.New("Metric store not initialised")
 		log.Error(err)
 		return err
 	}
 
-	uniqueId := nextUniqueId
-	nextUniqueId++
-
-	responseBodyString := ""
-	responseCode := ""
+	cfg := GetConfig()
+	responseCode := http.StatusInternalServerError
+	if resp != nil {
+		responseCode = resp.StatusCode
+	}
+	if !cfg.isSampled(responseCode) {
+		return nil
+	}
 
+	var metric ms.HttpMetric
 	if resp != nil {
 		if resp.Body != nil {
 			responseData, _ := ioutil.ReadAll(resp.Body)
-			responseBodyString = string(responseData)
+			metric.RespBody = cfg.formatBody(responseData)
+		}
+		metric.RespHeaders = cfg.formatHeaders(resp.Header)
+		if resp.Request != nil {
+			metric.ReqHeaders = cfg.formatHeaders(resp.Request.Header)
+			metric.CorrelationId = resp.Request.Header.Get(CorrelationIdHeader)
 		}
-		responseCode = strconv.Itoa(resp.StatusCode)
-	} else {
-		responseCode = strconv.Itoa(http.StatusInternalServerError)
 	}
 
-	var metric ms.HttpMetric
-	metric.LoggerName = logComponent
+	metric.LoggerName = component
 	metric.Direction = DirectionTX
-	metric.Id = uniqueId
+	metric.Id = getUniqueId()
 	metric.Url = url
 	metric.Endpoint = url //reusing the url info
 	metric.Method = method
-	metric.Body = body
-	metric.RespBody = responseBodyString
-	metric.RespCode = responseCode
+	metric.Body = cfg.formatBody([]byte(body))
+	metric.RespCode = strconv.Itoa(responseCode)
 	metric.ProcTime = strconv.Itoa(int(time.Since(startTime) / time.Microsecond))
 
-	err := metricStore.SetHttpMetric(metric)
+	err := store.SetHttpMetric(metric)
 	if err != nil {
 		log.Error("Failed to set http metric: ", err)
 	}
 	return err
 }
 
+// LogRx - Wrap handler to log received requests with their response
+// The request correlation ID is generated if not provided, added to the request context
+// and returned in the response headers.
 func LogRx(inner http.Handler, dummy string) http.Handler {
 
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 
 		start := time.Now()
+		store, tracker, component := getState()
+		tracker.Touch()
+
+		// Propagate correlation ID to handler & response
+		correlationId := r.Header.Get(CorrelationIdHeader)
+		if correlationId == "" {
+			correlationId = NewCorrelationId()
+		}
+		r = r.WithContext(WithCorrelationId(r.Context(), correlationId))
 
 		//use a recorder to record/intercept the response
 		rr := httptest.NewRecorder()
+		rr.Header().Set(CorrelationIdHeader, correlationId)
 
 		//consume the body and store it locally
 		rawBody, _ := ioutil.ReadAll(r.Body)
@@ -124,45 +252,50 @@ func LogRx(inner http.Handler, dummy string) http.Handler {
 
 		inner.ServeHTTP(rr, r)
 
-		if metricStore != nil {
+		cfg := GetConfig()
+		if store == nil {
+			log.Error("Metric store not initialised")
+		} else if cfg.isSampled(rr.Code) {
 			endpoint := strings.Split(r.RequestURI, "?")
 
-			uniqueId := nextUniqueId
-			nextUniqueId++
-
+			uniqueId := getUniqueId()
 			procTime := strconv.Itoa(int(time.Since(start) / time.Microsecond))
+			body := cfg.formatBody(rawBody)
+			respBody := cfg.formatBody(rr.Body.Bytes())
 
 			log.Debug(
 				"fields [id: ", uniqueId,
 				" url: ", r.RequestURI,
 				" endpoint: ", endpoint[0],
 				" method: ", r.Method,
-				" body: ", string(rawBody),
-				" resp_body: ", rr.Body.String(),
+				" body: ", body,
+				" resp_body: ", respBody,
 				" resp_code: ", int32(rr.Code),
 				" proc_time: ", procTime,
-				"] tags [name: ", logComponent,
+				" correlation_id: ", correlationId,
+				"] tags [name: ", component,
 				" direction: ", DirectionRX,
 			)
 
 			var metric ms.HttpMetric
-			metric.LoggerName = logComponent
+			metric.LoggerName = component
 			metric.Direction = DirectionRX
 			metric.Id = uniqueId
 			metric.Url = r.RequestURI
 			metric.Endpoint = endpoint[0]
 			metric.Method = r.Method
-			metric.Body = string(rawBody)
-			metric.RespBody = rr.Body.String()
+			metric.Body = body
+			metric.RespBody = respBody
 			metric.RespCode = strconv.Itoa(rr.Code)
 			metric.ProcTime = procTime
+			metric.ReqHeaders = cfg.formatHeaders(r.Header)
+			metric.RespHeaders = cfg.formatHeaders(rr.Header())
+			metric.CorrelationId = correlationId
 
-			err := metricStore.SetHttpMetric(metric)
+			err := store.SetHttpMetric(metric)
 			if err != nil {
 				log.Error("Failed to set http metric: ", err)
 			}
-		} else {
-			log.Error("Metric store not initialised")
 		}
 
 		// copy everything from response recorder
@@ -0,0 +1,224 @@
+/*
+ * Copyright (c) 2020  InterDigital Communications, Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package httpLogger
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	log "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-logger"
+)
+
+func TestTruncate(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	if truncate("abcdef", 0) != "abcdef" {
+		t.Fatalf("Body should not be truncated without limit")
+	}
+	if truncate("abcdef", 6) != "abcdef" {
+		t.Fatalf("Body should not be truncated at limit")
+	}
+	if truncate("abcdef", 3) != "abc"+truncatedMarker {
+		t.Fatalf("Invalid truncated body")
+	}
+	// 'é' is 2 bytes; truncation must not split it
+	if truncate("aéb", 2) != "a"+truncatedMarker {
+		t.Fatalf("Truncated body should not split characters")
+	}
+}
+
+func TestRedaction(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	fmt.Println("Redact body fields")
+	body := `{"user":"ue1","Password":"secret","nested":[{"token":"abc","value":1}]}`
+	redacted := redactBody([]byte(body), []string{"password", "token"})
+	var value map[string]interface{}
+	if err := json.Unmarshal([]byte(redacted), &value); err != nil {
+		t.Fatalf("Redacted body should be valid JSON")
+	}
+	if value["Password"] != RedactedValue || value["user"] != "ue1" {
+		t.Fatalf("Invalid redacted body: %s", redacted)
+	}
+	nested := value["nested"].([]interface{})[0].(map[string]interface{})
+	if nested["token"] != RedactedValue || nested["value"] != float64(1) {
+		t.Fatalf("Invalid redacted nested body: %s", redacted)
+	}
+
+	fmt.Println("Unchanged bodies")
+	if redactBody([]byte(`{"user": "ue1"}`), []string{"password"}) != `{"user": "ue1"}` {
+		t.Fatalf("Body without redacted fields should be unchanged")
+	}
+	if redactBody([]byte("password=secret"), []string{"password"}) != "password=secret" {
+		t.Fatalf("Non-JSON body should be unchanged")
+	}
+
+	fmt.Println("Redact headers")
+	cfg := DefaultConfig()
+	header := http.Header{}
+	header.Set("Authorization", "Bearer abc")
+	header.Set("Content-Type", "application/json")
+	var headers map[string]string
+	if err := json.Unmarshal([]byte(cfg.formatHeaders(header)), &headers); err != nil {
+		t.Fatalf("Headers should be valid JSON")
+	}
+	if headers["Authorization"] != RedactedValue || headers["Content-Type"] != "application/json" {
+		t.Fatalf("Invalid redacted headers: %v", headers)
+	}
+	cfg.CaptureHeaders = false
+	if cfg.formatHeaders(header) != "" {
+		t.Fatalf("Headers should not be captured")
+	}
+}
+
+func TestSampling(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	cfg := DefaultConfig()
+	cfg.SampleRate = 0
+	if cfg.isSampled(http.StatusOK) {
+		t.Fatalf("Request should not be sampled")
+	}
+	if !cfg.isSampled(http.StatusNotFound) {
+		t.Fatalf("Error response should always be sampled")
+	}
+	cfg.SampleRate = 1
+	if !cfg.isSampled(http.StatusOK) {
+		t.Fatalf("Request should be sampled")
+	}
+}
+
+func TestUniqueIds(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	const count = 1000
+	var wg sync.WaitGroup
+	ids := make(chan int32, count)
+	for i := 0; i < count; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ids <- getUniqueId()
+		}()
+	}
+	wg.Wait()
+	close(ids)
+	seen := make(map[int32]bool, count)
+	for id := range ids {
+		if seen[id] {
+			t.Fatalf("Duplicate id: %d", id)
+		}
+		seen[id] = true
+	}
+}
+
+func TestCorrelationId(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	var handlerId string
+	handler := LogRx(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		handlerId = GetCorrelationId(r.Context())
+		w.WriteHeader(http.StatusOK)
+	}), "")
+
+	fmt.Println("Propagate received correlation ID")
+	req := httptest.NewRequest("GET", "/test", strings.NewReader(""))
+	req.Header.Set(CorrelationIdHeader, "id1")
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+	if handlerId != "id1" || rr.Header().Get(CorrelationIdHeader) != "id1" {
+		t.Fatalf("Correlation ID not propagated")
+	}
+
+	fmt.Println("Generate missing correlation ID")
+	req = httptest.NewRequest("GET", "/test", strings.NewReader(""))
+	rr = httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+	if handlerId == "" || rr.Header().Get(CorrelationIdHeader) != handlerId {
+		t.Fatalf("Correlation ID not generated")
+	}
+	if NewCorrelationId() == NewCorrelationId() {
+		t.Fatalf("Correlation IDs should be unique")
+	}
+}
+
+func TestCorrelationIdClient(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	var receivedId string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		receivedId = r.Header.Get(CorrelationIdHeader)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+	client := NewCorrelationIdClient()
+
+	fmt.Println("Send correlation ID from request context")
+	req, _ := http.NewRequest("GET", server.URL, nil)
+	req = req.WithContext(WithCorrelationId(context.Background(), "id1"))
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("Request failed: %s", err.Error())
+	}
+	resp.Body.Close()
+	if receivedId != "id1" || resp.Request.Header.Get(CorrelationIdHeader) != "id1" {
+		t.Fatalf("Correlation ID not sent")
+	}
+	if req.Header.Get(CorrelationIdHeader) != "" {
+		t.Fatalf("Request should not be modified")
+	}
+
+	fmt.Println("Generate a new correlation ID per request")
+	var ids []string
+	for i := 0; i < 2; i++ {
+		req, _ = http.NewRequest("GET", server.URL, nil)
+		resp, err = client.Do(req)
+		if err != nil {
+			t.Fatalf("Request failed: %s", err.Error())
+		}
+		resp.Body.Close()
+		ids = append(ids, receivedId)
+	}
+	if ids[0] == "" || ids[1] == "" || ids[0] == ids[1] {
+		t.Fatalf("Correlation IDs should be generated per request")
+	}
+
+	fmt.Println("Keep explicit correlation ID header")
+	req, _ = http.NewRequest("GET", server.URL, nil)
+	req.Header.Set(CorrelationIdHeader, "id2")
+	req = req.WithContext(WithCorrelationId(context.Background(), "id1"))
+	resp, err = client.Do(req)
+	if err != nil {
+		t.Fatalf("Request failed: %s", err.Error())
+	}
+	resp.Body.Close()
+	if receivedId != "id2" {
+		t.Fatalf("Explicit correlation ID not sent")
+	}
+}
@@ -0,0 +1,178 @@
+/*
+ * Copyright (c) 2020  InterDigital Communications, Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package metricstore
+
+import (
+	"encoding/json"
+	"errors"
+
+	log "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-logger"
+
+	influx "github.com/influxdata/influxdb1-client/v2"
+)
+
+// Aggregation functions
+const AggMean = "mean"
+const AggMin = "min"
+const AggMax = "max"
+const AggP50 = "p50"
+const AggP95 = "p95"
+const AggP99 = "p99"
+const AggStddev = "stddev"
+const AggCount = "count"
+const AggSum = "sum"
+
+// Aggregation - Aggregation query parameters
+type Aggregation struct {
+	// Aggregation functions to apply to each queried field
+	Functions []string
+	// Time bucket size (e.g. 10s, 1m); no time bucketing if empty
+	Interval string
+	// Tag names to group results by
+	GroupBy []string
+}
+
+// AggregateMetric - Aggregated values for a single time bucket
+type AggregateMetric struct {
+	Time   interface{}
+	Values map[string]float64
+}
+
+// AggregateSeries - Aggregated metrics for a single tag group
+type AggregateSeries struct {
+	Tags    map[string]string
+	Metrics []AggregateMetric
+}
+
+// AggregateColumn - Name of the column holding the aggregation of a field
+func AggregateColumn(function string, field string) string {
+	return function + "_" + field
+}
+
+// IsValidAggregation - Check if aggregation function is supported
+func IsValidAggregation(function string) bool {
+	_, err := getAggregateSelector(function, "")
+	return err == nil
+}
+
+func getAggregateSelector(function string, field string) (string, error) {
+	switch function {
+	case AggMean:
+		return "MEAN(" + field + ")", nil
+	case AggMin:
+		return "MIN(" + field + ")", nil
+	case AggMax:
+		return "MAX(" + field + ")", nil
+	case AggP50:
+		return "PERCENTILE(" + field + ",50)", nil
+	case AggP95:
+		return "PERCENTILE(" + field + ",95)", nil
+	case AggP99:
+		return "PERCENTILE(" + field + ",99)", nil
+	case AggStddev:
+		return "STDDEV(" + field + ")", nil
+	case AggCount:
+		return "COUNT(" + field + ")", nil
+	case AggSum:
+		return "SUM(" + field + ")", nil
+	}
+	return "", errors.New("Unsupported aggregation function: " + function)
+}
+
+// buildAggregateQuery - Build aggregation query & return it with its bound parameters
+func buildAggregateQuery(metric string, tags map[string]string, fields []string, duration string, count int, agg *Aggregation) (string, map[string]interface{}, error) {
+	if agg == nil || len(agg.Functions) == 0 {
+		return "", nil, &InvalidQueryError{"no aggregation function specified"}
+	}
+	if len(fields) == 0 {
+		return "", nil, &InvalidQueryError{"no field specified for aggregation"}
+	}
+	if agg.Interval != "" && duration == "" {
+		return "", nil, &InvalidQueryError{"duration required for time bucket aggregation"}
+	}
+
+	q := NewInfluxQuery(metric)
+	for _, field := range fields {
+		for _, function := range agg.Functions {
+			q.SelectAggregate(function, field, AggregateColumn(function, field))
+		}
+	}
+	addInfluxTagConditions(q, tags)
+	q.WhereTimeWithin(duration).GroupByTime(agg.Interval).GroupByTags(agg.GroupBy...).OrderDesc().Limit(count)
+	return q.Build()
+}
+
+// GetInfluxMetricAggregate - Generic aggregated metric getter
+func (ms *MetricStore) GetInfluxMetricAggregate(metric string, tags map[string]string, fields []string, duration string, count int, agg *Aggregation) (series []AggregateSeries, err error) {
+	// Make sure we have set a store
+	if ms.name == "" {
+		return series, errors.New("Store name not specified")
+	}
+
+	// Create query
+	query, params, err := buildAggregateQuery(metric, tags, fields, duration, count, agg)
+	if err != nil {
+		log.Error("Failed to build query: ", err.Error())
+		return series, err
+	}
+	log.Debug("QUERY: ", query, " PARAMS: ", params)
+
+	return ms.queryAggregateSeries(query, params)
+}
+
+// queryAggregateSeries - Run aggregation query & return one series per tag group
+func (ms *MetricStore) queryAggregateSeries(query string, params map[string]interface{}) (series []AggregateSeries, err error) {
+	// Query store for metric
+	response, err := (*ms.influxClient).Query(influx.NewQueryWithParameters(query, ms.name, "", params))
+	if err != nil {
+		log.Error("Query failed with error: ", err.Error())
+		return series, err
+	}
+	if response.Error() != nil {
+		err = response.Error()
+		log.Error("Query failed with error: ", err.Error())
+		return series, err
+	}
+
+	// Process response
+	if len(response.Results) <= 0 || len(response.Results[0].Series) <= 0 {
+		err = errNoResults
+		log.Debug("Query failed with error: ", err.Error())
+		return series, err
+	}
+
+	// Read results; one series per tag group
+	for _, row := range response.Results[0].Series {
+		s := AggregateSeries{Tags: row.Tags}
+		for _, qValues := range row.Values {
+			m := AggregateMetric{Values: make(map[string]float64)}
+			for index, qVal := range qValues {
+				if row.Columns[index] == NetMetTime {
+					m.Time = qVal
+					continue
+				}
+				if num, ok := qVal.(json.Number); ok {
+					m.Values[row.Columns[index]] = JsonNumToFloat64(num)
+				}
+			}
+			s.Metrics = append(s.Metrics, m)
+		}
+		series = append(series, s)
+	}
+
+	return series, nil
+}
@@ -0,0 +1,178 @@
+/*
+ * Copyright (c) 2020  InterDigital Communications, Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package metricstore
+
+import (
+	"fmt"
+	"testing"
+
+	log "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-logger"
+)
+
+const aggStoreName string = "agg-store"
+const aggStoreNamespace string = "agg-ns"
+const aggStoreInfluxAddr string = "http://localhost:30986"
+const aggStoreRedisAddr string = "localhost:30380"
+
+func TestAggregateQuery(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	fmt.Println("Invalid aggregations")
+	_, _, err := buildAggregateQuery(NetMetName, nil, []string{NetMetLatency}, "", 0, nil)
+	if err == nil {
+		t.Fatalf("Missing aggregation should fail")
+	}
+	_, _, err = buildAggregateQuery(NetMetName, nil, []string{NetMetLatency}, "", 0, &Aggregation{})
+	if err == nil {
+		t.Fatalf("Missing aggregation function should fail")
+	}
+	_, _, err = buildAggregateQuery(NetMetName, nil, []string{NetMetLatency}, "", 0, &Aggregation{Functions: []string{"p42"}})
+	if err == nil {
+		t.Fatalf("Unsupported aggregation function should fail")
+	}
+	_, _, err = buildAggregateQuery(NetMetName, nil, []string{}, "", 0, &Aggregation{Functions: []string{AggMean}})
+	if err == nil {
+		t.Fatalf("Missing aggregation field should fail")
+	}
+	_, _, err = buildAggregateQuery(NetMetName, nil, []string{NetMetLatency}, "", 0, &Aggregation{Functions: []string{AggMean}, Interval: "1s"})
+	if err == nil {
+		t.Fatalf("Time bucket aggregation without duration should fail")
+	}
+	if IsValidAggregation("median") || !IsValidAggregation(AggP99) || !IsValidAggregation(AggSum) {
+		t.Fatalf("Invalid aggregation function check")
+	}
+
+	fmt.Println("Aggregation without grouping")
+	query, params, err := buildAggregateQuery(NetMetName, nil, []string{NetMetLatency}, "", 0, &Aggregation{Functions: []string{AggMean, AggP95}})
+	if err != nil {
+		t.Fatalf("Failed to build query")
+	}
+	if query != `SELECT MEAN("lat") AS "mean_lat",PERCENTILE("lat",95) AS "p95_lat" FROM "network" ORDER BY desc` {
+		t.Fatalf("Invalid query: " + query)
+	}
+	if len(params) != 0 {
+		t.Fatalf("Invalid query params")
+	}
+
+	fmt.Println("Aggregation with time bucket & tag grouping")
+	agg := &Aggregation{
+		Functions: []string{AggMin, AggMax, AggStddev, AggCount},
+		Interval:  "10s",
+		GroupBy:   []string{NetMetSrc, NetMetDst},
+	}
+	query, params, err = buildAggregateQuery(NetMetName, map[string]string{NetMetSrc: "node1"}, []string{NetMetLatency, NetMetULThroughput}, "1m", 5, agg)
+	if err != nil {
+		t.Fatalf("Failed to build query")
+	}
+	expected := `SELECT MIN("lat") AS "min_lat",MAX("lat") AS "max_lat",STDDEV("lat") AS "stddev_lat",COUNT("lat") AS "count_lat",` +
+		`MIN("ul") AS "min_ul",MAX("ul") AS "max_ul",STDDEV("ul") AS "stddev_ul",COUNT("ul") AS "count_ul" FROM "network"` +
+		` WHERE ("src" = $p0) AND time > now() - 1m GROUP BY time(10s),"src","dest" fill(none) ORDER BY desc LIMIT 5`
+	if query != expected {
+		t.Fatalf("Invalid query: " + query)
+	}
+	if len(params) != 1 || params["p0"] != "node1" {
+		t.Fatalf("Invalid query params")
+	}
+
+	fmt.Println("Aggregation with invalid group")
+	agg.GroupBy = []string{"src\" OR 1=1"}
+	_, _, err = buildAggregateQuery(NetMetName, nil, []string{NetMetLatency}, "1m", 0, agg)
+	if err == nil || !IsInvalidQueryError(err) {
+		t.Fatalf("Invalid group should fail")
+	}
+}
+
+func TestAggregateMetricGet(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	// INIT
+
+	fmt.Println("Create valid Metric Store")
+	ms, err := NewMetricStore("", aggStoreNamespace, aggStoreInfluxAddr, aggStoreRedisAddr)
+	if err != nil {
+		t.Fatalf("Unable to create Metric Store")
+	}
+
+	fmt.Println("Invoke API before setting store")
+	_, err = ms.GetNetworkMetricAggregate("node1", "node2", nil, "", &Aggregation{Functions: []string{AggMean}})
+	if err == nil {
+		t.Fatalf("API call should fail if no store is set")
+	}
+
+	fmt.Println("Set store")
+	err = ms.SetStore(aggStoreName)
+	if err != nil {
+		t.Fatalf("Unable to set Store")
+	}
+
+	fmt.Println("Flush store metrics")
+	ms.Flush()
+
+	fmt.Println("Set network metrics")
+	for i := 1; i <= 4; i++ {
+		err = ms.SetNetworkMetric(NetworkMetric{"node1", "node2", nil, int32(i * 10), 0, 0, 0, 0})
+		if err != nil {
+			t.Fatalf("Unable to set net metric")
+		}
+		err = ms.SetNetworkMetric(NetworkMetric{"node2", "node1", nil, int32(i * 100), 0, 0, 0, 0})
+		if err != nil {
+			t.Fatalf("Unable to set net metric")
+		}
+	}
+
+	fmt.Println("Get aggregated network metrics (node1 -> node2)")
+	agg := &Aggregation{Functions: []string{AggMean, AggMin, AggMax, AggCount}}
+	series, err := ms.GetNetworkMetricAggregate("node1", "node2", []string{NetMetLatency}, "", agg)
+	if err != nil || len(series) != 1 || len(series[0].Metrics) != 1 {
+		t.Fatalf("Failed to get aggregated metric")
+	}
+	values := series[0].Metrics[0].Values
+	if values["mean_lat"] != 25 || values["min_lat"] != 10 || values["max_lat"] != 40 || values["count_lat"] != 4 {
+		t.Fatalf("Invalid aggregated metric")
+	}
+
+	fmt.Println("Get aggregated network metrics grouped by src")
+	agg = &Aggregation{Functions: []string{AggMax}, GroupBy: []string{NetMetSrc}}
+	series, err = ms.GetNetworkMetricAggregate("", "", []string{NetMetLatency}, "", agg)
+	if err != nil || len(series) != 2 {
+		t.Fatalf("Failed to get aggregated metric")
+	}
+	for _, s := range series {
+		if len(s.Metrics) != 1 {
+			t.Fatalf("Invalid aggregated metric")
+		}
+		if (s.Tags[NetMetSrc] == "node1" && s.Metrics[0].Values["max_lat"] != 40) ||
+			(s.Tags[NetMetSrc] == "node2" && s.Metrics[0].Values["max_lat"] != 400) {
+			t.Fatalf("Invalid aggregated metric")
+		}
+	}
+
+	fmt.Println("Get aggregated network metrics in time buckets")
+	agg = &Aggregation{Functions: []string{AggP50}, Interval: "1h"}
+	series, err = ms.GetNetworkMetricAggregate("node2", "node1", []string{NetMetLatency}, "1d", agg)
+	if err != nil || len(series) != 1 || len(series[0].Metrics) == 0 {
+		t.Fatalf("Failed to get aggregated metric")
+	}
+
+	fmt.Println("Get aggregated event metrics")
+	_, err = ms.GetEventMetricAggregate("", "", &Aggregation{Functions: []string{AggMean}})
+	if err == nil {
+		t.Fatalf("Only event counts should be supported")
+	}
+}
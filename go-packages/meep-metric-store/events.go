@@ -81,3 +81,26 @@ func (ms *MetricStore) GetEventMetric(eventType string, duration string, count i
 	}
 	return
 }
+
+// GetEventMetricAggregate - Event fields are strings; only counts are supported
+func (ms *MetricStore) GetEventMetricAggregate(eventType string, duration string, agg *Aggregation) (series []AggregateSeries, err error) {
+	if agg != nil {
+		for _, function := range agg.Functions {
+			if function != AggCount {
+				err = &InvalidQueryError{"unsupported event aggregation function: " + function}
+				return
+			}
+		}
+	}
+
+	tags := map[string]string{}
+	if eventType != "" {
+		tags[EvMetType] = eventType
+	}
+	fields := []string{EvMetEvent}
+	series, err = ms.GetInfluxMetricAggregate(EvMetName, tags, fields, duration, 0, agg)
+	if err != nil {
+		log.Error("Failed to retrieve metrics with error: ", err.Error())
+	}
+	return
+}
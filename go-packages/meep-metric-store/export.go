@@ -0,0 +1,343 @@
+/*
+ * Copyright (c) 2020  InterDigital Communications, Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package metricstore
+
+import (
+	"archive/zip"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	log "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-logger"
+
+	influx "github.com/influxdata/influxdb1-client/v2"
+)
+
+// Export formats
+const ExportFormatCsv = "csv"
+const ExportFormatJsonLines = "jsonl"
+
+// Run information fields
+const RunInfoScenario = "scenario"
+const RunInfoReplayFile = "replayFile"
+
+const runInfoKey = "run-info:"
+const exportChunkSize = 10000
+const exportManifestFile = "manifest.json"
+
+// Measurements included in a store export
+var exportMeasurements = []string{NetMetName, EvMetName, HttpLogMetricName, MgMetName}
+
+// ExportMeasurement - Exported measurement file information
+type ExportMeasurement struct {
+	Name string `json:"name"`
+	File string `json:"file"`
+	Rows int    `json:"rows"`
+}
+
+// ExportManifest - Description of a metric store export
+type ExportManifest struct {
+	Store        string              `json:"store"`
+	ScenarioName string              `json:"scenarioName"`
+	ReplayFile   string              `json:"replayFile"`
+	Format       string              `json:"format"`
+	StartTime    string              `json:"startTime,omitempty"`
+	EndTime      string              `json:"endTime,omitempty"`
+	ExportTime   string              `json:"exportTime"`
+	Measurements []ExportMeasurement `json:"measurements"`
+}
+
+// RunNotFoundError - Error returned when no run information was recorded for a store
+type RunNotFoundError struct {
+	store string
+}
+
+func (e *RunNotFoundError) Error() string {
+	return "Metrics run not found: " + e.store
+}
+
+// IsRunNotFoundError - Check if error was caused by a missing store run
+func IsRunNotFoundError(err error) bool {
+	_, ok := err.(*RunNotFoundError)
+	return ok
+}
+
+// IsValidExportFormat - Check if export format is supported
+func IsValidExportFormat(format string) bool {
+	return format == ExportFormatCsv || format == ExportFormatJsonLines
+}
+
+// SetRunInfo - Store information on the scenario run that produced the store metrics
+func (ms *MetricStore) SetRunInfo(fields map[string]interface{}) error {
+	// Make sure we have set a store
+	if ms.name == "" {
+		return errors.New("Store name not specified")
+	}
+
+	err := ms.redisClient.SetEntry(ms.baseKey+runInfoKey+ms.name, fields)
+	if err != nil {
+		log.Error("Failed to set run info with error: ", err.Error())
+		return err
+	}
+	return nil
+}
+
+// GetRunInfo - Retrieve information on the scenario run that produced the store metrics
+func (ms *MetricStore) GetRunInfo() (map[string]string, error) {
+	// Make sure we have set a store
+	if ms.name == "" {
+		return nil, errors.New("Store name not specified")
+	}
+
+	return ms.redisClient.GetEntry(ms.baseKey + runInfoKey + ms.name)
+}
+
+// GetStoreRunInfo - Retrieve information on the scenario run that produced the named store metrics
+// Returns a RunNotFoundError if no run information was recorded for the store.
+func (ms *MetricStore) GetStoreRunInfo(name string) (map[string]string, error) {
+	if name == "" {
+		return nil, errors.New("Store name not specified")
+	}
+
+	runInfo, err := ms.redisClient.GetEntry(ms.baseKey + runInfoKey + ms.getStoreName(name))
+	if err != nil {
+		return nil, err
+	}
+	if len(runInfo) == 0 {
+		return nil, &RunNotFoundError{name}
+	}
+	return runInfo, nil
+}
+
+// copyRunInfo - Replace destination store run information with source store run information
+func (ms *MetricStore) copyRunInfo(srcStoreName string, dstStoreName string) error {
+	runInfo, err := ms.redisClient.GetEntry(ms.baseKey + runInfoKey + srcStoreName)
+	if err != nil {
+		return err
+	}
+	err = ms.redisClient.DelEntry(ms.baseKey + runInfoKey + dstStoreName)
+	if err != nil || len(runInfo) == 0 {
+		return err
+	}
+	fields := make(map[string]interface{}, len(runInfo))
+	for k, v := range runInfo {
+		fields[k] = v
+	}
+	return ms.redisClient.SetEntry(ms.baseKey+runInfoKey+dstStoreName, fields)
+}
+
+// ExportMetrics - Write measurement points within the time range in the requested format
+// Points are streamed from the store in chunks; a zero start or end time leaves the range open.
+func (ms *MetricStore) ExportMetrics(w io.Writer, measurement string, format string, start time.Time, end time.Time) (rows int, err error) {
+	// Make sure we have set a store
+	if ms.name == "" {
+		return 0, errors.New("Store name not specified")
+	}
+	return ms.exportMetrics(w, ms.name, measurement, format, start, end)
+}
+
+func (ms *MetricStore) exportMetrics(w io.Writer, storeName string, measurement string, format string, start time.Time, end time.Time) (rows int, err error) {
+	if !IsValidExportFormat(format) {
+		return 0, &InvalidQueryError{"unsupported export format '" + format + "'"}
+	}
+
+	// Create query
+	query, _, err := NewInfluxQuery(measurement).WhereTimeRange(start, end).Build()
+	if err != nil {
+		log.Error("Failed to build query: ", err.Error())
+		return 0, err
+	}
+	log.Debug("QUERY: ", query)
+
+	// Query store for metric
+	q := influx.NewQuery(query, storeName, "")
+	q.Chunked = true
+	q.ChunkSize = exportChunkSize
+	response, err := (*ms.influxClient).QueryAsChunk(q)
+	if err != nil {
+		log.Error("Query failed with error: ", err.Error())
+		return 0, err
+	}
+	defer response.Close()
+
+	// Write results as they are received
+	writer := newExportWriter(w, format)
+	for {
+		chunk, err := response.NextResponse()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			log.Error("Query failed with error: ", err.Error())
+			return rows, err
+		}
+		if chunk.Error() != nil {
+			err = chunk.Error()
+			log.Error("Query failed with error: ", err.Error())
+			return rows, err
+		}
+		for _, result := range chunk.Results {
+			for _, row := range result.Series {
+				for _, values := range row.Values {
+					err = writer.write(row.Columns, values)
+					if err != nil {
+						log.Error("Failed to write export row with error: ", err.Error())
+						return rows, err
+					}
+					rows++
+				}
+			}
+		}
+	}
+	return rows, writer.flush()
+}
+
+// ExportArchive - Write a zip archive of all store measurements within the time range
+// The archive contains one file per measurement followed by a manifest describing the run.
+// An empty name exports the active store; other stores must have recorded run information.
+func (ms *MetricStore) ExportArchive(w io.Writer, name string, format string, start time.Time, end time.Time) error {
+	if !IsValidExportFormat(format) {
+		return &InvalidQueryError{"unsupported export format '" + format + "'"}
+	}
+
+	// Select store & retrieve its run information
+	var storeName string
+	var runInfo map[string]string
+	var err error
+	if name == "" {
+		// Make sure we have set a store
+		if ms.name == "" {
+			return errors.New("Store name not specified")
+		}
+		storeName = ms.name
+		runInfo, err = ms.GetRunInfo()
+		if err != nil {
+			log.Warn("Failed to get run info: ", err.Error())
+		}
+	} else {
+		storeName = ms.getStoreName(name)
+		runInfo, err = ms.GetStoreRunInfo(name)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Create manifest
+	manifest := ExportManifest{
+		Store:      storeName,
+		Format:     format,
+		ExportTime: time.Now().UTC().Format(time.RFC3339),
+	}
+	if !start.IsZero() {
+		manifest.StartTime = start.UTC().Format(time.RFC3339Nano)
+	}
+	if !end.IsZero() {
+		manifest.EndTime = end.UTC().Format(time.RFC3339Nano)
+	}
+	manifest.ScenarioName = runInfo[RunInfoScenario]
+	manifest.ReplayFile = runInfo[RunInfoReplayFile]
+
+	// Export measurements
+	archive := zip.NewWriter(w)
+	for _, measurement := range exportMeasurements {
+		fileName := measurement + "." + format
+		file, err := archive.Create(fileName)
+		if err != nil {
+			return err
+		}
+		rows, err := ms.exportMetrics(file, storeName, measurement, format, start, end)
+		if err != nil {
+			return err
+		}
+		manifest.Measurements = append(manifest.Measurements, ExportMeasurement{Name: measurement, File: fileName, Rows: rows})
+	}
+
+	// Add manifest
+	file, err := archive.Create(exportManifestFile)
+	if err != nil {
+		return err
+	}
+	encoder := json.NewEncoder(file)
+	encoder.SetIndent("", "  ")
+	err = encoder.Encode(manifest)
+	if err != nil {
+		return err
+	}
+	return archive.Close()
+}
+
+// exportWriter - Writes query result rows in an export format
+type exportWriter struct {
+	format  string
+	w       io.Writer
+	csv     *csv.Writer
+	columns []string
+}
+
+func newExportWriter(w io.Writer, format string) *exportWriter {
+	writer := &exportWriter{format: format, w: w}
+	if format == ExportFormatCsv {
+		writer.csv = csv.NewWriter(w)
+	}
+	return writer
+}
+
+func (e *exportWriter) write(columns []string, values []interface{}) error {
+	if e.format == ExportFormatJsonLines {
+		obj := make(map[string]interface{}, len(columns))
+		for i, column := range columns {
+			if i < len(values) && values[i] != nil {
+				obj[column] = values[i]
+			}
+		}
+		line, err := json.Marshal(obj)
+		if err != nil {
+			return err
+		}
+		_, err = e.w.Write(append(line, '\n'))
+		return err
+	}
+
+	// CSV header is taken from the first row; later rows are written in header column order
+	if e.columns == nil {
+		e.columns = columns
+		if err := e.csv.Write(columns); err != nil {
+			return err
+		}
+	}
+	record := make([]string, len(e.columns))
+	for i, column := range e.columns {
+		for j := range columns {
+			if columns[j] == column && j < len(values) && values[j] != nil {
+				record[i] = fmt.Sprint(values[j])
+				break
+			}
+		}
+	}
+	return e.csv.Write(record)
+}
+
+func (e *exportWriter) flush() error {
+	if e.csv != nil {
+		e.csv.Flush()
+		return e.csv.Error()
+	}
+	return nil
+}
@@ -0,0 +1,167 @@
+/*
+ * Copyright (c) 2020  InterDigital Communications, Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package metricstore
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	log "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-logger"
+)
+
+func TestExportQuery(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	start := time.Unix(1590000000, 0)
+	end := time.Unix(1590000060, 500)
+
+	fmt.Println("Open time range")
+	query, _, err := NewInfluxQuery(NetMetName).WhereTimeRange(time.Time{}, time.Time{}).Build()
+	if err != nil || query != `SELECT * FROM "network"` {
+		t.Fatalf("Invalid query: " + query)
+	}
+
+	fmt.Println("Closed time range")
+	query, _, err = NewInfluxQuery(NetMetName).WhereTimeRange(start, end).Build()
+	if err != nil || query != `SELECT * FROM "network" WHERE time >= 1590000000000000000 AND time <= 1590000060000000500` {
+		t.Fatalf("Invalid query: " + query)
+	}
+
+	fmt.Println("Inverted time range")
+	_, _, err = NewInfluxQuery(NetMetName).WhereTimeRange(end, start).Build()
+	if err == nil || !IsInvalidQueryError(err) {
+		t.Fatalf("Inverted time range should fail")
+	}
+
+	fmt.Println("Export formats")
+	if !IsValidExportFormat(ExportFormatCsv) || !IsValidExportFormat(ExportFormatJsonLines) || IsValidExportFormat("parquet") {
+		t.Fatalf("Invalid export format check")
+	}
+}
+
+func TestExportWriter(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	columns := []string{"time", "dest", "lat", "src"}
+	rows := [][]interface{}{
+		{"2020-05-20T18:40:00Z", "node2", json.Number("10"), "node1"},
+		{"2020-05-20T18:40:01Z", "node1", nil, "node2"},
+	}
+
+	fmt.Println("Write CSV rows")
+	var buf bytes.Buffer
+	writer := newExportWriter(&buf, ExportFormatCsv)
+	for _, row := range rows {
+		if err := writer.write(columns, row); err != nil {
+			t.Fatalf("Failed to write row")
+		}
+	}
+	// Later chunks may return columns in a different order
+	if err := writer.write([]string{"src", "time", "dest", "lat"}, []interface{}{"node3", "2020-05-20T18:40:02Z", "node1", json.Number("3")}); err != nil {
+		t.Fatalf("Failed to write row")
+	}
+	if err := writer.flush(); err != nil {
+		t.Fatalf("Failed to flush rows")
+	}
+	expected := "time,dest,lat,src\n" +
+		"2020-05-20T18:40:00Z,node2,10,node1\n" +
+		"2020-05-20T18:40:01Z,node1,,node2\n" +
+		"2020-05-20T18:40:02Z,node1,3,node3\n"
+	if buf.String() != expected {
+		t.Fatalf("Invalid CSV export: " + buf.String())
+	}
+
+	fmt.Println("Write JSON-lines rows")
+	buf.Reset()
+	writer = newExportWriter(&buf, ExportFormatJsonLines)
+	for _, row := range rows {
+		if err := writer.write(columns, row); err != nil {
+			t.Fatalf("Failed to write row")
+		}
+	}
+	if err := writer.flush(); err != nil {
+		t.Fatalf("Failed to flush rows")
+	}
+	expected = `{"dest":"node2","lat":10,"src":"node1","time":"2020-05-20T18:40:00Z"}` + "\n" +
+		`{"dest":"node1","src":"node2","time":"2020-05-20T18:40:01Z"}` + "\n"
+	if buf.String() != expected {
+		t.Fatalf("Invalid JSON-lines export: " + buf.String())
+	}
+}
+
+func TestExportRunInfo(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	fmt.Println("Create valid Metric Store")
+	ms, err := NewMetricStore(metricStore1Name, metricStoreNamespace, metricStoreInfluxAddr, metricStoreRedisAddr)
+	if err != nil {
+		t.Fatalf("Unable to create Metric Store")
+	}
+
+	fmt.Println("Set run info")
+	err = ms.SetRunInfo(map[string]interface{}{RunInfoScenario: metricStore1Name, RunInfoReplayFile: "replay1"})
+	if err != nil {
+		t.Fatalf("Failed to set run info")
+	}
+	runInfo, err := ms.GetStoreRunInfo(metricStore1Name)
+	if err != nil || runInfo[RunInfoScenario] != metricStore1Name || runInfo[RunInfoReplayFile] != "replay1" {
+		t.Fatalf("Invalid run info")
+	}
+
+	fmt.Println("Export inactive store")
+	err = ms.SetStore(metricStore2Name)
+	if err != nil {
+		t.Fatalf("Unable to set Store")
+	}
+	var buf bytes.Buffer
+	err = ms.ExportArchive(&buf, metricStore1Name, ExportFormatCsv, time.Time{}, time.Time{})
+	if err != nil || buf.Len() == 0 {
+		t.Fatalf("Failed to export store")
+	}
+
+	fmt.Println("Copy run info")
+	err = ms.Copy(metricStore1Name, metricStore3Name)
+	if err != nil {
+		t.Fatalf("Failed to copy database")
+	}
+	runInfo, err = ms.GetStoreRunInfo(metricStore3Name)
+	if err != nil || runInfo[RunInfoReplayFile] != "replay1" {
+		t.Fatalf("Invalid copied run info")
+	}
+
+	fmt.Println("Delete stores")
+	for _, name := range []string{metricStore1Name, metricStore3Name} {
+		err = ms.DeleteStore(name)
+		if err != nil {
+			t.Fatalf("Failed to delete store")
+		}
+		_, err = ms.GetStoreRunInfo(name)
+		if !IsRunNotFoundError(err) {
+			t.Fatalf("Run info should be deleted")
+		}
+		err = ms.ExportArchive(&buf, name, ExportFormatCsv, time.Time{}, time.Time{})
+		if !IsRunNotFoundError(err) {
+			t.Fatalf("Deleted store export should fail")
+		}
+	}
+}
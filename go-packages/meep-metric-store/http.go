@@ -19,6 +19,9 @@ package metricstore
 import (
 	"encoding/json"
 	"errors"
+	"reflect"
+	"sort"
+	"time"
 
 	log "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-logger"
 )
@@ -35,22 +38,29 @@ const HttpBody = "body"
 const HttpRespBody = "resp_body"
 const HttpRespCode = "resp_code"
 const HttpProcTime = "proc_time"
+const HttpProcDuration = "proc_duration" // Numerical processing time (us) for aggregation queries
+const HttpReqHeaders = "req_headers"
+const HttpRespHeaders = "resp_headers"
+const HttpCorrelationId = "correlation_id"
 
 const HttpRxDirection = "RX"
 const HttpTxDirection = "TX"
 
 type HttpMetric struct {
-	LoggerName string
-	Direction  string
-	Id         int32
-	Url        string
-	Endpoint   string
-	Method     string
-	Body       string
-	RespBody   string
-	RespCode   string
-	ProcTime   string
-	Time       interface{}
+	LoggerName    string
+	Direction     string
+	Id            int32
+	Url           string
+	Endpoint      string
+	Method        string
+	Body          string
+	RespBody      string
+	RespCode      string
+	ProcTime      string
+	Time          interface{}
+	ReqHeaders    string
+	RespHeaders   string
+	CorrelationId string
 }
 
 // SetHttpMetric
@@ -60,14 +70,18 @@ func (ms *MetricStore) SetHttpMetric(h HttpMetric) error {
 	metric.Name = HttpLogMetricName
 	metric.Tags = map[string]string{HttpLoggerName: h.LoggerName, HttpLoggerDirection: h.Direction}
 	metric.Fields = map[string]interface{}{
-		HttpLogId:       h.Id,
-		HttpUrl:         h.Url,
-		HttpLogEndpoint: h.Endpoint,
-		HttpMethod:      h.Method,
-		HttpBody:        h.Body,
-		HttpRespBody:    h.RespBody,
-		HttpRespCode:    h.RespCode,
-		HttpProcTime:    h.ProcTime,
+		HttpLogId:         h.Id,
+		HttpUrl:           h.Url,
+		HttpLogEndpoint:   h.Endpoint,
+		HttpMethod:        h.Method,
+		HttpBody:          h.Body,
+		HttpRespBody:      h.RespBody,
+		HttpRespCode:      h.RespCode,
+		HttpProcTime:      h.ProcTime,
+		HttpProcDuration:  StrToInt32(h.ProcTime),
+		HttpReqHeaders:    h.ReqHeaders,
+		HttpRespHeaders:   h.RespHeaders,
+		HttpCorrelationId: h.CorrelationId,
 	}
 	return ms.SetInfluxMetric(metricList)
 }
@@ -88,7 +102,8 @@ func (ms *MetricStore) GetHttpMetric(loggerName string, direction string, durati
 	if direction != "" {
 		tags[HttpLoggerDirection] = direction
 	}
-	fields := []string{HttpLogId, HttpUrl, HttpLogEndpoint, HttpMethod, HttpBody, HttpRespBody, HttpRespCode, HttpProcTime}
+	fields := []string{HttpLogId, HttpUrl, HttpLogEndpoint, HttpMethod, HttpBody, HttpRespBody, HttpRespCode, HttpProcTime,
+		HttpReqHeaders, HttpRespHeaders, HttpCorrelationId}
 	var valuesArray []map[string]interface{}
 	valuesArray, err = ms.GetInfluxMetric(HttpLogMetricName, tags, fields, duration, count)
 	if err != nil {
@@ -124,6 +139,154 @@ func (ms *MetricStore) GetHttpMetric(loggerName string, direction string, durati
 		if val, ok := values[HttpProcTime].(string); ok {
 			metrics[index].ProcTime = val
 		}
+		if val, ok := values[HttpReqHeaders].(string); ok {
+			metrics[index].ReqHeaders = val
+		}
+		if val, ok := values[HttpRespHeaders].(string); ok {
+			metrics[index].RespHeaders = val
+		}
+		if val, ok := values[HttpCorrelationId].(string); ok {
+			metrics[index].CorrelationId = val
+		}
+	}
+	return
+}
+
+// GetHttpMetricAggregate
+func (ms *MetricStore) GetHttpMetricAggregate(loggerName string, direction string, fields []string, duration string, agg *Aggregation) (series []AggregateSeries, err error) {
+	tags := map[string]string{}
+	if loggerName != "" {
+		tags[HttpLoggerName] = loggerName
+	}
+	if direction != "" {
+		tags[HttpLoggerDirection] = direction
+	}
+	if len(fields) == 0 {
+		fields = []string{HttpProcDuration}
+	}
+	series, err = ms.GetInfluxMetricAggregate(HttpLogMetricName, tags, fields, duration, 0, agg)
+	if err != nil {
+		log.Error("Failed to retrieve metrics with error: ", err.Error())
 	}
 	return
 }
+
+// HttpHistogram - Cumulative distribution of processing durations (us) for a logger & direction
+type HttpHistogram struct {
+	Tags map[string]string
+	// Number of durations lower or equal to each bucket upper bound
+	Buckets []uint64
+	Count   uint64
+	Sum     float64
+}
+
+// Histogram update window settings
+// Durations logged less than httpHistogramLag ago are left for the next update so that points written late are
+// not missed; histograms start with durations logged within httpHistogramLookback of their creation.
+const httpHistogramLag = 5 * time.Second
+const httpHistogramLookback = time.Hour
+
+// httpHistogramCache - Cumulative histograms updated with durations logged since the last update
+// last is the end of the last queried time window; windows are half-open (last, end] so that points on
+// the window edge are counted exactly once.
+type httpHistogramCache struct {
+	store      string
+	buckets    []float64
+	last       time.Time
+	histograms map[string]*HttpHistogram
+}
+
+// GetHttpMetricHistogram - Get processing duration histograms for the provided bucket upper bounds (us)
+// Histograms are kept in memory and only updated with durations logged since the previous call, using a
+// single time-bounded query lagging behind the current time. They are reset when the store or bucket upper
+// bounds change.
+func (ms *MetricStore) GetHttpMetricHistogram(buckets []float64) (histograms []HttpHistogram, err error) {
+	// Make sure we have set a store
+	if ms.name == "" {
+		err = errors.New("Store name not specified")
+		return
+	}
+
+	ms.httpHistMutex.Lock()
+	defer ms.httpHistMutex.Unlock()
+
+	cache := ms.httpHist
+	if cache == nil || cache.store != ms.name || !reflect.DeepEqual(cache.buckets, buckets) {
+		cache = newHttpHistogramCache(ms.name, buckets, time.Now())
+		ms.httpHist = cache
+	}
+
+	// Get durations logged since last update
+	if end, ok := cache.nextWindowEnd(time.Now()); ok {
+		q := NewInfluxQuery(HttpLogMetricName).Select(HttpProcDuration).WhereTimeAfter(cache.last).WhereTimeRange(time.Time{}, end)
+		q.GroupByTags(HttpLoggerName, HttpLoggerDirection)
+		query, params, err := q.Build()
+		if err != nil {
+			log.Error("Failed to build query: ", err.Error())
+			return nil, err
+		}
+		series, err := ms.queryAggregateSeries(query, params)
+		if err != nil && !IsNoResultsError(err) {
+			return nil, err
+		}
+		cache.update(series, end)
+	}
+
+	// Return histogram copies sorted by logger & direction
+	keys := make([]string, 0, len(cache.histograms))
+	for key := range cache.histograms {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		h := *cache.histograms[key]
+		h.Buckets = append([]uint64{}, h.Buckets...)
+		histograms = append(histograms, h)
+	}
+	return histograms, nil
+}
+
+func newHttpHistogramCache(store string, buckets []float64, now time.Time) *httpHistogramCache {
+	return &httpHistogramCache{
+		store:      store,
+		buckets:    append([]float64{}, buckets...),
+		last:       now.Add(-httpHistogramLag - httpHistogramLookback),
+		histograms: make(map[string]*HttpHistogram),
+	}
+}
+
+// nextWindowEnd - Return the end of the next time window to query, if any
+func (cache *httpHistogramCache) nextWindowEnd(now time.Time) (time.Time, bool) {
+	end := now.Add(-httpHistogramLag)
+	return end, end.After(cache.last)
+}
+
+// update - Add durations queried up to the window end to the cumulative histograms
+func (cache *httpHistogramCache) update(series []AggregateSeries, end time.Time) {
+	for _, s := range series {
+		key := getHistogramKey(s.Tags)
+		h, found := cache.histograms[key]
+		if !found {
+			h = &HttpHistogram{Tags: s.Tags, Buckets: make([]uint64, len(cache.buckets))}
+			cache.histograms[key] = h
+		}
+		for _, m := range s.Metrics {
+			duration, found := m.Values[HttpProcDuration]
+			if !found {
+				continue
+			}
+			h.Count++
+			h.Sum += duration
+			for i, bucket := range cache.buckets {
+				if duration <= bucket {
+					h.Buckets[i]++
+				}
+			}
+		}
+	}
+	cache.last = end
+}
+
+func getHistogramKey(tags map[string]string) string {
+	return tags[HttpLoggerName] + ":" + tags[HttpLoggerDirection]
+}
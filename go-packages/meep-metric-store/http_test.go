@@ -19,6 +19,7 @@ package metricstore
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	log "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-logger"
 )
@@ -42,19 +43,19 @@ func TestHttpMetricsGetSet(t *testing.T) {
 	ms.Flush()
 
 	fmt.Println("Set http metrics")
-	err = ms.SetHttpMetric(HttpMetric{"logger1", "RX", 1, "url1", "endpoint1", "method1", "body1", "respBody1", "201", "101", nil})
+	err = ms.SetHttpMetric(HttpMetric{"logger1", "RX", 1, "url1", "endpoint1", "method1", "body1", "respBody1", "201", "101", nil, "reqHeaders1", "respHeaders1", "corrId1"})
 	if err != nil {
 		t.Fatalf("Unable to set http metric")
 	}
-	err = ms.SetHttpMetric(HttpMetric{"logger1", "TX", 2, "url2", "endpoint2", "method2", "body2", "respBody2", "202", "102", nil})
+	err = ms.SetHttpMetric(HttpMetric{"logger1", "TX", 2, "url2", "endpoint2", "method2", "body2", "respBody2", "202", "102", nil, "reqHeaders2", "respHeaders2", "corrId2"})
 	if err != nil {
 		t.Fatalf("Unable to set http metric")
 	}
-	err = ms.SetHttpMetric(HttpMetric{"logger1", "TX", 3, "url3", "endpoint3", "method3", "body3", "respBody3", "203", "103", nil})
+	err = ms.SetHttpMetric(HttpMetric{"logger1", "TX", 3, "url3", "endpoint3", "method3", "body3", "respBody3", "203", "103", nil, "reqHeaders3", "respHeaders3", "corrId3"})
 	if err != nil {
 		t.Fatalf("Unable to set http metric")
 	}
-	err = ms.SetHttpMetric(HttpMetric{"logger2", "RX", 4, "url4", "endpoint4", "method4", "body4", "respBody4", "204", "104", nil})
+	err = ms.SetHttpMetric(HttpMetric{"logger2", "RX", 4, "url4", "endpoint4", "method4", "body4", "respBody4", "204", "104", nil, "reqHeaders4", "respHeaders4", "corrId4"})
 	if err != nil {
 		t.Fatalf("Unable to set http metric")
 	}
@@ -68,7 +69,7 @@ func TestHttpMetricsGetSet(t *testing.T) {
 	if err != nil || len(h) != 1 {
 		t.Fatalf("Failed to get metric")
 	}
-	if !validateHttpMetric(h[0], "logger1", "RX", 1, "url1", "endpoint1", "method1", "body1", "respBody1", "201", "101") {
+	if !validateHttpMetric(h[0], "logger1", "RX", 1, "url1", "endpoint1", "method1", "body1", "respBody1", "201", "101", "reqHeaders1", "respHeaders1", "corrId1") {
 		t.Fatalf("Invalid http metric")
 	}
 
@@ -92,6 +93,68 @@ func TestHttpMetricsGetSet(t *testing.T) {
 	// t.Fatalf("DONE")
 }
 
-func validateHttpMetric(h HttpMetric, loggerName string, direction string, id int32, url string, endpoint string, method string, body string, respBody string, respCode string, procTime string) bool {
-	return h.LoggerName == loggerName && h.Direction == direction && h.Id == id && h.Url == url && h.Endpoint == endpoint && h.Method == method && h.Body == body && h.RespBody == respBody && h.RespCode == respCode && h.ProcTime == procTime
+func validateHttpMetric(h HttpMetric, loggerName string, direction string, id int32, url string, endpoint string, method string, body string, respBody string, respCode string, procTime string, reqHeaders string, respHeaders string, correlationId string) bool {
+	return h.LoggerName == loggerName && h.Direction == direction && h.Id == id && h.Url == url && h.Endpoint == endpoint && h.Method == method && h.Body == body && h.RespBody == respBody && h.RespCode == respCode && h.ProcTime == procTime &&
+		h.ReqHeaders == reqHeaders && h.RespHeaders == respHeaders && h.CorrelationId == correlationId
+}
+
+func TestHttpHistogramUpdate(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	now := time.Date(2020, 1, 1, 0, 0, 10, 0, time.UTC)
+	cache := newHttpHistogramCache("store1", []float64{100, 1000}, now)
+	if !cache.last.Equal(now.Add(-httpHistogramLag - httpHistogramLookback)) {
+		t.Fatalf("Invalid first window start: %s", cache.last)
+	}
+	rxTags := map[string]string{HttpLoggerName: "logger1", HttpLoggerDirection: HttpRxDirection}
+	txTags := map[string]string{HttpLoggerName: "logger1", HttpLoggerDirection: HttpTxDirection}
+	point := func(time string, duration float64) AggregateMetric {
+		return AggregateMetric{Time: time, Values: map[string]float64{HttpProcDuration: duration}}
+	}
+
+	fmt.Println("Add durations to new histograms")
+	end, ok := cache.nextWindowEnd(now)
+	if !ok || !end.Equal(now.Add(-httpHistogramLag)) {
+		t.Fatalf("Invalid window end: %s", end)
+	}
+	cache.update([]AggregateSeries{
+		{Tags: rxTags, Metrics: []AggregateMetric{point("2020-01-01T00:00:01Z", 50), point("2020-01-01T00:00:03.5Z", 500)}},
+		{Tags: txTags, Metrics: []AggregateMetric{point("2020-01-01T00:00:02Z", 5000)}},
+	}, end)
+	rx := cache.histograms[getHistogramKey(rxTags)]
+	tx := cache.histograms[getHistogramKey(txTags)]
+	if rx == nil || rx.Count != 2 || rx.Sum != 550 || rx.Buckets[0] != 1 || rx.Buckets[1] != 2 {
+		t.Fatalf("Invalid RX histogram")
+	}
+	if tx == nil || tx.Count != 1 || tx.Sum != 5000 || tx.Buckets[0] != 0 || tx.Buckets[1] != 0 {
+		t.Fatalf("Invalid TX histogram")
+	}
+	if !cache.last.Equal(end) {
+		t.Fatalf("Invalid last update time: %s", cache.last)
+	}
+
+	fmt.Println("No window before lag elapses")
+	if _, ok = cache.nextWindowEnd(now.Add(-time.Second)); ok {
+		t.Fatalf("Window should be empty")
+	}
+
+	fmt.Println("Accumulate new durations")
+	now = now.Add(time.Second)
+	end, ok = cache.nextWindowEnd(now)
+	if !ok {
+		t.Fatalf("Window should not be empty")
+	}
+	cache.update([]AggregateSeries{
+		{Tags: rxTags, Metrics: []AggregateMetric{point("2020-01-01T00:00:05Z", 100), {Time: "2020-01-01T00:00:06Z", Values: map[string]float64{}}}},
+	}, end)
+	if rx.Count != 3 || rx.Sum != 650 || rx.Buckets[0] != 2 || rx.Buckets[1] != 3 {
+		t.Fatalf("Invalid RX histogram")
+	}
+	if tx.Count != 1 || len(cache.histograms) != 2 {
+		t.Fatalf("Invalid TX histogram")
+	}
+	if cache.last.Format(time.RFC3339Nano) != "2020-01-01T00:00:06Z" {
+		t.Fatalf("Invalid last update time: %s", cache.last)
+	}
 }
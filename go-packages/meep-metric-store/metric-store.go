@@ -18,8 +18,9 @@ package metricstore
 
 import (
 	"errors"
-	"strconv"
+	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	dkm "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-data-key-mgr"
@@ -38,6 +39,13 @@ const dbMaxRetryCount = 2
 const metricsDb = 0
 const metricsKey = "metric-store:"
 
+var errNoResults = errors.New("Query returned no results")
+
+// IsNoResultsError - Check if error was caused by a query matching no stored metrics
+func IsNoResultsError(err error) bool {
+	return err == errNoResults
+}
+
 type Metric struct {
 	Name   string
 	Tags   map[string]string
@@ -54,6 +62,8 @@ type MetricStore struct {
 	influxClient   *influx.Client
 	redisClient    *redis.Connector
 	snapshotTicker *time.Ticker
+	httpHistMutex  sync.Mutex
+	httpHist       *httpHistogramCache
 }
 
 // NewMetricStore - Creates and initialize a Metric Store instance
@@ -132,9 +142,7 @@ func (ms *MetricStore) SetStore(name string) error {
 	var storeName string
 
 	if name != "" {
-		// Create store name using format: '<namespace>_<name>'
-		// Replace dashes with underscores
-		storeName = strings.Replace(ms.namespace+"_"+name, "-", "_", -1)
+		storeName = ms.getStoreName(name)
 
 		// Create new DB if necessary
 		q := influx.NewQuery("CREATE DATABASE "+storeName, "", "")
@@ -167,6 +175,11 @@ func (ms *MetricStore) Flush() {
 
 	// Flush Redis DB
 	ms.redisClient.DBFlush(ms.baseKey + NetMetName)
+
+	// Reset cached histograms
+	ms.httpHistMutex.Lock()
+	ms.httpHist = nil
+	ms.httpHistMutex.Unlock()
 }
 
 // Copy
@@ -178,10 +191,8 @@ func (ms *MetricStore) Copy(src string, dst string) error {
 		return err
 	}
 
-	// Create store name using format: '<namespace>_<name>'
-	// Replace dashes with underscores
-	srcStoreName := strings.Replace(ms.namespace+"_"+src, "-", "_", -1)
-	dstStoreName := strings.Replace(ms.namespace+"_"+dst, "-", "_", -1)
+	srcStoreName := ms.getStoreName(src)
+	dstStoreName := ms.getStoreName(dst)
 
 	// Flush destination DB, if any
 	q := influx.NewQuery("DROP SERIES FROM /.*/", dstStoreName, "")
@@ -206,9 +217,54 @@ func (ms *MetricStore) Copy(src string, dst string) error {
 	}
 	log.Info(response.Results)
 
+	// Replace destination run info with source run info
+	err = ms.copyRunInfo(srcStoreName, dstStoreName)
+	if err != nil {
+		log.Error("Failed to copy run info with error: ", err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// DeleteStore - Remove store database & run information
+func (ms *MetricStore) DeleteStore(name string) error {
+	// Validate input params
+	if name == "" {
+		err := errors.New("Invalid params: " + name)
+		log.Error("Error: ", err.Error())
+		return err
+	}
+	storeName := ms.getStoreName(name)
+
+	// Drop Influx DB
+	q := influx.NewQuery("DROP DATABASE "+storeName, "", "")
+	_, err := (*ms.influxClient).Query(q)
+	if err != nil {
+		log.Error("Query failed with error: ", err.Error())
+		return err
+	}
+
+	// Remove run info
+	err = ms.redisClient.DelEntry(ms.baseKey + runInfoKey + storeName)
+	if err != nil {
+		log.Error("Failed to delete run info with error: ", err.Error())
+		return err
+	}
+
+	// Release active store
+	if ms.name == storeName {
+		ms.name = ""
+	}
 	return nil
 }
 
+// getStoreName - Create store name using format: '<namespace>_<name>'
+// Dashes are replaced with underscores
+func (ms *MetricStore) getStoreName(name string) string {
+	return strings.Replace(ms.namespace+"_"+name, "-", "_", -1)
+}
+
 // SetInfluxMetric - Generic metric setter
 func (ms *MetricStore) SetInfluxMetric(metricList []Metric) error {
 	// Make sure we have set a store
@@ -249,66 +305,31 @@ func (ms *MetricStore) GetInfluxMetric(metric string, tags map[string]string, fi
 	}
 
 	// Create query
-
-	// Fields
-	fieldStr := ""
-	for _, field := range fields {
-		if fieldStr == "" {
-			fieldStr = field
-		} else {
-			fieldStr += "," + field
-		}
-	}
-	if fieldStr == "" {
-		fieldStr = "*"
-	}
-
-	// Tags
-	tagStr := ""
-	for k, v := range tags {
-		mv := strings.Split(v, ",")
-
-		if tagStr == "" {
-			tagStr = " WHERE (" // + k + "='" + v + "'"
-		} else {
-			tagStr += " AND (" //+ k + "='" + v + "'"
-		}
-		for i, v := range mv {
-			if i != 0 {
-				tagStr += " OR "
-			}
-			tagStr += k + "='" + v + "'"
-		}
-		tagStr += ")"
-	}
-	if duration != "" {
-		if tagStr == "" {
-			tagStr = " WHERE time > now() - " + duration
-		} else {
-			tagStr += " AND time > now() - " + duration
-		}
-	}
-
-	// Count
-	countStr := ""
-	if count != 0 {
-		countStr = " LIMIT " + strconv.Itoa(count)
+	q := NewInfluxQuery(metric).Select(fields...)
+	addInfluxTagConditions(q, tags)
+	q.WhereTimeWithin(duration).OrderDesc().Limit(count)
+	query, params, err := q.Build()
+	if err != nil {
+		log.Error("Failed to build query: ", err.Error())
+		return values, err
 	}
-
-	query := "SELECT " + fieldStr + " FROM " + metric + " " + tagStr + " ORDER BY desc" + countStr
-	log.Debug("QUERY: ", query)
+	log.Debug("QUERY: ", query, " PARAMS: ", params)
 
 	// Query store for metric
-	q := influx.NewQuery(query, ms.name, "")
-	response, err := (*ms.influxClient).Query(q)
+	response, err := (*ms.influxClient).Query(influx.NewQueryWithParameters(query, ms.name, "", params))
 	if err != nil {
 		log.Error("Query failed with error: ", err.Error())
 		return values, err
 	}
+	if response.Error() != nil {
+		err = response.Error()
+		log.Error("Query failed with error: ", err.Error())
+		return values, err
+	}
 
 	// Process response
 	if len(response.Results) <= 0 || len(response.Results[0].Series) <= 0 {
-		err = errors.New("Query returned no results")
+		err = errNoResults
 		log.Error("Query failed with error: ", err.Error())
 		return values, err
 	}
@@ -326,6 +347,19 @@ func (ms *MetricStore) GetInfluxMetric(metric string, tags map[string]string, fi
 	return values, nil
 }
 
+// addInfluxTagConditions - Add tag match conditions to query; comma-separated tag values match any value
+func addInfluxTagConditions(q *InfluxQuery, tags map[string]string) {
+	// Sort tag names for deterministic queries
+	names := make([]string, 0, len(tags))
+	for name := range tags {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		q.WhereTag(name, strings.Split(tags[name], ",")...)
+	}
+}
+
 // SetRedisMetric - Generic metric setter
 func (ms *MetricStore) SetRedisMetric(metric string, tagStr string, fields map[string]interface{}) (err error) {
 	// Make sure we have set a store
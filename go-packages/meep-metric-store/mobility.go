@@ -0,0 +1,147 @@
+/*
+ * Copyright (c) 2020  InterDigital Communications, Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package metricstore
+
+import (
+	"encoding/json"
+	"errors"
+
+	log "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-logger"
+)
+
+const MgMetName = "mobility"
+const MgMetGroup = "mg_name"
+const MgMetMode = "mode"
+const MgMetType = "type"
+const MgMetTime = "time"
+const MgMetUeId = "ue_id"
+const MgMetSrcApp = "src_app"
+const MgMetDstApp = "dst_app"
+const MgMetResult = "result"
+const MgMetDuration = "duration" // State transfer duration (ms)
+
+// Mobility group metric types
+const MgMetTypeStateUpdate = "STATE-UPDATE"
+const MgMetTypeStateTransfer = "STATE-TRANSFER"
+const MgMetTypeHandoff = "HANDOFF"
+
+// Mobility group metric results
+const MgMetResultComplete = "COMPLETE"
+const MgMetResultCancel = "CANCEL"
+const MgMetResultTimeout = "TIMEOUT"
+const MgMetResultError = "ERROR"
+
+// MobilityMetric - Mobility group state transfer timing
+type MobilityMetric struct {
+	MgName   string
+	Mode     string
+	Type     string
+	UeId     string
+	SrcApp   string
+	DstApp   string
+	Result   string
+	Duration int32
+	Time     interface{}
+}
+
+// SetMobilityMetric
+func (ms *MetricStore) SetMobilityMetric(m MobilityMetric) error {
+	metricList := make([]Metric, 1)
+	metric := &metricList[0]
+	metric.Name = MgMetName
+	metric.Tags = map[string]string{MgMetGroup: m.MgName, MgMetMode: m.Mode, MgMetType: m.Type}
+	metric.Fields = map[string]interface{}{
+		MgMetUeId:     m.UeId,
+		MgMetSrcApp:   m.SrcApp,
+		MgMetDstApp:   m.DstApp,
+		MgMetResult:   m.Result,
+		MgMetDuration: m.Duration,
+	}
+	return ms.SetInfluxMetric(metricList)
+}
+
+// GetMobilityMetric
+func (ms *MetricStore) GetMobilityMetric(mgName string, metricType string, duration string, count int) (metrics []MobilityMetric, err error) {
+	// Make sure we have set a store
+	if ms.name == "" {
+		err = errors.New("Store name not specified")
+		return
+	}
+
+	// Get Mobility metrics
+	tags := map[string]string{}
+	if mgName != "" {
+		tags[MgMetGroup] = mgName
+	}
+	if metricType != "" {
+		tags[MgMetType] = metricType
+	}
+	fields := []string{MgMetGroup, MgMetMode, MgMetType, MgMetUeId, MgMetSrcApp, MgMetDstApp, MgMetResult, MgMetDuration}
+	var valuesArray []map[string]interface{}
+	valuesArray, err = ms.GetInfluxMetric(MgMetName, tags, fields, duration, count)
+	if err != nil {
+		log.Error("Failed to retrieve metrics with error: ", err.Error())
+		return
+	}
+
+	// Format mobility metrics
+	metrics = make([]MobilityMetric, len(valuesArray))
+	for index, values := range valuesArray {
+		metrics[index].Time = values[MgMetTime]
+		if val, ok := values[MgMetGroup].(string); ok {
+			metrics[index].MgName = val
+		}
+		if val, ok := values[MgMetMode].(string); ok {
+			metrics[index].Mode = val
+		}
+		if val, ok := values[MgMetType].(string); ok {
+			metrics[index].Type = val
+		}
+		if val, ok := values[MgMetUeId].(string); ok {
+			metrics[index].UeId = val
+		}
+		if val, ok := values[MgMetSrcApp].(string); ok {
+			metrics[index].SrcApp = val
+		}
+		if val, ok := values[MgMetDstApp].(string); ok {
+			metrics[index].DstApp = val
+		}
+		if val, ok := values[MgMetResult].(string); ok {
+			metrics[index].Result = val
+		}
+		if val, ok := values[MgMetDuration].(json.Number); ok {
+			metrics[index].Duration = JsonNumToInt32(val)
+		}
+	}
+	return
+}
+
+// GetMobilityMetricAggregate - Aggregate state transfer durations
+func (ms *MetricStore) GetMobilityMetricAggregate(mgName string, metricType string, duration string, agg *Aggregation) (series []AggregateSeries, err error) {
+	tags := map[string]string{}
+	if mgName != "" {
+		tags[MgMetGroup] = mgName
+	}
+	if metricType != "" {
+		tags[MgMetType] = metricType
+	}
+	series, err = ms.GetInfluxMetricAggregate(MgMetName, tags, []string{MgMetDuration}, duration, 0, agg)
+	if err != nil {
+		log.Error("Failed to retrieve metrics with error: ", err.Error())
+	}
+	return
+}
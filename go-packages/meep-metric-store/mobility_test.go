@@ -0,0 +1,84 @@
+/*
+ * Copyright (c) 2020  InterDigital Communications, Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package metricstore
+
+import (
+	"fmt"
+	"testing"
+
+	log "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-logger"
+)
+
+const mgStoreName string = "mg-store"
+const mgStoreNamespace string = "mg-ns"
+const mgStoreInfluxAddr string = "http://localhost:30986"
+const mgStoreRedisAddr string = "localhost:30380"
+
+func TestMobilityMetricsGetSet(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	fmt.Println("Create valid Metric Store")
+	ms, err := NewMetricStore(mgStoreName, mgStoreNamespace, mgStoreInfluxAddr, mgStoreRedisAddr)
+	if err != nil {
+		t.Fatalf("Unable to create Metric Store")
+	}
+
+	fmt.Println("Flush store metrics")
+	ms.Flush()
+
+	fmt.Println("Set mobility metrics")
+	err = ms.SetMobilityMetric(MobilityMetric{"mg1", "STATE-MANAGED", MgMetTypeStateUpdate, "ue1", "app1", "app2", MgMetResultComplete, 10, nil})
+	if err != nil {
+		t.Fatalf("Unable to set mobility metric")
+	}
+	err = ms.SetMobilityMetric(MobilityMetric{"mg1", "STATE-MANAGED", MgMetTypeStateTransfer, "ue1", "app1", "app2", MgMetResultComplete, 200, nil})
+	if err != nil {
+		t.Fatalf("Unable to set mobility metric")
+	}
+	err = ms.SetMobilityMetric(MobilityMetric{"mg2", "HANDOFF-MANAGED", MgMetTypeHandoff, "ue2", "app3", "app4", MgMetResultTimeout, 3000, nil})
+	if err != nil {
+		t.Fatalf("Unable to set mobility metric")
+	}
+
+	fmt.Println("Get mobility metrics")
+	_, err = ms.GetMobilityMetric("mg3", "", "", 0)
+	if err == nil {
+		t.Fatalf("No metrics should be found for mg3")
+	}
+	mml, err := ms.GetMobilityMetric("mg1", "", "", 0)
+	if err != nil || len(mml) != 2 {
+		t.Fatalf("Failed to get metric")
+	}
+	if !validateMobilityMetric(mml[0], "mg1", "STATE-MANAGED", MgMetTypeStateTransfer, "ue1", "app1", "app2", MgMetResultComplete, 200) {
+		t.Fatalf("Invalid mobility metric")
+	}
+	mml, err = ms.GetMobilityMetric("", MgMetTypeHandoff, "", 0)
+	if err != nil || len(mml) != 1 {
+		t.Fatalf("Failed to get metric")
+	}
+	if !validateMobilityMetric(mml[0], "mg2", "HANDOFF-MANAGED", MgMetTypeHandoff, "ue2", "app3", "app4", MgMetResultTimeout, 3000) {
+		t.Fatalf("Invalid mobility metric")
+	}
+
+	// t.Fatalf("DONE")
+}
+
+func validateMobilityMetric(mm MobilityMetric, mgName string, mode string, metricType string, ueId string, srcApp string, dstApp string, result string, duration int32) bool {
+	return mm.MgName == mgName && mm.Mode == mode && mm.Type == metricType && mm.UeId == ueId &&
+		mm.SrcApp == srcApp && mm.DstApp == dstApp && mm.Result == result && mm.Duration == duration
+}
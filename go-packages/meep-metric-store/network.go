@@ -104,6 +104,33 @@ func (ms *MetricStore) GetCachedNetworkMetric(src string, dst string) (metric Ne
 	return ms.formatCachedNetworkMetric(valuesArray[0])
 }
 
+// GetCachedNetworkMetrics - Get latest cached metrics for all network element pairs
+func (ms *MetricStore) GetCachedNetworkMetrics() (metrics []NetworkMetric, err error) {
+	// Make sure we have set a store
+	if ms.name == "" {
+		err = errors.New("Store name not specified")
+		return
+	}
+
+	// Get all cached network metrics
+	var valuesArray []map[string]interface{}
+	valuesArray, err = ms.GetRedisMetric(NetMetName, "*")
+	if err != nil {
+		log.Error("Failed to retrieve metrics with error: ", err.Error())
+		return
+	}
+
+	// Format network metrics
+	for _, values := range valuesArray {
+		nm, err := ms.formatCachedNetworkMetric(values)
+		if err != nil {
+			continue
+		}
+		metrics = append(metrics, nm)
+	}
+	return metrics, nil
+}
+
 // SetNetworkMetric
 func (ms *MetricStore) SetNetworkMetric(nm NetworkMetric) error {
 	metricList := make([]Metric, 1)
@@ -153,6 +180,26 @@ func (ms *MetricStore) GetNetworkMetric(src string, dst string, duration string,
 	return
 }
 
+// GetNetworkMetricAggregate
+func (ms *MetricStore) GetNetworkMetricAggregate(src string, dst string, fields []string, duration string, agg *Aggregation) (series []AggregateSeries, err error) {
+	// Get aggregated Traffic metrics; empty src or dst matches all network elements
+	tags := map[string]string{}
+	if src != "" {
+		tags[NetMetSrc] = src
+	}
+	if dst != "" {
+		tags[NetMetDst] = dst
+	}
+	if len(fields) == 0 {
+		fields = []string{NetMetLatency, NetMetULThroughput, NetMetDLThroughput, NetMetULPktLoss, NetMetDLPktLoss}
+	}
+	series, err = ms.GetInfluxMetricAggregate(NetMetName, tags, fields, duration, 0, agg)
+	if err != nil {
+		log.Error("Failed to retrieve metrics with error: ", err.Error())
+	}
+	return
+}
+
 func (ms *MetricStore) formatCachedNetworkMetric(values map[string]interface{}) (metric NetworkMetric, err error) {
 	var ok bool
 	var val interface{}
@@ -0,0 +1,238 @@
+/*
+ * Copyright (c) 2020  InterDigital Communications, Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package metricstore
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var identifierRegex = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+var durationRegex = regexp.MustCompile(`^([0-9]+(ns|u|µ|ms|s|m|h|d|w))+$`)
+
+// InvalidQueryError - Error returned when query parameters are malformed
+type InvalidQueryError struct {
+	reason string
+}
+
+func (e *InvalidQueryError) Error() string {
+	return "Invalid query: " + e.reason
+}
+
+// IsInvalidQueryError - Check if error was caused by malformed query parameters
+func IsInvalidQueryError(err error) bool {
+	_, ok := err.(*InvalidQueryError)
+	return ok
+}
+
+// ValidateIdentifier - Check that a measurement, field or tag name is a valid identifier
+func ValidateIdentifier(name string) error {
+	if !identifierRegex.MatchString(name) {
+		return &InvalidQueryError{"invalid identifier '" + name + "'"}
+	}
+	return nil
+}
+
+// ValidateDuration - Check that a duration is a valid InfluxQL duration literal (e.g. 10s, 1h30m)
+func ValidateDuration(duration string) error {
+	if !durationRegex.MatchString(duration) {
+		return &InvalidQueryError{"invalid duration '" + duration + "'"}
+	}
+	return nil
+}
+
+// InfluxQuery - InfluxQL SELECT query builder
+// Identifiers & durations are validated and quoted; tag values are passed as bound parameters.
+type InfluxQuery struct {
+	measurement string
+	selectors   []string
+	conditions  []string
+	params      map[string]interface{}
+	groupBy     []string
+	fillNone    bool
+	orderDesc   bool
+	limit       int
+	err         error
+}
+
+// NewInfluxQuery - Create a new query on the provided measurement
+func NewInfluxQuery(measurement string) *InfluxQuery {
+	q := new(InfluxQuery)
+	q.params = make(map[string]interface{})
+	q.setErr(ValidateIdentifier(measurement))
+	q.measurement = measurement
+	return q
+}
+
+func (q *InfluxQuery) setErr(err error) {
+	if q.err == nil && err != nil {
+		q.err = err
+	}
+}
+
+// Select - Select fields; all fields are selected if none are specified
+func (q *InfluxQuery) Select(fields ...string) *InfluxQuery {
+	for _, field := range fields {
+		q.setErr(ValidateIdentifier(field))
+		q.selectors = append(q.selectors, quoteIdentifier(field))
+	}
+	return q
+}
+
+// SelectAggregate - Select aggregation of a field using the provided column name
+func (q *InfluxQuery) SelectAggregate(function string, field string, column string) *InfluxQuery {
+	q.setErr(ValidateIdentifier(field))
+	q.setErr(ValidateIdentifier(column))
+	selector, err := getAggregateSelector(function, quoteIdentifier(field))
+	if err != nil {
+		q.setErr(&InvalidQueryError{err.Error()})
+		return q
+	}
+	q.selectors = append(q.selectors, selector+" AS "+quoteIdentifier(column))
+	return q
+}
+
+// WhereTag - Match any of the provided tag values
+func (q *InfluxQuery) WhereTag(tag string, values ...string) *InfluxQuery {
+	q.setErr(ValidateIdentifier(tag))
+	if len(values) == 0 {
+		return q
+	}
+	cond := "("
+	for i, value := range values {
+		if i != 0 {
+			cond += " OR "
+		}
+		param := "p" + strconv.Itoa(len(q.params))
+		q.params[param] = value
+		cond += quoteIdentifier(tag) + " = $" + param
+	}
+	q.conditions = append(q.conditions, cond+")")
+	return q
+}
+
+// WhereTimeWithin - Match points within the provided duration from now
+func (q *InfluxQuery) WhereTimeWithin(duration string) *InfluxQuery {
+	if duration == "" {
+		return q
+	}
+	q.setErr(ValidateDuration(duration))
+	q.conditions = append(q.conditions, "time > now() - "+duration)
+	return q
+}
+
+// WhereTimeRange - Match points within the provided time range; zero times leave the range open
+func (q *InfluxQuery) WhereTimeRange(start time.Time, end time.Time) *InfluxQuery {
+	if !start.IsZero() && !end.IsZero() && end.Before(start) {
+		q.setErr(&InvalidQueryError{"end time before start time"})
+		return q
+	}
+	if !start.IsZero() {
+		q.conditions = append(q.conditions, "time >= "+strconv.FormatInt(start.UnixNano(), 10))
+	}
+	if !end.IsZero() {
+		q.conditions = append(q.conditions, "time <= "+strconv.FormatInt(end.UnixNano(), 10))
+	}
+	return q
+}
+
+// WhereTimeAfter - Match points strictly after the provided time; no condition if zero
+func (q *InfluxQuery) WhereTimeAfter(start time.Time) *InfluxQuery {
+	if !start.IsZero() {
+		q.conditions = append(q.conditions, "time > "+strconv.FormatInt(start.UnixNano(), 10))
+	}
+	return q
+}
+
+// GroupByTime - Group points in time buckets of the provided interval; empty buckets are omitted
+func (q *InfluxQuery) GroupByTime(interval string) *InfluxQuery {
+	if interval == "" {
+		return q
+	}
+	q.setErr(ValidateDuration(interval))
+	q.groupBy = append(q.groupBy, "time("+interval+")")
+	q.fillNone = true
+	return q
+}
+
+// GroupByTags - Group points by tag values
+func (q *InfluxQuery) GroupByTags(tags ...string) *InfluxQuery {
+	for _, tag := range tags {
+		q.setErr(ValidateIdentifier(tag))
+		q.groupBy = append(q.groupBy, quoteIdentifier(tag))
+	}
+	return q
+}
+
+// OrderDesc - Return most recent points first
+func (q *InfluxQuery) OrderDesc() *InfluxQuery {
+	q.orderDesc = true
+	return q
+}
+
+// Limit - Limit number of returned points; no limit if 0
+func (q *InfluxQuery) Limit(limit int) *InfluxQuery {
+	if limit < 0 {
+		q.setErr(&InvalidQueryError{"invalid limit " + strconv.Itoa(limit)})
+	}
+	q.limit = limit
+	return q
+}
+
+// Build - Build query string & bound parameters
+func (q *InfluxQuery) Build() (string, map[string]interface{}, error) {
+	if q.err != nil {
+		return "", nil, q.err
+	}
+
+	selectStr := "*"
+	if len(q.selectors) != 0 {
+		selectStr = strings.Join(q.selectors, ",")
+	}
+	query := "SELECT " + selectStr + " FROM " + quoteIdentifier(q.measurement)
+	if len(q.conditions) != 0 {
+		query += " WHERE " + strings.Join(q.conditions, " AND ")
+	}
+	if len(q.groupBy) != 0 {
+		query += " GROUP BY " + strings.Join(q.groupBy, ",")
+		if q.fillNone {
+			query += " fill(none)"
+		}
+	}
+	if q.orderDesc {
+		query += " ORDER BY desc"
+	}
+	if q.limit != 0 {
+		query += " LIMIT " + strconv.Itoa(q.limit)
+	}
+	return query, q.params, nil
+}
+
+func quoteIdentifier(name string) string {
+	return "\"" + name + "\""
+}
+
+// ValidateQuery - Validate metric query parameters without running the query
+func ValidateQuery(metric string, tags map[string]string, fields []string, duration string, count int) error {
+	q := NewInfluxQuery(metric).Select(fields...)
+	addInfluxTagConditions(q, tags)
+	q.WhereTimeWithin(duration).Limit(count)
+	_, _, err := q.Build()
+	return err
+}
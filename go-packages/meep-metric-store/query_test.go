@@ -0,0 +1,130 @@
+/*
+ * Copyright (c) 2020  InterDigital Communications, Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package metricstore
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	log "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-logger"
+)
+
+func TestQueryValidation(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	fmt.Println("Validate identifiers")
+	for _, id := range []string{"lat", "logger_name", "_x1", "Dest"} {
+		if ValidateIdentifier(id) != nil {
+			t.Fatalf("Valid identifier failed: " + id)
+		}
+	}
+	for _, id := range []string{"", "1lat", "lat,ul", "lat\"", "lat FROM x", "a-b", "*"} {
+		if ValidateIdentifier(id) == nil {
+			t.Fatalf("Invalid identifier should have failed: " + id)
+		}
+	}
+
+	fmt.Println("Validate durations")
+	for _, d := range []string{"10s", "1m", "1h30m", "500ms", "2d", "1w"} {
+		if ValidateDuration(d) != nil {
+			t.Fatalf("Valid duration failed: " + d)
+		}
+	}
+	for _, d := range []string{"", "10", "s", "1x", "10s; DROP DATABASE x", "-1s", "1.5s"} {
+		if ValidateDuration(d) == nil {
+			t.Fatalf("Invalid duration should have failed: " + d)
+		}
+	}
+}
+
+func TestQueryBuild(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	fmt.Println("Build query without parameters")
+	query, params, err := NewInfluxQuery(NetMetName).Build()
+	if err != nil || query != `SELECT * FROM "network"` || len(params) != 0 {
+		t.Fatalf("Invalid query: " + query)
+	}
+
+	fmt.Println("Build query with all parameters")
+	query, params, err = NewInfluxQuery(HttpLogMetricName).
+		Select(HttpLogId, HttpUrl).
+		WhereTag(HttpLoggerName, "logger1", "logger2").
+		WhereTag(HttpLoggerDirection, "RX").
+		WhereTimeWithin("10s").
+		OrderDesc().
+		Limit(10).
+		Build()
+	expected := `SELECT "id","url" FROM "http" WHERE ("logger_name" = $p0 OR "logger_name" = $p1) AND ("direction" = $p2)` +
+		` AND time > now() - 10s ORDER BY desc LIMIT 10`
+	if err != nil || query != expected {
+		t.Fatalf("Invalid query: " + query)
+	}
+	if len(params) != 3 || params["p0"] != "logger1" || params["p1"] != "logger2" || params["p2"] != "RX" {
+		t.Fatalf("Invalid query params")
+	}
+
+	fmt.Println("Tag values are bound, not inlined")
+	query, params, err = NewInfluxQuery(NetMetName).WhereTag(NetMetSrc, "ue1' OR '1'='1").Build()
+	if err != nil || query != `SELECT * FROM "network" WHERE ("src" = $p0)` || params["p0"] != "ue1' OR '1'='1" {
+		t.Fatalf("Invalid query: " + query)
+	}
+
+	fmt.Println("Build incremental histogram query")
+	query, _, err = NewInfluxQuery(HttpLogMetricName).
+		Select(HttpProcDuration).
+		WhereTimeAfter(time.Unix(10, 5)).
+		GroupByTags(HttpLoggerName, HttpLoggerDirection).
+		Build()
+	expected = `SELECT "proc_duration" FROM "http" WHERE time > 10000000005 GROUP BY "logger_name","direction"`
+	if err != nil || query != expected {
+		t.Fatalf("Invalid query: " + query)
+	}
+	query, _, err = NewInfluxQuery(HttpLogMetricName).WhereTimeAfter(time.Time{}).Build()
+	if err != nil || query != `SELECT * FROM "http"` {
+		t.Fatalf("Invalid query: " + query)
+	}
+
+	fmt.Println("Reject malformed input")
+	_, _, err = NewInfluxQuery("network; DROP DATABASE x").Build()
+	if !IsInvalidQueryError(err) {
+		t.Fatalf("Invalid measurement should have failed")
+	}
+	_, _, err = NewInfluxQuery(NetMetName).Select("lat", "ul FROM events").Build()
+	if !IsInvalidQueryError(err) {
+		t.Fatalf("Invalid field should have failed")
+	}
+	_, _, err = NewInfluxQuery(NetMetName).WhereTag("src' OR '1'='1", "ue1").Build()
+	if !IsInvalidQueryError(err) {
+		t.Fatalf("Invalid tag should have failed")
+	}
+	_, _, err = NewInfluxQuery(NetMetName).WhereTimeWithin("1m OR time > 0").Build()
+	if !IsInvalidQueryError(err) {
+		t.Fatalf("Invalid duration should have failed")
+	}
+	_, _, err = NewInfluxQuery(NetMetName).GroupByTime("1x").Build()
+	if !IsInvalidQueryError(err) {
+		t.Fatalf("Invalid interval should have failed")
+	}
+	_, _, err = NewInfluxQuery(NetMetName).Limit(-1).Build()
+	if !IsInvalidQueryError(err) {
+		t.Fatalf("Invalid limit should have failed")
+	}
+}
@@ -29,4 +29,5 @@ type NetworkNotification struct {
 	// CallBackData if passed by the application during the associated Network Subscription operation.
 	CallbackData      string             `json:"callbackData"`
 	NetworkMetricList *NetworkMetricList `json:"networkMetricList,omitempty"`
+	Trigger           *TriggerInfo       `json:"trigger,omitempty"`
 }
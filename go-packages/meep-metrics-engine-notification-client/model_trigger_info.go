@@ -0,0 +1,37 @@
+/*
+ * Copyright (c) 2020  InterDigital Communications, Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the \"License\");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an \"AS IS\" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * AdvantEDGE Metrics Service Notification REST API
+ *
+ * This API enables the Metrics Service to post metrics measurements/events to edge applications <p>**Micro-service**<br>None <p>**Type & Usage**<br>User's Edge Applications subscribing to Metrics measurements/events must implement this API <p>**Details**<br>API details available at _your-AdvantEDGE-ip-address/api_ <p>**Note**<br>This API is not exposed by default on the AdvantEDGE platform
+ *
+ * API version: 1.0.0
+ * Contact: AdvantEDGE@InterDigital.com
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+
+package client
+
+// Trigger information for threshold or change network notifications
+type TriggerInfo struct {
+	// Monitored network metric field
+	Field string `json:"field,omitempty"`
+	// Trigger state:<br> <li>RAISED: Threshold crossed <li>CLEARED: Value back within threshold & hysteresis <li>CHANGED: Value changed by more than delta
+	State string `json:"state,omitempty"`
+	// Metric value that fired the trigger
+	Value float64 `json:"value,omitempty"`
+	// Threshold value for threshold notifications; previously notified value for change notifications
+	RefValue float64 `json:"refValue,omitempty"`
+}
@@ -33,4 +33,6 @@ type MobilityGroupEvent struct {
 	// Mobility Group UE identifier
 	UeId     string                 `json:"ueId,omitempty"`
 	AppState *MobilityGroupAppState `json:"appState,omitempty"`
+	// Mobility Group Application peers involved in the state transfer
+	Peers []MobilityGroupPeer `json:"peers,omitempty"`
 }
@@ -0,0 +1,33 @@
+/*
+ * Copyright (c) 2020  InterDigital Communications, Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the \"License\");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an \"AS IS\" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * AdvantEDGE Mobility Group Application Notification REST API
+ *
+ * This API enables the Mobility Group Service to post state transfer events to edge applications <p>**Micro-service**<br>None <p>**Type & Usage**<br>User's Edge Applications participating in a Mobility Group must implement this API <p>**Details**<br>API details available at _your-AdvantEDGE-ip-address/api_ <p>**Note**<br>This API is not exposed by default on the AdvantEDGE platform
+ *
+ * API version: 1.0.0
+ * Contact: AdvantEDGE@InterDigital.com
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+
+package client
+
+// Mobility Group Application peer
+type MobilityGroupPeer struct {
+	// Mobility Group Application Identifier
+	AppId string `json:"appId,omitempty"`
+	// Mobility Group Application URL used to reach the peer
+	Url string `json:"url,omitempty"`
+}
@@ -3,6 +3,7 @@ module github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-mg-manager-client
 go 1.12
 
 require (
+	github.com/antihax/optional v1.0.0
 	golang.org/x/net v0.0.0-20190415100556-4a65cf94b679
 	golang.org/x/oauth2 v0.0.0-20190402181905-9f3314589c9a
 )
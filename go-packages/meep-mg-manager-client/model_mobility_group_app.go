@@ -30,4 +30,6 @@ type MobilityGroupApp struct {
 	Id string `json:"id,omitempty"`
 	// Event handler url
 	Url string `json:"url,omitempty"`
+	// Measured application instance load, in percent (0-100)
+	Load int32 `json:"load,omitempty"`
 }
@@ -27,12 +27,18 @@ package model
 type MobilityGroup struct {
 	// Mobility Group name
 	Name string `json:"name,omitempty"`
-	// State Transfer mode
+	// State Transfer mode<br>STATE-DIRECT: UE state is exchanged directly between App instances<br>STATE-MANAGED: UE state is exchanged through the Mobility Group Manager<br>HANDOFF-DIRECT: UE traffic stays on the source instance until it hands off its instance state directly to the target instance, then UE traffic is switched to the target instance; App instances are not relocated<br>HANDOFF-MANAGED: same as HANDOFF-DIRECT, with the instance state forwarded to the target instance by the Mobility Group Manager<br>NONE: no state transfer
 	StateTransferMode string `json:"stateTransferMode,omitempty"`
-	// State Transfer trigger
+	// State Transfer trigger<br>NET-LOC-IN-RANGE: state transfer starts when a network location served by another instance is in range of the UE<br>NET-LOC-CHANGE: state transfer completes when the UE moves to a network location served by another instance<br>GPS-PROXIMITY: state transfer starts when the UE projected position along its path comes within the proximity distance or time-to-arrival of a POA served by another instance
 	StateTransferTrigger string `json:"stateTransferTrigger,omitempty"`
-	// Session Transfer mode
+	// GPS-PROXIMITY trigger distance along the UE path to a POA coverage area, in meters (default: 100 when omitted)
+	ProximityDistance *int32 `json:"proximityDistance,omitempty"`
+	// GPS-PROXIMITY trigger time-to-arrival to a POA coverage area at the UE velocity, in seconds (default: 10 when omitted)
+	ProximityTime *int32 `json:"proximityTime,omitempty"`
+	// Session Transfer mode<br>FORCED: established connections are immediately moved to the new instance<br>GRACEFUL: established connections remain on the previous instance until they close or the drain timeout expires; new connections use the new instance
 	SessionTransferMode string `json:"sessionTransferMode,omitempty"`
+	// Graceful session transfer drain timeout, in seconds (default: 300)
+	SessionDrainTimeout int32 `json:"sessionDrainTimeout,omitempty"`
 	// Load Balancing Algorithm
 	LoadBalancingAlgorithm string `json:"loadBalancingAlgorithm,omitempty"`
 }
@@ -0,0 +1,46 @@
+/*
+ * Copyright (c) 2020  InterDigital Communications, Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the \"License\");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an \"AS IS\" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * MEEP Mobility Group Manager Model
+ *
+ * No description provided (generated by Swagger Codegen https://github.com/swagger-api/swagger-codegen)
+ *
+ * API version: 1.0.0
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+
+package model
+
+// Mobility Group event notification delivery to an application
+type MobilityGroupEventDelivery struct {
+	// Event delivery identifier
+	Id string `json:"id,omitempty"`
+	// Mobility Group Application Identifier
+	AppId string `json:"appId,omitempty"`
+	// Mobility Group UE Identifier
+	UeId string `json:"ueId,omitempty"`
+	// Mobility Group event type
+	EventType string `json:"eventType,omitempty"`
+	// Event delivery status<br>PENDING: event queued or being retried<br>FAILED: event could not be delivered and was dropped
+	Status string `json:"status,omitempty"`
+	// Number of delivery attempts
+	Attempts int32 `json:"attempts,omitempty"`
+	// Last delivery error
+	LastError string `json:"lastError,omitempty"`
+	// Time the event was queued (RFC 3339)
+	Created string `json:"created,omitempty"`
+	// Time of the last delivery attempt (RFC 3339)
+	LastAttempt string `json:"lastAttempt,omitempty"`
+}
@@ -29,4 +29,8 @@ type MobilityGroupServiceMap struct {
 	MgSvcName string `json:"mgSvcName,omitempty"`
 	// Load balanced service instance name
 	LbSvcName string `json:"lbSvcName,omitempty"`
+	// Mobility group session transfer mode
+	SessionTransferMode string `json:"sessionTransferMode,omitempty"`
+	// Graceful session transfer drain timeout, in seconds
+	SessionDrainTimeout int32 `json:"sessionDrainTimeout,omitempty"`
 }
@@ -0,0 +1,132 @@
+/*
+ * Copyright (c) 2020  InterDigital Communications, Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+
+	dataModel "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-data-model"
+)
+
+// diffNode - Scenario node attributes compared by DiffScenarios
+type diffNode struct {
+	name    string
+	typ     string
+	parent  string
+	netChar *dataModel.NetworkCharacteristics
+}
+
+// DiffScenarios - Return the structural differences between two JSON scenarios
+// Nodes are matched by name; a node with a different parent is reported as moved.
+func DiffScenarios(from []byte, to []byte) (diff *dataModel.ScenarioDiff, err error) {
+	fromNodes, err := getDiffNodes(from)
+	if err != nil {
+		return nil, err
+	}
+	toNodes, err := getDiffNodes(to)
+	if err != nil {
+		return nil, err
+	}
+	fromMap := make(map[string]*diffNode, len(fromNodes))
+	for _, node := range fromNodes {
+		fromMap[node.name] = node
+	}
+	toMap := make(map[string]*diffNode, len(toNodes))
+	for _, node := range toNodes {
+		toMap[node.name] = node
+	}
+
+	diff = new(dataModel.ScenarioDiff)
+	for _, node := range fromNodes {
+		if _, found := toMap[node.name]; !found {
+			diff.NodesRemoved = append(diff.NodesRemoved, dataModel.ScenarioNodeDiff{Name: node.name, Type_: node.typ, Parent: node.parent})
+		}
+	}
+	for _, node := range toNodes {
+		prev, found := fromMap[node.name]
+		if !found {
+			diff.NodesAdded = append(diff.NodesAdded, dataModel.ScenarioNodeDiff{Name: node.name, Type_: node.typ, Parent: node.parent})
+			continue
+		}
+		if prev.parent != node.parent {
+			diff.NodesMoved = append(diff.NodesMoved, dataModel.ScenarioNodeDiff{Name: node.name, Type_: node.typ, Parent: node.parent, PreviousParent: prev.parent})
+		}
+		if fields := diffNetChar(prev.netChar, node.netChar); len(fields) != 0 {
+			diff.NetCharChanges = append(diff.NetCharChanges, dataModel.ScenarioNetCharDiff{
+				Name:     node.name,
+				Type_:    node.typ,
+				Fields:   fields,
+				Previous: prev.netChar,
+				Current:  node.netChar,
+			})
+		}
+	}
+	return diff, nil
+}
+
+// getDiffNodes - Return scenario nodes in scenario order
+func getDiffNodes(jsonScenario []byte) (nodes []*diffNode, err error) {
+	scenario := new(dataModel.Scenario)
+	err = json.Unmarshal(jsonScenario, scenario)
+	if err != nil {
+		return nil, err
+	}
+	if scenario.Deployment == nil {
+		return nodes, nil
+	}
+
+	deployment := scenario.Deployment
+	nodes = append(nodes, &diffNode{scenario.Name, "DEPLOYMENT", "", deployment.NetChar})
+	for _, domain := range deployment.Domains {
+		nodes = append(nodes, &diffNode{domain.Name, domain.Type_, scenario.Name, domain.NetChar})
+		for _, zone := range domain.Zones {
+			nodes = append(nodes, &diffNode{zone.Name, zone.Type_, domain.Name, zone.NetChar})
+			for _, nl := range zone.NetworkLocations {
+				nodes = append(nodes, &diffNode{nl.Name, nl.Type_, zone.Name, nl.NetChar})
+				for _, pl := range nl.PhysicalLocations {
+					nodes = append(nodes, &diffNode{pl.Name, pl.Type_, nl.Name, pl.NetChar})
+					for _, proc := range pl.Processes {
+						nodes = append(nodes, &diffNode{proc.Name, proc.Type_, pl.Name, proc.NetChar})
+					}
+				}
+			}
+		}
+	}
+	return nodes, nil
+}
+
+// diffNetChar - Return the JSON names of the network characteristics fields that differ
+func diffNetChar(prev *dataModel.NetworkCharacteristics, cur *dataModel.NetworkCharacteristics) (fields []string) {
+	var empty dataModel.NetworkCharacteristics
+	if prev == nil {
+		prev = &empty
+	}
+	if cur == nil {
+		cur = &empty
+	}
+	prevValue := reflect.ValueOf(*prev)
+	curValue := reflect.ValueOf(*cur)
+	for i := 0; i < prevValue.NumField(); i++ {
+		if prevValue.Field(i).Interface() != curValue.Field(i).Interface() {
+			name := strings.Split(prevValue.Type().Field(i).Tag.Get("json"), ",")[0]
+			fields = append(fields, name)
+		}
+	}
+	return fields
+}
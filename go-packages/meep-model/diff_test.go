@@ -0,0 +1,148 @@
+/*
+ * Copyright (c) 2020  InterDigital Communications, Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	dataModel "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-data-model"
+	log "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-logger"
+)
+
+func TestDiffScenarios(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	fmt.Println("Identical scenarios")
+	diff, err := DiffScenarios([]byte(testScenario), []byte(testScenario))
+	if err != nil {
+		t.Fatalf("Failed to diff scenarios")
+	}
+	if len(diff.NodesAdded) != 0 || len(diff.NodesRemoved) != 0 || len(diff.NodesMoved) != 0 || len(diff.NetCharChanges) != 0 {
+		t.Fatalf("Identical scenarios should not differ")
+	}
+
+	fmt.Println("Modify scenario")
+	var scenario dataModel.Scenario
+	err = json.Unmarshal([]byte(testScenario), &scenario)
+	if err != nil {
+		t.Fatalf("Failed to unmarshal scenario")
+	}
+	zone1 := findZone(&scenario, "zone1")
+	if zone1 == nil {
+		t.Fatalf("Failed to find zone1")
+	}
+	// Move ue1 from zone1-poa1 to zone1-poa2
+	var ue1 dataModel.PhysicalLocation
+	for iNL := range zone1.NetworkLocations {
+		nl := &zone1.NetworkLocations[iNL]
+		for iPL, pl := range nl.PhysicalLocations {
+			if pl.Name == "ue1" {
+				ue1 = pl
+				nl.PhysicalLocations = append(nl.PhysicalLocations[:iPL], nl.PhysicalLocations[iPL+1:]...)
+				break
+			}
+		}
+	}
+	for iNL := range zone1.NetworkLocations {
+		nl := &zone1.NetworkLocations[iNL]
+		if nl.Name == "zone1-poa2" {
+			nl.PhysicalLocations = append(nl.PhysicalLocations, ue1)
+		}
+	}
+	// Add ue3 to zone1-poa2
+	for iNL := range zone1.NetworkLocations {
+		nl := &zone1.NetworkLocations[iNL]
+		if nl.Name == "zone1-poa2" {
+			nl.PhysicalLocations = append(nl.PhysicalLocations, dataModel.PhysicalLocation{Name: "ue3", Type_: "UE"})
+		}
+	}
+	// Remove zone2
+	domain := &scenario.Deployment.Domains[1]
+	for iZone, zone := range domain.Zones {
+		if zone.Name == "zone2" {
+			domain.Zones = append(domain.Zones[:iZone], domain.Zones[iZone+1:]...)
+			break
+		}
+	}
+	// Update zone1 latency
+	zone1 = findZone(&scenario, "zone1")
+	prevLatency := zone1.NetChar.Latency
+	zone1.NetChar.Latency = prevLatency + 10
+	updated, err := json.Marshal(scenario)
+	if err != nil {
+		t.Fatalf("Failed to marshal scenario")
+	}
+
+	fmt.Println("Diff modified scenario")
+	diff, err = DiffScenarios([]byte(testScenario), updated)
+	if err != nil {
+		t.Fatalf("Failed to diff scenarios")
+	}
+	if len(diff.NodesAdded) != 1 || diff.NodesAdded[0].Name != "ue3" || diff.NodesAdded[0].Parent != "zone1-poa2" {
+		t.Fatalf("Invalid added nodes: %+v", diff.NodesAdded)
+	}
+	if len(diff.NodesMoved) != 1 || diff.NodesMoved[0].Name != "ue1" ||
+		diff.NodesMoved[0].Parent != "zone1-poa2" || diff.NodesMoved[0].PreviousParent != "zone1-poa1" {
+		t.Fatalf("Invalid moved nodes: %+v", diff.NodesMoved)
+	}
+	if len(diff.NodesRemoved) == 0 || diff.NodesRemoved[0].Name != "zone2" || diff.NodesRemoved[0].Parent != "operator1" {
+		t.Fatalf("Invalid removed nodes: %+v", diff.NodesRemoved)
+	}
+	for _, node := range diff.NodesRemoved {
+		if node.Name == "ue1" {
+			t.Fatalf("Moved node should not be removed")
+		}
+	}
+	if len(diff.NetCharChanges) != 1 || diff.NetCharChanges[0].Name != "zone1" ||
+		len(diff.NetCharChanges[0].Fields) != 1 || diff.NetCharChanges[0].Fields[0] != "latency" ||
+		diff.NetCharChanges[0].Previous.Latency != prevLatency || diff.NetCharChanges[0].Current.Latency != prevLatency+10 {
+		t.Fatalf("Invalid netChar changes: %+v", diff.NetCharChanges)
+	}
+
+	fmt.Println("Reverse diff")
+	diff, err = DiffScenarios(updated, []byte(testScenario))
+	if err != nil {
+		t.Fatalf("Failed to diff scenarios")
+	}
+	if len(diff.NodesRemoved) != 1 || diff.NodesRemoved[0].Name != "ue3" {
+		t.Fatalf("Invalid removed nodes: %+v", diff.NodesRemoved)
+	}
+	if len(diff.NodesMoved) != 1 || diff.NodesMoved[0].PreviousParent != "zone1-poa2" {
+		t.Fatalf("Invalid moved nodes: %+v", diff.NodesMoved)
+	}
+
+	fmt.Println("Invalid scenario")
+	_, err = DiffScenarios([]byte("{"), []byte(testScenario))
+	if err == nil {
+		t.Fatalf("Invalid scenario should fail")
+	}
+}
+
+func findZone(scenario *dataModel.Scenario, name string) *dataModel.Zone {
+	for iDomain := range scenario.Deployment.Domains {
+		domain := &scenario.Deployment.Domains[iDomain]
+		for iZone := range domain.Zones {
+			if domain.Zones[iZone].Name == name {
+				return &domain.Zones[iZone]
+			}
+		}
+	}
+	return nil
+}
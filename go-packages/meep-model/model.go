@@ -190,6 +190,33 @@ func JSONMarshallReplay(replay []byte) (rStr string, err error) {
 	return string(json), nil
 }
 
+// GetScenarioPodCount - Return the number of pods deployed for the provided scenario
+// Each non-external process is deployed in its own pod.
+func GetScenarioPodCount(scenario []byte) (count int, err error) {
+	var s dataModel.Scenario
+	err = json.Unmarshal(scenario, &s)
+	if err != nil {
+		return 0, err
+	}
+	if s.Deployment == nil {
+		return 0, nil
+	}
+	for _, domain := range s.Deployment.Domains {
+		for _, zone := range domain.Zones {
+			for _, nl := range zone.NetworkLocations {
+				for _, pl := range nl.PhysicalLocations {
+					for _, proc := range pl.Processes {
+						if !proc.IsExternal {
+							count++
+						}
+					}
+				}
+			}
+		}
+	}
+	return count, nil
+}
+
 // SetScenario - Initialize model from JSON string
 func (m *Model) SetScenario(j []byte) (err error) {
 	m.lock.Lock()
@@ -635,6 +662,14 @@ func (m *Model) GetNetworkGraph() *dijkstra.Graph {
 	return m.networkGraph.graph
 }
 
+// GetLatencyGraph - Get the network graph weighted by configured latency
+func (m *Model) GetLatencyGraph() *dijkstra.Graph {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	return m.networkGraph.latencyGraph
+}
+
 //---Internal Funcs---
 
 func (m *Model) parseNodes() (err error) {
@@ -655,35 +690,35 @@ func (m *Model) parseNodes() (err error) {
 				domain := &m.scenario.Deployment.Domains[iDomain]
 				ctx := NewNodeContext(m.scenario.Name, domain.Name, "", "", "")
 				m.nodeMap.AddNode(NewNode(domain.Name, domain.Type_, domain, &domain.Zones, m.scenario.Deployment, ctx))
-				m.networkGraph.AddNode(domain.Name, "", false)
+				m.networkGraph.AddNode(domain.Name, "", false, domain.NetChar)
 
 				// Zones
 				for iZone := range domain.Zones {
 					zone := &domain.Zones[iZone]
 					ctx := NewNodeContext(m.scenario.Name, domain.Name, zone.Name, "", "")
 					m.nodeMap.AddNode(NewNode(zone.Name, zone.Type_, zone, &zone.NetworkLocations, domain, ctx))
-					m.networkGraph.AddNode(zone.Name, domain.Name, isDefaultZone(zone.Type_))
+					m.networkGraph.AddNode(zone.Name, domain.Name, isDefaultZone(zone.Type_), zone.NetChar)
 
 					// Network Locations
 					for iNL := range zone.NetworkLocations {
 						nl := &zone.NetworkLocations[iNL]
 						ctx := NewNodeContext(m.scenario.Name, domain.Name, zone.Name, nl.Name, "")
 						m.nodeMap.AddNode(NewNode(nl.Name, nl.Type_, nl, &nl.PhysicalLocations, zone, ctx))
-						m.networkGraph.AddNode(nl.Name, zone.Name, isDefaultNetLoc(nl.Type_))
+						m.networkGraph.AddNode(nl.Name, zone.Name, isDefaultNetLoc(nl.Type_), nl.NetChar)
 
 						// Physical Locations
 						for iPL := range nl.PhysicalLocations {
 							pl := &nl.PhysicalLocations[iPL]
 							ctx := NewNodeContext(m.scenario.Name, domain.Name, zone.Name, nl.Name, pl.Name)
 							m.nodeMap.AddNode(NewNode(pl.Name, pl.Type_, pl, &pl.Processes, nl, ctx))
-							m.networkGraph.AddNode(pl.Name, nl.Name, false)
+							m.networkGraph.AddNode(pl.Name, nl.Name, false, pl.NetChar)
 
 							// Processes
 							for iProc := range pl.Processes {
 								proc := &pl.Processes[iProc]
 								ctx := NewNodeContext(m.scenario.Name, domain.Name, zone.Name, nl.Name, pl.Name)
 								m.nodeMap.AddNode(NewNode(proc.Name, proc.Type_, proc, nil, pl, ctx))
-								m.networkGraph.AddNode(proc.Name, pl.Name, false)
+								m.networkGraph.AddNode(proc.Name, pl.Name, false, proc.NetChar)
 
 								// Update service map for external processes
 								if proc.IsExternal {
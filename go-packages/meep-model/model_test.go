@@ -960,6 +960,10 @@ func TestGetters(t *testing.T) {
 	if len(graph.Verticies) != 29 {
 		t.Fatalf("Invalid Network Graph")
 	}
+	latencyGraph := m.GetLatencyGraph()
+	if len(latencyGraph.Verticies) != 29 {
+		t.Fatalf("Invalid Latency Graph")
+	}
 }
 
 func TestScenarioUpdate(t *testing.T) {
@@ -1196,3 +1200,24 @@ func TestValidateScenario(t *testing.T) {
 		t.Fatalf("validJsonScenario != testScenario_v1_5_0")
 	}
 }
+
+func TestGetScenarioPodCount(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	fmt.Println("Count scenario pods")
+	count, err := GetScenarioPodCount([]byte(testScenario))
+	if err != nil {
+		t.Fatalf("Failed to count pods")
+	}
+	// External processes are not deployed
+	if count != 9 {
+		t.Fatalf("Invalid pod count: %d", count)
+	}
+
+	fmt.Println("Invalid scenario")
+	_, err = GetScenarioPodCount([]byte("{"))
+	if err == nil {
+		t.Fatalf("Invalid scenario should fail")
+	}
+}
@@ -16,22 +16,31 @@
 
 package model
 
-import "github.com/RyanCarrier/dijkstra"
+import (
+	dataModel "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-data-model"
+
+	"github.com/RyanCarrier/dijkstra"
+)
 
 // NodeContext
 type NetworkGraph struct {
-	graph *dijkstra.Graph
+	graph        *dijkstra.Graph
+	latencyGraph *dijkstra.Graph
 }
 
 // NewNodeContext - allocate an empty NodeGraph
 func NewNetworkGraph() (ng *NetworkGraph) {
 	ng = new(NetworkGraph)
 	ng.graph = dijkstra.NewGraph()
+	ng.latencyGraph = dijkstra.NewGraph()
 	return ng
 }
 
-func (ng *NetworkGraph) AddNode(node string, parent string, zeroDist bool) {
+// AddNode - Add node to hop count & latency graphs
+// NOTE: Latency graph arcs are weighted using the latency (ms) configured on the child node
+func (ng *NetworkGraph) AddNode(node string, parent string, zeroDist bool, nc *dataModel.NetworkCharacteristics) {
 	ng.graph.AddMappedVertex(node)
+	ng.latencyGraph.AddMappedVertex(node)
 	if parent != "" {
 		var distance int64 = 0
 		if !zeroDist {
@@ -39,5 +48,12 @@ func (ng *NetworkGraph) AddNode(node string, parent string, zeroDist bool) {
 		}
 		_ = ng.graph.AddMappedArc(parent, node, distance)
 		_ = ng.graph.AddMappedArc(node, parent, distance)
+
+		var latency int64 = 0
+		if nc != nil && nc.Latency > 0 {
+			latency = int64(nc.Latency)
+		}
+		_ = ng.latencyGraph.AddMappedArc(parent, node, latency)
+		_ = ng.latencyGraph.AddMappedArc(node, parent, latency)
 	}
 }
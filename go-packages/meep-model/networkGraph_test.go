@@ -0,0 +1,82 @@
+/*
+ * Copyright (c) 2020  InterDigital Communications, Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"fmt"
+	"testing"
+
+	dataModel "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-data-model"
+	log "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-logger"
+
+	"github.com/RyanCarrier/dijkstra"
+)
+
+func TestNetworkGraph(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	fmt.Println("Create network graph")
+	ng := NewNetworkGraph()
+	ng.AddNode("zone1", "", false, &dataModel.NetworkCharacteristics{Latency: 100})
+	ng.AddNode("zone1-default", "zone1", true, nil)
+	ng.AddNode("zone1-edge1", "zone1-default", false, &dataModel.NetworkCharacteristics{Latency: 2})
+	ng.AddNode("zone1-poa1", "zone1", false, &dataModel.NetworkCharacteristics{Latency: 5})
+	ng.AddNode("zone1-poa2", "zone1", false, &dataModel.NetworkCharacteristics{Latency: 20})
+	ng.AddNode("zone1-fog1", "zone1-poa2", false, &dataModel.NetworkCharacteristics{Latency: 1})
+	ng.AddNode("isolated", "", false, nil)
+
+	fmt.Println("Validate hop count distances")
+	if getDistance(t, ng.graph, "zone1-poa1", "zone1-edge1") != 2 {
+		t.Fatalf("Invalid hop count to edge")
+	}
+	if getDistance(t, ng.graph, "zone1-poa1", "zone1-fog1") != 3 {
+		t.Fatalf("Invalid hop count to fog")
+	}
+
+	fmt.Println("Validate latency distances")
+	if getDistance(t, ng.latencyGraph, "zone1-poa1", "zone1-edge1") != 7 {
+		t.Fatalf("Invalid latency to edge")
+	}
+	if getDistance(t, ng.latencyGraph, "zone1-poa1", "zone1-fog1") != 26 {
+		t.Fatalf("Invalid latency to fog")
+	}
+
+	fmt.Println("Validate unreachable node")
+	src, _ := ng.latencyGraph.GetMapping("zone1-poa1")
+	dst, _ := ng.latencyGraph.GetMapping("isolated")
+	_, err := ng.latencyGraph.Shortest(src, dst)
+	if err == nil {
+		t.Fatalf("Isolated node should be unreachable")
+	}
+}
+
+func getDistance(t *testing.T, graph *dijkstra.Graph, srcName string, dstName string) int64 {
+	src, err := graph.GetMapping(srcName)
+	if err != nil {
+		t.Fatalf("Missing vertex: " + srcName)
+	}
+	dst, err := graph.GetMapping(dstName)
+	if err != nil {
+		t.Fatalf("Missing vertex: " + dstName)
+	}
+	path, err := graph.Shortest(src, dst)
+	if err != nil {
+		t.Fatalf("No path from " + srcName + " to " + dstName)
+	}
+	return path.Distance
+}
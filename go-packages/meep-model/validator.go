@@ -19,6 +19,8 @@ package model
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
+	"strings"
 
 	dataModel "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-data-model"
 	log "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-logger"
@@ -44,6 +46,38 @@ var Version150 = semver.Version{Major: 1, Minor: 5, Patch: 0}
 // Default latency distribution
 const DEFAULT_LATENCY_DISTRIBUTION = "Normal"
 
+// Supported packet loss models
+var packetLossModels = []string{"Random", "Gilbert-Elliott"}
+
+// Supported parent node types per process type
+var procParentTypes = map[string][]string{
+	NodeTypeUEApp:    {NodeTypeUE},
+	NodeTypeEdgeApp:  {NodeTypeEdge, NodeTypeFog},
+	NodeTypeCloudApp: {NodeTypeCloud},
+}
+
+// ValidationError - Scenario validation error
+// Path is the JSON path of the invalid scenario element, e.g. $.deployment.domains[0].name
+type ValidationError struct {
+	Path    string
+	Message string
+}
+
+func (e ValidationError) Error() string {
+	return e.Path + ": " + e.Message
+}
+
+// ValidationErrors - List of scenario validation errors
+type ValidationErrors []ValidationError
+
+func (errs ValidationErrors) Error() string {
+	msgs := make([]string, len(errs))
+	for i, e := range errs {
+		msgs[i] = e.Error()
+	}
+	return "Invalid scenario: " + strings.Join(msgs, "; ")
+}
+
 // setNetChar - Creates a new netchar object if non-existent and migrate values from deprecated fields
 func createNetChar(lat int32, latVar int32, dist string, tputDl int32, tputUl int32, loss float64) *dataModel.NetworkCharacteristics {
 	nc := new(dataModel.NetworkCharacteristics)
@@ -57,6 +91,7 @@ func createNetChar(lat int32, latVar int32, dist string, tputDl int32, tputUl in
 }
 
 // ValidateScenario - Verify if json scenario is valid & supported. Upgrade scenario if possible & necessary.
+// Returns ValidationErrors if the scenario structure is invalid.
 func ValidateScenario(jsonScenario []byte) (validJsonScenario []byte, status string, err error) {
 	var scenarioVersion semver.Version
 
@@ -86,8 +121,12 @@ func ValidateScenario(jsonScenario []byte) (validJsonScenario []byte, status str
 			return nil, ValidatorStatusError, err
 		}
 
-		// Skip validation if already current version
+		// Skip upgrade if already current version
 		if scenarioVersion.EQ(ValidatorVersion) {
+			errs := checkScenario(scenario)
+			if len(errs) != 0 {
+				return nil, ValidatorStatusError, errs
+			}
 			return jsonScenario, ValidatorStatusValid, nil
 		}
 	}
@@ -108,6 +147,12 @@ func ValidateScenario(jsonScenario []byte) (validJsonScenario []byte, status str
 	// Set current scenario version
 	scenario.Version = ValidatorVersion.String()
 
+	// Validate upgraded scenario
+	errs := checkScenario(scenario)
+	if len(errs) != 0 {
+		return nil, ValidatorStatusError, errs
+	}
+
 	// Marshal updated scenario
 	validJsonScenario, err = json.Marshal(scenario)
 	if err != nil {
@@ -300,3 +345,197 @@ func validatePL(pl *dataModel.PhysicalLocation) error {
 	}
 	return nil
 }
+
+// scenarioChecker - Scenario structure validation state
+type scenarioChecker struct {
+	errs      ValidationErrors
+	nodePaths map[string]string
+	poaNames  map[string]bool
+}
+
+// checkScenario - Verify scenario structure
+// Returns the list of errors found, each with the JSON path of the invalid element.
+func checkScenario(scenario *dataModel.Scenario) ValidationErrors {
+	c := &scenarioChecker{
+		nodePaths: make(map[string]string),
+		poaNames:  make(map[string]bool),
+	}
+	if scenario.Name != "" {
+		c.nodePaths[scenario.Name] = "$.name"
+	}
+	if scenario.Deployment == nil {
+		return c.errs
+	}
+	deploy := scenario.Deployment
+	c.checkNetChar("$.deployment.netChar", deploy.NetChar)
+
+	// Retrieve POA names referenced by physical locations
+	for _, domain := range deploy.Domains {
+		for _, zone := range domain.Zones {
+			for _, nl := range zone.NetworkLocations {
+				if nl.Type_ == NodeTypePoa || nl.Type_ == NodeTypePoaCell {
+					c.poaNames[nl.Name] = true
+				}
+			}
+		}
+	}
+
+	for iDomain := range deploy.Domains {
+		domain := &deploy.Domains[iDomain]
+		domainPath := fmt.Sprintf("$.deployment.domains[%d]", iDomain)
+		c.checkName(domainPath, domain.Name)
+		c.checkNetChar(domainPath+".netChar", domain.NetChar)
+
+		for iZone := range domain.Zones {
+			zone := &domain.Zones[iZone]
+			zonePath := fmt.Sprintf("%s.zones[%d]", domainPath, iZone)
+			c.checkName(zonePath, zone.Name)
+			c.checkNetChar(zonePath+".netChar", zone.NetChar)
+
+			for iNL := range zone.NetworkLocations {
+				nl := &zone.NetworkLocations[iNL]
+				nlPath := fmt.Sprintf("%s.networkLocations[%d]", zonePath, iNL)
+				c.checkName(nlPath, nl.Name)
+				c.checkNetChar(nlPath+".netChar", nl.NetChar)
+				c.checkGeoData(nlPath+".geoData", nl.GeoData)
+
+				// Cellular POAs require a cell ID, either their own or the domain default
+				if nl.Type_ == NodeTypePoaCell &&
+					(nl.CellularPoaConfig == nil || nl.CellularPoaConfig.CellId == "") &&
+					(domain.CellularDomainConfig == nil || domain.CellularDomainConfig.DefaultCellId == "") {
+					c.addError(nlPath+".cellularPoaConfig.cellId", "Missing cell ID & no default cell ID configured in domain "+domain.Name)
+				}
+
+				for iPL := range nl.PhysicalLocations {
+					pl := &nl.PhysicalLocations[iPL]
+					plPath := fmt.Sprintf("%s.physicalLocations[%d]", nlPath, iPL)
+					c.checkName(plPath, pl.Name)
+					c.checkNetChar(plPath+".netChar", pl.NetChar)
+					c.checkGeoData(plPath+".geoData", pl.GeoData)
+
+					if pl.Type_ == NodeTypeUE && nl.Type_ != NodeTypePoa && nl.Type_ != NodeTypePoaCell {
+						c.addError(plPath+".type", "Invalid parent type "+nl.Type_+" for "+NodeTypeUE+" "+pl.Name)
+					}
+					for i, poaName := range pl.NetworkLocationsInRange {
+						if !c.poaNames[poaName] {
+							c.addError(fmt.Sprintf("%s.networkLocationsInRange[%d]", plPath, i), "POA "+poaName+" not found")
+						}
+					}
+
+					for iProc := range pl.Processes {
+						proc := &pl.Processes[iProc]
+						procPath := fmt.Sprintf("%s.processes[%d]", plPath, iProc)
+						c.checkName(procPath, proc.Name)
+						c.checkNetChar(procPath+".netChar", proc.NetChar)
+
+						if parentTypes, found := procParentTypes[proc.Type_]; found && !contains(parentTypes, pl.Type_) {
+							c.addError(procPath+".type", "Invalid parent type "+pl.Type_+" for "+proc.Type_+" "+proc.Name)
+						}
+						if !proc.IsExternal && proc.UserChartLocation == "" && proc.Image == "" {
+							c.addError(procPath+".image", "Missing image")
+						}
+					}
+				}
+			}
+		}
+	}
+	return c.errs
+}
+
+func (c *scenarioChecker) addError(path string, msg string) {
+	c.errs = append(c.errs, ValidationError{Path: path, Message: msg})
+}
+
+// checkName - Verify node name is set & unique
+func (c *scenarioChecker) checkName(path string, name string) {
+	if name == "" {
+		c.addError(path+".name", "Missing name")
+	} else if prevPath, found := c.nodePaths[name]; found {
+		c.addError(path+".name", "Duplicate name "+name+", already used at "+prevPath)
+	} else {
+		c.nodePaths[name] = path
+	}
+}
+
+// checkNetChar - Verify network characteristics values
+// Zero throughput is allowed as it means the default throughput is used.
+func (c *scenarioChecker) checkNetChar(path string, nc *dataModel.NetworkCharacteristics) {
+	if nc == nil {
+		return
+	}
+	if nc.Latency < 0 {
+		c.addError(path+".latency", fmt.Sprintf("Invalid latency %d, must not be negative", nc.Latency))
+	}
+	if nc.LatencyVariation < 0 {
+		c.addError(path+".latencyVariation", fmt.Sprintf("Invalid latency variation %d, must not be negative", nc.LatencyVariation))
+	}
+	if nc.ThroughputDl < 0 {
+		c.addError(path+".throughputDl", fmt.Sprintf("Invalid throughput %d, must not be negative", nc.ThroughputDl))
+	}
+	if nc.ThroughputUl < 0 {
+		c.addError(path+".throughputUl", fmt.Sprintf("Invalid throughput %d, must not be negative", nc.ThroughputUl))
+	}
+	if nc.PacketLossModel != "" && !contains(packetLossModels, nc.PacketLossModel) {
+		c.addError(path+".packetLossModel", "Invalid packet loss model "+nc.PacketLossModel+", must be one of: "+strings.Join(packetLossModels, ", "))
+	}
+	c.checkPercentage(path+".packetLoss", "packet loss", nc.PacketLoss)
+	c.checkPercentage(path+".packetLossCorrelation", "packet loss correlation", nc.PacketLossCorrelation)
+	c.checkPercentage(path+".burstLossGoodToBad", "burst loss good to bad transition", nc.BurstLossGoodToBad)
+	c.checkPercentage(path+".burstLossBadToGood", "burst loss bad to good transition", nc.BurstLossBadToGood)
+	c.checkPercentage(path+".burstLossBad", "burst loss in bad state", nc.BurstLossBad)
+	c.checkPercentage(path+".burstLossGood", "burst loss in good state", nc.BurstLossGood)
+	c.checkPercentage(path+".packetDuplication", "packet duplication", nc.PacketDuplication)
+	c.checkPercentage(path+".packetCorruption", "packet corruption", nc.PacketCorruption)
+	c.checkPercentage(path+".packetReordering", "packet reordering", nc.PacketReordering)
+}
+
+// checkPercentage - Verify percentage value is between 0 and 100
+func (c *scenarioChecker) checkPercentage(path string, name string, value float64) {
+	if value < 0 || value > 100 {
+		c.addError(path, fmt.Sprintf("Invalid %s %g, must be between 0 and 100", name, value))
+	}
+}
+
+// checkGeoData - Verify geographic coordinates, radius & velocity
+func (c *scenarioChecker) checkGeoData(path string, geoData *dataModel.GeoData) {
+	if geoData == nil {
+		return
+	}
+	if geoData.Location != nil {
+		c.checkCoordinates(path+".location.coordinates", geoData.Location.Coordinates)
+	}
+	if geoData.Path != nil {
+		for i, coordinates := range geoData.Path.Coordinates {
+			c.checkCoordinates(fmt.Sprintf("%s.path.coordinates[%d]", path, i), coordinates)
+		}
+	}
+	if geoData.Radius < 0 {
+		c.addError(path+".radius", fmt.Sprintf("Invalid radius %g, must not be negative", geoData.Radius))
+	}
+	if geoData.Velocity < 0 {
+		c.addError(path+".velocity", fmt.Sprintf("Invalid velocity %g, must not be negative", geoData.Velocity))
+	}
+}
+
+// checkCoordinates - Verify GeoJSON [longitude, latitude] position
+func (c *scenarioChecker) checkCoordinates(path string, coordinates []float32) {
+	if len(coordinates) < 2 {
+		c.addError(path, "Invalid position, expected [longitude, latitude]")
+		return
+	}
+	if coordinates[0] < -180 || coordinates[0] > 180 {
+		c.addError(path+"[0]", fmt.Sprintf("Invalid longitude %g, must be between -180 and 180", coordinates[0]))
+	}
+	if coordinates[1] < -90 || coordinates[1] > 90 {
+		c.addError(path+"[1]", fmt.Sprintf("Invalid latitude %g, must be between -90 and 90", coordinates[1]))
+	}
+}
+
+func contains(list []string, value string) bool {
+	for _, entry := range list {
+		if entry == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,279 @@
+/*
+ * Copyright (c) 2020  InterDigital Communications, Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	dataModel "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-data-model"
+	log "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-logger"
+)
+
+func TestValidateScenarioStructure(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	fmt.Println("Valid scenario")
+	_, status, err := ValidateScenario([]byte(testScenario_v1_5_0))
+	if err != nil || status != ValidatorStatusValid {
+		t.Fatalf("Scenario should be valid")
+	}
+
+	fmt.Println("Upgraded scenario")
+	_, status, err = ValidateScenario([]byte(testScenario_v1_4_0))
+	if err != nil || status != ValidatorStatusUpdated {
+		t.Fatalf("Scenario should be updated")
+	}
+
+	fmt.Println("Invalid JSON")
+	_, status, err = ValidateScenario([]byte("{"))
+	if err == nil || status != ValidatorStatusError {
+		t.Fatalf("Invalid JSON should fail")
+	}
+	if _, ok := err.(ValidationErrors); ok {
+		t.Fatalf("Invalid JSON should not return validation errors")
+	}
+
+	fmt.Println("Duplicate name")
+	validateInvalidScenario(t, func(s *dataModel.Scenario) string {
+		zone, path := findValidatorNode(s, "zone2")
+		zone.(*dataModel.Zone).Name = "zone1"
+		return path + ".name"
+	})
+
+	fmt.Println("Missing name")
+	validateInvalidScenario(t, func(s *dataModel.Scenario) string {
+		pl, path := findValidatorNode(s, "ue1")
+		pl.(*dataModel.PhysicalLocation).Name = ""
+		return path + ".name"
+	})
+
+	fmt.Println("Cellular POA without cell ID")
+	validateInvalidScenario(t, func(s *dataModel.Scenario) string {
+		nl, path := findValidatorNode(s, "zone1-poa1")
+		nl.(*dataModel.NetworkLocation).Type_ = NodeTypePoaCell
+		return path + ".cellularPoaConfig.cellId"
+	})
+
+	fmt.Println("Cellular POA with domain default cell ID")
+	scenario := getValidatorScenario(t)
+	nl, _ := findValidatorNode(scenario, "zone1-poa1")
+	nl.(*dataModel.NetworkLocation).Type_ = NodeTypePoaCell
+	domain, _ := findValidatorNode(scenario, "operator1")
+	domain.(*dataModel.Domain).CellularDomainConfig = &dataModel.CellularDomainConfig{DefaultCellId: "1234567"}
+	errs := validateScenarioStruct(t, scenario)
+	if len(errs) != 0 {
+		t.Fatalf("Scenario should be valid: %s", errs.Error())
+	}
+
+	fmt.Println("Negative throughput")
+	validateInvalidScenario(t, func(s *dataModel.Scenario) string {
+		zone, path := findValidatorNode(s, "zone1")
+		zone.(*dataModel.Zone).NetChar.ThroughputDl = -1
+		return path + ".netChar.throughputDl"
+	})
+
+	fmt.Println("Invalid packet loss")
+	validateInvalidScenario(t, func(s *dataModel.Scenario) string {
+		nl, path := findValidatorNode(s, "zone1-poa1")
+		nl.(*dataModel.NetworkLocation).NetChar.PacketLoss = 101
+		return path + ".netChar.packetLoss"
+	})
+
+	fmt.Println("Invalid packet loss model")
+	validateInvalidScenario(t, func(s *dataModel.Scenario) string {
+		nl, path := findValidatorNode(s, "zone1-poa1")
+		nl.(*dataModel.NetworkLocation).NetChar.PacketLossModel = "gilbert"
+		return path + ".netChar.packetLossModel"
+	})
+
+	fmt.Println("Invalid impairment percentages")
+	for field, setValue := range map[string]func(nc *dataModel.NetworkCharacteristics){
+		"packetLossCorrelation": func(nc *dataModel.NetworkCharacteristics) { nc.PacketLossCorrelation = -1 },
+		"burstLossGoodToBad":    func(nc *dataModel.NetworkCharacteristics) { nc.BurstLossGoodToBad = 101 },
+		"burstLossBadToGood":    func(nc *dataModel.NetworkCharacteristics) { nc.BurstLossBadToGood = -5 },
+		"burstLossBad":          func(nc *dataModel.NetworkCharacteristics) { nc.BurstLossBad = 150 },
+		"burstLossGood":         func(nc *dataModel.NetworkCharacteristics) { nc.BurstLossGood = -0.1 },
+		"packetDuplication":     func(nc *dataModel.NetworkCharacteristics) { nc.PacketDuplication = 100.5 },
+		"packetCorruption":      func(nc *dataModel.NetworkCharacteristics) { nc.PacketCorruption = -1 },
+		"packetReordering":      func(nc *dataModel.NetworkCharacteristics) { nc.PacketReordering = 200 },
+	} {
+		validateInvalidScenario(t, func(s *dataModel.Scenario) string {
+			nl, path := findValidatorNode(s, "zone1-poa1")
+			setValue(nl.(*dataModel.NetworkLocation).NetChar)
+			return path + ".netChar." + field
+		})
+	}
+
+	fmt.Println("Valid impairments")
+	scenario = getValidatorScenario(t)
+	nl, _ = findValidatorNode(scenario, "zone1-poa1")
+	nl.(*dataModel.NetworkLocation).NetChar = &dataModel.NetworkCharacteristics{
+		ThroughputDl:       0,
+		PacketLossModel:    "Gilbert-Elliott",
+		BurstLossGoodToBad: 0,
+		BurstLossBadToGood: 100,
+		BurstLossBad:       100,
+		PacketDuplication:  1,
+		PacketCorruption:   1,
+		PacketReordering:   1,
+	}
+	errs = validateScenarioStruct(t, scenario)
+	if len(errs) != 0 {
+		t.Fatalf("Scenario should be valid: %s", errs.Error())
+	}
+
+	fmt.Println("Invalid longitude")
+	validateInvalidScenario(t, func(s *dataModel.Scenario) string {
+		pl, path := findValidatorNode(s, "ue1")
+		pl.(*dataModel.PhysicalLocation).GeoData = &dataModel.GeoData{
+			Location: &dataModel.Point{Type_: "Point", Coordinates: []float32{200, 45}},
+		}
+		return path + ".geoData.location.coordinates[0]"
+	})
+
+	fmt.Println("Invalid path latitude")
+	validateInvalidScenario(t, func(s *dataModel.Scenario) string {
+		pl, path := findValidatorNode(s, "ue1")
+		pl.(*dataModel.PhysicalLocation).GeoData = &dataModel.GeoData{
+			Location: &dataModel.Point{Type_: "Point", Coordinates: []float32{7.42, 43.73}},
+			Path:     &dataModel.LineString{Type_: "LineString", Coordinates: [][]float32{{7.42, 43.73}, {7.43, -95}}},
+		}
+		return path + ".geoData.path.coordinates[1][1]"
+	})
+
+	fmt.Println("Missing process image")
+	validateInvalidScenario(t, func(s *dataModel.Scenario) string {
+		proc, path := findValidatorNode(s, "zone1-edge1-iperf")
+		proc.(*dataModel.Process).Image = ""
+		return path + ".image"
+	})
+
+	fmt.Println("Invalid process parent")
+	validateInvalidScenario(t, func(s *dataModel.Scenario) string {
+		proc, path := findValidatorNode(s, "ue1-iperf")
+		proc.(*dataModel.Process).Type_ = NodeTypeCloudApp
+		return path + ".type"
+	})
+
+	fmt.Println("Unknown POA in range")
+	validateInvalidScenario(t, func(s *dataModel.Scenario) string {
+		pl, path := findValidatorNode(s, "ue1")
+		pl.(*dataModel.PhysicalLocation).NetworkLocationsInRange = []string{"zone1-poa1", "unknown-poa"}
+		return path + ".networkLocationsInRange[1]"
+	})
+
+	fmt.Println("Multiple errors")
+	scenario = getValidatorScenario(t)
+	proc, _ := findValidatorNode(scenario, "zone1-edge1-iperf")
+	proc.(*dataModel.Process).Image = ""
+	zone, _ := findValidatorNode(scenario, "zone2")
+	zone.(*dataModel.Zone).Name = "zone1"
+	errs = validateScenarioStruct(t, scenario)
+	if len(errs) != 2 {
+		t.Fatalf("Expected 2 errors, got: %s", errs.Error())
+	}
+}
+
+func getValidatorScenario(t *testing.T) *dataModel.Scenario {
+	scenario := new(dataModel.Scenario)
+	err := json.Unmarshal([]byte(testScenario), scenario)
+	if err != nil {
+		t.Fatalf("Failed to unmarshal scenario")
+	}
+	return scenario
+}
+
+func validateScenarioStruct(t *testing.T, scenario *dataModel.Scenario) ValidationErrors {
+	jsonScenario, err := json.Marshal(scenario)
+	if err != nil {
+		t.Fatalf("Failed to marshal scenario")
+	}
+	_, status, err := ValidateScenario(jsonScenario)
+	if err == nil {
+		if status != ValidatorStatusUpdated {
+			t.Fatalf("Invalid status: %s", status)
+		}
+		return nil
+	}
+	if status != ValidatorStatusError {
+		t.Fatalf("Invalid status: %s", status)
+	}
+	errs, ok := err.(ValidationErrors)
+	if !ok {
+		t.Fatalf("Expected validation errors, got: %s", err.Error())
+	}
+	return errs
+}
+
+// validateInvalidScenario - Verify that the scenario modified by update fails with a single error at the returned path
+func validateInvalidScenario(t *testing.T, update func(*dataModel.Scenario) string) {
+	scenario := getValidatorScenario(t)
+	path := update(scenario)
+	errs := validateScenarioStruct(t, scenario)
+	if len(errs) != 1 {
+		t.Fatalf("Expected 1 error, got %d", len(errs))
+	}
+	if errs[0].Path != path {
+		t.Fatalf("Invalid error path: %s, expected: %s", errs[0].Path, path)
+	}
+	if !strings.Contains(errs.Error(), path) {
+		t.Fatalf("Error message should contain path")
+	}
+}
+
+// findValidatorNode - Return the scenario node with the provided name & its JSON path
+func findValidatorNode(scenario *dataModel.Scenario, name string) (interface{}, string) {
+	for iDomain := range scenario.Deployment.Domains {
+		domain := &scenario.Deployment.Domains[iDomain]
+		domainPath := fmt.Sprintf("$.deployment.domains[%d]", iDomain)
+		if domain.Name == name {
+			return domain, domainPath
+		}
+		for iZone := range domain.Zones {
+			zone := &domain.Zones[iZone]
+			zonePath := fmt.Sprintf("%s.zones[%d]", domainPath, iZone)
+			if zone.Name == name {
+				return zone, zonePath
+			}
+			for iNL := range zone.NetworkLocations {
+				nl := &zone.NetworkLocations[iNL]
+				nlPath := fmt.Sprintf("%s.networkLocations[%d]", zonePath, iNL)
+				if nl.Name == name {
+					return nl, nlPath
+				}
+				for iPL := range nl.PhysicalLocations {
+					pl := &nl.PhysicalLocations[iPL]
+					plPath := fmt.Sprintf("%s.physicalLocations[%d]", nlPath, iPL)
+					if pl.Name == name {
+						return pl, plPath
+					}
+					for iProc := range pl.Processes {
+						proc := &pl.Processes[iProc]
+						if proc.Name == name {
+							return proc, fmt.Sprintf("%s.processes[%d]", plPath, iProc)
+						}
+					}
+				}
+			}
+		}
+	}
+	return nil, ""
+}
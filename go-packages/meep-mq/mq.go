@@ -55,8 +55,9 @@ type Message string
 
 const (
 	// Sandbox Control
-	MsgSandboxCreate  Message = "SANDBOX-CREATE"
-	MsgSandboxDestroy Message = "SANDBOX-DESTROY"
+	MsgSandboxCreate        Message = "SANDBOX-CREATE"
+	MsgSandboxDestroy       Message = "SANDBOX-DESTROY"
+	MsgSandboxExpiryWarning Message = "SANDBOX-EXPIRY-WARNING"
 
 	// Scenario Management
 	MsgScenarioActivate  Message = "SCENARIO-ACTIVATE"
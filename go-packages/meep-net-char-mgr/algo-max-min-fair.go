@@ -0,0 +1,183 @@
+/*
+ * Copyright (c) 2020  InterDigital Communications, Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package netchar
+
+import (
+	"math"
+	"sort"
+	"strconv"
+
+	log "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-logger"
+)
+
+// Remaining bandwidth below which a segment or flow demand is considered exhausted
+const maxMinFairEpsilon = 1e-9
+
+// MaxMinFairAlgoConfig - Max-min Fair Algorithm Config
+type MaxMinFairAlgoConfig struct {
+	// Measured throughput (Mbps) from which a flow is considered active
+	ActivityThreshold float64
+	// Bandwidth (Mbps) reserved for an inactive flow so it may become active
+	InactiveFlowBw float64
+}
+
+// MaxMinFairAlgorithm - Closed-form max-min fair share of segment bandwidth over the flow path graph
+// Flow segmentation, latency, jitter, packet loss & impairments are computed as in the segment algorithm;
+// only flow bandwidth allocation differs. Active flows demand their maximum configured throughput while
+// inactive flows only demand the inactive flow bandwidth.
+type MaxMinFairAlgorithm struct {
+	*SegmentAlgorithm
+	MmfConfig MaxMinFairAlgoConfig
+}
+
+// NewMaxMinFairAlgorithm - Create, Initialize and connect
+func NewMaxMinFairAlgorithm(name string, namespace string, redisAddr string) (*MaxMinFairAlgorithm, error) {
+	segAlgo, err := NewSegmentAlgorithm(name, namespace, redisAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	var algo MaxMinFairAlgorithm
+	algo.SegmentAlgorithm = segAlgo
+	algo.MmfConfig.ActivityThreshold = 1.0
+	algo.MmfConfig.InactiveFlowBw = 6.0
+	return &algo, nil
+}
+
+// CalculateNetChar - Run algorithm to recalculate network characteristics using latest scenario & metrics
+func (algo *MaxMinFairAlgorithm) CalculateNetChar() []FlowNetChar {
+	return algo.calculateNetChar(algo.allocateMaxMinFairBw)
+}
+
+// SetConfigAttribute
+func (algo *MaxMinFairAlgorithm) SetConfigAttribute(fieldName string, fieldValue string) {
+	switch fieldName {
+	case "mmfActivityThreshold":
+		value, err := strconv.ParseFloat(fieldValue, 64)
+		if err == nil && value >= 0 {
+			algo.MmfConfig.ActivityThreshold = value
+		}
+	case "mmfInactiveFlowBw":
+		value, err := strconv.ParseFloat(fieldValue, 64)
+		if err == nil && value > 0 {
+			algo.MmfConfig.InactiveFlowBw = value
+		}
+	default:
+		algo.SegmentAlgorithm.SetConfigAttribute(fieldName, fieldValue)
+	}
+}
+
+// allocateMaxMinFairBw - Allocate flow bandwidth using the max-min fair share of flow demands
+func (algo *MaxMinFairAlgorithm) allocateMaxMinFairBw() {
+	// Sort flows to allocate bandwidth in a deterministic order
+	flowNames := make([]string, 0, len(algo.FlowMap))
+	for name := range algo.FlowMap {
+		flowNames = append(flowNames, name)
+	}
+	sort.Strings(flowNames)
+
+	flows := make([]*SegAlgoFlow, len(flowNames))
+	demands := make([]float64, len(flowNames))
+	for i, name := range flowNames {
+		flow := algo.FlowMap[name]
+		flows[i] = flow
+		demands[i] = flow.ConfiguredNetChar.Throughput
+		if flow.CurrentThroughput < algo.MmfConfig.ActivityThreshold && algo.MmfConfig.InactiveFlowBw < demands[i] {
+			demands[i] = algo.MmfConfig.InactiveFlowBw
+		}
+	}
+
+	allocations := getMaxMinFairShare(flows, demands)
+	for i, flow := range flows {
+		flow.MaxPlannedThroughput = allocations[i]
+		flow.MaxPlannedLowerBound = allocations[i]
+		flow.MaxPlannedUpperBound = allocations[i]
+		if algo.Config.LogVerbose {
+			log.Info("Max-min fair share for ", flow.Name, ": ", allocations[i], " (demand: ", demands[i], ")")
+		}
+	}
+}
+
+// getMaxMinFairShare - Allocate segment bandwidth to flows using progressive filling
+// All unfrozen flows are increased equally until either a flow reaches its demand or a segment
+// on its path is saturated; the flow is then frozen and filling continues with remaining flows.
+func getMaxMinFairShare(flows []*SegAlgoFlow, demands []float64) []float64 {
+	allocations := make([]float64, len(flows))
+	remainingBw := make(map[*SegAlgoSegment]float64)
+	unfrozen := make([]bool, len(flows))
+	unfrozenCount := 0
+	for i, flow := range flows {
+		if flow.Path == nil || demands[i] <= 0 {
+			continue
+		}
+		unfrozen[i] = true
+		unfrozenCount++
+		for _, segment := range flow.Path.Segments {
+			remainingBw[segment] = segment.ConfiguredNetChar.Throughput
+		}
+	}
+
+	for unfrozenCount > 0 {
+		// Find largest increment possible for all unfrozen flows
+		segmentFlowCount := make(map[*SegAlgoSegment]int)
+		for i, flow := range flows {
+			if unfrozen[i] {
+				for _, segment := range flow.Path.Segments {
+					segmentFlowCount[segment]++
+				}
+			}
+		}
+		increment := math.MaxFloat64
+		for segment, count := range segmentFlowCount {
+			increment = math.Min(increment, remainingBw[segment]/float64(count))
+		}
+		for i := range flows {
+			if unfrozen[i] {
+				increment = math.Min(increment, demands[i]-allocations[i])
+			}
+		}
+		increment = math.Max(increment, 0)
+
+		// Allocate increment
+		for i, flow := range flows {
+			if unfrozen[i] {
+				allocations[i] += increment
+				for _, segment := range flow.Path.Segments {
+					remainingBw[segment] -= increment
+				}
+			}
+		}
+
+		// Freeze flows that reached their demand or cross a saturated segment
+		for i, flow := range flows {
+			if !unfrozen[i] {
+				continue
+			}
+			frozen := allocations[i] >= demands[i]-maxMinFairEpsilon
+			for _, segment := range flow.Path.Segments {
+				if remainingBw[segment] <= maxMinFairEpsilon {
+					frozen = true
+				}
+			}
+			if frozen {
+				unfrozen[i] = false
+				unfrozenCount--
+			}
+		}
+	}
+	return allocations
+}
@@ -0,0 +1,168 @@
+/*
+ * Copyright (c) 2020  InterDigital Communications, Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package netchar
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	log "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-logger"
+	mod "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-model"
+	redis "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-redis"
+)
+
+func TestMaxMinFairShare(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	// Segments: s1 (10) shared by f1, f2 & f3; s2 (4) shared by f1 & f4
+	s1 := newTestSegment("s1", 10)
+	s2 := newTestSegment("s2", 4)
+	f1 := newTestFlow("f1", s1, s2)
+	f2 := newTestFlow("f2", s1)
+	f3 := newTestFlow("f3", s1)
+	f4 := newTestFlow("f4", s2)
+	flows := []*SegAlgoFlow{f1, f2, f3, f4}
+
+	fmt.Println("Share bandwidth between backlogged flows")
+	allocations := getMaxMinFairShare(flows, []float64{100, 100, 100, 100})
+	if !validateAllocations(allocations, []float64{2, 4, 4, 2}) {
+		t.Fatalf("Invalid allocations: %v", allocations)
+	}
+
+	fmt.Println("Share bandwidth with limited demands")
+	allocations = getMaxMinFairShare(flows, []float64{100, 1, 100, 0.5})
+	if !validateAllocations(allocations, []float64{3.5, 1, 5.5, 0.5}) {
+		t.Fatalf("Invalid allocations: %v", allocations)
+	}
+
+	fmt.Println("Ignore flows without path or demand")
+	f2.Path = nil
+	allocations = getMaxMinFairShare(flows, []float64{100, 100, 100, 0})
+	if !validateAllocations(allocations, []float64{4, 0, 6, 0}) {
+		t.Fatalf("Invalid allocations: %v", allocations)
+	}
+}
+
+func TestMaxMinFairAlgoCalculation(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	// Create connection to Metrics Redis DB to inject metrics
+	rc, err := redis.NewConnector(segAlgoRedisAddr, metricsDb)
+	if err != nil {
+		t.Fatalf("Failed connection to Metrics redis DB")
+	}
+
+	// Create Model & add Scenario to use for testing
+	fmt.Println("Create Model")
+	modelCfg := mod.ModelCfg{Name: "activeScenario", Namespace: testModuleNamespace, Module: testModuleName, UpdateCb: nil, DbAddr: segAlgoRedisAddr}
+	activeModel, err := mod.NewModel(modelCfg)
+	if err != nil {
+		t.Fatalf("Failed to create Model instance")
+	}
+	fmt.Println("Set scenario in Model")
+	err = activeModel.SetScenario([]byte(jsonTestScenario))
+	if err != nil {
+		t.Fatalf("Failed to set scenario in model")
+	}
+
+	// Create & Process new Algorithm
+	fmt.Println("Create new algorithm")
+	algo, err := NewMaxMinFairAlgorithm(testModuleName, testModuleNamespace, segAlgoRedisAddr)
+	if err != nil {
+		t.Fatalf("Failed to create a MaxMinFairAlgorithm object.")
+	}
+	fmt.Println("Process scenario model")
+	err = algo.ProcessScenario(activeModel)
+	if err != nil {
+		t.Fatalf("Failed to process scenario model")
+	}
+
+	// Validate algorithm Calculations
+	fmt.Println("Test algorithm calculations without metrics")
+	updatedNetCharList := algo.CalculateNetChar()
+	if len(updatedNetCharList) != 90 {
+		t.Fatalf("Updated net char list not fully filled")
+	}
+	if !validateNetCharUpdate(updatedNetCharList, "cloud-iperf", "ue1-iperf", 121, 15, 0, 6) {
+		t.Fatalf("Error in Net Char initial calculation")
+	}
+
+	// Update metrics & recalculate
+	fmt.Println("Test algorithm calculations with active flows")
+	if !setMetrics(rc, "zone1-fog1-iperf", "ue1-iperf", 100) {
+		t.Fatalf("Error updating metrics")
+	}
+	if !setMetrics(rc, "zone1-fog1-svc", "ue2-svc", 100) {
+		t.Fatalf("Error updating metrics")
+	}
+	updatedNetCharList = algo.CalculateNetChar()
+	if len(updatedNetCharList) != 2 {
+		t.Fatalf("Invalid net char update list")
+	}
+	// 16 inactive flows use 96 Mbps of zone1-poa1 downlink; 2 active flows share the rest
+	if !validateNetCharUpdate(updatedNetCharList, "zone1-fog1-iperf", "ue1-iperf", 1, 1, 0, 452) {
+		t.Fatalf("Error in Net Char update")
+	}
+	if !validateNetCharUpdate(updatedNetCharList, "zone1-fog1-svc", "ue2-svc", 1, 1, 0, 452) {
+		t.Fatalf("Error in Net Char update")
+	}
+
+	fmt.Println("Test algorithm calculation without changes in metrics")
+	updatedNetCharList = algo.CalculateNetChar()
+	if len(updatedNetCharList) != 0 {
+		t.Fatalf("Updated net char list not empty")
+	}
+
+	fmt.Println("Update inactive flow bandwidth")
+	algo.SetConfigAttribute("mmfInactiveFlowBw", "2")
+	updatedNetCharList = algo.CalculateNetChar()
+	if len(updatedNetCharList) != 90 {
+		t.Fatalf("Updated net char list not fully filled")
+	}
+	if !validateNetCharUpdate(updatedNetCharList, "zone1-fog1-iperf", "ue1-iperf", 1, 1, 0, 484) {
+		t.Fatalf("Error in Net Char update")
+	}
+}
+
+func newTestSegment(name string, throughput float64) *SegAlgoSegment {
+	segment := new(SegAlgoSegment)
+	segment.Name = name
+	segment.ConfiguredNetChar.Throughput = throughput
+	return segment
+}
+
+func newTestFlow(name string, segments ...*SegAlgoSegment) *SegAlgoFlow {
+	flow := new(SegAlgoFlow)
+	flow.Name = name
+	flow.Path = &SegAlgoPath{Name: name, Segments: segments}
+	return flow
+}
+
+func validateAllocations(allocations []float64, expected []float64) bool {
+	if len(allocations) != len(expected) {
+		return false
+	}
+	for i := range allocations {
+		if math.Abs(allocations[i]-expected[i]) > 1e-6 {
+			return false
+		}
+	}
+	return true
+}
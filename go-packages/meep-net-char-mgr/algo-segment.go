@@ -78,6 +78,7 @@ type SegAlgoFlow struct {
 	ComputedLatency               float64
 	ComputedJitter                float64
 	ComputedPacketLoss            float64
+	ComputedImpairments           Impairments
 	AllocatedThroughput           float64 //allocated
 	AllocatedThroughputLowerBound float64 //allocated
 	AllocatedThroughputUpperBound float64 //allocated
@@ -253,6 +254,11 @@ func (algo *SegmentAlgorithm) ProcessScenario(model *mod.Model) error {
 
 // CalculateNetChar - Run algorithm to recalculate network characteristics using latest scenario & metrics
 func (algo *SegmentAlgorithm) CalculateNetChar() []FlowNetChar {
+	return algo.calculateNetChar(algo.allocateSegmentBw)
+}
+
+// calculateNetChar - Recalculate network characteristics using the provided flow bandwidth allocation function
+func (algo *SegmentAlgorithm) calculateNetChar(allocateBw func()) []FlowNetChar {
 	var updatedNetCharList []FlowNetChar
 	currentTime := time.Now()
 	algo.logTimeLapse(&currentTime, "time to print")
@@ -267,7 +273,7 @@ func (algo *SegmentAlgorithm) CalculateNetChar() []FlowNetChar {
 	algo.logTimeLapse(&currentTime, "time to update metrics")
 
 	// Recalculate segment BW allocation for each flow
-	algo.reCalculateNetChar()
+	algo.reCalculateNetChar(allocateBw)
 	algo.logTimeLapse(&currentTime, "time to recalculate throughput")
 
 	// Prepare list of updated flows
@@ -294,6 +300,7 @@ func (algo *SegmentAlgorithm) CalculateNetChar() []FlowNetChar {
 		if (flow.ComputedLatency != flow.AppliedNetChar.Latency) ||
 			(flow.ComputedJitter != flow.AppliedNetChar.Jitter) ||
 			(flow.ComputedPacketLoss != flow.AppliedNetChar.PacketLoss) ||
+			(flow.ComputedImpairments != flow.AppliedNetChar.Impairments) ||
 			(flow.ConfiguredNetChar.Distribution != flow.AppliedNetChar.Distribution) {
 			if algo.Config.LogVerbose {
 				log.Info("Update other netchars for ", flow.Name, " to ", flow.ComputedLatency, "-", flow.ComputedJitter, "-", flow.ComputedPacketLoss, " from ", flow.AppliedNetChar.Latency, "-", flow.AppliedNetChar.Jitter, "-", flow.AppliedNetChar.PacketLoss, "-", flow.AppliedNetChar.Distribution)
@@ -302,12 +309,13 @@ func (algo *SegmentAlgorithm) CalculateNetChar() []FlowNetChar {
 			flow.AppliedNetChar.Latency = flow.ComputedLatency
 			flow.AppliedNetChar.Jitter = flow.ComputedJitter
 			flow.AppliedNetChar.PacketLoss = flow.ComputedPacketLoss
+			flow.AppliedNetChar.Impairments = flow.ComputedImpairments
 			flow.AppliedNetChar.Distribution = flow.ConfiguredNetChar.Distribution
 			updateNeeded = true
 		}
 
 		if updateNeeded {
-			netchar := NetChar{flow.AppliedNetChar.Latency, flow.AppliedNetChar.Jitter, flow.AppliedNetChar.PacketLoss, flow.AppliedNetChar.Throughput, flow.ConfiguredNetChar.Distribution}
+			netchar := NetChar{flow.AppliedNetChar.Latency, flow.AppliedNetChar.Jitter, flow.AppliedNetChar.PacketLoss, flow.AppliedNetChar.Throughput, flow.ConfiguredNetChar.Distribution, flow.AppliedNetChar.Impairments}
 			flowNetChar := FlowNetChar{flow.SrcNetElem, flow.DstNetElem, netchar}
 			updatedNetCharList = append(updatedNetCharList, flowNetChar)
 		}
@@ -577,6 +585,16 @@ func (algo *SegmentAlgorithm) createSegment(elemName string, direction string, f
 		segment.ConfiguredNetChar.Jitter = float64(nc.LatencyVariation)
 		segment.ConfiguredNetChar.PacketLoss = float64(nc.PacketLoss)
 		segment.ConfiguredNetChar.Throughput = float64(ncThroughput)
+		impairments, err := getImpairments(nc)
+		if err != nil {
+			log.Error("Ignoring impairments for ", elemName, ": ", err.Error())
+		}
+		segment.ConfiguredNetChar.Impairments = impairments
+
+		// Burst loss replaces uniform packet loss on the segment
+		if segment.ConfiguredNetChar.Impairments.PacketLossModel == PacketLossModelGilbertElliott {
+			segment.ConfiguredNetChar.PacketLoss = 0
+		}
 
 		maxThroughput := ncThroughput
 		// Initialize segment-specific BW attributes from Algo config
@@ -628,27 +646,18 @@ func (algo *SegmentAlgorithm) getMetricsThroughputEntryHandler(key string, field
 }
 
 // reCalculateNetChar -
-func (algo *SegmentAlgorithm) reCalculateNetChar() {
+func (algo *SegmentAlgorithm) reCalculateNetChar(allocateBw func()) {
 	//reset every planned throughput values for every flow since they will start to populate those
 	for _, flow := range algo.FlowMap {
 		resetComputedNetChar(flow)
 	}
 
+	//throughput specific
+	allocateBw()
+
 	//all segments determined by the scenario
 	for _, segment := range algo.SegmentMap {
 
-		//throughput specific
-		updateMaxFairShareBwPerFlow(segment)
-		unusedBw, list := needToReevaluate(segment)
-
-		if list != nil {
-			if algo.Config.LogVerbose {
-				log.Info("Segment ", segment.Name, " reevaluation result - BW unused: ", unusedBw, "***Flows to evaluate***: ", printFlowNamesFromList(list))
-			}
-
-			recalculateSegmentBw(segment, list, unusedBw)
-		}
-
 		//latency, jitter, packet-loss computation for each flow in each segment
 		for _, flow := range segment.Flows {
 			flow.ComputedLatency += segment.ConfiguredNetChar.Latency
@@ -661,12 +670,82 @@ func (algo *SegmentAlgorithm) reCalculateNetChar() {
 					flow.ComputedPacketLoss += (flow.ComputedPacketLoss * (1 - segment.ConfiguredNetChar.PacketLoss))
 				}
 			}
+			addSegmentImpairments(&flow.ComputedImpairments, &segment.ConfiguredNetChar.Impairments)
 		}
 		if algo.Config.LogVerbose {
 			printFlows(segment)
 		}
 
 	}
+
+	// Apply uniform packet loss on top of burst loss, if any
+	for _, flow := range algo.FlowMap {
+		finalizeImpairments(&flow.ComputedPacketLoss, &flow.ComputedImpairments)
+	}
+}
+
+// allocateSegmentBw - Allocate flow bandwidth segment by segment based on measured flow activity
+func (algo *SegmentAlgorithm) allocateSegmentBw() {
+	for _, segment := range algo.SegmentMap {
+		updateMaxFairShareBwPerFlow(segment)
+		unusedBw, list := needToReevaluate(segment)
+
+		if list != nil {
+			if algo.Config.LogVerbose {
+				log.Info("Segment ", segment.Name, " reevaluation result - BW unused: ", unusedBw, "***Flows to evaluate***: ", printFlowNamesFromList(list))
+			}
+
+			recalculateSegmentBw(segment, list, unusedBw)
+		}
+	}
+}
+
+// addSegmentImpairments - Combine segment impairments with impairments computed so far for a flow
+//   - Duplication, corruption & reordering are independent on each segment
+//   - Loss correlation is the highest correlation configured on the path
+//   - Burst loss uses the segment model with the highest average loss on the path
+func addSegmentImpairments(flowImp *Impairments, segmentImp *Impairments) {
+	flowImp.PacketDuplication = combinePercent(flowImp.PacketDuplication, segmentImp.PacketDuplication)
+	flowImp.PacketCorruption = combinePercent(flowImp.PacketCorruption, segmentImp.PacketCorruption)
+	flowImp.PacketReordering = combinePercent(flowImp.PacketReordering, segmentImp.PacketReordering)
+	if segmentImp.PacketLossCorrelation > flowImp.PacketLossCorrelation {
+		flowImp.PacketLossCorrelation = segmentImp.PacketLossCorrelation
+	}
+	if segmentImp.PacketLossModel == PacketLossModelGilbertElliott {
+		if flowImp.PacketLossModel != PacketLossModelGilbertElliott || getBurstLossAverage(segmentImp) > getBurstLossAverage(flowImp) {
+			flowImp.PacketLossModel = PacketLossModelGilbertElliott
+			flowImp.BurstLossGoodToBad = segmentImp.BurstLossGoodToBad
+			flowImp.BurstLossBadToGood = segmentImp.BurstLossBadToGood
+			flowImp.BurstLossBad = segmentImp.BurstLossBad
+			flowImp.BurstLossGood = segmentImp.BurstLossGood
+		}
+	}
+}
+
+// finalizeImpairments - Fold uniform packet loss into the burst loss model states when burst loss is used
+// NOTE: Flows without burst loss keep an empty packet loss model, equivalent to the random loss model
+func finalizeImpairments(packetLoss *float64, imp *Impairments) {
+	if imp.PacketLossModel != PacketLossModelGilbertElliott {
+		return
+	}
+	imp.BurstLossGood = combinePercent(imp.BurstLossGood, *packetLoss)
+	imp.BurstLossBad = combinePercent(imp.BurstLossBad, *packetLoss)
+	*packetLoss = 0
+}
+
+// getBurstLossAverage - Get Gilbert-Elliott model steady state loss percentage
+func getBurstLossAverage(imp *Impairments) float64 {
+	transitions := imp.BurstLossGoodToBad + imp.BurstLossBadToGood
+	if transitions == 0 {
+		return imp.BurstLossGood
+	}
+	badRatio := imp.BurstLossGoodToBad / transitions
+	return badRatio*imp.BurstLossBad + (1-badRatio)*imp.BurstLossGood
+}
+
+// combinePercent - Combine two independent event percentages
+func combinePercent(p1 float64, p2 float64) float64 {
+	return p1 + p2 - (p1 * p2 / 100)
 }
 
 // resetComputedNetChar -
@@ -677,6 +756,7 @@ func resetComputedNetChar(flow *SegAlgoFlow) {
 	flow.ComputedLatency = 0
 	flow.ComputedJitter = 0
 	flow.ComputedPacketLoss = 0
+	flow.ComputedImpairments = Impairments{}
 
 }
 
@@ -874,6 +954,49 @@ func getNetChars(elemName string, model *mod.Model) *dataModel.NetworkCharacteri
 	return nc
 }
 
+// getImpairments - Retrieve packet impairments from provided network characteristics
+// No impairments are returned if the packet loss model or any percentage is invalid.
+func getImpairments(nc *dataModel.NetworkCharacteristics) (Impairments, error) {
+	var imp Impairments
+	switch nc.PacketLossModel {
+	case "", PacketLossModelRandom:
+		imp.PacketLossModel = PacketLossModelRandom
+	case PacketLossModelGilbertElliott:
+		imp.PacketLossModel = PacketLossModelGilbertElliott
+		imp.BurstLossGoodToBad = nc.BurstLossGoodToBad
+		imp.BurstLossBadToGood = nc.BurstLossBadToGood
+		imp.BurstLossBad = nc.BurstLossBad
+		imp.BurstLossGood = nc.BurstLossGood
+	default:
+		return Impairments{}, errors.New("Unsupported packet loss model: " + nc.PacketLossModel)
+	}
+	imp.PacketLossCorrelation = nc.PacketLossCorrelation
+	imp.PacketDuplication = nc.PacketDuplication
+	imp.PacketCorruption = nc.PacketCorruption
+	imp.PacketReordering = nc.PacketReordering
+
+	// Validate percentages
+	percentages := []struct {
+		name  string
+		value float64
+	}{
+		{"packet loss correlation", imp.PacketLossCorrelation},
+		{"burst loss good to bad", imp.BurstLossGoodToBad},
+		{"burst loss bad to good", imp.BurstLossBadToGood},
+		{"burst loss bad", imp.BurstLossBad},
+		{"burst loss good", imp.BurstLossGood},
+		{"packet duplication", imp.PacketDuplication},
+		{"packet corruption", imp.PacketCorruption},
+		{"packet reordering", imp.PacketReordering},
+	}
+	for _, percentage := range percentages {
+		if percentage.value < 0 || percentage.value > 100 {
+			return Impairments{}, errors.New("Invalid " + percentage.name + " percentage: " + strconv.FormatFloat(percentage.value, 'g', -1, 64))
+		}
+	}
+	return imp, nil
+}
+
 // printFlowNamesFromList -
 func printFlowNamesFromList(list []*SegAlgoFlow) string {
 	str := ""
@@ -461,6 +461,77 @@ func validatePath(algo *SegmentAlgorithm, flowName string, segmentCount int) boo
 	return false
 }
 
+func TestSegAlgoImpairments(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	fmt.Println("Combine independent impairments")
+	var flowImp Impairments
+	addSegmentImpairments(&flowImp, &Impairments{PacketDuplication: 10, PacketCorruption: 50, PacketLossCorrelation: 25})
+	addSegmentImpairments(&flowImp, &Impairments{PacketDuplication: 10, PacketReordering: 20, PacketLossCorrelation: 10})
+	if flowImp.PacketDuplication != 19 || flowImp.PacketCorruption != 50 || flowImp.PacketReordering != 20 ||
+		flowImp.PacketLossCorrelation != 25 || flowImp.PacketLossModel != "" {
+		t.Fatalf("Invalid combined impairments: %+v", flowImp)
+	}
+	packetLoss := 10.0
+	finalizeImpairments(&packetLoss, &flowImp)
+	if packetLoss != 10 || flowImp.PacketLossModel != "" {
+		t.Fatalf("Uniform loss should be unchanged")
+	}
+
+	fmt.Println("Combine burst loss impairments")
+	flowImp = Impairments{}
+	lowBurstLoss := Impairments{PacketLossModel: PacketLossModelGilbertElliott, BurstLossGoodToBad: 1, BurstLossBadToGood: 99, BurstLossBad: 100}
+	highBurstLoss := Impairments{PacketLossModel: PacketLossModelGilbertElliott, BurstLossGoodToBad: 10, BurstLossBadToGood: 40, BurstLossBad: 50}
+	addSegmentImpairments(&flowImp, &lowBurstLoss)
+	addSegmentImpairments(&flowImp, &highBurstLoss)
+	addSegmentImpairments(&flowImp, &lowBurstLoss)
+	if flowImp.PacketLossModel != PacketLossModelGilbertElliott || flowImp.BurstLossGoodToBad != 10 ||
+		flowImp.BurstLossBadToGood != 40 || flowImp.BurstLossBad != 50 || flowImp.BurstLossGood != 0 {
+		t.Fatalf("Invalid burst loss model: %+v", flowImp)
+	}
+	packetLoss = 10.0
+	finalizeImpairments(&packetLoss, &flowImp)
+	if packetLoss != 0 || flowImp.BurstLossBad != 55 || flowImp.BurstLossGood != 10 {
+		t.Fatalf("Uniform loss not applied to burst loss model: %+v", flowImp)
+	}
+}
+
+func TestSegAlgoGetImpairments(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	tests := []struct {
+		name  string
+		nc    dataModel.NetworkCharacteristics
+		imp   Impairments
+		valid bool
+	}{
+		{"default model", dataModel.NetworkCharacteristics{PacketDuplication: 5},
+			Impairments{PacketLossModel: PacketLossModelRandom, PacketDuplication: 5}, true},
+		{"random model ignores burst loss", dataModel.NetworkCharacteristics{PacketLossModel: PacketLossModelRandom, BurstLossBad: 50, PacketLossCorrelation: 25},
+			Impairments{PacketLossModel: PacketLossModelRandom, PacketLossCorrelation: 25}, true},
+		{"burst loss model", dataModel.NetworkCharacteristics{PacketLossModel: PacketLossModelGilbertElliott, BurstLossGoodToBad: 10, BurstLossBadToGood: 40, BurstLossBad: 100},
+			Impairments{PacketLossModel: PacketLossModelGilbertElliott, BurstLossGoodToBad: 10, BurstLossBadToGood: 40, BurstLossBad: 100}, true},
+		{"unknown model", dataModel.NetworkCharacteristics{PacketLossModel: "gilbert-elliott", PacketCorruption: 5}, Impairments{}, false},
+		{"negative percentage", dataModel.NetworkCharacteristics{PacketReordering: -1}, Impairments{}, false},
+		{"percentage above 100", dataModel.NetworkCharacteristics{PacketLossModel: PacketLossModelGilbertElliott, BurstLossGood: 101}, Impairments{}, false},
+	}
+
+	for _, test := range tests {
+		imp, err := getImpairments(&test.nc)
+		if test.valid && err != nil {
+			t.Fatalf("%s: unexpected error: %s", test.name, err.Error())
+		}
+		if !test.valid && err == nil {
+			t.Fatalf("%s: expected error", test.name)
+		}
+		if imp != test.imp {
+			t.Fatalf("%s: invalid impairments: %+v", test.name, imp)
+		}
+	}
+}
+
 func validateNetCharUpdate(updatedNetCharList []FlowNetChar, src string, dst string, latency float64, jitter float64, packetloss float64, throughput float64) bool {
 	found := false
 	for _, flowNetChar := range updatedNetCharList {
@@ -29,13 +29,26 @@ import (
 	redis "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-redis"
 )
 
-const netCharControlDb = 0
+const NetCharControlDb = 0
 const defaultTickerPeriod int = 500
 const NetCharControls string = "net-char-controls"
 const NetCharControlChannel string = NetCharControls
 
+// NetChar algorithms
+const (
+	NetCharAlgoSegment    = "SEGMENT"
+	NetCharAlgoMaxMinFair = "MAX-MIN-FAIR"
+)
+const defaultNetCharAlgo = NetCharAlgoSegment
+
+// Packet loss models
+const (
+	PacketLossModelRandom         = "Random"
+	PacketLossModelGilbertElliott = "Gilbert-Elliott"
+)
+
 // Callback function types
-type NetCharUpdateCb func(string, string, float64, float64, float64, string, float64)
+type NetCharUpdateCb func(string, string, float64, float64, float64, string, float64, Impairments)
 type UpdateCompleteCb func()
 
 // NetChar Interface
@@ -60,6 +73,21 @@ type NetChar struct {
 	PacketLoss   float64
 	Throughput   float64
 	Distribution string
+	Impairments  Impairments
+}
+
+// Impairments - Packet impairments other than latency, uniform loss & throughput
+// NOTE: Percentages are in the [0, 100] range
+type Impairments struct {
+	PacketLossModel       string
+	PacketLossCorrelation float64
+	BurstLossGoodToBad    float64
+	BurstLossBadToGood    float64
+	BurstLossBad          float64
+	BurstLossGood         float64
+	PacketDuplication     float64
+	PacketCorruption      float64
+	PacketReordering      float64
 }
 
 // NetChar
@@ -84,6 +112,7 @@ type NetCharConfig struct {
 	Action              string
 	RecalculationPeriod int
 	LogVerbose          bool
+	Algorithm           string
 }
 
 // NetCharManager Object
@@ -91,6 +120,7 @@ type NetCharManager struct {
 	name             string
 	namespace        string
 	baseKey          string
+	redisAddr        string
 	isStarted        bool
 	ticker           *time.Ticker
 	rc               *redis.Connector
@@ -101,6 +131,7 @@ type NetCharManager struct {
 	netCharUpdateCb  NetCharUpdateCb
 	updateCompleteCb UpdateCompleteCb
 	algo             NetCharAlgo
+	algos            map[string]NetCharAlgo
 	handlerId        int
 }
 
@@ -117,8 +148,10 @@ func NewNetChar(name string, namespace string, redisAddr string) (*NetCharManage
 	ncm.name = name
 	ncm.namespace = namespace
 	ncm.baseKey = dkm.GetKeyRoot(namespace)
+	ncm.redisAddr = redisAddr
 	ncm.isStarted = false
 	ncm.config.RecalculationPeriod = defaultTickerPeriod
+	ncm.config.Algorithm = defaultNetCharAlgo
 
 	// Create message queue
 	ncm.mqLocal, err = mq.NewMsgQueue(mq.GetLocalName(namespace), name, namespace, redisAddr)
@@ -128,12 +161,17 @@ func NewNetChar(name string, namespace string, redisAddr string) (*NetCharManage
 	}
 	log.Info("Message Queue created")
 
-	// Create new NetCharAlgo
-	ncm.algo, err = NewSegmentAlgorithm(ncm.name, ncm.namespace, redisAddr)
-	if err != nil {
-		log.Error("Failed to create NetCharAlgo with error: ", err)
-		return nil, err
+	// Create supported NetCharAlgos
+	// NOTE: Algorithms are created once & reused when switching algorithm, as creation resets stored metrics
+	ncm.algos = make(map[string]NetCharAlgo)
+	for _, algoName := range []string{NetCharAlgoSegment, NetCharAlgoMaxMinFair} {
+		ncm.algos[algoName], err = ncm.newNetCharAlgo(algoName)
+		if err != nil {
+			log.Error("Failed to create NetCharAlgo with error: ", err)
+			return nil, err
+		}
 	}
+	ncm.algo = ncm.algos[ncm.config.Algorithm]
 
 	// Create new Model
 	modelCfg := mod.ModelCfg{
@@ -150,7 +188,7 @@ func NewNetChar(name string, namespace string, redisAddr string) (*NetCharManage
 	}
 
 	// Create new Control listener
-	ncm.rc, err = redis.NewConnector(redisAddr, netCharControlDb)
+	ncm.rc, err = redis.NewConnector(redisAddr, NetCharControlDb)
 	if err != nil {
 		log.Error("Failed connection to redis DB. Error: ", err)
 		return nil, err
@@ -179,6 +217,49 @@ func NewNetChar(name string, namespace string, redisAddr string) (*NetCharManage
 	return &ncm, nil
 }
 
+// IsValidNetCharAlgo - Verify that the provided NetChar algorithm is supported
+func IsValidNetCharAlgo(algoName string) bool {
+	return algoName == NetCharAlgoSegment || algoName == NetCharAlgoMaxMinFair
+}
+
+// GetNetCharAlgo - Get NetChar algorithm selected in the controls of the provided sandbox
+func GetNetCharAlgo(rc *redis.Connector, namespace string) (string, error) {
+	fields, err := rc.GetEntry(dkm.GetKeyRoot(namespace) + NetCharControls)
+	if err != nil {
+		return "", err
+	}
+	algoName := fields["algorithm"]
+	if algoName == "" {
+		algoName = defaultNetCharAlgo
+	}
+	return algoName, nil
+}
+
+// SetNetCharAlgo - Select NetChar algorithm in the controls of the provided sandbox & notify NetChar managers
+func SetNetCharAlgo(rc *redis.Connector, namespace string, algoName string) error {
+	if !IsValidNetCharAlgo(algoName) {
+		return errors.New("Unsupported NetChar algorithm: " + algoName)
+	}
+	fields := map[string]interface{}{"algorithm": algoName}
+	err := rc.SetEntry(dkm.GetKeyRoot(namespace)+NetCharControls, fields)
+	if err != nil {
+		return err
+	}
+	return rc.Publish(NetCharControlChannel, namespace)
+}
+
+// newNetCharAlgo - Create NetChar algorithm with the provided name
+func (ncm *NetCharManager) newNetCharAlgo(algoName string) (NetCharAlgo, error) {
+	switch algoName {
+	case NetCharAlgoSegment:
+		return NewSegmentAlgorithm(ncm.name, ncm.namespace, ncm.redisAddr)
+	case NetCharAlgoMaxMinFair:
+		return NewMaxMinFairAlgorithm(ncm.name, ncm.namespace, ncm.redisAddr)
+	default:
+		return nil, errors.New("Unsupported NetChar algorithm: " + algoName)
+	}
+}
+
 // Register - Register NetChar callback functions
 func (ncm *NetCharManager) Register(netCharUpdateCb NetCharUpdateCb, updateCompleteCb UpdateCompleteCb) {
 	ncm.netCharUpdateCb = netCharUpdateCb
@@ -286,7 +367,7 @@ func (ncm *NetCharManager) updateNetChars() {
 	if len(updatedNetCharList) != 0 {
 		for _, flowNetChar := range updatedNetCharList {
 			if ncm.netCharUpdateCb != nil {
-				ncm.netCharUpdateCb(flowNetChar.DstElemName, flowNetChar.SrcElemName, flowNetChar.MyNetChar.Throughput, flowNetChar.MyNetChar.Latency, flowNetChar.MyNetChar.Jitter, flowNetChar.MyNetChar.Distribution /*flowNetChar.MyNetChar.Distribution,*/, flowNetChar.MyNetChar.PacketLoss)
+				ncm.netCharUpdateCb(flowNetChar.DstElemName, flowNetChar.SrcElemName, flowNetChar.MyNetChar.Throughput, flowNetChar.MyNetChar.Latency, flowNetChar.MyNetChar.Jitter, flowNetChar.MyNetChar.Distribution /*flowNetChar.MyNetChar.Distribution,*/, flowNetChar.MyNetChar.PacketLoss, flowNetChar.MyNetChar.Impairments)
 			}
 		}
 		if ncm.updateCompleteCb != nil {
@@ -314,6 +395,13 @@ func (ncm *NetCharManager) getControlsEntryHandler(key string, fields map[string
 	tickerPeriod := defaultTickerPeriod
 	logVerbose := false
 
+	// Replace algorithm before applying algorithm config attributes
+	algoName := fields["algorithm"]
+	if algoName == "" {
+		algoName = defaultNetCharAlgo
+	}
+	algoUpdated := ncm.setAlgorithm(algoName)
+
 	for fieldName, fieldValue := range fields {
 		switch fieldName {
 		case "action":
@@ -336,10 +424,37 @@ func (ncm *NetCharManager) getControlsEntryHandler(key string, fields map[string
 	ncm.config.RecalculationPeriod = tickerPeriod
 	ncm.config.LogVerbose = logVerbose
 
+	// Process current scenario using new algorithm
+	if algoUpdated && ncm.isStarted {
+		err = ncm.algo.ProcessScenario(ncm.activeModel)
+		if err != nil {
+			log.Error("Failed to process active model with error: ", err)
+		} else {
+			ncm.updateNetChars()
+		}
+	}
+
 	ncm.applyAction()
 	return nil
 }
 
+// setAlgorithm - Replace NetChar algorithm if a different one is selected; returns true if replaced
+// NOTE: Must be called with ncm.mutex held as the algorithm is used by the recalculation ticker
+func (ncm *NetCharManager) setAlgorithm(algoName string) bool {
+	if algoName == ncm.config.Algorithm {
+		return false
+	}
+	algo, found := ncm.algos[algoName]
+	if !found {
+		log.Error("Unsupported NetChar algorithm: ", algoName)
+		return false
+	}
+	log.Info("NetChar algorithm updated from ", ncm.config.Algorithm, " to ", algoName)
+	ncm.algo = algo
+	ncm.config.Algorithm = algoName
+	return true
+}
+
 // applyAction - Execute the action in the configuration parameters for controls on the NetChar object
 func (ncm *NetCharManager) applyAction() {
 	switch ncm.config.Action {
@@ -74,3 +74,33 @@ func TestNetCharBasic(t *testing.T) {
 		t.Fatalf("NetChar should not be running")
 	}
 }
+
+func TestNetCharSetAlgorithm(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	segAlgo := &SegmentAlgorithm{}
+	mmfAlgo := &MaxMinFairAlgorithm{SegmentAlgorithm: &SegmentAlgorithm{}}
+	var ncm NetCharManager
+	ncm.config.Algorithm = NetCharAlgoSegment
+	ncm.algos = map[string]NetCharAlgo{NetCharAlgoSegment: segAlgo, NetCharAlgoMaxMinFair: mmfAlgo}
+	ncm.algo = segAlgo
+
+	fmt.Println("Keep current algorithm")
+	if ncm.setAlgorithm(NetCharAlgoSegment) || ncm.algo != segAlgo {
+		t.Fatalf("Algorithm should not be replaced")
+	}
+
+	fmt.Println("Reject unsupported algorithm")
+	if ncm.setAlgorithm("UNSUPPORTED") || ncm.algo != segAlgo || ncm.config.Algorithm != NetCharAlgoSegment {
+		t.Fatalf("Unsupported algorithm should be ignored")
+	}
+
+	fmt.Println("Switch algorithms using existing instances")
+	if !ncm.setAlgorithm(NetCharAlgoMaxMinFair) || ncm.algo != mmfAlgo || ncm.config.Algorithm != NetCharAlgoMaxMinFair {
+		t.Fatalf("Algorithm should be replaced by max-min fair algorithm")
+	}
+	if !ncm.setAlgorithm(NetCharAlgoSegment) || ncm.algo != segAlgo {
+		t.Fatalf("Algorithm should be replaced by existing segment algorithm")
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"database/sql"
 	"errors"
 	"strings"
+	"sync"
 
 	log "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-logger"
 
@@ -101,6 +102,7 @@ type Compute struct {
 
 type PoaInfo struct {
 	Distance float32
+	Radius   float32
 	SubType  string
 	InRange  bool
 }
@@ -119,6 +121,10 @@ type Connector struct {
 	db        *sql.DB
 	connected bool
 	updateCb  func(string, string)
+
+	hoPolicy      HandoverPolicy
+	ueHoPolicyMap map[string]HandoverPolicy
+	hoPolicyMutex sync.Mutex
 }
 
 // NewConnector - Creates and initializes a Postgis connector
@@ -131,6 +137,8 @@ func NewConnector(name, namespace, user, pwd, host, port string) (pc *Connector,
 	// Create new connector
 	pc = new(Connector)
 	pc.name = name
+	pc.hoPolicy = NewDefaultPolicy()
+	pc.ueHoPolicyMap = make(map[string]HandoverPolicy)
 	if namespace != "" {
 		pc.namespace = namespace
 	} else {
@@ -217,6 +225,64 @@ func (pc *Connector) notifyListener(cbType string, assetName string) {
 	}
 }
 
+// SetHandoverPolicy - Set handover policy used for UEs without a UE-specific policy
+func (pc *Connector) SetHandoverPolicy(policy HandoverPolicy) {
+	pc.hoPolicyMutex.Lock()
+	defer pc.hoPolicyMutex.Unlock()
+	if policy == nil {
+		policy = NewDefaultPolicy()
+	}
+	pc.hoPolicy = policy
+}
+
+// SetUeHandoverPolicy - Set UE-specific handover policy; nil policy removes it
+func (pc *Connector) SetUeHandoverPolicy(ueName string, policy HandoverPolicy) {
+	pc.hoPolicyMutex.Lock()
+	defer pc.hoPolicyMutex.Unlock()
+	if policy == nil {
+		delete(pc.ueHoPolicyMap, ueName)
+	} else {
+		pc.ueHoPolicyMap[ueName] = policy
+	}
+}
+
+// ResetHandoverPolicies - Restore default handover policy & remove all UE-specific policies
+func (pc *Connector) ResetHandoverPolicies() {
+	pc.hoPolicyMutex.Lock()
+	defer pc.hoPolicyMutex.Unlock()
+	pc.hoPolicy = NewDefaultPolicy()
+	pc.ueHoPolicyMap = make(map[string]HandoverPolicy)
+}
+
+// removeHandoverUe - Release state kept by handover policies for the provided UE; all UEs if empty
+func (pc *Connector) removeHandoverUe(ueName string) {
+	pc.hoPolicyMutex.Lock()
+	defer pc.hoPolicyMutex.Unlock()
+
+	policies := []HandoverPolicy{pc.hoPolicy}
+	if ueName == "" {
+		for _, policy := range pc.ueHoPolicyMap {
+			policies = append(policies, policy)
+		}
+	} else if policy, found := pc.ueHoPolicyMap[ueName]; found {
+		policies = append(policies, policy)
+	}
+	for _, policy := range policies {
+		if statePolicy, ok := policy.(UeStatePolicy); ok {
+			statePolicy.RemoveUe(ueName)
+		}
+	}
+}
+
+func (pc *Connector) getHandoverPolicy(ueName string) HandoverPolicy {
+	pc.hoPolicyMutex.Lock()
+	defer pc.hoPolicyMutex.Unlock()
+	if policy, found := pc.ueHoPolicyMap[ueName]; found {
+		return policy
+	}
+	return pc.hoPolicy
+}
+
 // CreateDb -- Create new DB with provided name
 func (pc *Connector) CreateDb(name string) (err error) {
 	_, err = pc.db.Exec("CREATE DATABASE " + name)
@@ -838,6 +904,9 @@ func (pc *Connector) DeleteUe(name string) (err error) {
 		return err
 	}
 
+	// Release UE handover state
+	pc.removeHandoverUe(name)
+
 	// Notify listener
 	pc.notifyListener(TypeUe, name)
 
@@ -900,6 +969,9 @@ func (pc *Connector) DeleteAllUe() (err error) {
 		return err
 	}
 
+	// Release UE handover state
+	pc.removeHandoverUe("")
+
 	// Notify listener
 	pc.notifyListener(TypeUe, "")
 
@@ -1033,26 +1105,50 @@ func (pc *Connector) refreshUePath(name string) (err error) {
 	return nil
 }
 
-// Recalculate nearest POA & POAs in range for provided UE
-func (pc *Connector) refreshUePoa(name string) (err error) {
+// GetUePoaInfo - Get distance & range information from provided UE to each POA
+func (pc *Connector) GetUePoaInfo(name string) (uePoaInfo *UePoaInfo, err error) {
+	// Validate input
+	if name == "" {
+		return nil, errors.New("Missing Name")
+	}
 
-	// Calculate distance from provided UE to each POA and check if within range
-	var rows *sql.Rows
-	rows, err = pc.db.Query(`
-		SELECT ue.name AS ue, ue.poa AS cur_poa, poa.name as poa, poa.type AS type,
+	uePoaInfoMap, err := pc.getUePoaInfo(name)
+	if err != nil {
+		return nil, err
+	}
+	uePoaInfo, found := uePoaInfoMap[name]
+	if !found {
+		return nil, errors.New("UE POA info not found")
+	}
+	return uePoaInfo, nil
+}
+
+// GetAllUePoaInfo - Get distance & range information from all UEs to each POA
+func (pc *Connector) GetAllUePoaInfo() (uePoaInfoMap map[string]*UePoaInfo, err error) {
+	return pc.getUePoaInfo("")
+}
+
+// Calculate distance from provided UE (or all UEs if no name provided) to each POA and check if within range
+func (pc *Connector) getUePoaInfo(name string) (uePoaInfoMap map[string]*UePoaInfo, err error) {
+	query := `
+		SELECT ue.name AS ue, ue.poa AS cur_poa, poa.name as poa, poa.type AS type, poa.radius AS radius,
 			ST_Distance(ue.position::geography, poa.position::geography) AS dist,
 			ST_DWithin(ue.position::geography, poa.position::geography, poa.radius) AS in_range
-		FROM `+UeTable+` AS ue, `+PoaTable+` AS poa
-		WHERE ue.name = ($1)`, name)
+		FROM ` + UeTable + ` AS ue, ` + PoaTable + ` AS poa`
+
+	var rows *sql.Rows
+	if name != "" {
+		rows, err = pc.db.Query(query+` WHERE ue.name = ($1)`, name)
+	} else {
+		rows, err = pc.db.Query(query)
+	}
 	if err != nil {
 		log.Error(err.Error())
-		return err
+		return nil, err
 	}
 	defer rows.Close()
 
-	poaInRange := []string{}
-	poaInfoMap := make(map[string]*PoaInfo)
-	currentPoa := ""
+	uePoaInfoMap = make(map[string]*UePoaInfo)
 
 	// Scan results
 	for rows.Next() {
@@ -1060,36 +1156,78 @@ func (pc *Connector) refreshUePoa(name string) (err error) {
 		curPoa := ""
 		poaName := ""
 		poaType := ""
+		radius := float32(0)
 		dist := float32(0)
 		inRange := false
 
-		err := rows.Scan(&ue, &curPoa, &poaName, &poaType, &dist, &inRange)
+		err := rows.Scan(&ue, &curPoa, &poaName, &poaType, &radius, &dist, &inRange)
 		if err != nil {
 			log.Error(err.Error())
-			return err
+			return nil, err
+		}
+
+		// Get/Create new UE-specific POA Info
+		uePoaInfo, found := uePoaInfoMap[ue]
+		if !found {
+			uePoaInfo = new(UePoaInfo)
+			uePoaInfo.PoaInRange = []string{}
+			uePoaInfo.PoaInfoMap = make(map[string]*PoaInfo)
+			uePoaInfo.CurrentPoa = ""
+			uePoaInfoMap[ue] = uePoaInfo
 		}
 
 		// Store POA Info
-		currentPoa = curPoa
+		uePoaInfo.CurrentPoa = curPoa
 		poaInfo := new(PoaInfo)
 		poaInfo.Distance = dist
+		poaInfo.Radius = radius
 		poaInfo.SubType = poaType
 		if inRange {
 			poaInfo.InRange = true
-			poaInRange = append(poaInRange, poaName)
+			uePoaInfo.PoaInRange = append(uePoaInfo.PoaInRange, poaName)
 		}
-		poaInfoMap[poaName] = poaInfo
+		uePoaInfo.PoaInfoMap[poaName] = poaInfo
 	}
 	err = rows.Err()
 	if err != nil {
 		log.Error(err)
 	}
+	return uePoaInfoMap, nil
+}
+
+// Recalculate nearest POA & POAs in range for provided UE
+func (pc *Connector) refreshUePoa(name string) (err error) {
+
+	// Calculate distance from provided UE to each POA and check if within range
+	uePoaInfoMap, err := pc.getUePoaInfo(name)
+	if err != nil {
+		return err
+	}
+	uePoaInfo, found := uePoaInfoMap[name]
+	if !found {
+		uePoaInfo = new(UePoaInfo)
+		uePoaInfo.PoaInRange = []string{}
+		uePoaInfo.PoaInfoMap = make(map[string]*PoaInfo)
+		uePoaInfo.CurrentPoa = ""
+	}
+
+	// Get current POA load, excluding UE, if required by handover policy
+	policy := pc.getHandoverPolicy(name)
+	var poaLoad map[string]int
+	if requiresPoaLoad(policy) {
+		poaLoad, err = pc.getPoaLoad()
+		if err != nil {
+			log.Error("Failed to get POA load: ", err.Error())
+		} else if uePoaInfo.CurrentPoa != "" {
+			poaLoad[uePoaInfo.CurrentPoa]--
+		}
+	}
 
 	// Select POA
-	selectedPoa := selectPoa(currentPoa, poaInRange, poaInfoMap)
+	selectedPoa := policy.SelectPoa(name, uePoaInfo, poaLoad)
 	distance := float32(0)
 	if selectedPoa != "" {
-		distance = poaInfoMap[selectedPoa].Distance
+		distance = uePoaInfo.PoaInfoMap[selectedPoa].Distance
 	}
 
 	// Update POA entries for UE
@@ -1098,7 +1236,7 @@ func (pc *Connector) refreshUePoa(name string) (err error) {
 			poa_distance = $3,
 			poa_in_range = $4
 		WHERE name = ($1)`
-	_, err = pc.db.Exec(query, name, selectedPoa, distance, pq.Array(poaInRange))
+	_, err = pc.db.Exec(query, name, selectedPoa, distance, pq.Array(uePoaInfo.PoaInRange))
 	if err != nil {
 		log.Error(err.Error())
 		return err
@@ -1109,61 +1247,11 @@ func (pc *Connector) refreshUePoa(name string) (err error) {
 // Recalculate nearest POA & POAs in range for all UEs
 func (pc *Connector) refreshAllUePoa() (err error) {
 
-	// Calculate distance from provided UE to each POA and check if within range
-	var rows *sql.Rows
-	rows, err = pc.db.Query(`
-		SELECT ue.name AS ue, ue.poa AS cur_poa, poa.name as poa, poa.type AS type,
-			ST_Distance(ue.position::geography, poa.position::geography) AS dist,
-			ST_DWithin(ue.position::geography, poa.position::geography, poa.radius) AS in_range
-		FROM ` + UeTable + `, ` + PoaTable)
+	// Calculate distance from each UE to each POA and check if within range
+	uePoaInfoMap, err := pc.getUePoaInfo("")
 	if err != nil {
-		log.Error(err.Error())
 		return err
 	}
-	defer rows.Close()
-
-	uePoaInfoMap := make(map[string]*UePoaInfo)
-
-	// Scan results
-	for rows.Next() {
-		ue := ""
-		curPoa := ""
-		poaName := ""
-		poaType := ""
-		dist := float32(0)
-		inRange := false
-
-		err := rows.Scan(&ue, &curPoa, &poaName, &poaType, &dist, &inRange)
-		if err != nil {
-			log.Error(err.Error())
-			return err
-		}
-
-		// Get/Create new UE-specific POA Info
-		uePoaInfo, found := uePoaInfoMap[ue]
-		if !found {
-			uePoaInfo = new(UePoaInfo)
-			uePoaInfo.PoaInRange = []string{}
-			uePoaInfo.PoaInfoMap = make(map[string]*PoaInfo)
-			uePoaInfo.CurrentPoa = ""
-			uePoaInfoMap[ue] = uePoaInfo
-		}
-
-		// Store POA Info
-		uePoaInfo.CurrentPoa = curPoa
-		poaInfo := new(PoaInfo)
-		poaInfo.Distance = dist
-		poaInfo.SubType = poaType
-		if inRange {
-			poaInfo.InRange = true
-			uePoaInfo.PoaInRange = append(uePoaInfo.PoaInRange, poaName)
-		}
-		uePoaInfo.PoaInfoMap[poaName] = poaInfo
-	}
-	err = rows.Err()
-	if err != nil {
-		log.Error(err)
-	}
 
 	// If no POAs found, reset all UE POA info
 	if len(uePoaInfoMap) == 0 {
@@ -1197,12 +1285,37 @@ func (pc *Connector) refreshAllUePoa() (err error) {
 	}
 
 	// Select & update POA for all UEs
+	// POA load is only retrieved once a handover policy requires it, then kept up to date with selected POAs
+	var poaLoad map[string]int
+	poaLoadFailed := false
 	for ue, uePoaInfo := range uePoaInfoMap {
+		policy := pc.getHandoverPolicy(ue)
+		if poaLoad == nil && !poaLoadFailed && requiresPoaLoad(policy) {
+			poaLoad, err = pc.getPoaLoad()
+			if err != nil {
+				log.Error("Failed to get POA load: ", err.Error())
+				poaLoadFailed = true
+			}
+		}
+
+		// Exclude UE from load of its current POA
+		if poaLoad != nil && uePoaInfo.CurrentPoa != "" {
+			poaLoad[uePoaInfo.CurrentPoa]--
+		}
+
 		// Select POA
-		selectedPoa := selectPoa(uePoaInfo.CurrentPoa, uePoaInfo.PoaInRange, uePoaInfo.PoaInfoMap)
+		var selectedPoa string
+		if requiresPoaLoad(policy) {
+			selectedPoa = policy.SelectPoa(ue, uePoaInfo, poaLoad)
+		} else {
+			selectedPoa = policy.SelectPoa(ue, uePoaInfo, nil)
+		}
 		distance := float32(0)
 		if selectedPoa != "" {
 			distance = uePoaInfo.PoaInfoMap[selectedPoa].Distance
+			if poaLoad != nil {
+				poaLoad[selectedPoa]++
+			}
 		}
 
 		// Update in DB
@@ -1221,20 +1334,39 @@ func (pc *Connector) refreshAllUePoa() (err error) {
 	return nil
 }
 
-// POA Selection Algorithm
+// Get number of UEs served by each POA
+func (pc *Connector) getPoaLoad() (poaLoad map[string]int, err error) {
+	var rows *sql.Rows
+	rows, err = pc.db.Query(`SELECT poa, COUNT(*) FROM ` + UeTable + ` WHERE poa <> '' GROUP BY poa`)
+	if err != nil {
+		log.Error(err.Error())
+		return nil, err
+	}
+	defer rows.Close()
+
+	poaLoad = make(map[string]int)
+	for rows.Next() {
+		poa := ""
+		count := 0
+		err = rows.Scan(&poa, &count)
+		if err != nil {
+			log.Error(err.Error())
+			return nil, err
+		}
+		poaLoad[poa] = count
+	}
+	err = rows.Err()
+	if err != nil {
+		log.Error(err)
+	}
+	return poaLoad, nil
+}
+
+// Default POA Selection Algorithm
 func selectPoa(currentPoa string, poaInRange []string, poaInfoMap map[string]*PoaInfo) (selectedPoa string) {
 	if len(poaInRange) == 0 {
-		// Stay on current POA if it still exists
-		if _, found := poaInfoMap[currentPoa]; found {
-			selectedPoa = currentPoa
-		} else {
-			// Get nearest POA
-			for poa, poaInfo := range poaInfoMap {
-				if selectedPoa == "" || poaInfo.Distance < poaInfoMap[selectedPoa].Distance {
-					selectedPoa = poa
-				}
-			}
-		}
+		// Stay on current POA if it still exists, otherwise get nearest POA
+		selectedPoa = selectPoaOutOfRange(currentPoa, poaInfoMap)
 	} else if len(poaInRange) == 1 {
 		// Select only available POA
 		selectedPoa = poaInRange[0]
@@ -1036,6 +1036,124 @@ func TestPostgisMovement(t *testing.T) {
 	// t.Fatalf("DONE")
 }
 
+func TestPostgisProximity(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	// Create Connector
+	fmt.Println("Create valid Postgis Connector")
+	pc, err := NewConnector(pcName, pcNamespace, pcDBUser, pcDBPwd, pcDBHost, pcDBPort)
+	if err != nil || pc == nil {
+		t.Fatalf("Failed to create postgis Connector")
+	}
+
+	// Cleanup
+	_ = pc.DeleteTables()
+
+	// Create tables
+	fmt.Println("Create Tables")
+	err = pc.CreateTables()
+	if err != nil {
+		t.Fatalf("Failed to create tables")
+	}
+
+	// Add POAs & UEs
+	fmt.Println("Add POAs & UEs")
+	err = pc.CreatePoa(poa1Id, poa1Name, poa1Type, poa1Loc, poa1Radius)
+	if err != nil {
+		t.Fatalf("Failed to create asset")
+	}
+	err = pc.CreatePoa(poa2Id, poa2Name, poa2Type, poa2Loc, poa2Radius)
+	if err != nil {
+		t.Fatalf("Failed to create asset")
+	}
+	err = pc.CreatePoa(poa3Id, poa3Name, poa3Type, poa3Loc, poa3Radius)
+	if err != nil {
+		t.Fatalf("Failed to create asset")
+	}
+	err = pc.CreateUe(ue1Id, ue1Name, ue1Loc, ue1Path, ue1PathMode, ue1Velocity)
+	if err != nil {
+		t.Fatalf("Failed to create asset")
+	}
+	err = pc.CreateUe(ue2Id, ue2Name, ue2Loc, ue2Path, ue2PathMode, ue2Velocity)
+	if err != nil {
+		t.Fatalf("Failed to create asset")
+	}
+	err = pc.CreateUe(ue3Id, ue3Name, ue3Loc, ue3Path, ue3PathMode, ue3Velocity)
+	if err != nil {
+		t.Fatalf("Failed to create asset")
+	}
+
+	// Invalid input
+	fmt.Println("Get POAs in proximity with invalid input")
+	_, err = pc.GetUePoaInProximity("", 0, 0)
+	if err == nil {
+		t.Fatalf("Missing UE name should fail")
+	}
+	_, err = pc.GetUePoaInProximity(ue1Name, -1, 0)
+	if err == nil {
+		t.Fatalf("Negative proximity distance should fail")
+	}
+	_, err = pc.GetAllUePoaInProximity(0, -1)
+	if err == nil {
+		t.Fatalf("Negative proximity time should fail")
+	}
+	_, err = pc.GetUePoaInProximity("ue-unknown", 0, 0)
+	if err == nil {
+		t.Fatalf("Unknown UE should fail")
+	}
+
+	// Moving UE
+	fmt.Println("Get POAs in proximity of moving UE")
+	poaList, err := pc.GetUePoaInProximity(ue1Name, 0, 0)
+	if err != nil || !validatePoaList(poaList, []string{poa1Name}) {
+		t.Fatalf("Invalid POAs in proximity at current position")
+	}
+	poaList, err = pc.GetUePoaInProximity(ue1Name, 300, 0)
+	if err != nil || !validatePoaList(poaList, []string{poa1Name, poa2Name}) {
+		t.Fatalf("Invalid POAs in proximity distance")
+	}
+	poaList, err = pc.GetUePoaInProximity(ue1Name, 0, 60)
+	if err != nil || !validatePoaList(poaList, []string{poa1Name, poa2Name}) {
+		t.Fatalf("Invalid POAs in proximity time")
+	}
+
+	// Stationary UE
+	fmt.Println("Get POAs in proximity of stationary UE")
+	poaList, err = pc.GetUePoaInProximity(ue2Name, 1000, 1000)
+	if err != nil || !validatePoaList(poaList, []string{poa2Name}) {
+		t.Fatalf("Stationary UE should only return POAs in range")
+	}
+
+	// All UEs
+	fmt.Println("Get POAs in proximity of all UEs")
+	poaMap, err := pc.GetAllUePoaInProximity(300, 10)
+	if err != nil || len(poaMap) != 3 {
+		t.Fatalf("Failed to get POAs in proximity of all UEs")
+	}
+	if !validatePoaList(poaMap[ue1Name], []string{poa1Name, poa2Name}) ||
+		!validatePoaList(poaMap[ue2Name], []string{poa2Name}) ||
+		!validatePoaList(poaMap[ue3Name], []string{poa1Name, poa3Name}) {
+		t.Fatalf("Invalid POAs in proximity of all UEs")
+	}
+}
+
+func validatePoaList(poaList []string, expectedPoaList []string) bool {
+	if len(poaList) != len(expectedPoaList) {
+		fmt.Println("len(poaList) != len(expectedPoaList)")
+		return false
+	}
+	sort.Strings(poaList)
+	sort.Strings(expectedPoaList)
+	for i := range poaList {
+		if poaList[i] != expectedPoaList[i] {
+			fmt.Println("poaList[i] != expectedPoaList[i]")
+			return false
+		}
+	}
+	return true
+}
+
 func validateUe(ue *Ue, id string, name string, position string, path string,
 	mode string, velocity float32, length float32, increment float32, fraction float32,
 	poa string, distance float32, poaInRange []string) bool {
@@ -0,0 +1,335 @@
+/*
+ * Copyright (c) 2020  InterDigital Communications, Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package postgisdb
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Handover Policies
+const (
+	HoPolicyDefault         = "DEFAULT"
+	HoPolicyStrongestSignal = "STRONGEST-SIGNAL"
+	HoPolicyHysteresis      = "HYSTERESIS"
+	HoPolicyLoadAware       = "LOAD-AWARE"
+	HoPolicyRatPreference   = "RAT-PREFERENCE"
+)
+
+// Handover Policy Parameters
+const (
+	HoParamHysteresis    = "hysteresis"
+	HoParamTimeToTrigger = "timeToTrigger"
+	HoParamRatPreference = "ratPreference"
+)
+
+// HandoverPolicy - POA selection policy invoked on every UE POA refresh
+type HandoverPolicy interface {
+	// SelectPoa - Returns the POA to use for the provided UE
+	// poaLoad contains the number of other UEs currently served by each POA; it is only
+	// provided to policies implementing PoaLoadPolicy and is nil otherwise
+	SelectPoa(ue string, uePoaInfo *UePoaInfo, poaLoad map[string]int) string
+}
+
+// PoaLoadPolicy - Optional interface of handover policies selecting POAs based on POA load
+type PoaLoadPolicy interface {
+	// RequiresPoaLoad - Returns true if POA load must be provided on POA selection
+	RequiresPoaLoad() bool
+}
+
+// UeStatePolicy - Optional interface of handover policies keeping UE-specific state
+type UeStatePolicy interface {
+	// RemoveUe - Release state kept for the provided UE; all UEs if empty
+	RemoveUe(ue string)
+}
+
+// NewHandoverPolicy - Creates a handover policy from its name & string parameters
+func NewHandoverPolicy(name string, params map[string]string) (policy HandoverPolicy, err error) {
+	switch strings.ToUpper(name) {
+	case "", HoPolicyDefault:
+		policy = NewDefaultPolicy()
+	case HoPolicyStrongestSignal:
+		policy = NewStrongestSignalPolicy()
+	case HoPolicyHysteresis:
+		hysteresis := float64(0)
+		if value, found := params[HoParamHysteresis]; found {
+			hysteresis, err = strconv.ParseFloat(value, 32)
+			if err != nil || hysteresis < 0 {
+				return nil, errors.New("Invalid hysteresis: " + value)
+			}
+		}
+		ttt := time.Duration(0)
+		if value, found := params[HoParamTimeToTrigger]; found {
+			ttt, err = time.ParseDuration(value)
+			if err != nil || ttt < 0 {
+				return nil, errors.New("Invalid time to trigger: " + value)
+			}
+		}
+		policy = NewHysteresisPolicy(float32(hysteresis), ttt)
+	case HoPolicyLoadAware:
+		policy = NewLoadAwarePolicy()
+	case HoPolicyRatPreference:
+		var rats []string
+		if value, found := params[HoParamRatPreference]; found {
+			for _, rat := range strings.Split(value, ",") {
+				if rat = strings.TrimSpace(rat); rat != "" {
+					rats = append(rats, rat)
+				}
+			}
+		}
+		if len(rats) == 0 {
+			return nil, errors.New("Missing RAT preference")
+		}
+		policy = NewRatPreferencePolicy(rats)
+	default:
+		return nil, errors.New("Unsupported handover policy: " + name)
+	}
+	return policy, nil
+}
+
+// ---------------------------- Default Policy ---------------------------------
+
+// DefaultPolicy - Stay on current POA until out of range or more localized RAT is in range
+type DefaultPolicy struct{}
+
+// NewDefaultPolicy - Creates a default handover policy
+func NewDefaultPolicy() *DefaultPolicy {
+	return new(DefaultPolicy)
+}
+
+// SelectPoa - Select POA using default policy
+func (p *DefaultPolicy) SelectPoa(ue string, uePoaInfo *UePoaInfo, poaLoad map[string]int) string {
+	return selectPoa(uePoaInfo.CurrentPoa, uePoaInfo.PoaInRange, uePoaInfo.PoaInfoMap)
+}
+
+// ---------------------------- Strongest Signal Policy ------------------------
+
+// StrongestSignalPolicy - Always select the nearest POA in range
+type StrongestSignalPolicy struct{}
+
+// NewStrongestSignalPolicy - Creates a strongest signal handover policy
+func NewStrongestSignalPolicy() *StrongestSignalPolicy {
+	return new(StrongestSignalPolicy)
+}
+
+// SelectPoa - Select POA using strongest signal policy
+func (p *StrongestSignalPolicy) SelectPoa(ue string, uePoaInfo *UePoaInfo, poaLoad map[string]int) string {
+	if len(uePoaInfo.PoaInRange) == 0 {
+		return selectPoaOutOfRange(uePoaInfo.CurrentPoa, uePoaInfo.PoaInfoMap)
+	}
+	return getNearestPoa(uePoaInfo.PoaInRange, uePoaInfo.PoaInfoMap)
+}
+
+// ---------------------------- Hysteresis Policy ------------------------------
+
+type hoCandidate struct {
+	poa   string
+	start time.Time
+}
+
+// HysteresisPolicy - Switch to a nearer POA in range only if it is nearer than
+// the current POA by at least the hysteresis distance for the time to trigger
+type HysteresisPolicy struct {
+	hysteresis float32
+	ttt        time.Duration
+	candidates map[string]*hoCandidate
+	mutex      sync.Mutex
+	now        func() time.Time
+}
+
+// NewHysteresisPolicy - Creates a hysteresis handover policy
+// hysteresis is a distance in meters; ttt is the time to trigger
+func NewHysteresisPolicy(hysteresis float32, ttt time.Duration) *HysteresisPolicy {
+	p := new(HysteresisPolicy)
+	p.hysteresis = hysteresis
+	p.ttt = ttt
+	p.candidates = make(map[string]*hoCandidate)
+	p.now = time.Now
+	return p
+}
+
+// SelectPoa - Select POA using hysteresis policy
+func (p *HysteresisPolicy) SelectPoa(ue string, uePoaInfo *UePoaInfo, poaLoad map[string]int) string {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if len(uePoaInfo.PoaInRange) == 0 {
+		delete(p.candidates, ue)
+		return selectPoaOutOfRange(uePoaInfo.CurrentPoa, uePoaInfo.PoaInfoMap)
+	}
+
+	// Select nearest POA immediately if current POA is out of range
+	nearestPoa := getNearestPoa(uePoaInfo.PoaInRange, uePoaInfo.PoaInfoMap)
+	currentPoaInfo, found := uePoaInfo.PoaInfoMap[uePoaInfo.CurrentPoa]
+	if !found || !currentPoaInfo.InRange {
+		delete(p.candidates, ue)
+		return nearestPoa
+	}
+
+	// Stay on current POA if no POA exceeds hysteresis
+	if nearestPoa == uePoaInfo.CurrentPoa ||
+		uePoaInfo.PoaInfoMap[nearestPoa].Distance+p.hysteresis >= currentPoaInfo.Distance {
+		delete(p.candidates, ue)
+		return uePoaInfo.CurrentPoa
+	}
+
+	// Start time to trigger if candidate changed
+	now := p.now()
+	candidate, found := p.candidates[ue]
+	if !found || candidate.poa != nearestPoa {
+		candidate = &hoCandidate{poa: nearestPoa, start: now}
+		p.candidates[ue] = candidate
+	}
+
+	// Handover once time to trigger has expired
+	if now.Sub(candidate.start) >= p.ttt {
+		delete(p.candidates, ue)
+		return nearestPoa
+	}
+	return uePoaInfo.CurrentPoa
+}
+
+// RemoveUe - Release handover candidate of the provided UE; all UEs if empty
+func (p *HysteresisPolicy) RemoveUe(ue string) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if ue == "" {
+		p.candidates = make(map[string]*hoCandidate)
+	} else {
+		delete(p.candidates, ue)
+	}
+}
+
+// ---------------------------- Load-Aware Policy ------------------------------
+
+// LoadAwarePolicy - Select the least loaded POA in range, nearest first on ties
+type LoadAwarePolicy struct{}
+
+// NewLoadAwarePolicy - Creates a load-aware handover policy
+func NewLoadAwarePolicy() *LoadAwarePolicy {
+	return new(LoadAwarePolicy)
+}
+
+// RequiresPoaLoad - Load-aware policy requires POA load
+func (p *LoadAwarePolicy) RequiresPoaLoad() bool {
+	return true
+}
+
+// SelectPoa - Select POA using load-aware policy
+func (p *LoadAwarePolicy) SelectPoa(ue string, uePoaInfo *UePoaInfo, poaLoad map[string]int) string {
+	if len(uePoaInfo.PoaInRange) == 0 {
+		return selectPoaOutOfRange(uePoaInfo.CurrentPoa, uePoaInfo.PoaInfoMap)
+	}
+
+	selectedPoa := ""
+	for _, poa := range uePoaInfo.PoaInRange {
+		if selectedPoa == "" || poaLoad[poa] < poaLoad[selectedPoa] ||
+			(poaLoad[poa] == poaLoad[selectedPoa] &&
+				uePoaInfo.PoaInfoMap[poa].Distance < uePoaInfo.PoaInfoMap[selectedPoa].Distance) {
+			selectedPoa = poa
+		}
+	}
+
+	// Stay on current POA if it is in range and as lightly loaded as the selected POA
+	currentPoaInfo, found := uePoaInfo.PoaInfoMap[uePoaInfo.CurrentPoa]
+	if found && currentPoaInfo.InRange && poaLoad[uePoaInfo.CurrentPoa] <= poaLoad[selectedPoa] {
+		return uePoaInfo.CurrentPoa
+	}
+	return selectedPoa
+}
+
+// ---------------------------- RAT Preference Policy --------------------------
+
+// RatPreferencePolicy - Select the nearest POA in range with the most preferred RAT
+type RatPreferencePolicy struct {
+	priority map[string]int
+}
+
+// NewRatPreferencePolicy - Creates a RAT preference handover policy
+// rats contains POA sub-types ordered from most to least preferred
+func NewRatPreferencePolicy(rats []string) *RatPreferencePolicy {
+	p := new(RatPreferencePolicy)
+	p.priority = make(map[string]int)
+	for i, rat := range rats {
+		p.priority[rat] = len(rats) - i
+	}
+	return p
+}
+
+// SelectPoa - Select POA using RAT preference policy
+func (p *RatPreferencePolicy) SelectPoa(ue string, uePoaInfo *UePoaInfo, poaLoad map[string]int) string {
+	if len(uePoaInfo.PoaInRange) == 0 {
+		return selectPoaOutOfRange(uePoaInfo.CurrentPoa, uePoaInfo.PoaInfoMap)
+	}
+
+	// Start with current POA as selected POA, if still in range
+	selectedPoa := ""
+	currentPoaInfo, found := uePoaInfo.PoaInfoMap[uePoaInfo.CurrentPoa]
+	if found && currentPoaInfo.InRange {
+		selectedPoa = uePoaInfo.CurrentPoa
+	}
+
+	// Look for POA in range with a more preferred RAT, or nearest POA if no POA selected yet
+	for _, poa := range uePoaInfo.PoaInRange {
+		if selectedPoa == "" {
+			selectedPoa = poa
+			continue
+		}
+		poaPriority := p.priority[uePoaInfo.PoaInfoMap[poa].SubType]
+		selectedPriority := p.priority[uePoaInfo.PoaInfoMap[selectedPoa].SubType]
+		if poaPriority > selectedPriority ||
+			(poaPriority == selectedPriority && selectedPoa != uePoaInfo.CurrentPoa &&
+				uePoaInfo.PoaInfoMap[poa].Distance < uePoaInfo.PoaInfoMap[selectedPoa].Distance) {
+			selectedPoa = poa
+		}
+	}
+	return selectedPoa
+}
+
+// ---------------------------- Helpers ----------------------------------------
+
+// Check if POA load must be provided to policy
+func requiresPoaLoad(policy HandoverPolicy) bool {
+	loadPolicy, ok := policy.(PoaLoadPolicy)
+	return ok && loadPolicy.RequiresPoaLoad()
+}
+
+// Stay on current POA if it still exists, otherwise select nearest POA
+func selectPoaOutOfRange(currentPoa string, poaInfoMap map[string]*PoaInfo) (selectedPoa string) {
+	if _, found := poaInfoMap[currentPoa]; found {
+		return currentPoa
+	}
+	for poa, poaInfo := range poaInfoMap {
+		if selectedPoa == "" || poaInfo.Distance < poaInfoMap[selectedPoa].Distance {
+			selectedPoa = poa
+		}
+	}
+	return selectedPoa
+}
+
+// Get nearest POA from provided list
+func getNearestPoa(poaList []string, poaInfoMap map[string]*PoaInfo) (selectedPoa string) {
+	for _, poa := range poaList {
+		if selectedPoa == "" || poaInfoMap[poa].Distance < poaInfoMap[selectedPoa].Distance {
+			selectedPoa = poa
+		}
+	}
+	return selectedPoa
+}
@@ -0,0 +1,165 @@
+/*
+ * Copyright (c) 2020  InterDigital Communications, Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package postgisdb
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	log "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-logger"
+)
+
+func newTestUePoaInfo(currentPoa string) *UePoaInfo {
+	uePoaInfo := new(UePoaInfo)
+	uePoaInfo.CurrentPoa = currentPoa
+	uePoaInfo.PoaInfoMap = map[string]*PoaInfo{
+		"cell1": {Distance: 100, SubType: PoaTypeCell4g, InRange: true},
+		"cell2": {Distance: 60, SubType: PoaTypeCell4g, InRange: true},
+		"wifi1": {Distance: 80, SubType: PoaTypeWifi, InRange: true},
+		"cell3": {Distance: 500, SubType: PoaTypeCell5g, InRange: false},
+	}
+	uePoaInfo.PoaInRange = []string{"cell1", "cell2", "wifi1"}
+	return uePoaInfo
+}
+
+func TestHandoverPolicyNew(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	fmt.Println("Invalid policies")
+	if _, err := NewHandoverPolicy("invalid", nil); err == nil {
+		t.Fatalf("Policy creation should have failed")
+	}
+	if _, err := NewHandoverPolicy(HoPolicyHysteresis, map[string]string{HoParamHysteresis: "-1"}); err == nil {
+		t.Fatalf("Policy creation should have failed")
+	}
+	if _, err := NewHandoverPolicy(HoPolicyHysteresis, map[string]string{HoParamTimeToTrigger: "invalid"}); err == nil {
+		t.Fatalf("Policy creation should have failed")
+	}
+	if _, err := NewHandoverPolicy(HoPolicyRatPreference, nil); err == nil {
+		t.Fatalf("Policy creation should have failed")
+	}
+
+	fmt.Println("Valid policies")
+	if policy, err := NewHandoverPolicy("", nil); err != nil || policy == nil {
+		t.Fatalf("Failed to create default policy")
+	}
+	if policy, err := NewHandoverPolicy(HoPolicyStrongestSignal, nil); err != nil || policy == nil {
+		t.Fatalf("Failed to create strongest signal policy")
+	}
+	params := map[string]string{HoParamHysteresis: "10", HoParamTimeToTrigger: "2s"}
+	if policy, err := NewHandoverPolicy(HoPolicyHysteresis, params); err != nil || policy == nil {
+		t.Fatalf("Failed to create hysteresis policy")
+	}
+	if policy, err := NewHandoverPolicy(HoPolicyLoadAware, nil); err != nil || policy == nil {
+		t.Fatalf("Failed to create load-aware policy")
+	}
+	params = map[string]string{HoParamRatPreference: PoaTypeWifi + ", " + PoaTypeCell5g}
+	if policy, err := NewHandoverPolicy(HoPolicyRatPreference, params); err != nil || policy == nil {
+		t.Fatalf("Failed to create RAT preference policy")
+	}
+}
+
+func TestHandoverPolicySelectPoa(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	fmt.Println("Default policy")
+	policy := NewDefaultPolicy()
+	if poa := policy.SelectPoa("ue1", newTestUePoaInfo("cell1"), nil); poa != "wifi1" {
+		t.Fatalf("Wrong POA: %s", poa)
+	}
+
+	fmt.Println("Strongest signal policy")
+	ssPolicy := NewStrongestSignalPolicy()
+	if poa := ssPolicy.SelectPoa("ue1", newTestUePoaInfo("cell1"), nil); poa != "cell2" {
+		t.Fatalf("Wrong POA: %s", poa)
+	}
+	uePoaInfo := newTestUePoaInfo("cell3")
+	uePoaInfo.PoaInRange = []string{}
+	if poa := ssPolicy.SelectPoa("ue1", uePoaInfo, nil); poa != "cell3" {
+		t.Fatalf("Wrong POA: %s", poa)
+	}
+
+	fmt.Println("Hysteresis policy")
+	now := time.Now()
+	hoPolicy := NewHysteresisPolicy(50, time.Second)
+	hoPolicy.now = func() time.Time { return now }
+	if poa := hoPolicy.SelectPoa("ue1", newTestUePoaInfo("cell1"), nil); poa != "cell1" {
+		t.Fatalf("Wrong POA: %s", poa)
+	}
+	hoPolicy = NewHysteresisPolicy(10, time.Second)
+	hoPolicy.now = func() time.Time { return now }
+	if poa := hoPolicy.SelectPoa("ue1", newTestUePoaInfo("cell1"), nil); poa != "cell1" {
+		t.Fatalf("Wrong POA: %s", poa)
+	}
+	now = now.Add(500 * time.Millisecond)
+	if poa := hoPolicy.SelectPoa("ue1", newTestUePoaInfo("cell1"), nil); poa != "cell1" {
+		t.Fatalf("Wrong POA: %s", poa)
+	}
+	now = now.Add(500 * time.Millisecond)
+	if poa := hoPolicy.SelectPoa("ue1", newTestUePoaInfo("cell1"), nil); poa != "cell2" {
+		t.Fatalf("Wrong POA: %s", poa)
+	}
+	if poa := hoPolicy.SelectPoa("ue2", newTestUePoaInfo("cell3"), nil); poa != "cell2" {
+		t.Fatalf("Wrong POA: %s", poa)
+	}
+
+	fmt.Println("Hysteresis policy UE removal")
+	_ = hoPolicy.SelectPoa("ue1", newTestUePoaInfo("cell1"), nil)
+	_ = hoPolicy.SelectPoa("ue3", newTestUePoaInfo("cell1"), nil)
+	if len(hoPolicy.candidates) != 2 {
+		t.Fatalf("Invalid candidates")
+	}
+	hoPolicy.RemoveUe("ue1")
+	if _, found := hoPolicy.candidates["ue1"]; found || len(hoPolicy.candidates) != 1 {
+		t.Fatalf("UE candidate not removed")
+	}
+	hoPolicy.RemoveUe("")
+	if len(hoPolicy.candidates) != 0 {
+		t.Fatalf("UE candidates not removed")
+	}
+
+	fmt.Println("Load-aware policy")
+	laPolicy := NewLoadAwarePolicy()
+	if !requiresPoaLoad(laPolicy) || requiresPoaLoad(hoPolicy) || requiresPoaLoad(NewDefaultPolicy()) {
+		t.Fatalf("Invalid POA load requirement")
+	}
+	poaLoad := map[string]int{"cell1": 3, "cell2": 2, "wifi1": 2}
+	if poa := laPolicy.SelectPoa("ue1", newTestUePoaInfo(""), poaLoad); poa != "cell2" {
+		t.Fatalf("Wrong POA: %s", poa)
+	}
+	if poa := laPolicy.SelectPoa("ue1", newTestUePoaInfo("wifi1"), poaLoad); poa != "wifi1" {
+		t.Fatalf("Wrong POA: %s", poa)
+	}
+	if poa := laPolicy.SelectPoa("ue1", newTestUePoaInfo("cell1"), poaLoad); poa != "cell2" {
+		t.Fatalf("Wrong POA: %s", poa)
+	}
+
+	fmt.Println("RAT preference policy")
+	ratPolicy := NewRatPreferencePolicy([]string{PoaTypeCell4g, PoaTypeWifi})
+	if poa := ratPolicy.SelectPoa("ue1", newTestUePoaInfo(""), nil); poa != "cell2" {
+		t.Fatalf("Wrong POA: %s", poa)
+	}
+	if poa := ratPolicy.SelectPoa("ue1", newTestUePoaInfo("cell1"), nil); poa != "cell1" {
+		t.Fatalf("Wrong POA: %s", poa)
+	}
+	if poa := ratPolicy.SelectPoa("ue1", newTestUePoaInfo("wifi1"), nil); poa != "cell2" {
+		t.Fatalf("Wrong POA: %s", poa)
+	}
+}
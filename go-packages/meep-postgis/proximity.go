@@ -0,0 +1,166 @@
+/*
+ * Copyright (c) 2020  InterDigital Communications, Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package postgisdb
+
+import (
+	"database/sql"
+	"errors"
+	"math"
+
+	log "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-logger"
+
+	"github.com/lib/pq"
+)
+
+// pathSection - Portion of a UE path, expressed as fractions of the path length
+type pathSection struct {
+	start float64
+	end   float64
+}
+
+// GetUePoaInProximity - Get POAs whose coverage area the UE reaches along its path
+// within the provided distance (m) or time-to-arrival (s), including POAs already in range.
+// Stationary UEs only return the POAs in range of their current position.
+func (pc *Connector) GetUePoaInProximity(name string, distance float32, timeToArrival float32) (poaInProximity []string, err error) {
+	// Validate input
+	if name == "" {
+		return nil, errors.New("Missing Name")
+	}
+	if distance < 0 || timeToArrival < 0 {
+		return nil, errors.New("Invalid proximity")
+	}
+
+	// Get UE position along path
+	ue, err := pc.GetUe(name)
+	if err != nil {
+		return nil, err
+	}
+
+	poaInProximityMap, err := pc.getUePoaInProximity(map[string]*Ue{name: ue}, distance, timeToArrival)
+	if err != nil {
+		return nil, err
+	}
+	return poaInProximityMap[name], nil
+}
+
+// GetAllUePoaInProximity - Get POAs in proximity of all UEs, indexed by UE name
+// NOTE: Uses a single query for all moving UEs
+func (pc *Connector) GetAllUePoaInProximity(distance float32, timeToArrival float32) (poaInProximityMap map[string][]string, err error) {
+	// Validate input
+	if distance < 0 || timeToArrival < 0 {
+		return nil, errors.New("Invalid proximity")
+	}
+
+	// Get all UE positions along their path
+	ueMap, err := pc.GetAllUe()
+	if err != nil {
+		return nil, err
+	}
+	return pc.getUePoaInProximity(ueMap, distance, timeToArrival)
+}
+
+// getUePoaInProximity - Get POAs in proximity of the provided UEs
+func (pc *Connector) getUePoaInProximity(ueMap map[string]*Ue, distance float32, timeToArrival float32) (poaInProximityMap map[string][]string, err error) {
+	poaInProximityMap = make(map[string][]string)
+
+	// Determine path sections travelled by each moving UE within the proximity distance or time
+	ueNames := []string{}
+	sectionStarts := []float64{}
+	sectionEnds := []float64{}
+	for name, ue := range ueMap {
+		poaInProximityMap[name] = []string{}
+		if ue.Path == "" || ue.PathVelocity <= 0 || ue.PathLength <= 0 {
+			poaInProximityMap[name] = append(poaInProximityMap[name], ue.PoaInRange...)
+			continue
+		}
+		lookahead := math.Max(float64(distance), float64(ue.PathVelocity)*float64(timeToArrival))
+		for _, section := range getProjectedPathSections(ue.PathMode, float64(ue.PathFraction), lookahead/float64(ue.PathLength)) {
+			ueNames = append(ueNames, name)
+			sectionStarts = append(sectionStarts, section.start)
+			sectionEnds = append(sectionEnds, section.end)
+		}
+	}
+	if len(ueNames) == 0 {
+		return poaInProximityMap, nil
+	}
+
+	// Find POAs with coverage area intersecting the projected path sections
+	var rows *sql.Rows
+	rows, err = pc.db.Query(`
+		SELECT DISTINCT section.ue, poa.name
+		FROM unnest(($1)::text[], ($2)::float8[], ($3)::float8[]) AS section(ue, start_fraction, end_fraction)
+			JOIN `+UeTable+` AS ue ON ue.name = section.ue, `+PoaTable+` AS poa
+		WHERE ST_DWithin(ST_LineSubstring(ue.path, section.start_fraction, section.end_fraction)::geography, poa.position::geography, poa.radius)`,
+		pq.Array(ueNames), pq.Array(sectionStarts), pq.Array(sectionEnds))
+	if err != nil {
+		log.Error(err.Error())
+		return nil, err
+	}
+	defer rows.Close()
+
+	// Scan results
+	for rows.Next() {
+		ueName := ""
+		poaName := ""
+		err = rows.Scan(&ueName, &poaName)
+		if err != nil {
+			log.Error(err.Error())
+			return nil, err
+		}
+		poaInProximityMap[ueName] = append(poaInProximityMap[ueName], poaName)
+	}
+	err = rows.Err()
+	if err != nil {
+		log.Error(err)
+		return nil, err
+	}
+	return poaInProximityMap, nil
+}
+
+// getProjectedPathSections - Get path sections travelled by a UE advancing by the provided
+// fraction of its path length, starting from its current path fraction
+// NOTE: Path fraction follows AdvanceUePosition; LOOP paths restart at the beginning of the
+// path while REVERSE paths are travelled backwards when the fraction is between 1 & 2
+func getProjectedPathSections(mode string, fraction float64, increment float64) []pathSection {
+	if increment >= 1 {
+		return []pathSection{{0, 1}}
+	}
+
+	if mode == PathModeReverse {
+		pos := math.Mod(fraction, 2)
+		if pos <= 1 {
+			// Moving forward; bounce back at the end of the path
+			if pos+increment <= 1 {
+				return []pathSection{{pos, pos + increment}}
+			}
+			return []pathSection{{pos, 1}, {2 - pos - increment, 1}}
+		}
+		// Moving backward; bounce back at the beginning of the path
+		pos = 2 - pos
+		if pos-increment >= 0 {
+			return []pathSection{{pos - increment, pos}}
+		}
+		return []pathSection{{0, pos}, {0, increment - pos}}
+	}
+
+	// Loop back to the beginning of the path
+	pos := math.Mod(fraction, 1)
+	if pos+increment <= 1 {
+		return []pathSection{{pos, pos + increment}}
+	}
+	return []pathSection{{pos, 1}, {0, pos + increment - 1}}
+}
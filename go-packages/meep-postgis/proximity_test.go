@@ -0,0 +1,58 @@
+/*
+ * Copyright (c) 2020  InterDigital Communications, Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package postgisdb
+
+import (
+	"fmt"
+	"testing"
+
+	log "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-logger"
+)
+
+func TestProjectedPathSections(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	fmt.Println("Full path")
+	validateSections(t, getProjectedPathSections(PathModeLoop, 0.5, 1), []pathSection{{0, 1}})
+	validateSections(t, getProjectedPathSections(PathModeReverse, 1.5, 2), []pathSection{{0, 1}})
+
+	fmt.Println("Loop path")
+	validateSections(t, getProjectedPathSections(PathModeLoop, 0.25, 0.5), []pathSection{{0.25, 0.75}})
+	validateSections(t, getProjectedPathSections(PathModeLoop, 0.75, 0.5), []pathSection{{0.75, 1}, {0, 0.25}})
+	validateSections(t, getProjectedPathSections(PathModeLoop, 1.25, 0.5), []pathSection{{0.25, 0.75}})
+
+	fmt.Println("Reverse path moving forward")
+	validateSections(t, getProjectedPathSections(PathModeReverse, 0.25, 0.5), []pathSection{{0.25, 0.75}})
+	validateSections(t, getProjectedPathSections(PathModeReverse, 0.75, 0.5), []pathSection{{0.75, 1}, {0.75, 1}})
+	validateSections(t, getProjectedPathSections(PathModeReverse, 2.5, 0.75), []pathSection{{0.5, 1}, {0.75, 1}})
+
+	fmt.Println("Reverse path moving backward")
+	validateSections(t, getProjectedPathSections(PathModeReverse, 1.25, 0.5), []pathSection{{0.25, 0.75}})
+	validateSections(t, getProjectedPathSections(PathModeReverse, 1.75, 0.5), []pathSection{{0, 0.25}, {0, 0.25}})
+}
+
+func validateSections(t *testing.T, sections []pathSection, expected []pathSection) {
+	if len(sections) != len(expected) {
+		t.Fatalf("Invalid section count: %v != %v", sections, expected)
+	}
+	for i := range sections {
+		if sections[i] != expected[i] {
+			t.Fatalf("Invalid sections: %v != %v", sections, expected)
+		}
+	}
+}
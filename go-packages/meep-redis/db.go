@@ -30,6 +30,15 @@ import (
 const defaultRedisAddr = "meep-redis-master.default.svc.cluster.local:6379"
 const dbMaxRetryCount = 2
 
+// Update hash fields only if the entry exists
+var updateEntryScript = redis.NewScript(`
+if redis.call("EXISTS", KEYS[1]) == 0 then
+	return 0
+end
+redis.call("HMSET", KEYS[1], unpack(ARGV))
+return 1
+`)
+
 // Connector - Implements a Redis connector
 type Connector struct {
 	addr          string
@@ -228,6 +237,26 @@ func (rc *Connector) SetEntry(key string, fields map[string]interface{}) error {
 	return nil
 }
 
+// UpdateEntry - Update existing entry; returns false without creating the entry if it does not exist
+// The existence check & update are performed atomically so that a concurrently deleted entry is never recreated.
+func (rc *Connector) UpdateEntry(key string, fields map[string]interface{}) (bool, error) {
+	if !rc.connected {
+		return false, errors.New("Redis Connector is disconnected (UpdateEntry)")
+	}
+	if len(fields) == 0 {
+		return rc.EntryExists(key), nil
+	}
+	args := make([]interface{}, 0, 2*len(fields))
+	for field, value := range fields {
+		args = append(args, field, value)
+	}
+	updated, err := updateEntryScript.Run(rc.client, []string{key}, args...).Int()
+	if err != nil {
+		return false, err
+	}
+	return updated == 1, nil
+}
+
 // DelEntry - delete an existing entry from DB
 func (rc *Connector) DelEntry(key string) error {
 	if !rc.connected {
@@ -0,0 +1,49 @@
+/*
+ * Copyright (c) 2020  InterDigital Communications, Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the \"License\");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an \"AS IS\" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * AdvantEDGE Radio Network Information Service Subscription Notification REST API
+ *
+ * This API enables the Radio Network Information Service to post notification events to subscribers' applications <p>**Micro-service**<br>None <p>**Type & Usage**<br>User's Edge Applications subscribing to Radio Network Information events must implement this API <p>**Details**<br>API details available at _your-AdvantEDGE-ip-address/api_ <p>**Note**<br>This API is not exposed by default on the AdvantEDGE platform
+ *
+ * API version: 1.0.0
+ * Contact: AdvantEDGE@InterDigital.com
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+
+package client
+
+// Trigger : Description of the measurement report trigger
+type Trigger string
+
+// List of Trigger
+const (
+	NOT_AVAILABLE_Trigger                             Trigger = "NOT_AVAILABLE"
+	PERIODICAL_REPORT_STRONGEST_CELLS_Trigger         Trigger = "PERIODICAL_REPORT_STRONGEST_CELLS"
+	PERIODICAL_REPORT_STRONGEST_CELLS_FOR_SON_Trigger Trigger = "PERIODICAL_REPORT_STRONGEST_CELLS_FOR_SON"
+	PERIODICAL_REPORT_CGI_Trigger                     Trigger = "PERIODICAL_REPORT_CGI"
+	EVENT_A1_Trigger                                  Trigger = "EVENT_A1"
+	EVENT_A2_Trigger                                  Trigger = "EVENT_A2"
+	EVENT_A3_Trigger                                  Trigger = "EVENT_A3"
+	EVENT_A4_Trigger                                  Trigger = "EVENT_A4"
+	EVENT_A5_Trigger                                  Trigger = "EVENT_A5"
+	EVENT_A6_Trigger                                  Trigger = "EVENT_A6"
+	EVENT_B1_Trigger                                  Trigger = "EVENT_B1"
+	EVENT_B2_Trigger                                  Trigger = "EVENT_B2"
+	EVENT_C1_Trigger                                  Trigger = "EVENT_C1"
+	EVENT_C2_Trigger                                  Trigger = "EVENT_C2"
+	EVENT_W1_Trigger                                  Trigger = "EVENT_W1"
+	EVENT_W2_Trigger                                  Trigger = "EVENT_W2"
+	EVENT_W3_Trigger                                  Trigger = "EVENT_W3"
+)
@@ -65,6 +65,8 @@ type APIClient struct {
 	EventReplayApi *EventReplayApiService
 
 	EventsApi *EventsApiService
+
+	NetworkCharacteristicsApi *NetworkCharacteristicsApiService
 }
 
 type service struct {
@@ -86,6 +88,7 @@ func NewAPIClient(cfg *Configuration) *APIClient {
 	c.ActiveScenarioApi = (*ActiveScenarioApiService)(&c.common)
 	c.EventReplayApi = (*EventReplayApiService)(&c.common)
 	c.EventsApi = (*EventsApiService)(&c.common)
+	c.NetworkCharacteristicsApi = (*NetworkCharacteristicsApiService)(&c.common)
 
 	return c
 }
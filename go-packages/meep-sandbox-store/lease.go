@@ -0,0 +1,74 @@
+/*
+ * Copyright (c) 2020  InterDigital Communications, Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sandboxstore
+
+import (
+	"sync"
+	"time"
+)
+
+// Minimum delay between sandbox activity updates in the store
+const activityUpdateInterval = 30 * time.Second
+
+// GetExpiryTime - Return the time at which the sandbox expires
+// Sandbox expires at the end of its lease or after being idle for longer than its idle timeout,
+// whichever comes first. Returns zero time if the sandbox never expires.
+func (sbox *Sandbox) GetExpiryTime() time.Time {
+	expiry := sbox.Expiry
+	if sbox.IdleTimeout != 0 && !sbox.LastActivity.IsZero() {
+		idleExpiry := sbox.LastActivity.Add(sbox.IdleTimeout)
+		if expiry.IsZero() || idleExpiry.Before(expiry) {
+			expiry = idleExpiry
+		}
+	}
+	return expiry
+}
+
+// ActivityTracker - Records sandbox activity in the sandbox store
+// Updates are rate-limited to avoid a store write on every request.
+type ActivityTracker struct {
+	store      *SandboxStore
+	sboxName   string
+	mutex      sync.Mutex
+	lastUpdate time.Time
+}
+
+// NewActivityTracker - Create an activity tracker for the provided sandbox
+func NewActivityTracker(store *SandboxStore, sboxName string) *ActivityTracker {
+	t := new(ActivityTracker)
+	t.store = store
+	t.sboxName = sboxName
+	return t
+}
+
+// Touch - Record sandbox activity
+func (t *ActivityTracker) Touch() {
+	if t == nil {
+		return
+	}
+	now := time.Now()
+
+	t.mutex.Lock()
+	if now.Sub(t.lastUpdate) < activityUpdateInterval {
+		t.mutex.Unlock()
+		return
+	}
+	t.lastUpdate = now
+	t.mutex.Unlock()
+
+	_ = t.store.SetLastActivity(t.sboxName, now)
+}
@@ -0,0 +1,62 @@
+/*
+ * Copyright (c) 2020  InterDigital Communications, Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sandboxstore
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	log "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-logger"
+)
+
+func TestSandboxExpiryTime(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	now := time.Unix(1590000000, 0)
+
+	fmt.Println("No expiry")
+	sbox := Sandbox{Name: sbox1Name, LastActivity: now}
+	if !sbox.GetExpiryTime().IsZero() {
+		t.Fatalf("Sandbox should not expire")
+	}
+
+	fmt.Println("TTL only")
+	sbox.Expiry = now.Add(time.Hour)
+	if !sbox.GetExpiryTime().Equal(now.Add(time.Hour)) {
+		t.Fatalf("Invalid TTL expiry")
+	}
+
+	fmt.Println("Idle timeout before TTL")
+	sbox.IdleTimeout = 10 * time.Minute
+	if !sbox.GetExpiryTime().Equal(now.Add(10 * time.Minute)) {
+		t.Fatalf("Invalid idle expiry")
+	}
+
+	fmt.Println("TTL before idle timeout")
+	sbox.LastActivity = now.Add(55 * time.Minute)
+	if !sbox.GetExpiryTime().Equal(now.Add(time.Hour)) {
+		t.Fatalf("Invalid TTL expiry")
+	}
+
+	fmt.Println("Idle timeout only")
+	sbox.Expiry = time.Time{}
+	if !sbox.GetExpiryTime().Equal(now.Add(65 * time.Minute)) {
+		t.Fatalf("Invalid idle expiry")
+	}
+}
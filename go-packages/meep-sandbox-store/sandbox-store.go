@@ -18,6 +18,8 @@ package sandboxstore
 
 import (
 	"errors"
+	"strconv"
+	"time"
 
 	dkm "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-data-key-mgr"
 	log "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-logger"
@@ -32,10 +34,28 @@ var keyRoot = dkm.GetKeyRootGlobal() + "sandbox-store:"
 // DB Fields
 const fieldSandboxName = "sbox-name"
 const fieldScenarioName = "scenario-name"
+const fieldOwner = "owner"
+const fieldPods = "pods"
+const fieldExpiry = "expiry"
+const fieldIdleTimeout = "idle-timeout"
+const fieldLastActivity = "last-activity"
+const fieldExpiryWarning = "expiry-warning"
 
 type Sandbox struct {
 	Name         string
 	ScenarioName string
+	// Authenticated user that created the sandbox; empty if authentication is disabled
+	Owner string
+	// Number of scenario pods currently deployed in the sandbox
+	Pods int
+	// Sandbox lease end; no TTL if zero
+	Expiry time.Time
+	// Maximum inactivity period before sandbox expiry; no idle timeout if 0
+	IdleTimeout time.Duration
+	// Time of last REST request or event received by the sandbox
+	LastActivity time.Time
+	// Expiry time for which an expiry warning was last sent
+	ExpiryWarning time.Time
 }
 
 type SandboxStore struct {
@@ -73,6 +93,12 @@ func (ss *SandboxStore) Set(sbox *Sandbox) error {
 	fields := make(map[string]interface{})
 	fields[fieldSandboxName] = sbox.Name
 	fields[fieldScenarioName] = sbox.ScenarioName
+	fields[fieldOwner] = sbox.Owner
+	fields[fieldPods] = sbox.Pods
+	fields[fieldExpiry] = formatTime(sbox.Expiry)
+	fields[fieldIdleTimeout] = int64(sbox.IdleTimeout / time.Second)
+	fields[fieldLastActivity] = formatTime(sbox.LastActivity)
+	fields[fieldExpiryWarning] = formatTime(sbox.ExpiryWarning)
 
 	// Update entry in DB
 	key := keyRoot + sbox.Name
@@ -88,24 +114,20 @@ func (ss *SandboxStore) Set(sbox *Sandbox) error {
 func (ss *SandboxStore) Get(sboxName string) (*Sandbox, error) {
 	key := keyRoot + sboxName
 
-	// Make sure entry exists
-	if !ss.rc.EntryExists(key) {
-		err := errors.New("Entry not found")
-		log.Error(err.Error())
-		return nil, err
-	}
-
-	// Find entry
+	// Find entry; missing entries have no fields
 	fields, err := ss.rc.GetEntry(key)
 	if err != nil {
 		log.Error("Failed to get entry with error: ", err.Error())
 		return nil, err
 	}
+	if len(fields) == 0 {
+		err = errors.New("Entry not found")
+		log.Error(err.Error())
+		return nil, err
+	}
 
 	// Prepare sandbox
-	sbox := new(Sandbox)
-	sbox.Name = fields[fieldSandboxName]
-	sbox.ScenarioName = fields[fieldScenarioName]
+	sbox := newSandbox(fields)
 	return sbox, nil
 }
 
@@ -123,6 +145,68 @@ func (ss *SandboxStore) GetAll() (map[string]*Sandbox, error) {
 	return sboxMap, nil
 }
 
+// SetPods - Update number of scenario pods deployed in sandbox
+func (ss *SandboxStore) SetPods(sboxName string, pods int) error {
+	fields := make(map[string]interface{})
+	fields[fieldPods] = pods
+	return ss.setFields(sboxName, fields)
+}
+
+// SetExpiry - Update sandbox lease end; no TTL if zero
+func (ss *SandboxStore) SetExpiry(sboxName string, expiry time.Time) error {
+	fields := make(map[string]interface{})
+	fields[fieldExpiry] = formatTime(expiry)
+	return ss.setFields(sboxName, fields)
+}
+
+// SetLastActivity - Update time of last REST request or event received by the sandbox
+func (ss *SandboxStore) SetLastActivity(sboxName string, lastActivity time.Time) error {
+	fields := make(map[string]interface{})
+	fields[fieldLastActivity] = formatTime(lastActivity)
+	return ss.setFields(sboxName, fields)
+}
+
+// SetExpiryWarning - Update expiry time for which an expiry warning was sent
+func (ss *SandboxStore) SetExpiryWarning(sboxName string, expiry time.Time) error {
+	fields := make(map[string]interface{})
+	fields[fieldExpiryWarning] = formatTime(expiry)
+	return ss.setFields(sboxName, fields)
+}
+
+// setFields - Update fields of an existing entry
+// Entry is never recreated if the sandbox is concurrently deleted.
+func (ss *SandboxStore) setFields(sboxName string, fields map[string]interface{}) error {
+	key := keyRoot + sboxName
+
+	found, err := ss.rc.UpdateEntry(key, fields)
+	if err != nil {
+		log.Error("Failed to set entry with error: ", err.Error())
+		return err
+	}
+	if !found {
+		err = errors.New("Entry not found")
+		log.Error(err.Error())
+		return err
+	}
+	return nil
+}
+
+// GetOwnerUsage - Return number of sandboxes & deployed pods belonging to owner
+// Sandbox with name 'exclude' is not counted.
+func (ss *SandboxStore) GetOwnerUsage(owner string, exclude string) (sandboxes int, pods int, err error) {
+	sboxMap, err := ss.GetAll()
+	if err != nil {
+		return 0, 0, err
+	}
+	for _, sbox := range sboxMap {
+		if sbox.Owner == owner && sbox.Name != exclude {
+			sandboxes++
+			pods += sbox.Pods
+		}
+	}
+	return sandboxes, pods, nil
+}
+
 // Del - Remove sandbox with provided name
 func (ss *SandboxStore) Del(sboxName string) {
 	key := keyRoot + sboxName
@@ -141,11 +225,39 @@ func getSandbox(key string, fields map[string]string, userData interface{}) erro
 	sboxMap := *(userData.(*map[string]*Sandbox))
 
 	// Prepare sandbox
-	sbox := new(Sandbox)
-	sbox.Name = fields[fieldSandboxName]
-	sbox.ScenarioName = fields[fieldScenarioName]
+	sbox := newSandbox(fields)
 
 	// Add sandbox to
 	sboxMap[sbox.Name] = sbox
 	return nil
 }
+
+func newSandbox(fields map[string]string) *Sandbox {
+	sbox := new(Sandbox)
+	sbox.Name = fields[fieldSandboxName]
+	sbox.ScenarioName = fields[fieldScenarioName]
+	sbox.Owner = fields[fieldOwner]
+	sbox.Pods, _ = strconv.Atoi(fields[fieldPods])
+	sbox.Expiry = parseTime(fields[fieldExpiry])
+	idleTimeout, _ := strconv.ParseInt(fields[fieldIdleTimeout], 10, 64)
+	sbox.IdleTimeout = time.Duration(idleTimeout) * time.Second
+	sbox.LastActivity = parseTime(fields[fieldLastActivity])
+	sbox.ExpiryWarning = parseTime(fields[fieldExpiryWarning])
+	return sbox
+}
+
+// Times are stored as unix timestamps in seconds; 0 for zero time
+func formatTime(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.Unix()
+}
+
+func parseTime(value string) time.Time {
+	sec, _ := strconv.ParseInt(value, 10, 64)
+	if sec == 0 {
+		return time.Time{}
+	}
+	return time.Unix(sec, 0)
+}
@@ -19,6 +19,7 @@ package sandboxstore
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	log "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-logger"
 )
@@ -31,6 +32,7 @@ const sbox2Name = "sbox2Name"
 const sbox2ScenarioName = "sbox2ScenarioName"
 const sbox3Name = "sbox3Name"
 const sbox3ScenarioName = "sbox3ScenarioName"
+const sbox1Owner = "sbox1Owner"
 
 func TestSandboxStore(t *testing.T) {
 	fmt.Println("--- ", t.Name())
@@ -64,12 +66,12 @@ func TestSandboxStore(t *testing.T) {
 	}
 
 	fmt.Println("Add store entries")
-	sbox1 := Sandbox{Name: sbox1Name, ScenarioName: sbox1ScenarioName}
+	sbox1 := Sandbox{Name: sbox1Name, ScenarioName: sbox1ScenarioName, Owner: sbox1Owner}
 	err = ss.Set(&sbox1)
 	if err != nil {
 		t.Fatalf("Failed to set new store entry")
 	}
-	sbox2 := Sandbox{Name: sbox2Name, ScenarioName: sbox2ScenarioName}
+	sbox2 := Sandbox{Name: sbox2Name, ScenarioName: sbox2ScenarioName, Owner: sbox1Owner, Pods: 4}
 	err = ss.Set(&sbox2)
 	if err != nil {
 		t.Fatalf("Failed to set new store entry")
@@ -131,7 +133,7 @@ func TestSandboxStore(t *testing.T) {
 	}
 
 	fmt.Println("Update store entries")
-	sbox1 = Sandbox{Name: sbox1Name, ScenarioName: "newScenario"}
+	sbox1 = Sandbox{Name: sbox1Name, ScenarioName: "newScenario", Owner: sbox1Owner, Pods: 2}
 	err = ss.Set(&sbox1)
 	if err != nil {
 		t.Fatalf("Failed to set new store entry")
@@ -141,7 +143,7 @@ func TestSandboxStore(t *testing.T) {
 	if err != nil {
 		t.Fatalf("Failed to set new store entry")
 	}
-	sbox3 = Sandbox{Name: sbox3Name, ScenarioName: sbox3ScenarioName}
+	sbox3 = Sandbox{Name: sbox3Name, ScenarioName: sbox3ScenarioName, Expiry: time.Unix(1590003600, 0), IdleTimeout: 10 * time.Minute, LastActivity: time.Unix(1590000000, 0)}
 	err = ss.Set(&sbox3)
 	if err != nil {
 		t.Fatalf("Failed to set new store entry")
@@ -188,6 +190,58 @@ func TestSandboxStore(t *testing.T) {
 		t.Fatalf("Invalid store entry")
 	}
 
+	fmt.Println("Get owner usage")
+	sandboxes, pods, err := ss.GetOwnerUsage(sbox1Owner, "")
+	if err != nil || sandboxes != 1 || pods != 2 {
+		t.Fatalf("Invalid owner usage")
+	}
+	sandboxes, pods, err = ss.GetOwnerUsage("", sbox3Name)
+	if err != nil || sandboxes != 1 || pods != 0 {
+		t.Fatalf("Invalid owner usage")
+	}
+
+	fmt.Println("Set sandbox pods")
+	err = ss.SetPods("invalid-name", 1)
+	if err == nil {
+		t.Fatalf("SetPods should have failed")
+	}
+	err = ss.SetPods(sbox1Name, 5)
+	if err != nil {
+		t.Fatalf("Failed to set sandbox pods")
+	}
+	sbox1.Pods = 5
+	sbox, err = ss.Get(sbox1.Name)
+	if err != nil {
+		t.Fatalf("Failed to get store entry")
+	}
+	if !validateSandbox(sbox, &sbox1) {
+		t.Fatalf("Invalid store entry")
+	}
+
+	fmt.Println("Set sandbox lease")
+	err = ss.SetExpiry(sbox3Name, time.Unix(1590007200, 0))
+	if err != nil {
+		t.Fatalf("Failed to set sandbox expiry")
+	}
+	err = ss.SetLastActivity(sbox3Name, time.Unix(1590000600, 0))
+	if err != nil {
+		t.Fatalf("Failed to set sandbox activity")
+	}
+	err = ss.SetExpiryWarning(sbox3Name, time.Unix(1590001200, 0))
+	if err != nil {
+		t.Fatalf("Failed to set sandbox expiry warning")
+	}
+	sbox3.Expiry = time.Unix(1590007200, 0)
+	sbox3.LastActivity = time.Unix(1590000600, 0)
+	sbox3.ExpiryWarning = time.Unix(1590001200, 0)
+	sbox, err = ss.Get(sbox3.Name)
+	if err != nil {
+		t.Fatalf("Failed to get store entry")
+	}
+	if !validateSandbox(sbox, &sbox3) {
+		t.Fatalf("Invalid store entry")
+	}
+
 	fmt.Println("Delete store entries")
 	ss.Del("invalid-name")
 	sboxMap, err = ss.GetAll()
@@ -235,6 +289,12 @@ func validateSandbox(sbox *Sandbox, sboxExpected *Sandbox) bool {
 	} else {
 		return sbox != nil &&
 			sbox.Name == sboxExpected.Name &&
-			sbox.ScenarioName == sboxExpected.ScenarioName
+			sbox.ScenarioName == sboxExpected.ScenarioName &&
+			sbox.Owner == sboxExpected.Owner &&
+			sbox.Pods == sboxExpected.Pods &&
+			sbox.Expiry.Equal(sboxExpected.Expiry) &&
+			sbox.IdleTimeout == sboxExpected.IdleTimeout &&
+			sbox.LastActivity.Equal(sboxExpected.LastActivity) &&
+			sbox.ExpiryWarning.Equal(sboxExpected.ExpiryWarning)
 	}
 }
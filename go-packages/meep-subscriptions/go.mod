@@ -0,0 +1,13 @@
+module github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-subscriptions
+
+go 1.12
+
+require (
+	github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-logger v0.0.0
+	github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-redis v0.0.0
+)
+
+replace (
+	github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-logger => ../../go-packages/meep-logger
+	github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-redis => ../../go-packages/meep-redis
+)
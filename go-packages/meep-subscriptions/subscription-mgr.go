@@ -0,0 +1,541 @@
+/*
+ * Copyright (c) 2020  InterDigital Communications, Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package subscriptions
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-logger"
+	redis "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-redis"
+)
+
+const subscriptionKey = "subscriptions:"
+
+// Expiry check interval
+const expiryCheckInterval = time.Second
+
+// Default notification retry settings
+const defaultNotifBackoff = 500 * time.Millisecond
+const maxNotifBackoff = 30 * time.Second
+
+type SubscriptionMgrCfg struct {
+	Module       string
+	Basekey      string
+	RedisTable   int
+	ExpiredSubCb func(*Subscription)
+	NotifRetries int
+	NotifBackoff time.Duration
+	// Allocate subscription IDs from a single counter shared by all subscription types
+	SharedIds bool
+	// Subscription types previously stored by the service as Basekey + type + ":" + ID;
+	// converted to subscription manager entries on startup using LegacySubCb
+	LegacyTypes []string
+	LegacySubCb func(subType string, id string, jsonSub string) (*SubscriptionCfg, error)
+}
+
+type SubscriptionCfg struct {
+	Id         string     `json:"id"`
+	Type       string     `json:"type"`
+	Self       string     `json:"self"`
+	NotifyUrl  string     `json:"notifyUrl"`
+	ExpiryTime *time.Time `json:"expiryTime,omitempty"`
+	// Correlation ID of the request that created or last updated the subscription, sent with its notifications
+	CorrelationId string `json:"correlationId,omitempty"`
+}
+
+type Subscription struct {
+	Cfg         *SubscriptionCfg `json:"cfg"`
+	JsonSubOrig string           `json:"jsonSubOrig"`
+	// Closed when the subscription is removed to cancel pending notification retries
+	cancel chan struct{}
+}
+
+type SubscriptionLink struct {
+	Href string
+	Type string
+}
+
+type SubscriptionMgr struct {
+	cfg           *SubscriptionMgrCfg
+	rc            *redis.Connector
+	subscriptions map[string]map[string]*Subscription
+	nextIds       map[string]int
+	mutex         sync.Mutex
+	expiryTicker  *time.Ticker
+	stopChan      chan struct{}
+}
+
+// NewSubscriptionMgr - Creates and initializes a new Subscription Manager instance
+func NewSubscriptionMgr(cfg *SubscriptionMgrCfg, redisAddr string) (sm *SubscriptionMgr, err error) {
+	if cfg == nil {
+		return nil, errors.New("Missing Subscription Manager config")
+	}
+	if cfg.Module == "" {
+		return nil, errors.New("Missing module name")
+	}
+
+	// Create new Subscription Manager instance
+	sm = new(SubscriptionMgr)
+	sm.cfg = cfg
+	sm.subscriptions = make(map[string]map[string]*Subscription)
+	sm.nextIds = make(map[string]int)
+	if sm.cfg.NotifBackoff <= 0 {
+		sm.cfg.NotifBackoff = defaultNotifBackoff
+	}
+
+	// Connect to Redis DB
+	sm.rc, err = redis.NewConnector(redisAddr, sm.cfg.RedisTable)
+	if err != nil {
+		log.Error("Failed connection to Subscription Manager Redis DB. Error: ", err)
+		return nil, err
+	}
+	log.Info("Connected to Subscription Manager Redis DB")
+
+	// Load stored subscriptions
+	keyName := sm.cfg.Basekey + subscriptionKey + "*"
+	err = sm.rc.ForEachJSONEntry(keyName, sm.loadSubscription, nil)
+	if err != nil {
+		log.Error("Failed to load subscriptions with error: ", err.Error())
+		return nil, err
+	}
+
+	// Migrate subscriptions stored by previous service versions
+	err = sm.migrateLegacySubscriptions()
+	if err != nil {
+		log.Error("Failed to migrate subscriptions with error: ", err.Error())
+		return nil, err
+	}
+
+	// Start subscription expiry checks
+	sm.expiryTicker = time.NewTicker(expiryCheckInterval)
+	sm.stopChan = make(chan struct{})
+	go func(ticker *time.Ticker, stopChan chan struct{}) {
+		for {
+			select {
+			case <-ticker.C:
+				sm.checkForExpiredSubscriptions()
+			case <-stopChan:
+				return
+			}
+		}
+	}(sm.expiryTicker, sm.stopChan)
+
+	log.Info("Created Subscription Manager: ", sm.cfg.Module)
+	return sm, nil
+}
+
+// Stop - Stop subscription expiry checks & cancel pending notification retries
+func (sm *SubscriptionMgr) Stop() {
+	sm.mutex.Lock()
+	defer sm.mutex.Unlock()
+
+	if sm.expiryTicker != nil {
+		sm.expiryTicker.Stop()
+		sm.expiryTicker = nil
+	}
+	if sm.stopChan != nil {
+		close(sm.stopChan)
+		sm.stopChan = nil
+	}
+}
+
+// AllocateId - Reserve & return next available subscription ID for the provided subscription type
+func (sm *SubscriptionMgr) AllocateId(subType string) string {
+	sm.mutex.Lock()
+	defer sm.mutex.Unlock()
+	idKey := sm.getIdKey(subType)
+	nextId := sm.getNextId(idKey)
+	sm.nextIds[idKey] = nextId + 1
+	return strconv.Itoa(nextId)
+}
+
+// NextId - Return next subscription ID to be allocated for the provided subscription type
+func (sm *SubscriptionMgr) NextId(subType string) int {
+	sm.mutex.Lock()
+	defer sm.mutex.Unlock()
+	return sm.getNextId(sm.getIdKey(subType))
+}
+
+// CreateSubscription - Create & store new subscription
+func (sm *SubscriptionMgr) CreateSubscription(cfg *SubscriptionCfg, jsonSubOrig string) (*Subscription, error) {
+	err := validateSubscriptionCfg(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	sub := new(Subscription)
+	sub.Cfg = cfg
+	sub.JsonSubOrig = jsonSubOrig
+
+	sm.mutex.Lock()
+	defer sm.mutex.Unlock()
+
+	if sm.getSubscription(cfg.Type, cfg.Id) != nil {
+		return nil, errors.New("Subscription already exists: " + cfg.Type + ":" + cfg.Id)
+	}
+	err = sm.storeSubscription(sub)
+	if err != nil {
+		return nil, err
+	}
+	log.Info("New subscription: ", cfg.Id, " type: ", cfg.Type)
+	return sub, nil
+}
+
+// SetSubscription - Create or update stored subscription
+func (sm *SubscriptionMgr) SetSubscription(cfg *SubscriptionCfg, jsonSubOrig string) (*Subscription, error) {
+	err := validateSubscriptionCfg(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	sub := new(Subscription)
+	sub.Cfg = cfg
+	sub.JsonSubOrig = jsonSubOrig
+
+	sm.mutex.Lock()
+	defer sm.mutex.Unlock()
+
+	err = sm.storeSubscription(sub)
+	if err != nil {
+		return nil, err
+	}
+	return sub, nil
+}
+
+// GetSubscription - Return subscription with provided type & ID
+func (sm *SubscriptionMgr) GetSubscription(subType string, id string) *Subscription {
+	sm.mutex.Lock()
+	defer sm.mutex.Unlock()
+	return sm.getSubscription(subType, id)
+}
+
+// GetSubscriptions - Return subscriptions of provided type (all types if empty), ordered by ID
+func (sm *SubscriptionMgr) GetSubscriptions(subType string) []*Subscription {
+	sm.mutex.Lock()
+	defer sm.mutex.Unlock()
+
+	var subList []*Subscription
+	for t, subMap := range sm.subscriptions {
+		if subType != "" && subType != t {
+			continue
+		}
+		for _, sub := range subMap {
+			subList = append(subList, sub)
+		}
+	}
+	sortSubscriptions(subList)
+	return subList
+}
+
+// GetLinkList - Return subscription links of provided type (all types if empty), ordered by ID
+func (sm *SubscriptionMgr) GetLinkList(subType string) []SubscriptionLink {
+	var linkList []SubscriptionLink
+	for _, sub := range sm.GetSubscriptions(subType) {
+		linkList = append(linkList, SubscriptionLink{Href: sub.Cfg.Self, Type: sub.Cfg.Type})
+	}
+	return linkList
+}
+
+// DeleteSubscription - Remove subscription from manager & DB
+func (sm *SubscriptionMgr) DeleteSubscription(sub *Subscription) error {
+	if sub == nil || sub.Cfg == nil {
+		return errors.New("Invalid subscription")
+	}
+
+	sm.mutex.Lock()
+	defer sm.mutex.Unlock()
+
+	err := sm.deleteSubscription(sub.Cfg.Type, sub.Cfg.Id)
+	if err != nil {
+		return err
+	}
+	log.Info("Deleted subscription: ", sub.Cfg.Id, " type: ", sub.Cfg.Type)
+	return nil
+}
+
+// DeleteAllSubscriptions - Remove all subscriptions from manager & DB and reset ID allocation
+func (sm *SubscriptionMgr) DeleteAllSubscriptions() {
+	sm.mutex.Lock()
+	defer sm.mutex.Unlock()
+
+	_ = sm.rc.DBFlush(sm.cfg.Basekey + subscriptionKey)
+	for _, subMap := range sm.subscriptions {
+		for _, sub := range subMap {
+			close(sub.cancel)
+		}
+	}
+	sm.subscriptions = make(map[string]map[string]*Subscription)
+	sm.nextIds = make(map[string]int)
+}
+
+// SendNotification - Send subscription notification using provided function, retrying with backoff on failure
+// Blocks until the notification is sent or retries are exhausted and returns the final result; callers that
+// must not block should invoke it in a goroutine. Pending retries are cancelled when the subscription is
+// removed or the manager is stopped. Notifications for removed subscriptions (e.g. expiry) are sent once.
+func (sm *SubscriptionMgr) SendNotification(subType string, id string, notifyUrl string, send func() (*http.Response, error)) error {
+	// Get retry cancellation channels
+	var cancel chan struct{}
+	sm.mutex.Lock()
+	if sub := sm.getSubscription(subType, id); sub != nil {
+		cancel = sub.cancel
+	}
+	stopChan := sm.stopChan
+	sm.mutex.Unlock()
+
+	backoff := sm.cfg.NotifBackoff
+	for attempt := 0; ; attempt++ {
+		err := sendNotificationAttempt(send)
+		if err == nil {
+			return nil
+		}
+		if attempt >= sm.cfg.NotifRetries || cancel == nil {
+			log.Error("Failed to send notification to ", notifyUrl, " after ", attempt+1, " attempt(s). Error: ", err)
+			return err
+		}
+
+		// Wait for backoff unless retries are cancelled
+		log.Debug("Retrying notification to ", notifyUrl, " in ", backoff)
+		timer := time.NewTimer(backoff)
+		select {
+		case <-timer.C:
+		case <-cancel:
+			timer.Stop()
+			log.Info("Cancelled notification retries to ", notifyUrl, " for removed subscription: ", id, " type: ", subType)
+			return err
+		case <-stopChan:
+			timer.Stop()
+			log.Info("Cancelled notification retries to ", notifyUrl, " on stop")
+			return err
+		}
+		backoff = nextBackoff(backoff)
+	}
+}
+
+// sendNotificationAttempt - Send notification & convert error responses to errors
+func sendNotificationAttempt(send func() (*http.Response, error)) error {
+	resp, err := send()
+	if resp != nil && resp.Body != nil {
+		resp.Body.Close()
+	}
+	if err == nil && resp != nil && resp.StatusCode >= http.StatusMultipleChoices {
+		err = errors.New("Notification failed with status: " + resp.Status)
+	}
+	return err
+}
+
+func (sm *SubscriptionMgr) checkForExpiredSubscriptions() {
+	var expiredSubs []*Subscription
+	currentTime := time.Now()
+
+	// Remove expired subscriptions
+	sm.mutex.Lock()
+	for _, subMap := range sm.subscriptions {
+		for _, sub := range subMap {
+			if sub.Cfg.ExpiryTime != nil && !sub.Cfg.ExpiryTime.After(currentTime) {
+				expiredSubs = append(expiredSubs, sub)
+			}
+		}
+	}
+	for _, sub := range expiredSubs {
+		err := sm.deleteSubscription(sub.Cfg.Type, sub.Cfg.Id)
+		if err != nil {
+			log.Error("Failed to delete expired subscription: ", err.Error())
+		}
+		log.Info("Expired subscription: ", sub.Cfg.Id, " type: ", sub.Cfg.Type)
+	}
+	sm.mutex.Unlock()
+
+	// Notify expired subscriptions
+	if sm.cfg.ExpiredSubCb != nil {
+		sortSubscriptions(expiredSubs)
+		for _, sub := range expiredSubs {
+			sm.cfg.ExpiredSubCb(sub)
+		}
+	}
+}
+
+func (sm *SubscriptionMgr) loadSubscription(key string, jsonInfo string, userData interface{}) error {
+	sub := new(Subscription)
+	err := json.Unmarshal([]byte(jsonInfo), sub)
+	if err != nil {
+		return err
+	}
+	if validateSubscriptionCfg(sub.Cfg) != nil {
+		log.Error("Ignoring invalid subscription: ", key)
+		return nil
+	}
+	sm.addSubscription(sub)
+	return nil
+}
+
+func (sm *SubscriptionMgr) migrateLegacySubscriptions() error {
+	if sm.cfg.LegacySubCb == nil {
+		return nil
+	}
+	for _, subType := range sm.cfg.LegacyTypes {
+		// Collect legacy entries before updating the DB
+		legacySubs := make(map[string]string)
+		keyPrefix := sm.cfg.Basekey + subType + ":"
+		err := sm.rc.ForEachJSONEntry(keyPrefix+"*", func(key string, jsonInfo string, userData interface{}) error {
+			legacySubs[key] = jsonInfo
+			return nil
+		}, nil)
+		if err != nil {
+			return err
+		}
+
+		for key, jsonInfo := range legacySubs {
+			// Ignore nested keys that are not subscriptions
+			id := key[len(keyPrefix):]
+			if strings.Contains(id, ":") {
+				continue
+			}
+			cfg, err := sm.cfg.LegacySubCb(subType, id, jsonInfo)
+			if err == nil {
+				cfg.Id = id
+				cfg.Type = subType
+				err = sm.storeSubscription(&Subscription{Cfg: cfg, JsonSubOrig: jsonInfo})
+			}
+			if err != nil {
+				log.Error("Failed to migrate subscription ", key, ": ", err.Error())
+				continue
+			}
+			_ = sm.rc.JSONDelEntry(key, ".")
+			log.Info("Migrated subscription: ", id, " type: ", subType)
+		}
+	}
+	return nil
+}
+
+func (sm *SubscriptionMgr) storeSubscription(sub *Subscription) error {
+	jsonSub, err := json.Marshal(sub)
+	if err != nil {
+		log.Error(err.Error())
+		return err
+	}
+	err = sm.rc.JSONSetEntry(sm.getKey(sub.Cfg.Type, sub.Cfg.Id), ".", string(jsonSub))
+	if err != nil {
+		log.Error("Failed to store subscription with error: ", err.Error())
+		return err
+	}
+	sm.addSubscription(sub)
+	return nil
+}
+
+func (sm *SubscriptionMgr) addSubscription(sub *Subscription) {
+	subMap, found := sm.subscriptions[sub.Cfg.Type]
+	if !found {
+		subMap = make(map[string]*Subscription)
+		sm.subscriptions[sub.Cfg.Type] = subMap
+	}
+
+	// Cancel notification retries of replaced subscription
+	if prevSub, found := subMap[sub.Cfg.Id]; found && prevSub != sub {
+		close(prevSub.cancel)
+	}
+	if sub.cancel == nil {
+		sub.cancel = make(chan struct{})
+	}
+	subMap[sub.Cfg.Id] = sub
+
+	// Make sure allocated IDs are never reused
+	idKey := sm.getIdKey(sub.Cfg.Type)
+	if id, err := strconv.Atoi(sub.Cfg.Id); err == nil && id >= sm.getNextId(idKey) {
+		sm.nextIds[idKey] = id + 1
+	}
+}
+
+func (sm *SubscriptionMgr) getIdKey(subType string) string {
+	if sm.cfg.SharedIds {
+		return ""
+	}
+	return subType
+}
+
+func (sm *SubscriptionMgr) getNextId(idKey string) int {
+	if nextId, found := sm.nextIds[idKey]; found {
+		return nextId
+	}
+	return 1
+}
+
+func (sm *SubscriptionMgr) getSubscription(subType string, id string) *Subscription {
+	if subMap, found := sm.subscriptions[subType]; found {
+		return subMap[id]
+	}
+	return nil
+}
+
+func (sm *SubscriptionMgr) deleteSubscription(subType string, id string) error {
+	sm.removeSubscription(subType, id)
+	return sm.rc.JSONDelEntry(sm.getKey(subType, id), ".")
+}
+
+func (sm *SubscriptionMgr) removeSubscription(subType string, id string) {
+	if subMap, found := sm.subscriptions[subType]; found {
+		if sub, found := subMap[id]; found {
+			close(sub.cancel)
+			delete(subMap, id)
+		}
+	}
+}
+
+func (sm *SubscriptionMgr) getKey(subType string, id string) string {
+	return sm.cfg.Basekey + subscriptionKey + subType + ":" + id
+}
+
+func validateSubscriptionCfg(cfg *SubscriptionCfg) error {
+	if cfg == nil {
+		return errors.New("Missing subscription config")
+	}
+	if cfg.Id == "" {
+		return errors.New("Missing subscription ID")
+	}
+	if cfg.Type == "" {
+		return errors.New("Missing subscription type")
+	}
+	return nil
+}
+
+func sortSubscriptions(subList []*Subscription) {
+	sort.Slice(subList, func(i, j int) bool {
+		idI, errI := strconv.Atoi(subList[i].Cfg.Id)
+		idJ, errJ := strconv.Atoi(subList[j].Cfg.Id)
+		if errI != nil || errJ != nil || idI == idJ {
+			if subList[i].Cfg.Id == subList[j].Cfg.Id {
+				return subList[i].Cfg.Type < subList[j].Cfg.Type
+			}
+			return subList[i].Cfg.Id < subList[j].Cfg.Id
+		}
+		return idI < idJ
+	})
+}
+
+func nextBackoff(backoff time.Duration) time.Duration {
+	backoff *= 2
+	if backoff > maxNotifBackoff {
+		backoff = maxNotifBackoff
+	}
+	return backoff
+}
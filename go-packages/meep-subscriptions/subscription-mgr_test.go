@@ -0,0 +1,347 @@
+/*
+ * Copyright (c) 2020  InterDigital Communications, Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package subscriptions
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	log "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-logger"
+	redis "github.com/InterDigitalInc/AdvantEDGE/go-packages/meep-redis"
+)
+
+const redisAddr string = "localhost:30380"
+const testModule = "test-module"
+const testBasekey = "test-basekey:"
+
+const subType1 = "type1"
+const subType2 = "type2"
+
+func TestSubscriptionMgr(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	fmt.Println("Create invalid subscription manager")
+	sm, err := NewSubscriptionMgr(nil, redisAddr)
+	if err == nil || sm != nil {
+		t.Fatalf("Subscription manager creation should have failed")
+	}
+	cfg := &SubscriptionMgrCfg{Module: testModule, Basekey: testBasekey}
+	sm, err = NewSubscriptionMgr(cfg, "ExpectedFailure-InvalidDbAddr")
+	if err == nil || sm != nil {
+		t.Fatalf("Subscription manager creation should have failed")
+	}
+
+	fmt.Println("Create valid subscription manager")
+	sm, err = NewSubscriptionMgr(cfg, redisAddr)
+	if err != nil || sm == nil {
+		t.Fatalf("Failed to create subscription manager")
+	}
+	sm.DeleteAllSubscriptions()
+	if sm.NextId(subType1) != 1 || sm.NextId(subType2) != 1 {
+		t.Fatalf("Invalid next ID")
+	}
+
+	fmt.Println("Create subscriptions")
+	id1 := sm.AllocateId(subType1)
+	_, err = sm.CreateSubscription(&SubscriptionCfg{Id: id1, Type: subType1, Self: "self1"}, "{}")
+	if err != nil {
+		t.Fatalf("Failed to create subscription")
+	}
+	id2 := sm.AllocateId(subType2)
+	_, err = sm.CreateSubscription(&SubscriptionCfg{Id: id2, Type: subType2, Self: "self2"}, "{}")
+	if err != nil {
+		t.Fatalf("Failed to create subscription")
+	}
+	_, err = sm.CreateSubscription(&SubscriptionCfg{Id: id2, Type: subType2, Self: "self2"}, "{}")
+	if err == nil {
+		t.Fatalf("Duplicate subscription creation should have failed")
+	}
+	_, err = sm.CreateSubscription(&SubscriptionCfg{Type: subType2}, "{}")
+	if err == nil {
+		t.Fatalf("Subscription creation without ID should have failed")
+	}
+	if sm.NextId(subType1) != 2 || sm.NextId(subType2) != 2 {
+		t.Fatalf("Invalid next ID")
+	}
+
+	fmt.Println("Get subscriptions")
+	sub := sm.GetSubscription(subType1, id1)
+	if sub == nil || sub.Cfg.Self != "self1" || sub.JsonSubOrig != "{}" {
+		t.Fatalf("Invalid subscription")
+	}
+	if sm.GetSubscription(subType2, id1) != nil {
+		t.Fatalf("Subscription should not exist")
+	}
+	if len(sm.GetSubscriptions(subType1)) != 1 {
+		t.Fatalf("Invalid subscription count")
+	}
+	linkList := sm.GetLinkList("")
+	if len(linkList) != 2 || linkList[0].Href != "self1" || linkList[1].Type != subType2 {
+		t.Fatalf("Invalid link list")
+	}
+
+	fmt.Println("Reload subscriptions from DB")
+	sm.Stop()
+	sm, err = NewSubscriptionMgr(cfg, redisAddr)
+	if err != nil {
+		t.Fatalf("Failed to create subscription manager")
+	}
+	if len(sm.GetSubscriptions("")) != 2 {
+		t.Fatalf("Invalid subscription count")
+	}
+	if sm.NextId(subType1) != 2 || sm.NextId(subType2) != 2 {
+		t.Fatalf("Invalid next ID")
+	}
+
+	fmt.Println("Delete subscription")
+	err = sm.DeleteSubscription(sm.GetSubscription(subType1, id1))
+	if err != nil {
+		t.Fatalf("Failed to delete subscription")
+	}
+	if sm.GetSubscription(subType1, id1) != nil {
+		t.Fatalf("Subscription should not exist")
+	}
+
+	fmt.Println("Delete all subscriptions")
+	sm.DeleteAllSubscriptions()
+	if len(sm.GetSubscriptions("")) != 0 || sm.NextId(subType1) != 1 {
+		t.Fatalf("Subscriptions not deleted")
+	}
+	sm.Stop()
+}
+
+func TestSubscriptionMgrExpiry(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	expiredSubs := make(chan *Subscription, 1)
+	cfg := &SubscriptionMgrCfg{
+		Module:       testModule,
+		Basekey:      testBasekey,
+		ExpiredSubCb: func(sub *Subscription) { expiredSubs <- sub },
+	}
+	sm, err := NewSubscriptionMgr(cfg, redisAddr)
+	if err != nil {
+		t.Fatalf("Failed to create subscription manager")
+	}
+	sm.DeleteAllSubscriptions()
+
+	fmt.Println("Create expiring subscription")
+	expiryTime := time.Now().Add(time.Second)
+	id := sm.AllocateId(subType1)
+	_, err = sm.CreateSubscription(&SubscriptionCfg{Id: id, Type: subType1, ExpiryTime: &expiryTime}, "{}")
+	if err != nil {
+		t.Fatalf("Failed to create subscription")
+	}
+
+	fmt.Println("Wait for expiry")
+	select {
+	case sub := <-expiredSubs:
+		if sub.Cfg.Id != id {
+			t.Fatalf("Invalid expired subscription")
+		}
+	case <-time.After(3 * time.Second):
+		t.Fatalf("Subscription did not expire")
+	}
+	if sm.GetSubscription(subType1, id) != nil {
+		t.Fatalf("Expired subscription should not exist")
+	}
+
+	sm.DeleteAllSubscriptions()
+	sm.Stop()
+}
+
+func TestSubscriptionMgrMigration(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	fmt.Println("Store legacy subscription")
+	rc, err := redis.NewConnector(redisAddr, 0)
+	if err != nil {
+		t.Fatalf("Failed to connect to Redis DB")
+	}
+	legacyKey := testBasekey + subType1 + ":5"
+	err = rc.JSONSetEntry(legacyKey, ".", `{"self":"legacy-self"}`)
+	if err != nil {
+		t.Fatalf("Failed to store legacy subscription")
+	}
+
+	fmt.Println("Create subscription manager with legacy migration")
+	cfg := &SubscriptionMgrCfg{
+		Module:      testModule,
+		Basekey:     testBasekey,
+		LegacyTypes: []string{subType1},
+		LegacySubCb: func(subType string, id string, jsonSub string) (*SubscriptionCfg, error) {
+			return &SubscriptionCfg{Self: "legacy-self"}, nil
+		},
+	}
+	sm, err := NewSubscriptionMgr(cfg, redisAddr)
+	if err != nil {
+		t.Fatalf("Failed to create subscription manager")
+	}
+	sub := sm.GetSubscription(subType1, "5")
+	if sub == nil || sub.Cfg.Self != "legacy-self" || sub.JsonSubOrig != `{"self":"legacy-self"}` {
+		t.Fatalf("Legacy subscription not migrated")
+	}
+	if sm.NextId(subType1) != 6 {
+		t.Fatalf("Invalid next ID")
+	}
+	if jsonSub, _ := rc.JSONGetEntry(legacyKey, "."); jsonSub != "" {
+		t.Fatalf("Legacy subscription not removed")
+	}
+
+	sm.DeleteAllSubscriptions()
+	sm.Stop()
+}
+
+func TestSendNotification(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	var mutex sync.Mutex
+	attempts := 0
+	failures := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		attempts++
+		if attempts <= failures {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+	send := func() (*http.Response, error) {
+		return http.Post(server.URL, "application/json", nil)
+	}
+	reset := func(f int) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		attempts = 0
+		failures = f
+	}
+	getAttempts := func() int {
+		mutex.Lock()
+		defer mutex.Unlock()
+		return attempts
+	}
+
+	sm := &SubscriptionMgr{cfg: &SubscriptionMgrCfg{NotifRetries: 2, NotifBackoff: time.Millisecond},
+		subscriptions: make(map[string]map[string]*Subscription), nextIds: make(map[string]int), stopChan: make(chan struct{})}
+	sm.addSubscription(&Subscription{Cfg: &SubscriptionCfg{Id: "1", Type: subType1}})
+	sendAsync := func(subType string, id string) chan error {
+		result := make(chan error, 1)
+		go func() {
+			result <- sm.SendNotification(subType, id, server.URL, send)
+		}()
+		return result
+	}
+	waitResult := func(result chan error) error {
+		select {
+		case err := <-result:
+			return err
+		case <-time.After(time.Second):
+			t.Fatalf("Notification did not complete")
+		}
+		return nil
+	}
+
+	fmt.Println("Send notification without failure")
+	err := sm.SendNotification(subType1, "1", server.URL, send)
+	if err != nil || getAttempts() != 1 {
+		t.Fatalf("Notification failed")
+	}
+
+	fmt.Println("Send notification with recoverable failures")
+	reset(2)
+	err = sm.SendNotification(subType1, "1", server.URL, send)
+	if err != nil || getAttempts() != 3 {
+		t.Fatalf("Notification retries failed")
+	}
+
+	fmt.Println("Send notification with too many failures")
+	reset(3)
+	err = sm.SendNotification(subType1, "1", server.URL, send)
+	if err == nil || getAttempts() != 3 {
+		t.Fatalf("Notification should have failed")
+	}
+
+	fmt.Println("Send notification for unknown subscription")
+	reset(1)
+	err = sm.SendNotification(subType1, "2", server.URL, send)
+	if err == nil || getAttempts() != 1 {
+		t.Fatalf("Notification should not be retried")
+	}
+
+	fmt.Println("Cancel retries on subscription removal")
+	sm.cfg.NotifBackoff = time.Minute
+	reset(3)
+	result := sendAsync(subType1, "1")
+	for getAttempts() != 1 {
+		time.Sleep(time.Millisecond)
+	}
+	sm.removeSubscription(subType1, "1")
+	if waitResult(result) == nil || getAttempts() != 1 {
+		t.Fatalf("Notification retries should have been cancelled")
+	}
+
+	fmt.Println("Cancel retries on stop")
+	sm.addSubscription(&Subscription{Cfg: &SubscriptionCfg{Id: "1", Type: subType1}})
+	reset(3)
+	result = sendAsync(subType1, "1")
+	for getAttempts() != 1 {
+		time.Sleep(time.Millisecond)
+	}
+	sm.Stop()
+	if waitResult(result) == nil || getAttempts() != 1 {
+		t.Fatalf("Notification retries should have been cancelled")
+	}
+
+	fmt.Println("Validate backoff")
+	if nextBackoff(time.Second) != 2*time.Second || nextBackoff(maxNotifBackoff) != maxNotifBackoff {
+		t.Fatalf("Invalid backoff")
+	}
+}
+
+func TestSubscriptionIds(t *testing.T) {
+	fmt.Println("--- ", t.Name())
+	log.MeepTextLogInit(t.Name())
+
+	fmt.Println("Per-type subscription IDs")
+	sm := &SubscriptionMgr{cfg: &SubscriptionMgrCfg{}, nextIds: make(map[string]int)}
+	if sm.AllocateId(subType1) != "1" || sm.AllocateId(subType2) != "1" || sm.AllocateId(subType1) != "2" {
+		t.Fatalf("Invalid per-type subscription IDs")
+	}
+	if sm.NextId(subType1) != 3 || sm.NextId(subType2) != 2 {
+		t.Fatalf("Invalid next ID")
+	}
+
+	fmt.Println("Shared subscription IDs")
+	sm = &SubscriptionMgr{cfg: &SubscriptionMgrCfg{SharedIds: true}, nextIds: make(map[string]int)}
+	if sm.AllocateId(subType1) != "1" || sm.AllocateId(subType2) != "2" || sm.AllocateId(subType1) != "3" {
+		t.Fatalf("Invalid shared subscription IDs")
+	}
+	if sm.NextId(subType1) != 4 || sm.NextId(subType2) != 4 {
+		t.Fatalf("Invalid next ID")
+	}
+}
@@ -62,6 +62,56 @@
     this.apiClient = apiClient || ApiClient.instance;
 
 
+    /**
+     * Callback function to receive the result of the getMetricsExport operation.
+     * @callback module:api/MetricsApi~getMetricsExportCallback
+     * @param {String} error Error message, if any.
+     * @param {File} data The data returned by the service call.
+     * @param {String} response The complete HTTP response.
+     */
+
+    /**
+     * Streams a zip archive of the network, event & HTTP metrics of the active scenario or of a previous scenario run, with a manifest recording the scenario name & replay file used
+     * @param {Object} opts Optional parameters
+     * @param {module:model/String} opts.format Export file format (default to csv)
+     * @param {String} opts.store Scenario run store to export; active scenario store if omitted
+     * @param {Date} opts.start Export time range start (RFC3339); no lower bound if omitted
+     * @param {Date} opts.end Export time range end (RFC3339); no upper bound if omitted
+     * @param {module:api/MetricsApi~getMetricsExportCallback} callback The callback function, accepting three arguments: error, data, response
+     * data is of type: {@link File}
+     */
+    this.getMetricsExport = function(opts, callback) {
+      opts = opts || {};
+      var postBody = null;
+
+
+      var pathParams = {
+      };
+      var queryParams = {
+        'format': opts['format'],
+        'store': opts['store'],
+        'start': opts['start'],
+        'end': opts['end'],
+      };
+      var collectionQueryParams = {
+      };
+      var headerParams = {
+      };
+      var formParams = {
+      };
+
+      var authNames = [];
+      var contentTypes = ['application/json'];
+      var accepts = ['application/zip'];
+      var returnType = File;
+
+      return this.apiClient.callApi(
+        '/metrics/export', 'GET',
+        pathParams, queryParams, collectionQueryParams, headerParams, formParams, postBody,
+        authNames, contentTypes, accepts, returnType, callback
+      );
+    }
+
     /**
      * Callback function to receive the result of the postEventQuery operation.
      * @callback module:api/MetricsApi~postEventQueryCallback
@@ -0,0 +1,165 @@
+/*
+ * Copyright (c) 2020  InterDigital Communications, Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the \"License\");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an \"AS IS\" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * AdvantEDGE Metrics Service REST API
+ * Metrics Service provides metrics about the active scenario <p>**Micro-service**<br>[meep-metrics-engine](https://github.com/InterDigitalInc/AdvantEDGE/tree/master/go-apps/meep-metrics-engine) <p>**Type & Usage**<br>Platform Service used by control/monitoring software and possibly by edge applications that require metrics <p>**Details**<br>API details available at _your-AdvantEDGE-ip-address/api_
+ *
+ * OpenAPI spec version: 1.0.0
+ * Contact: AdvantEDGE@InterDigital.com
+ *
+ * NOTE: This class is auto generated by the swagger code generator program.
+ * https://github.com/swagger-api/swagger-codegen.git
+ *
+ * Swagger Codegen version: 2.4.9
+ *
+ * Do not edit the class manually.
+ *
+ */
+
+(function(root, factory) {
+  if (typeof define === 'function' && define.amd) {
+    // AMD. Register as an anonymous module.
+    define(['ApiClient'], factory);
+  } else if (typeof module === 'object' && module.exports) {
+    // CommonJS-like environments that support module.exports, like Node.
+    module.exports = factory(require('../ApiClient'));
+  } else {
+    // Browser globals (root is window)
+    if (!root.AdvantEdgeMetricsServiceRestApi) {
+      root.AdvantEdgeMetricsServiceRestApi = {};
+    }
+    root.AdvantEdgeMetricsServiceRestApi.Aggregation = factory(root.AdvantEdgeMetricsServiceRestApi.ApiClient);
+  }
+}(this, function(ApiClient) {
+  'use strict';
+
+  /**
+   * The Aggregation model module.
+   * @module model/Aggregation
+   * @version 1.0.0
+   */
+
+  /**
+   * Constructs a new <code>Aggregation</code>.
+   * Aggregation applied to queried fields
+   * @alias module:model/Aggregation
+   * @class
+   */
+  var exports = function() {
+  };
+
+  /**
+   * Constructs a <code>Aggregation</code> from a plain JavaScript object, optionally creating a new instance.
+   * Copies all relevant properties from <code>data</code> to <code>obj</code> if supplied or a new instance if not.
+   * @param {Object} data The plain JavaScript object bearing properties of interest.
+   * @param {module:model/Aggregation} obj Optional instance to populate.
+   * @return {module:model/Aggregation} The populated <code>Aggregation</code> instance.
+   */
+  exports.constructFromObject = function(data, obj) {
+    if (data) {
+      obj = obj || new exports();
+      if (data.hasOwnProperty('functions'))
+        obj.functions = ApiClient.convertToType(data['functions'], ['String']);
+      if (data.hasOwnProperty('interval'))
+        obj.interval = ApiClient.convertToType(data['interval'], 'String');
+      if (data.hasOwnProperty('groupBy'))
+        obj.groupBy = ApiClient.convertToType(data['groupBy'], ['String']);
+    }
+    return obj;
+  }
+
+  /**
+   * Aggregation functions to apply to each queried field. Supported values:<br> <li>mean: Mean value<br> <li>min: Minimum value<br> <li>max: Maximum value<br> <li>p50: 50th percentile<br> <li>p95: 95th percentile<br> <li>p99: 99th percentile<br> <li>stddev: Standard deviation<br> <li>count: Number of values<br> <li>sum: Sum of values
+   * @member {Array.<module:model/Aggregation.FunctionsEnum>} functions
+   */
+  exports.prototype.functions = undefined;
+
+  /**
+   * Time bucket size used to group values (specify s for seconds, m for minutes, h for hours); values are aggregated over the whole query scope if not set. Requires a scope duration.
+   * @member {String} interval
+   */
+  exports.prototype.interval = undefined;
+
+  /**
+   * Tag names used to group values; one series is returned per distinct tag value combination
+   * @member {Array.<String>} groupBy
+   */
+  exports.prototype.groupBy = undefined;
+
+
+  /**
+   * Allowed values for the <code>functions</code> property.
+   * @enum {String}
+   * @readonly
+   */
+  exports.FunctionsEnum = {
+    /**
+     * value: "mean"
+     * @const
+     */
+    mean: "mean",
+
+    /**
+     * value: "min"
+     * @const
+     */
+    min: "min",
+
+    /**
+     * value: "max"
+     * @const
+     */
+    max: "max",
+
+    /**
+     * value: "p50"
+     * @const
+     */
+    p50: "p50",
+
+    /**
+     * value: "p95"
+     * @const
+     */
+    p95: "p95",
+
+    /**
+     * value: "p99"
+     * @const
+     */
+    p99: "p99",
+
+    /**
+     * value: "stddev"
+     * @const
+     */
+    stddev: "stddev",
+
+    /**
+     * value: "count"
+     * @const
+     */
+    count: "count",
+
+    /**
+     * value: "sum"
+     * @const
+     */
+    sum: "sum"
+  };
+
+  return exports;
+
+}));
@@ -31,18 +31,18 @@
 (function(root, factory) {
   if (typeof define === 'function' && define.amd) {
     // AMD. Register as an anonymous module.
-    define(['ApiClient', 'model/EventMetric'], factory);
+    define(['ApiClient', 'model/AggregateSeries', 'model/EventMetric'], factory);
   } else if (typeof module === 'object' && module.exports) {
     // CommonJS-like environments that support module.exports, like Node.
-    module.exports = factory(require('../ApiClient'), require('./EventMetric'));
+    module.exports = factory(require('../ApiClient'), require('./AggregateSeries'), require('./EventMetric'));
   } else {
     // Browser globals (root is window)
     if (!root.AdvantEdgeMetricsServiceRestApi) {
       root.AdvantEdgeMetricsServiceRestApi = {};
     }
-    root.AdvantEdgeMetricsServiceRestApi.EventMetricList = factory(root.AdvantEdgeMetricsServiceRestApi.ApiClient, root.AdvantEdgeMetricsServiceRestApi.EventMetric);
+    root.AdvantEdgeMetricsServiceRestApi.EventMetricList = factory(root.AdvantEdgeMetricsServiceRestApi.ApiClient, root.AdvantEdgeMetricsServiceRestApi.AggregateSeries, root.AdvantEdgeMetricsServiceRestApi.EventMetric);
   }
-}(this, function(ApiClient, EventMetric) {
+}(this, function(ApiClient, AggregateSeries, EventMetric) {
   'use strict';
 
   /**
@@ -76,6 +76,8 @@
         obj.columns = ApiClient.convertToType(data['columns'], ['String']);
       if (data.hasOwnProperty('values'))
         obj.values = ApiClient.convertToType(data['values'], [EventMetric]);
+      if (data.hasOwnProperty('series'))
+        obj.series = ApiClient.convertToType(data['series'], [AggregateSeries]);
     }
     return obj;
   }
@@ -97,6 +99,12 @@
    */
   exports.prototype.values = undefined;
 
+  /**
+   * Aggregated metrics, one series per tag group; returned instead of values when an aggregation is requested
+   * @member {Array.<module:model/AggregateSeries>} series
+   */
+  exports.prototype.series = undefined;
+
   return exports;
 
 }));
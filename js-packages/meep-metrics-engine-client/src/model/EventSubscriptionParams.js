@@ -101,13 +101,13 @@
   exports.prototype.eventQueryParams = undefined;
 
   /**
-   * Notification interval in seconds, disabled if set to 0
+   * Notification interval in seconds, disabled if set to 0. Event polling interval for change subscriptions (default 1s)
    * @member {Number} period
    */
   exports.prototype.period = undefined;
 
   /**
-   * Type of subscription triggering notifications
+   * Type of subscription triggering notifications:<br> <li>period: Periodic notifications <li>change: Notification when new events are recorded
    * @member {module:model/EventSubscriptionParams.SubscriptionTypeEnum} subscriptionType
    */
   exports.prototype.subscriptionType = undefined;
@@ -123,7 +123,13 @@
      * value: "period"
      * @const
      */
-    period: "period"
+    period: "period",
+
+    /**
+     * value: "change"
+     * @const
+     */
+    change: "change"
   };
 
   return exports;
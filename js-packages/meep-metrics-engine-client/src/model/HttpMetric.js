@@ -92,6 +92,12 @@
         obj.loggerName = ApiClient.convertToType(data['logger_name'], 'String');
       if (data.hasOwnProperty('direction'))
         obj.direction = ApiClient.convertToType(data['direction'], 'String');
+      if (data.hasOwnProperty('req_headers'))
+        obj.reqHeaders = ApiClient.convertToType(data['req_headers'], 'String');
+      if (data.hasOwnProperty('resp_headers'))
+        obj.respHeaders = ApiClient.convertToType(data['resp_headers'], 'String');
+      if (data.hasOwnProperty('correlation_id'))
+        obj.correlationId = ApiClient.convertToType(data['correlation_id'], 'String');
     }
     return obj;
   }
@@ -162,6 +168,24 @@
    */
   exports.prototype.direction = undefined;
 
+  /**
+   * Http metrics request headers (JSON object)
+   * @member {String} reqHeaders
+   */
+  exports.prototype.reqHeaders = undefined;
+
+  /**
+   * Http metrics response headers (JSON object)
+   * @member {String} respHeaders
+   */
+  exports.prototype.respHeaders = undefined;
+
+  /**
+   * Correlation identifier shared by the sent notification or request & its received counterpart
+   * @member {String} correlationId
+   */
+  exports.prototype.correlationId = undefined;
+
   return exports;
 
 }));
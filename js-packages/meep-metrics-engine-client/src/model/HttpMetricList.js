@@ -31,18 +31,18 @@
 (function(root, factory) {
   if (typeof define === 'function' && define.amd) {
     // AMD. Register as an anonymous module.
-    define(['ApiClient', 'model/HttpMetric'], factory);
+    define(['ApiClient', 'model/AggregateSeries', 'model/HttpMetric'], factory);
   } else if (typeof module === 'object' && module.exports) {
     // CommonJS-like environments that support module.exports, like Node.
-    module.exports = factory(require('../ApiClient'), require('./HttpMetric'));
+    module.exports = factory(require('../ApiClient'), require('./AggregateSeries'), require('./HttpMetric'));
   } else {
     // Browser globals (root is window)
     if (!root.AdvantEdgeMetricsServiceRestApi) {
       root.AdvantEdgeMetricsServiceRestApi = {};
     }
-    root.AdvantEdgeMetricsServiceRestApi.HttpMetricList = factory(root.AdvantEdgeMetricsServiceRestApi.ApiClient, root.AdvantEdgeMetricsServiceRestApi.HttpMetric);
+    root.AdvantEdgeMetricsServiceRestApi.HttpMetricList = factory(root.AdvantEdgeMetricsServiceRestApi.ApiClient, root.AdvantEdgeMetricsServiceRestApi.AggregateSeries, root.AdvantEdgeMetricsServiceRestApi.HttpMetric);
   }
-}(this, function(ApiClient, HttpMetric) {
+}(this, function(ApiClient, AggregateSeries, HttpMetric) {
   'use strict';
 
   /**
@@ -76,6 +76,8 @@
         obj.columns = ApiClient.convertToType(data['columns'], ['String']);
       if (data.hasOwnProperty('values'))
         obj.values = ApiClient.convertToType(data['values'], [HttpMetric]);
+      if (data.hasOwnProperty('series'))
+        obj.series = ApiClient.convertToType(data['series'], [AggregateSeries]);
     }
     return obj;
   }
@@ -97,6 +99,12 @@
    */
   exports.prototype.values = undefined;
 
+  /**
+   * Aggregated metrics, one series per tag group; returned instead of values when an aggregation is requested
+   * @member {Array.<module:model/AggregateSeries>} series
+   */
+  exports.prototype.series = undefined;
+
   return exports;
 
 }));
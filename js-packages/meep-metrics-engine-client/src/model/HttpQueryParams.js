@@ -31,18 +31,18 @@
 (function(root, factory) {
   if (typeof define === 'function' && define.amd) {
     // AMD. Register as an anonymous module.
-    define(['ApiClient', 'model/Scope', 'model/Tag'], factory);
+    define(['ApiClient', 'model/Aggregation', 'model/Scope', 'model/Tag'], factory);
   } else if (typeof module === 'object' && module.exports) {
     // CommonJS-like environments that support module.exports, like Node.
-    module.exports = factory(require('../ApiClient'), require('./Scope'), require('./Tag'));
+    module.exports = factory(require('../ApiClient'), require('./Aggregation'), require('./Scope'), require('./Tag'));
   } else {
     // Browser globals (root is window)
     if (!root.AdvantEdgeMetricsServiceRestApi) {
       root.AdvantEdgeMetricsServiceRestApi = {};
     }
-    root.AdvantEdgeMetricsServiceRestApi.HttpQueryParams = factory(root.AdvantEdgeMetricsServiceRestApi.ApiClient, root.AdvantEdgeMetricsServiceRestApi.Scope, root.AdvantEdgeMetricsServiceRestApi.Tag);
+    root.AdvantEdgeMetricsServiceRestApi.HttpQueryParams = factory(root.AdvantEdgeMetricsServiceRestApi.ApiClient, root.AdvantEdgeMetricsServiceRestApi.Aggregation, root.AdvantEdgeMetricsServiceRestApi.Scope, root.AdvantEdgeMetricsServiceRestApi.Tag);
   }
-}(this, function(ApiClient, Scope, Tag) {
+}(this, function(ApiClient, Aggregation, Scope, Tag) {
   'use strict';
 
   /**
@@ -76,6 +76,8 @@
         obj.fields = ApiClient.convertToType(data['fields'], ['String']);
       if (data.hasOwnProperty('scope'))
         obj.scope = Scope.constructFromObject(data['scope']);
+      if (data.hasOwnProperty('aggregation'))
+        obj.aggregation = Aggregation.constructFromObject(data['aggregation']);
     }
     return obj;
   }
@@ -87,7 +89,7 @@
   exports.prototype.tags = undefined;
 
   /**
-   * Field names to return in query response. Supported values:<br> <li>id: Http metrics identifier<br> <li>endpoint: Http metrics queried endpoint<br> <li>url: Http metrics queried endpoint with query parameters<br> <li>method: Http metrics method<br> <li>resp_code: Http metrics response status code<br> <li>resp_body: Http metrics response body<br> <li>body: Http metrics body<br> <li>proc_time: Request processing time in ms
+   * Field names to return in query response. Supported values:<br> <li>id: Http metrics identifier<br> <li>endpoint: Http metrics queried endpoint<br> <li>url: Http metrics queried endpoint with query parameters<br> <li>method: Http metrics method<br> <li>resp_code: Http metrics response status code<br> <li>resp_body: Http metrics response body<br> <li>body: Http metrics body<br> <li>proc_time: Request processing time in ms<br> <li>proc_duration: Numerical request processing time, used for aggregations<br> <li>req_headers: Http metrics request headers<br> <li>resp_headers: Http metrics response headers<br> <li>correlation_id: Http metrics correlation identifier
    * @member {Array.<module:model/HttpQueryParams.FieldsEnum>} fields
    */
   exports.prototype.fields = undefined;
@@ -97,6 +99,11 @@
    */
   exports.prototype.scope = undefined;
 
+  /**
+   * @member {module:model/Aggregation} aggregation
+   */
+  exports.prototype.aggregation = undefined;
+
 
   /**
    * Allowed values for the <code>fields</code> property.
@@ -152,6 +159,30 @@
      */
     procTime: "proc_time",
 
+    /**
+     * value: "proc_duration"
+     * @const
+     */
+    procDuration: "proc_duration",
+
+    /**
+     * value: "req_headers"
+     * @const
+     */
+    reqHeaders: "req_headers",
+
+    /**
+     * value: "resp_headers"
+     * @const
+     */
+    respHeaders: "resp_headers",
+
+    /**
+     * value: "correlation_id"
+     * @const
+     */
+    correlationId: "correlation_id",
+
     /**
      * value: "logger_name"
      * @const
@@ -31,18 +31,18 @@
 (function(root, factory) {
   if (typeof define === 'function' && define.amd) {
     // AMD. Register as an anonymous module.
-    define(['ApiClient', 'model/Scope', 'model/Tag'], factory);
+    define(['ApiClient', 'model/Aggregation', 'model/Scope', 'model/Tag'], factory);
   } else if (typeof module === 'object' && module.exports) {
     // CommonJS-like environments that support module.exports, like Node.
-    module.exports = factory(require('../ApiClient'), require('./Scope'), require('./Tag'));
+    module.exports = factory(require('../ApiClient'), require('./Aggregation'), require('./Scope'), require('./Tag'));
   } else {
     // Browser globals (root is window)
     if (!root.AdvantEdgeMetricsServiceRestApi) {
       root.AdvantEdgeMetricsServiceRestApi = {};
     }
-    root.AdvantEdgeMetricsServiceRestApi.NetworkQueryParams = factory(root.AdvantEdgeMetricsServiceRestApi.ApiClient, root.AdvantEdgeMetricsServiceRestApi.Scope, root.AdvantEdgeMetricsServiceRestApi.Tag);
+    root.AdvantEdgeMetricsServiceRestApi.NetworkQueryParams = factory(root.AdvantEdgeMetricsServiceRestApi.ApiClient, root.AdvantEdgeMetricsServiceRestApi.Aggregation, root.AdvantEdgeMetricsServiceRestApi.Scope, root.AdvantEdgeMetricsServiceRestApi.Tag);
   }
-}(this, function(ApiClient, Scope, Tag) {
+}(this, function(ApiClient, Aggregation, Scope, Tag) {
   'use strict';
 
   /**
@@ -76,6 +76,8 @@
         obj.fields = ApiClient.convertToType(data['fields'], ['String']);
       if (data.hasOwnProperty('scope'))
         obj.scope = Scope.constructFromObject(data['scope']);
+      if (data.hasOwnProperty('aggregation'))
+        obj.aggregation = Aggregation.constructFromObject(data['aggregation']);
     }
     return obj;
   }
@@ -97,6 +99,11 @@
    */
   exports.prototype.scope = undefined;
 
+  /**
+   * @member {module:model/Aggregation} aggregation
+   */
+  exports.prototype.aggregation = undefined;
+
 
   /**
    * Allowed values for the <code>fields</code> property.
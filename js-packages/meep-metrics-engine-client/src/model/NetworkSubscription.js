@@ -31,18 +31,18 @@
 (function(root, factory) {
   if (typeof define === 'function' && define.amd) {
     // AMD. Register as an anonymous module.
-    define(['ApiClient', 'model/NetworkCallbackReference', 'model/NetworkQueryParams'], factory);
+    define(['ApiClient', 'model/NetworkCallbackReference', 'model/NetworkQueryParams', 'model/NetworkTrigger'], factory);
   } else if (typeof module === 'object' && module.exports) {
     // CommonJS-like environments that support module.exports, like Node.
-    module.exports = factory(require('../ApiClient'), require('./NetworkCallbackReference'), require('./NetworkQueryParams'));
+    module.exports = factory(require('../ApiClient'), require('./NetworkCallbackReference'), require('./NetworkQueryParams'), require('./NetworkTrigger'));
   } else {
     // Browser globals (root is window)
     if (!root.AdvantEdgeMetricsServiceRestApi) {
       root.AdvantEdgeMetricsServiceRestApi = {};
     }
-    root.AdvantEdgeMetricsServiceRestApi.NetworkSubscription = factory(root.AdvantEdgeMetricsServiceRestApi.ApiClient, root.AdvantEdgeMetricsServiceRestApi.NetworkCallbackReference, root.AdvantEdgeMetricsServiceRestApi.NetworkQueryParams);
+    root.AdvantEdgeMetricsServiceRestApi.NetworkSubscription = factory(root.AdvantEdgeMetricsServiceRestApi.ApiClient, root.AdvantEdgeMetricsServiceRestApi.NetworkCallbackReference, root.AdvantEdgeMetricsServiceRestApi.NetworkQueryParams, root.AdvantEdgeMetricsServiceRestApi.NetworkTrigger);
   }
-}(this, function(ApiClient, NetworkCallbackReference, NetworkQueryParams) {
+}(this, function(ApiClient, NetworkCallbackReference, NetworkQueryParams, NetworkTrigger) {
   'use strict';
 
   /**
@@ -80,6 +80,8 @@
         obj.resourceURL = ApiClient.convertToType(data['resourceURL'], 'String');
       if (data.hasOwnProperty('networkQueryParams'))
         obj.networkQueryParams = NetworkQueryParams.constructFromObject(data['networkQueryParams']);
+      if (data.hasOwnProperty('trigger'))
+        obj.trigger = NetworkTrigger.constructFromObject(data['trigger']);
       if (data.hasOwnProperty('period'))
         obj.period = ApiClient.convertToType(data['period'], 'Number');
       if (data.hasOwnProperty('subscriptionType'))
@@ -117,13 +119,18 @@
   exports.prototype.networkQueryParams = undefined;
 
   /**
-   * Notification interval in seconds, disabled if set to 0
+   * @member {module:model/NetworkTrigger} trigger
+   */
+  exports.prototype.trigger = undefined;
+
+  /**
+   * Notification interval in seconds, disabled if set to 0. Metric evaluation interval for threshold & change subscriptions (default 1s)
    * @member {Number} period
    */
   exports.prototype.period = undefined;
 
   /**
-   * Type of subscription triggering notifications
+   * Type of subscription triggering notifications:<br> <li>period: Periodic notifications <li>threshold: Notification when the trigger field crosses the threshold <li>change: Notification when the trigger field changes by more than delta
    * @member {module:model/NetworkSubscription.SubscriptionTypeEnum} subscriptionType
    */
   exports.prototype.subscriptionType = undefined;
@@ -139,7 +146,19 @@
      * value: "period"
      * @const
      */
-    period: "period"
+    period: "period",
+
+    /**
+     * value: "threshold"
+     * @const
+     */
+    threshold: "threshold",
+
+    /**
+     * value: "change"
+     * @const
+     */
+    change: "change"
   };
 
   return exports;
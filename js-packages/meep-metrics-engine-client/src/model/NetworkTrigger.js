@@ -0,0 +1,139 @@
+/*
+ * Copyright (c) 2020  InterDigital Communications, Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the \"License\");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an \"AS IS\" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * AdvantEDGE Metrics Service REST API
+ * Metrics Service provides metrics about the active scenario <p>**Micro-service**<br>[meep-metrics-engine](https://github.com/InterDigitalInc/AdvantEDGE/tree/master/go-apps/meep-metrics-engine) <p>**Type & Usage**<br>Platform Service used by control/monitoring software and possibly by edge applications that require metrics <p>**Details**<br>API details available at _your-AdvantEDGE-ip-address/api_
+ *
+ * OpenAPI spec version: 1.0.0
+ * Contact: AdvantEDGE@InterDigital.com
+ *
+ * NOTE: This class is auto generated by the swagger code generator program.
+ * https://github.com/swagger-api/swagger-codegen.git
+ *
+ * Swagger Codegen version: 2.4.9
+ *
+ * Do not edit the class manually.
+ *
+ */
+
+(function(root, factory) {
+  if (typeof define === 'function' && define.amd) {
+    // AMD. Register as an anonymous module.
+    define(['ApiClient'], factory);
+  } else if (typeof module === 'object' && module.exports) {
+    // CommonJS-like environments that support module.exports, like Node.
+    module.exports = factory(require('../ApiClient'));
+  } else {
+    // Browser globals (root is window)
+    if (!root.AdvantEdgeMetricsServiceRestApi) {
+      root.AdvantEdgeMetricsServiceRestApi = {};
+    }
+    root.AdvantEdgeMetricsServiceRestApi.NetworkTrigger = factory(root.AdvantEdgeMetricsServiceRestApi.ApiClient);
+  }
+}(this, function(ApiClient) {
+  'use strict';
+
+  /**
+   * The NetworkTrigger model module.
+   * @module model/NetworkTrigger
+   * @version 1.0.0
+   */
+
+  /**
+   * Constructs a new <code>NetworkTrigger</code>.
+   * Network metrics subscription trigger, used by threshold and change subscriptions
+   * @alias module:model/NetworkTrigger
+   * @class
+   */
+  var exports = function() {
+  };
+
+  /**
+   * Constructs a <code>NetworkTrigger</code> from a plain JavaScript object, optionally creating a new instance.
+   * Copies all relevant properties from <code>data</code> to <code>obj</code> if supplied or a new instance if not.
+   * @param {Object} data The plain JavaScript object bearing properties of interest.
+   * @param {module:model/NetworkTrigger} obj Optional instance to populate.
+   * @return {module:model/NetworkTrigger} The populated <code>NetworkTrigger</code> instance.
+   */
+  exports.constructFromObject = function(data, obj) {
+    if (data) {
+      obj = obj || new exports();
+      if (data.hasOwnProperty('field'))
+        obj.field = ApiClient.convertToType(data['field'], 'String');
+      if (data.hasOwnProperty('direction'))
+        obj.direction = ApiClient.convertToType(data['direction'], 'String');
+      if (data.hasOwnProperty('threshold'))
+        obj.threshold = ApiClient.convertToType(data['threshold'], 'Number');
+      if (data.hasOwnProperty('delta'))
+        obj.delta = ApiClient.convertToType(data['delta'], 'Number');
+      if (data.hasOwnProperty('hysteresis'))
+        obj.hysteresis = ApiClient.convertToType(data['hysteresis'], 'Number');
+    }
+    return obj;
+  }
+
+  /**
+   * Network metric field to monitor. Supported values:<br> <li>lat: Round-trip latency (ms)<br> <li>ul: Uplink throughput from src to dest (Mbps) <li>dl: Downlink throughput from dest to src (Mbps) <li>ulos: Uplink packet loss from src to dest (%) <li>dlos: Downlink packet loss from dest to src (%)
+   * @member {String} field
+   */
+  exports.prototype.field = undefined;
+
+  /**
+   * Threshold crossing direction raising the trigger (threshold subscriptions only)
+   * @member {module:model/NetworkTrigger.DirectionEnum} direction
+   */
+  exports.prototype.direction = undefined;
+
+  /**
+   * Threshold value (threshold subscriptions only)
+   * @member {Number} threshold
+   */
+  exports.prototype.threshold = undefined;
+
+  /**
+   * Minimum value change triggering a notification (change subscriptions only)
+   * @member {Number} delta
+   */
+  exports.prototype.delta = undefined;
+
+  /**
+   * Hysteresis margin. Threshold subscriptions: value must move back past the threshold by this margin to clear the trigger. Change subscriptions: additional change required to notify a change in the opposite direction.
+   * @member {Number} hysteresis
+   */
+  exports.prototype.hysteresis = undefined;
+
+
+  /**
+   * Allowed values for the <code>direction</code> property.
+   * @enum {String}
+   * @readonly
+   */
+  exports.DirectionEnum = {
+    /**
+     * value: "above"
+     * @const
+     */
+    above: "above",
+
+    /**
+     * value: "below"
+     * @const
+     */
+    below: "below"
+  };
+
+  return exports;
+
+}));
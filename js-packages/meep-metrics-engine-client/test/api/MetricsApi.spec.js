@@ -50,6 +50,30 @@
 
   describe('(package)', function() {
     describe('MetricsApi', function() {
+      describe('getMetricsExport', function() {
+        it('should call getMetricsExport successfully', function(done) {
+          // TODO: uncomment, update parameter values for getMetricsExport call and complete the assertions
+          /*
+          var opts = {};
+          opts.format = "csv";
+          opts.start = new Date("2013-10-20T19:20:30+01:00");
+          opts.end = new Date("2013-10-20T19:20:30+01:00");
+
+          instance.getMetricsExport(opts, function(error, data, response) {
+            if (error) {
+              done(error);
+              return;
+            }
+            // TODO: update response assertions
+            expect(data).to.be.a(File);
+
+            done();
+          });
+          */
+          // TODO: uncomment and complete method invocation above, then delete this line and the next:
+          done();
+        });
+      });
       describe('postEventQuery', function() {
         it('should call postEventQuery successfully', function(done) {
           // TODO: uncomment, update parameter values for postEventQuery call and complete the assertions
@@ -73,6 +73,12 @@
         // expect(instance.values).to.be(expectedValueLiteral);
       });
 
+      it('should have the property series (base name: "series")', function() {
+        // TODO: update the code to test the property series
+        expect(instance).to.have.property('series');
+        // expect(instance.series).to.be(expectedValueLiteral);
+      });
+
     });
   });
 
@@ -121,6 +121,24 @@
         // expect(instance.direction).to.be(expectedValueLiteral);
       });
 
+      it('should have the property reqHeaders (base name: "req_headers")', function() {
+        // TODO: update the code to test the property reqHeaders
+        expect(instance).to.have.property('reqHeaders');
+        // expect(instance.reqHeaders).to.be(expectedValueLiteral);
+      });
+
+      it('should have the property respHeaders (base name: "resp_headers")', function() {
+        // TODO: update the code to test the property respHeaders
+        expect(instance).to.have.property('respHeaders');
+        // expect(instance.respHeaders).to.be(expectedValueLiteral);
+      });
+
+      it('should have the property correlationId (base name: "correlation_id")', function() {
+        // TODO: update the code to test the property correlationId
+        expect(instance).to.have.property('correlationId');
+        // expect(instance.correlationId).to.be(expectedValueLiteral);
+      });
+
     });
   });
 
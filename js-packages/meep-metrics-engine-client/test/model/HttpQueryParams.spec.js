@@ -73,6 +73,12 @@
         // expect(instance.scope).to.be(expectedValueLiteral);
       });
 
+      it('should have the property aggregation (base name: "aggregation")', function() {
+        // TODO: update the code to test the property aggregation
+        expect(instance).to.have.property('aggregation');
+        // expect(instance.aggregation).to.be(expectedValueLiteral);
+      });
+
     });
   });
 
@@ -73,6 +73,12 @@
         // expect(instance.networkQueryParams).to.be(expectedValueLiteral);
       });
 
+      it('should have the property trigger (base name: "trigger")', function() {
+        // TODO: update the code to test the property trigger
+        expect(instance).to.have.property('trigger');
+        // expect(instance.trigger).to.be(expectedValueLiteral);
+      });
+
       it('should have the property period (base name: "period")', function() {
         // TODO: update the code to test the property period
         expect(instance).to.have.property('period');
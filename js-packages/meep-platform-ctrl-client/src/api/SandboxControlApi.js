@@ -31,18 +31,18 @@
 (function(root, factory) {
   if (typeof define === 'function' && define.amd) {
     // AMD. Register as an anonymous module.
-    define(['ApiClient', 'model/Sandbox', 'model/SandboxConfig', 'model/SandboxList'], factory);
+    define(['ApiClient', 'model/Sandbox', 'model/SandboxConfig', 'model/SandboxLease', 'model/SandboxList'], factory);
   } else if (typeof module === 'object' && module.exports) {
     // CommonJS-like environments that support module.exports, like Node.
-    module.exports = factory(require('../ApiClient'), require('../model/Sandbox'), require('../model/SandboxConfig'), require('../model/SandboxList'));
+    module.exports = factory(require('../ApiClient'), require('../model/Sandbox'), require('../model/SandboxConfig'), require('../model/SandboxLease'), require('../model/SandboxList'));
   } else {
     // Browser globals (root is window)
     if (!root.AdvantEdgePlatformControllerRestApi) {
       root.AdvantEdgePlatformControllerRestApi = {};
     }
-    root.AdvantEdgePlatformControllerRestApi.SandboxControlApi = factory(root.AdvantEdgePlatformControllerRestApi.ApiClient, root.AdvantEdgePlatformControllerRestApi.Sandbox, root.AdvantEdgePlatformControllerRestApi.SandboxConfig, root.AdvantEdgePlatformControllerRestApi.SandboxList);
+    root.AdvantEdgePlatformControllerRestApi.SandboxControlApi = factory(root.AdvantEdgePlatformControllerRestApi.ApiClient, root.AdvantEdgePlatformControllerRestApi.Sandbox, root.AdvantEdgePlatformControllerRestApi.SandboxConfig, root.AdvantEdgePlatformControllerRestApi.SandboxLease, root.AdvantEdgePlatformControllerRestApi.SandboxList);
   }
-}(this, function(ApiClient, Sandbox, SandboxConfig, SandboxList) {
+}(this, function(ApiClient, Sandbox, SandboxConfig, SandboxLease, SandboxList) {
   'use strict';
 
   /**
@@ -97,7 +97,7 @@
       var formParams = {
       };
 
-      var authNames = [];
+      var authNames = ['bearerAuth'];
       var contentTypes = ['application/json'];
       var accepts = ['application/json'];
       var returnType = Sandbox;
@@ -150,7 +150,7 @@
       var formParams = {
       };
 
-      var authNames = [];
+      var authNames = ['bearerAuth'];
       var contentTypes = ['application/json'];
       var accepts = ['application/json'];
       var returnType = null;
@@ -197,7 +197,7 @@
       var formParams = {
       };
 
-      var authNames = [];
+      var authNames = ['bearerAuth'];
       var contentTypes = ['application/json'];
       var accepts = ['application/json'];
       var returnType = null;
@@ -237,7 +237,7 @@
       var formParams = {
       };
 
-      var authNames = [];
+      var authNames = ['bearerAuth'];
       var contentTypes = ['application/json'];
       var accepts = ['application/json'];
       var returnType = null;
@@ -249,6 +249,60 @@
       );
     }
 
+    /**
+     * Callback function to receive the result of the extendSandboxLease operation.
+     * @callback module:api/SandboxControlApi~extendSandboxLeaseCallback
+     * @param {String} error Error message, if any.
+     * @param {module:model/Sandbox} data The data returned by the service call.
+     * @param {String} response The complete HTTP response.
+     */
+
+    /**
+     * Extend a sandbox lease
+     * Set a new time-to-live for the sandbox with the provided name; also restarts the sandbox idle timeout
+     * @param {String} name Sandbox name
+     * @param {module:model/SandboxLease} lease Sandbox lease information
+     * @param {module:api/SandboxControlApi~extendSandboxLeaseCallback} callback The callback function, accepting three arguments: error, data, response
+     * data is of type: {@link module:model/Sandbox}
+     */
+    this.extendSandboxLease = function(name, lease, callback) {
+      var postBody = lease;
+
+      // verify the required parameter 'name' is set
+      if (name === undefined || name === null) {
+        throw new Error("Missing the required parameter 'name' when calling extendSandboxLease");
+      }
+
+      // verify the required parameter 'lease' is set
+      if (lease === undefined || lease === null) {
+        throw new Error("Missing the required parameter 'lease' when calling extendSandboxLease");
+      }
+
+
+      var pathParams = {
+        'name': name
+      };
+      var queryParams = {
+      };
+      var collectionQueryParams = {
+      };
+      var headerParams = {
+      };
+      var formParams = {
+      };
+
+      var authNames = ['bearerAuth'];
+      var contentTypes = ['application/json'];
+      var accepts = ['application/json'];
+      var returnType = Sandbox;
+
+      return this.apiClient.callApi(
+        '/sandboxes/{name}/lease', 'PUT',
+        pathParams, queryParams, collectionQueryParams, headerParams, formParams, postBody,
+        authNames, contentTypes, accepts, returnType, callback
+      );
+    }
+
     /**
      * Callback function to receive the result of the getSandbox operation.
      * @callback module:api/SandboxControlApi~getSandboxCallback
@@ -285,7 +339,7 @@
       var formParams = {
       };
 
-      var authNames = [];
+      var authNames = ['bearerAuth'];
       var contentTypes = ['application/json'];
       var accepts = ['application/json'];
       var returnType = Sandbox;
@@ -326,7 +380,7 @@
       var formParams = {
       };
 
-      var authNames = [];
+      var authNames = ['bearerAuth'];
       var contentTypes = ['application/json'];
       var accepts = ['application/json'];
       var returnType = SandboxList;
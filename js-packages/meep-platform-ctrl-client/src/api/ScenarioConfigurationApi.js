@@ -31,18 +31,18 @@
 (function(root, factory) {
   if (typeof define === 'function' && define.amd) {
     // AMD. Register as an anonymous module.
-    define(['ApiClient', 'model/Scenario', 'model/ScenarioList'], factory);
+    define(['ApiClient', 'model/Scenario', 'model/ScenarioDiff', 'model/ScenarioHistory', 'model/ScenarioList', 'model/ScenarioValidation', 'model/ScenarioVersion'], factory);
   } else if (typeof module === 'object' && module.exports) {
     // CommonJS-like environments that support module.exports, like Node.
-    module.exports = factory(require('../ApiClient'), require('../model/Scenario'), require('../model/ScenarioList'));
+    module.exports = factory(require('../ApiClient'), require('../model/Scenario'), require('../model/ScenarioDiff'), require('../model/ScenarioHistory'), require('../model/ScenarioList'), require('../model/ScenarioValidation'), require('../model/ScenarioVersion'));
   } else {
     // Browser globals (root is window)
     if (!root.AdvantEdgePlatformControllerRestApi) {
       root.AdvantEdgePlatformControllerRestApi = {};
     }
-    root.AdvantEdgePlatformControllerRestApi.ScenarioConfigurationApi = factory(root.AdvantEdgePlatformControllerRestApi.ApiClient, root.AdvantEdgePlatformControllerRestApi.Scenario, root.AdvantEdgePlatformControllerRestApi.ScenarioList);
+    root.AdvantEdgePlatformControllerRestApi.ScenarioConfigurationApi = factory(root.AdvantEdgePlatformControllerRestApi.ApiClient, root.AdvantEdgePlatformControllerRestApi.Scenario, root.AdvantEdgePlatformControllerRestApi.ScenarioDiff, root.AdvantEdgePlatformControllerRestApi.ScenarioHistory, root.AdvantEdgePlatformControllerRestApi.ScenarioList, root.AdvantEdgePlatformControllerRestApi.ScenarioValidation, root.AdvantEdgePlatformControllerRestApi.ScenarioVersion);
   }
-}(this, function(ApiClient, Scenario, ScenarioList) {
+}(this, function(ApiClient, Scenario, ScenarioDiff, ScenarioHistory, ScenarioList, ScenarioValidation, ScenarioVersion) {
   'use strict';
 
   /**
@@ -103,7 +103,7 @@
       var formParams = {
       };
 
-      var authNames = [];
+      var authNames = ['bearerAuth'];
       var contentTypes = ['application/json'];
       var accepts = ['application/json'];
       var returnType = null;
@@ -150,7 +150,7 @@
       var formParams = {
       };
 
-      var authNames = [];
+      var authNames = ['bearerAuth'];
       var contentTypes = ['application/json'];
       var accepts = ['application/json'];
       var returnType = null;
@@ -190,7 +190,7 @@
       var formParams = {
       };
 
-      var authNames = [];
+      var authNames = ['bearerAuth'];
       var contentTypes = ['application/json'];
       var accepts = ['application/json'];
       var returnType = null;
@@ -202,6 +202,60 @@
       );
     }
 
+    /**
+     * Callback function to receive the result of the diffScenario operation.
+     * @callback module:api/ScenarioConfigurationApi~diffScenarioCallback
+     * @param {String} error Error message, if any.
+     * @param {module:model/ScenarioDiff} data The data returned by the service call.
+     * @param {String} response The complete HTTP response.
+     */
+
+    /**
+     * Compare scenario versions
+     * Get the nodes added, removed or moved & the network characteristics changed between two scenario versions
+     * @param {String} name Scenario name
+     * @param {Object} opts Optional parameters
+     * @param {String} opts.from Version compared from; defaults to the version preceding 'to'
+     * @param {String} opts.to Version compared to; defaults to 'latest'
+     * @param {module:api/ScenarioConfigurationApi~diffScenarioCallback} callback The callback function, accepting three arguments: error, data, response
+     * data is of type: {@link module:model/ScenarioDiff}
+     */
+    this.diffScenario = function(name, opts, callback) {
+      opts = opts || {};
+      var postBody = null;
+
+      // verify the required parameter 'name' is set
+      if (name === undefined || name === null) {
+        throw new Error("Missing the required parameter 'name' when calling diffScenario");
+      }
+
+
+      var pathParams = {
+        'name': name
+      };
+      var queryParams = {
+        'from': opts['from'],
+        'to': opts['to'],
+      };
+      var collectionQueryParams = {
+      };
+      var headerParams = {
+      };
+      var formParams = {
+      };
+
+      var authNames = ['bearerAuth'];
+      var contentTypes = ['application/json'];
+      var accepts = ['application/json'];
+      var returnType = ScenarioDiff;
+
+      return this.apiClient.callApi(
+        '/scenarios/{name}/diff', 'GET',
+        pathParams, queryParams, collectionQueryParams, headerParams, formParams, postBody,
+        authNames, contentTypes, accepts, returnType, callback
+      );
+    }
+
     /**
      * Callback function to receive the result of the getScenario operation.
      * @callback module:api/ScenarioConfigurationApi~getScenarioCallback
@@ -238,7 +292,7 @@
       var formParams = {
       };
 
-      var authNames = [];
+      var authNames = ['bearerAuth'];
       var contentTypes = ['application/json'];
       var accepts = ['application/json'];
       var returnType = Scenario;
@@ -250,6 +304,54 @@
       );
     }
 
+    /**
+     * Callback function to receive the result of the getScenarioHistory operation.
+     * @callback module:api/ScenarioConfigurationApi~getScenarioHistoryCallback
+     * @param {String} error Error message, if any.
+     * @param {module:model/ScenarioHistory} data The data returned by the service call.
+     * @param {String} response The complete HTTP response.
+     */
+
+    /**
+     * Get scenario history
+     * Get the list of stored versions of a scenario, oldest first
+     * @param {String} name Scenario name
+     * @param {module:api/ScenarioConfigurationApi~getScenarioHistoryCallback} callback The callback function, accepting three arguments: error, data, response
+     * data is of type: {@link module:model/ScenarioHistory}
+     */
+    this.getScenarioHistory = function(name, callback) {
+      var postBody = null;
+
+      // verify the required parameter 'name' is set
+      if (name === undefined || name === null) {
+        throw new Error("Missing the required parameter 'name' when calling getScenarioHistory");
+      }
+
+
+      var pathParams = {
+        'name': name
+      };
+      var queryParams = {
+      };
+      var collectionQueryParams = {
+      };
+      var headerParams = {
+      };
+      var formParams = {
+      };
+
+      var authNames = ['bearerAuth'];
+      var contentTypes = ['application/json'];
+      var accepts = ['application/json'];
+      var returnType = ScenarioHistory;
+
+      return this.apiClient.callApi(
+        '/scenarios/{name}/versions', 'GET',
+        pathParams, queryParams, collectionQueryParams, headerParams, formParams, postBody,
+        authNames, contentTypes, accepts, returnType, callback
+      );
+    }
+
     /**
      * Callback function to receive the result of the getScenarioList operation.
      * @callback module:api/ScenarioConfigurationApi~getScenarioListCallback
@@ -279,7 +381,7 @@
       var formParams = {
       };
 
-      var authNames = [];
+      var authNames = ['bearerAuth'];
       var contentTypes = ['application/json'];
       var accepts = ['application/json'];
       var returnType = ScenarioList;
@@ -291,6 +393,116 @@
       );
     }
 
+    /**
+     * Callback function to receive the result of the getScenarioVersion operation.
+     * @callback module:api/ScenarioConfigurationApi~getScenarioVersionCallback
+     * @param {String} error Error message, if any.
+     * @param {module:model/Scenario} data The data returned by the service call.
+     * @param {String} response The complete HTTP response.
+     */
+
+    /**
+     * Get a scenario version
+     * Get a past version of a scenario from the platform scenario history
+     * @param {String} name Scenario name
+     * @param {String} version Scenario version number, version tag or 'latest'
+     * @param {module:api/ScenarioConfigurationApi~getScenarioVersionCallback} callback The callback function, accepting three arguments: error, data, response
+     * data is of type: {@link module:model/Scenario}
+     */
+    this.getScenarioVersion = function(name, version, callback) {
+      var postBody = null;
+
+      // verify the required parameter 'name' is set
+      if (name === undefined || name === null) {
+        throw new Error("Missing the required parameter 'name' when calling getScenarioVersion");
+      }
+
+      // verify the required parameter 'version' is set
+      if (version === undefined || version === null) {
+        throw new Error("Missing the required parameter 'version' when calling getScenarioVersion");
+      }
+
+
+      var pathParams = {
+        'name': name,
+        'version': version
+      };
+      var queryParams = {
+      };
+      var collectionQueryParams = {
+      };
+      var headerParams = {
+      };
+      var formParams = {
+      };
+
+      var authNames = ['bearerAuth'];
+      var contentTypes = ['application/json'];
+      var accepts = ['application/json'];
+      var returnType = Scenario;
+
+      return this.apiClient.callApi(
+        '/scenarios/{name}/versions/{version}', 'GET',
+        pathParams, queryParams, collectionQueryParams, headerParams, formParams, postBody,
+        authNames, contentTypes, accepts, returnType, callback
+      );
+    }
+
+    /**
+     * Callback function to receive the result of the rollbackScenario operation.
+     * @callback module:api/ScenarioConfigurationApi~rollbackScenarioCallback
+     * @param {String} error Error message, if any.
+     * @param {module:model/ScenarioVersion} data The data returned by the service call.
+     * @param {String} response The complete HTTP response.
+     */
+
+    /**
+     * Roll back a scenario
+     * Restore a past scenario version as the current scenario; the restored scenario is recorded as a new version
+     * @param {String} name Scenario name
+     * @param {String} version Scenario version number, version tag or 'latest'
+     * @param {module:api/ScenarioConfigurationApi~rollbackScenarioCallback} callback The callback function, accepting three arguments: error, data, response
+     * data is of type: {@link module:model/ScenarioVersion}
+     */
+    this.rollbackScenario = function(name, version, callback) {
+      var postBody = null;
+
+      // verify the required parameter 'name' is set
+      if (name === undefined || name === null) {
+        throw new Error("Missing the required parameter 'name' when calling rollbackScenario");
+      }
+
+      // verify the required parameter 'version' is set
+      if (version === undefined || version === null) {
+        throw new Error("Missing the required parameter 'version' when calling rollbackScenario");
+      }
+
+
+      var pathParams = {
+        'name': name,
+        'version': version
+      };
+      var queryParams = {
+      };
+      var collectionQueryParams = {
+      };
+      var headerParams = {
+      };
+      var formParams = {
+      };
+
+      var authNames = ['bearerAuth'];
+      var contentTypes = ['application/json'];
+      var accepts = ['application/json'];
+      var returnType = ScenarioVersion;
+
+      return this.apiClient.callApi(
+        '/scenarios/{name}/versions/{version}/rollback', 'POST',
+        pathParams, queryParams, collectionQueryParams, headerParams, formParams, postBody,
+        authNames, contentTypes, accepts, returnType, callback
+      );
+    }
+
     /**
      * Callback function to receive the result of the setScenario operation.
      * @callback module:api/ScenarioConfigurationApi~setScenarioCallback
@@ -332,7 +544,7 @@
       var formParams = {
       };
 
-      var authNames = [];
+      var authNames = ['bearerAuth'];
       var contentTypes = ['application/json'];
       var accepts = ['application/json'];
       var returnType = null;
@@ -343,6 +555,121 @@
         authNames, contentTypes, accepts, returnType, callback
       );
     }
+
+    /**
+     * Callback function to receive the result of the setScenarioVersion operation.
+     * @callback module:api/ScenarioConfigurationApi~setScenarioVersionCallback
+     * @param {String} error Error message, if any.
+     * @param {module:model/ScenarioVersion} data The data returned by the service call.
+     * @param {String} response The complete HTTP response.
+     */
+
+    /**
+     * Tag a scenario version
+     * Set the tag & comment of a scenario version; tags must be unique within the scenario history and may be used instead of version numbers
+     * @param {String} name Scenario name
+     * @param {String} version Scenario version number, version tag or 'latest'
+     * @param {module:model/ScenarioVersion} versionInfo Version tag & comment; other fields are ignored
+     * @param {module:api/ScenarioConfigurationApi~setScenarioVersionCallback} callback The callback function, accepting three arguments: error, data, response
+     * data is of type: {@link module:model/ScenarioVersion}
+     */
+    this.setScenarioVersion = function(name, version, versionInfo, callback) {
+      var postBody = versionInfo;
+
+      // verify the required parameter 'name' is set
+      if (name === undefined || name === null) {
+        throw new Error("Missing the required parameter 'name' when calling setScenarioVersion");
+      }
+
+      // verify the required parameter 'version' is set
+      if (version === undefined || version === null) {
+        throw new Error("Missing the required parameter 'version' when calling setScenarioVersion");
+      }
+
+      // verify the required parameter 'versionInfo' is set
+      if (versionInfo === undefined || versionInfo === null) {
+        throw new Error("Missing the required parameter 'versionInfo' when calling setScenarioVersion");
+      }
+
+
+      var pathParams = {
+        'name': name,
+        'version': version
+      };
+      var queryParams = {
+      };
+      var collectionQueryParams = {
+      };
+      var headerParams = {
+      };
+      var formParams = {
+      };
+
+      var authNames = ['bearerAuth'];
+      var contentTypes = ['application/json'];
+      var accepts = ['application/json'];
+      var returnType = ScenarioVersion;
+
+      return this.apiClient.callApi(
+        '/scenarios/{name}/versions/{version}', 'PUT',
+        pathParams, queryParams, collectionQueryParams, headerParams, formParams, postBody,
+        authNames, contentTypes, accepts, returnType, callback
+      );
+    }
+
+    /**
+     * Callback function to receive the result of the validateScenario operation.
+     * @callback module:api/ScenarioConfigurationApi~validateScenarioCallback
+     * @param {String} error Error message, if any.
+     * @param {module:model/ScenarioValidation} data The data returned by the service call.
+     * @param {String} response The complete HTTP response.
+     */
+
+    /**
+     * Validate a scenario
+     * Validate a scenario without storing it; invalid scenarios are rejected by createScenario & setScenario with the same errors
+     * @param {String} name Scenario name
+     * @param {module:model/Scenario} scenario Scenario
+     * @param {module:api/ScenarioConfigurationApi~validateScenarioCallback} callback The callback function, accepting three arguments: error, data, response
+     * data is of type: {@link module:model/ScenarioValidation}
+     */
+    this.validateScenario = function(name, scenario, callback) {
+      var postBody = scenario;
+
+      // verify the required parameter 'name' is set
+      if (name === undefined || name === null) {
+        throw new Error("Missing the required parameter 'name' when calling validateScenario");
+      }
+
+      // verify the required parameter 'scenario' is set
+      if (scenario === undefined || scenario === null) {
+        throw new Error("Missing the required parameter 'scenario' when calling validateScenario");
+      }
+
+
+      var pathParams = {
+        'name': name
+      };
+      var queryParams = {
+      };
+      var collectionQueryParams = {
+      };
+      var headerParams = {
+      };
+      var formParams = {
+      };
+
+      var authNames = ['bearerAuth'];
+      var contentTypes = ['application/json'];
+      var accepts = ['application/json'];
+      var returnType = ScenarioValidation;
+
+      return this.apiClient.callApi(
+        '/scenarios/{name}/validate', 'POST',
+        pathParams, queryParams, collectionQueryParams, headerParams, formParams, postBody,
+        authNames, contentTypes, accepts, returnType, callback
+      );
+    }
   };
 
   return exports;
@@ -31,12 +31,12 @@
 (function(factory) {
   if (typeof define === 'function' && define.amd) {
     // AMD. Register as an anonymous module.
-    define(['ApiClient', 'model/CellularDomainConfig', 'model/CellularPoaConfig', 'model/Deployment', 'model/Domain', 'model/EgressService', 'model/ExternalConfig', 'model/GeoData', 'model/GpuConfig', 'model/IngressService', 'model/LineString', 'model/NetworkCharacteristics', 'model/NetworkLocation', 'model/PhysicalLocation', 'model/Point', 'model/Process', 'model/Sandbox', 'model/SandboxConfig', 'model/SandboxList', 'model/Scenario', 'model/ScenarioConfig', 'model/ScenarioList', 'model/ServiceConfig', 'model/ServicePort', 'model/Zone', 'api/SandboxControlApi', 'api/ScenarioConfigurationApi'], factory);
+    define(['ApiClient', 'model/CellularDomainConfig', 'model/CellularPoaConfig', 'model/Deployment', 'model/Domain', 'model/EgressService', 'model/ExternalConfig', 'model/GeoData', 'model/GpuConfig', 'model/IngressService', 'model/LineString', 'model/NetworkCharacteristics', 'model/NetworkLocation', 'model/PhysicalLocation', 'model/Point', 'model/Process', 'model/Sandbox', 'model/SandboxConfig', 'model/SandboxLease', 'model/SandboxList', 'model/Scenario', 'model/ScenarioConfig', 'model/ScenarioDiff', 'model/ScenarioHistory', 'model/ScenarioList', 'model/ScenarioNetCharDiff', 'model/ScenarioNodeDiff', 'model/ScenarioValidation', 'model/ScenarioValidationError', 'model/ScenarioVersion', 'model/ServiceConfig', 'model/ServicePort', 'model/Zone', 'api/SandboxControlApi', 'api/ScenarioConfigurationApi'], factory);
   } else if (typeof module === 'object' && module.exports) {
     // CommonJS-like environments that support module.exports, like Node.
-    module.exports = factory(require('./ApiClient'), require('./model/CellularDomainConfig'), require('./model/CellularPoaConfig'), require('./model/Deployment'), require('./model/Domain'), require('./model/EgressService'), require('./model/ExternalConfig'), require('./model/GeoData'), require('./model/GpuConfig'), require('./model/IngressService'), require('./model/LineString'), require('./model/NetworkCharacteristics'), require('./model/NetworkLocation'), require('./model/PhysicalLocation'), require('./model/Point'), require('./model/Process'), require('./model/Sandbox'), require('./model/SandboxConfig'), require('./model/SandboxList'), require('./model/Scenario'), require('./model/ScenarioConfig'), require('./model/ScenarioList'), require('./model/ServiceConfig'), require('./model/ServicePort'), require('./model/Zone'), require('./api/SandboxControlApi'), require('./api/ScenarioConfigurationApi'));
+    module.exports = factory(require('./ApiClient'), require('./model/CellularDomainConfig'), require('./model/CellularPoaConfig'), require('./model/Deployment'), require('./model/Domain'), require('./model/EgressService'), require('./model/ExternalConfig'), require('./model/GeoData'), require('./model/GpuConfig'), require('./model/IngressService'), require('./model/LineString'), require('./model/NetworkCharacteristics'), require('./model/NetworkLocation'), require('./model/PhysicalLocation'), require('./model/Point'), require('./model/Process'), require('./model/Sandbox'), require('./model/SandboxConfig'), require('./model/SandboxLease'), require('./model/SandboxList'), require('./model/Scenario'), require('./model/ScenarioConfig'), require('./model/ScenarioDiff'), require('./model/ScenarioHistory'), require('./model/ScenarioList'), require('./model/ScenarioNetCharDiff'), require('./model/ScenarioNodeDiff'), require('./model/ScenarioValidation'), require('./model/ScenarioValidationError'), require('./model/ScenarioVersion'), require('./model/ServiceConfig'), require('./model/ServicePort'), require('./model/Zone'), require('./api/SandboxControlApi'), require('./api/ScenarioConfigurationApi'));
   }
-}(function(ApiClient, CellularDomainConfig, CellularPoaConfig, Deployment, Domain, EgressService, ExternalConfig, GeoData, GpuConfig, IngressService, LineString, NetworkCharacteristics, NetworkLocation, PhysicalLocation, Point, Process, Sandbox, SandboxConfig, SandboxList, Scenario, ScenarioConfig, ScenarioList, ServiceConfig, ServicePort, Zone, SandboxControlApi, ScenarioConfigurationApi) {
+}(function(ApiClient, CellularDomainConfig, CellularPoaConfig, Deployment, Domain, EgressService, ExternalConfig, GeoData, GpuConfig, IngressService, LineString, NetworkCharacteristics, NetworkLocation, PhysicalLocation, Point, Process, Sandbox, SandboxConfig, SandboxLease, SandboxList, Scenario, ScenarioConfig, ScenarioDiff, ScenarioHistory, ScenarioList, ScenarioNetCharDiff, ScenarioNodeDiff, ScenarioValidation, ScenarioValidationError, ScenarioVersion, ServiceConfig, ServicePort, Zone, SandboxControlApi, ScenarioConfigurationApi) {
   'use strict';
 
   /**
@@ -161,6 +161,11 @@
      * @property {module:model/SandboxConfig}
      */
     SandboxConfig: SandboxConfig,
+    /**
+     * The SandboxLease model constructor.
+     * @property {module:model/SandboxLease}
+     */
+    SandboxLease: SandboxLease,
     /**
      * The SandboxList model constructor.
      * @property {module:model/SandboxList}
@@ -176,11 +181,46 @@
      * @property {module:model/ScenarioConfig}
      */
     ScenarioConfig: ScenarioConfig,
+    /**
+     * The ScenarioDiff model constructor.
+     * @property {module:model/ScenarioDiff}
+     */
+    ScenarioDiff: ScenarioDiff,
+    /**
+     * The ScenarioHistory model constructor.
+     * @property {module:model/ScenarioHistory}
+     */
+    ScenarioHistory: ScenarioHistory,
     /**
      * The ScenarioList model constructor.
      * @property {module:model/ScenarioList}
      */
     ScenarioList: ScenarioList,
+    /**
+     * The ScenarioNetCharDiff model constructor.
+     * @property {module:model/ScenarioNetCharDiff}
+     */
+    ScenarioNetCharDiff: ScenarioNetCharDiff,
+    /**
+     * The ScenarioNodeDiff model constructor.
+     * @property {module:model/ScenarioNodeDiff}
+     */
+    ScenarioNodeDiff: ScenarioNodeDiff,
+    /**
+     * The ScenarioValidation model constructor.
+     * @property {module:model/ScenarioValidation}
+     */
+    ScenarioValidation: ScenarioValidation,
+    /**
+     * The ScenarioValidationError model constructor.
+     * @property {module:model/ScenarioValidationError}
+     */
+    ScenarioValidationError: ScenarioValidationError,
+    /**
+     * The ScenarioVersion model constructor.
+     * @property {module:model/ScenarioVersion}
+     */
+    ScenarioVersion: ScenarioVersion,
     /**
      * The ServiceConfig model constructor.
      * @property {module:model/ServiceConfig}
@@ -72,6 +72,10 @@
       obj = obj || new exports();
       if (data.hasOwnProperty('name'))
         obj.name = ApiClient.convertToType(data['name'], 'String');
+      if (data.hasOwnProperty('owner'))
+        obj.owner = ApiClient.convertToType(data['owner'], 'String');
+      if (data.hasOwnProperty('expiryTime'))
+        obj.expiryTime = ApiClient.convertToType(data['expiryTime'], 'String');
     }
     return obj;
   }
@@ -82,6 +86,18 @@
    */
   exports.prototype.name = undefined;
 
+  /**
+   * Sandbox owner; set from the authenticated user on sandbox creation
+   * @member {String} owner
+   */
+  exports.prototype.owner = undefined;
+
+  /**
+   * Time at which the sandbox expires (RFC3339), considering its lease & idle timeout; not set if sandbox never expires
+   * @member {String} expiryTime
+   */
+  exports.prototype.expiryTime = undefined;
+
   return exports;
 
 }));
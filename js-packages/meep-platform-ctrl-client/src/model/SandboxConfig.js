@@ -72,6 +72,10 @@
       obj = obj || new exports();
       if (data.hasOwnProperty('scenarioName'))
         obj.scenarioName = ApiClient.convertToType(data['scenarioName'], 'String');
+      if (data.hasOwnProperty('ttl'))
+        obj.ttl = ApiClient.convertToType(data['ttl'], 'Number');
+      if (data.hasOwnProperty('idleTimeout'))
+        obj.idleTimeout = ApiClient.convertToType(data['idleTimeout'], 'Number');
     }
     return obj;
   }
@@ -82,6 +86,18 @@
    */
   exports.prototype.scenarioName = undefined;
 
+  /**
+   * Sandbox time-to-live in seconds; sandbox never expires if not set
+   * @member {Number} ttl
+   */
+  exports.prototype.ttl = undefined;
+
+  /**
+   * Sandbox expires after this number of seconds without REST requests or events; no idle timeout if not set
+   * @member {Number} idleTimeout
+   */
+  exports.prototype.idleTimeout = undefined;
+
   return exports;
 
 }));
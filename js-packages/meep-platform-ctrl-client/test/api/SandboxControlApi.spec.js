@@ -132,6 +132,31 @@
           done();
         });
       });
+      describe('extendSandboxLease', function() {
+        it('should call extendSandboxLease successfully', function(done) {
+          // TODO: uncomment, update parameter values for extendSandboxLease call and complete the assertions
+          /*
+          var name = "name_example";
+          var lease = new AdvantEdgePlatformControllerRestApi.SandboxLease();
+          lease.ttl = 0;
+
+          instance.extendSandboxLease(name, lease, function(error, data, response) {
+            if (error) {
+              done(error);
+              return;
+            }
+            // TODO: update response assertions
+            expect(data).to.be.a(AdvantEdgePlatformControllerRestApi.Sandbox);
+            expect(data.name).to.be.a('string');
+            expect(data.name).to.be("");
+
+            done();
+          });
+          */
+          // TODO: uncomment and complete method invocation above, then delete this line and the next:
+          done();
+        });
+      });
       describe('getSandbox', function() {
         it('should call getSandbox successfully', function(done) {
           // TODO: uncomment, update parameter values for getSandbox call and complete the assertions
@@ -287,6 +287,30 @@
           done();
         });
       });
+      describe('diffScenario', function() {
+        it('should call diffScenario successfully', function(done) {
+          // TODO: uncomment, update parameter values for diffScenario call and complete the assertions
+          /*
+          var name = "name_example";
+          var opts = {};
+          opts.from = "from_example";
+          opts.to = "to_example";
+
+          instance.diffScenario(name, opts, function(error, data, response) {
+            if (error) {
+              done(error);
+              return;
+            }
+            // TODO: update response assertions
+            expect(data).to.be.a(AdvantEdgePlatformControllerRestApi.ScenarioDiff);
+
+            done();
+          });
+          */
+          // TODO: uncomment and complete method invocation above, then delete this line and the next:
+          done();
+        });
+      });
       describe('getScenario', function() {
         it('should call getScenario successfully', function(done) {
           // TODO: uncomment, update parameter values for getScenario call and complete the assertions
@@ -841,6 +865,27 @@
           done();
         });
       });
+      describe('getScenarioHistory', function() {
+        it('should call getScenarioHistory successfully', function(done) {
+          // TODO: uncomment, update parameter values for getScenarioHistory call and complete the assertions
+          /*
+          var name = "name_example";
+
+          instance.getScenarioHistory(name, function(error, data, response) {
+            if (error) {
+              done(error);
+              return;
+            }
+            // TODO: update response assertions
+            expect(data).to.be.a(AdvantEdgePlatformControllerRestApi.ScenarioHistory);
+
+            done();
+          });
+          */
+          // TODO: uncomment and complete method invocation above, then delete this line and the next:
+          done();
+        });
+      });
       describe('getScenarioList', function() {
         it('should call getScenarioList successfully', function(done) {
           // TODO: uncomment getScenarioList call and complete the assertions
@@ -1403,6 +1448,50 @@
           done();
         });
       });
+      describe('getScenarioVersion', function() {
+        it('should call getScenarioVersion successfully', function(done) {
+          // TODO: uncomment, update parameter values for getScenarioVersion call and complete the assertions
+          /*
+          var name = "name_example";
+          var version = "version_example";
+
+          instance.getScenarioVersion(name, version, function(error, data, response) {
+            if (error) {
+              done(error);
+              return;
+            }
+            // TODO: update response assertions
+            expect(data).to.be.a(AdvantEdgePlatformControllerRestApi.Scenario);
+
+            done();
+          });
+          */
+          // TODO: uncomment and complete method invocation above, then delete this line and the next:
+          done();
+        });
+      });
+      describe('rollbackScenario', function() {
+        it('should call rollbackScenario successfully', function(done) {
+          // TODO: uncomment, update parameter values for rollbackScenario call and complete the assertions
+          /*
+          var name = "name_example";
+          var version = "version_example";
+
+          instance.rollbackScenario(name, version, function(error, data, response) {
+            if (error) {
+              done(error);
+              return;
+            }
+            // TODO: update response assertions
+            expect(data).to.be.a(AdvantEdgePlatformControllerRestApi.ScenarioVersion);
+
+            done();
+          });
+          */
+          // TODO: uncomment and complete method invocation above, then delete this line and the next:
+          done();
+        });
+      });
       describe('setScenario', function() {
         it('should call setScenario successfully', function(done) {
           // TODO: uncomment, update parameter values for setScenario call
@@ -1603,6 +1692,51 @@
           done();
         });
       });
+      describe('setScenarioVersion', function() {
+        it('should call setScenarioVersion successfully', function(done) {
+          // TODO: uncomment, update parameter values for setScenarioVersion call and complete the assertions
+          /*
+          var name = "name_example";
+          var version = "version_example";
+          var versionInfo = new AdvantEdgePlatformControllerRestApi.ScenarioVersion();
+
+          instance.setScenarioVersion(name, version, versionInfo, function(error, data, response) {
+            if (error) {
+              done(error);
+              return;
+            }
+            // TODO: update response assertions
+            expect(data).to.be.a(AdvantEdgePlatformControllerRestApi.ScenarioVersion);
+
+            done();
+          });
+          */
+          // TODO: uncomment and complete method invocation above, then delete this line and the next:
+          done();
+        });
+      });
+      describe('validateScenario', function() {
+        it('should call validateScenario successfully', function(done) {
+          // TODO: uncomment, update parameter values for validateScenario call and complete the assertions
+          /*
+          var name = "name_example";
+          var scenario = new AdvantEdgePlatformControllerRestApi.Scenario();
+
+          instance.validateScenario(name, scenario, function(error, data, response) {
+            if (error) {
+              done(error);
+              return;
+            }
+            // TODO: update response assertions
+            expect(data).to.be.a(AdvantEdgePlatformControllerRestApi.ScenarioValidation);
+
+            done();
+          });
+          */
+          // TODO: uncomment and complete method invocation above, then delete this line and the next:
+          done();
+        });
+      });
     });
   });
 
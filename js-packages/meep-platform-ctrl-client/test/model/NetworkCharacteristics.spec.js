@@ -97,6 +97,60 @@
         // expect(instance.packetLoss).to.be(expectedValueLiteral);
       });
 
+      it('should have the property packetLossCorrelation (base name: "packetLossCorrelation")', function() {
+        // TODO: update the code to test the property packetLossCorrelation
+        expect(instance).to.have.property('packetLossCorrelation');
+        // expect(instance.packetLossCorrelation).to.be(expectedValueLiteral);
+      });
+
+      it('should have the property packetLossModel (base name: "packetLossModel")', function() {
+        // TODO: update the code to test the property packetLossModel
+        expect(instance).to.have.property('packetLossModel');
+        // expect(instance.packetLossModel).to.be(expectedValueLiteral);
+      });
+
+      it('should have the property burstLossGoodToBad (base name: "burstLossGoodToBad")', function() {
+        // TODO: update the code to test the property burstLossGoodToBad
+        expect(instance).to.have.property('burstLossGoodToBad');
+        // expect(instance.burstLossGoodToBad).to.be(expectedValueLiteral);
+      });
+
+      it('should have the property burstLossBadToGood (base name: "burstLossBadToGood")', function() {
+        // TODO: update the code to test the property burstLossBadToGood
+        expect(instance).to.have.property('burstLossBadToGood');
+        // expect(instance.burstLossBadToGood).to.be(expectedValueLiteral);
+      });
+
+      it('should have the property burstLossBad (base name: "burstLossBad")', function() {
+        // TODO: update the code to test the property burstLossBad
+        expect(instance).to.have.property('burstLossBad');
+        // expect(instance.burstLossBad).to.be(expectedValueLiteral);
+      });
+
+      it('should have the property burstLossGood (base name: "burstLossGood")', function() {
+        // TODO: update the code to test the property burstLossGood
+        expect(instance).to.have.property('burstLossGood');
+        // expect(instance.burstLossGood).to.be(expectedValueLiteral);
+      });
+
+      it('should have the property packetDuplication (base name: "packetDuplication")', function() {
+        // TODO: update the code to test the property packetDuplication
+        expect(instance).to.have.property('packetDuplication');
+        // expect(instance.packetDuplication).to.be(expectedValueLiteral);
+      });
+
+      it('should have the property packetCorruption (base name: "packetCorruption")', function() {
+        // TODO: update the code to test the property packetCorruption
+        expect(instance).to.have.property('packetCorruption');
+        // expect(instance.packetCorruption).to.be(expectedValueLiteral);
+      });
+
+      it('should have the property packetReordering (base name: "packetReordering")', function() {
+        // TODO: update the code to test the property packetReordering
+        expect(instance).to.have.property('packetReordering');
+        // expect(instance.packetReordering).to.be(expectedValueLiteral);
+      });
+
     });
   });
 
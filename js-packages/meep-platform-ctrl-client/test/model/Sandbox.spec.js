@@ -61,6 +61,18 @@
         // expect(instance.name).to.be(expectedValueLiteral);
       });
 
+      it('should have the property owner (base name: "owner")', function() {
+        // TODO: update the code to test the property owner
+        expect(instance).to.have.property('owner');
+        // expect(instance.owner).to.be(expectedValueLiteral);
+      });
+
+      it('should have the property expiryTime (base name: "expiryTime")', function() {
+        // TODO: update the code to test the property expiryTime
+        expect(instance).to.have.property('expiryTime');
+        // expect(instance.expiryTime).to.be(expectedValueLiteral);
+      });
+
     });
   });
 
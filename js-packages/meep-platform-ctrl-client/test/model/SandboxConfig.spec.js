@@ -61,6 +61,18 @@
         // expect(instance.scenarioName).to.be(expectedValueLiteral);
       });
 
+      it('should have the property ttl (base name: "ttl")', function() {
+        // TODO: update the code to test the property ttl
+        expect(instance).to.have.property('ttl');
+        // expect(instance.ttl).to.be(expectedValueLiteral);
+      });
+
+      it('should have the property idleTimeout (base name: "idleTimeout")', function() {
+        // TODO: update the code to test the property idleTimeout
+        expect(instance).to.have.property('idleTimeout');
+        // expect(instance.idleTimeout).to.be(expectedValueLiteral);
+      });
+
     });
   });
 
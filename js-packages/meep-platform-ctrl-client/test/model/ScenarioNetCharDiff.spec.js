@@ -0,0 +1,91 @@
+/*
+ * Copyright (c) 2020  InterDigital Communications, Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the \"License\");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an \"AS IS\" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * AdvantEDGE Platform Controller REST API
+ * This API is the main Platform Controller API for scenario configuration & sandbox management <p>**Micro-service**<br>[meep-pfm-ctrl](https://github.com/InterDigitalInc/AdvantEDGE/tree/master/go-apps/meep-platform-ctrl) <p>**Type & Usage**<br>Platform main interface used by controller software to configure scenarios and manage sandboxes in the AdvantEDGE platform <p>**Details**<br>API details available at _your-AdvantEDGE-ip-address/api_
+ *
+ * OpenAPI spec version: 1.0.0
+ * Contact: AdvantEDGE@InterDigital.com
+ *
+ * NOTE: This class is auto generated by the swagger code generator program.
+ * https://github.com/swagger-api/swagger-codegen.git
+ *
+ * Swagger Codegen version: 2.4.9
+ *
+ * Do not edit the class manually.
+ *
+ */
+
+(function(root, factory) {
+  if (typeof define === 'function' && define.amd) {
+    // AMD.
+    define(['expect.js', '../../src/index'], factory);
+  } else if (typeof module === 'object' && module.exports) {
+    // CommonJS-like environments that support module.exports, like Node.
+    factory(require('expect.js'), require('../../src/index'));
+  } else {
+    // Browser globals (root is window)
+    factory(root.expect, root.AdvantEdgePlatformControllerRestApi);
+  }
+}(this, function(expect, AdvantEdgePlatformControllerRestApi) {
+  'use strict';
+
+  var instance;
+
+  describe('(package)', function() {
+    describe('ScenarioNetCharDiff', function() {
+      beforeEach(function() {
+        instance = new AdvantEdgePlatformControllerRestApi.ScenarioNetCharDiff();
+      });
+
+      it('should create an instance of ScenarioNetCharDiff', function() {
+        // TODO: update the code to test ScenarioNetCharDiff
+        expect(instance).to.be.a(AdvantEdgePlatformControllerRestApi.ScenarioNetCharDiff);
+      });
+
+      it('should have the property name (base name: "name")', function() {
+        // TODO: update the code to test the property name
+        expect(instance).to.have.property('name');
+        // expect(instance.name).to.be(expectedValueLiteral);
+      });
+
+      it('should have the property type (base name: "type")', function() {
+        // TODO: update the code to test the property type
+        expect(instance).to.have.property('type');
+        // expect(instance.type).to.be(expectedValueLiteral);
+      });
+
+      it('should have the property fields (base name: "fields")', function() {
+        // TODO: update the code to test the property fields
+        expect(instance).to.have.property('fields');
+        // expect(instance.fields).to.be(expectedValueLiteral);
+      });
+
+      it('should have the property previous (base name: "previous")', function() {
+        // TODO: update the code to test the property previous
+        expect(instance).to.have.property('previous');
+        // expect(instance.previous).to.be(expectedValueLiteral);
+      });
+
+      it('should have the property current (base name: "current")', function() {
+        // TODO: update the code to test the property current
+        expect(instance).to.have.property('current');
+        // expect(instance.current).to.be(expectedValueLiteral);
+      });
+
+    });
+  });
+
+}));
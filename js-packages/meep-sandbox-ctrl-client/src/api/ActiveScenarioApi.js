@@ -100,7 +100,7 @@
       var formParams = {
       };
 
-      var authNames = [];
+      var authNames = ['bearerAuth'];
       var contentTypes = ['application/json'];
       var accepts = ['application/json'];
       var returnType = null;
@@ -149,7 +149,7 @@
       var formParams = {
       };
 
-      var authNames = [];
+      var authNames = ['bearerAuth'];
       var contentTypes = ['application/json'];
       var accepts = ['application/json'];
       var returnType = [NodeServiceMaps];
@@ -190,7 +190,7 @@
       var formParams = {
       };
 
-      var authNames = [];
+      var authNames = ['bearerAuth'];
       var contentTypes = ['application/json'];
       var accepts = ['application/json'];
       var returnType = Scenario;
@@ -230,7 +230,7 @@
       var formParams = {
       };
 
-      var authNames = [];
+      var authNames = ['bearerAuth'];
       var contentTypes = ['application/json'];
       var accepts = ['application/json'];
       var returnType = null;
@@ -103,7 +103,7 @@
       var formParams = {
       };
 
-      var authNames = [];
+      var authNames = ['bearerAuth'];
       var contentTypes = ['application/json'];
       var accepts = ['application/json'];
       var returnType = null;
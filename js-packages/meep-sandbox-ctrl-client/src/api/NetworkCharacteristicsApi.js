@@ -0,0 +1,154 @@
+/*
+ * Copyright (c) 2020  InterDigital Communications, Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the \"License\");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an \"AS IS\" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * AdvantEDGE Sandbox Controller REST API
+ * This API is the main Sandbox Controller API for scenario deployment & event injection <p>**Micro-service**<br>[meep-sandbox-ctrl](https://github.com/InterDigitalInc/AdvantEDGE/tree/master/go-apps/meep-sandbox-ctrl) <p>**Type & Usage**<br>Platform runtime interface to manage active scenarios and inject events in AdvantEDGE platform <p>**Details**<br>API details available at _your-AdvantEDGE-ip-address/api_
+ *
+ * OpenAPI spec version: 1.0.0
+ * Contact: AdvantEDGE@InterDigital.com
+ *
+ * NOTE: This class is auto generated by the swagger code generator program.
+ * https://github.com/swagger-api/swagger-codegen.git
+ *
+ * Swagger Codegen version: 2.4.9
+ *
+ * Do not edit the class manually.
+ *
+ */
+
+(function(root, factory) {
+  if (typeof define === 'function' && define.amd) {
+    // AMD. Register as an anonymous module.
+    define(['ApiClient', 'model/NetCharAlgorithm'], factory);
+  } else if (typeof module === 'object' && module.exports) {
+    // CommonJS-like environments that support module.exports, like Node.
+    module.exports = factory(require('../ApiClient'), require('../model/NetCharAlgorithm'));
+  } else {
+    // Browser globals (root is window)
+    if (!root.AdvantEdgeSandboxControllerRestApi) {
+      root.AdvantEdgeSandboxControllerRestApi = {};
+    }
+    root.AdvantEdgeSandboxControllerRestApi.NetworkCharacteristicsApi = factory(root.AdvantEdgeSandboxControllerRestApi.ApiClient, root.AdvantEdgeSandboxControllerRestApi.NetCharAlgorithm);
+  }
+}(this, function(ApiClient, NetCharAlgorithm) {
+  'use strict';
+
+  /**
+   * NetworkCharacteristics service.
+   * @module api/NetworkCharacteristicsApi
+   * @version 1.0.0
+   */
+
+  /**
+   * Constructs a new NetworkCharacteristicsApi. 
+   * @alias module:api/NetworkCharacteristicsApi
+   * @class
+   * @param {module:ApiClient} [apiClient] Optional API client implementation to use,
+   * default to {@link module:ApiClient#instance} if unspecified.
+   */
+  var exports = function(apiClient) {
+    this.apiClient = apiClient || ApiClient.instance;
+
+
+    /**
+     * Callback function to receive the result of the getNetCharAlgorithm operation.
+     * @callback module:api/NetworkCharacteristicsApi~getNetCharAlgorithmCallback
+     * @param {String} error Error message, if any.
+     * @param {module:model/NetCharAlgorithm} data The data returned by the service call.
+     * @param {String} response The complete HTTP response.
+     */
+
+    /**
+     * Get network characteristics algorithm
+     * Get the algorithm used to compute network characteristics in the sandbox
+     * @param {module:api/NetworkCharacteristicsApi~getNetCharAlgorithmCallback} callback The callback function, accepting three arguments: error, data, response
+     * data is of type: {@link module:model/NetCharAlgorithm}
+     */
+    this.getNetCharAlgorithm = function(callback) {
+      var postBody = null;
+
+
+      var pathParams = {
+      };
+      var queryParams = {
+      };
+      var collectionQueryParams = {
+      };
+      var headerParams = {
+      };
+      var formParams = {
+      };
+
+      var authNames = ['bearerAuth'];
+      var contentTypes = ['application/json'];
+      var accepts = ['application/json'];
+      var returnType = NetCharAlgorithm;
+
+      return this.apiClient.callApi(
+        '/netchar/algorithm', 'GET',
+        pathParams, queryParams, collectionQueryParams, headerParams, formParams, postBody,
+        authNames, contentTypes, accepts, returnType, callback
+      );
+    }
+
+    /**
+     * Callback function to receive the result of the setNetCharAlgorithm operation.
+     * @callback module:api/NetworkCharacteristicsApi~setNetCharAlgorithmCallback
+     * @param {String} error Error message, if any.
+     * @param data This operation does not return a value.
+     * @param {String} response The complete HTTP response.
+     */
+
+    /**
+     * Set network characteristics algorithm
+     * Select the algorithm used to compute network characteristics in the sandbox
+     * @param {module:model/NetCharAlgorithm} algorithm Network characteristics algorithm
+     * @param {module:api/NetworkCharacteristicsApi~setNetCharAlgorithmCallback} callback The callback function, accepting three arguments: error, data, response
+     */
+    this.setNetCharAlgorithm = function(algorithm, callback) {
+      var postBody = algorithm;
+
+      // verify the required parameter 'algorithm' is set
+      if (algorithm === undefined || algorithm === null) {
+        throw new Error("Missing the required parameter 'algorithm' when calling setNetCharAlgorithm");
+      }
+
+
+      var pathParams = {
+      };
+      var queryParams = {
+      };
+      var collectionQueryParams = {
+      };
+      var headerParams = {
+      };
+      var formParams = {
+      };
+
+      var authNames = ['bearerAuth'];
+      var contentTypes = ['application/json'];
+      var accepts = ['application/json'];
+      var returnType = null;
+
+      return this.apiClient.callApi(
+        '/netchar/algorithm', 'PUT',
+        pathParams, queryParams, collectionQueryParams, headerParams, formParams, postBody,
+        authNames, contentTypes, accepts, returnType, callback
+      );
+    }
+  };
+
+  return exports;
+}));
@@ -31,12 +31,12 @@
 (function(factory) {
   if (typeof define === 'function' && define.amd) {
     // AMD. Register as an anonymous module.
-    define(['ApiClient', 'model/ActivationInfo', 'model/CellularDomainConfig', 'model/CellularPoaConfig', 'model/Deployment', 'model/Domain', 'model/EgressService', 'model/Event', 'model/EventMobility', 'model/EventNetworkCharacteristicsUpdate', 'model/EventPoasInRange', 'model/EventScenarioUpdate', 'model/ExternalConfig', 'model/GeoData', 'model/GpuConfig', 'model/IngressService', 'model/LineString', 'model/NetworkCharacteristics', 'model/NetworkLocation', 'model/NodeDataUnion', 'model/NodeServiceMaps', 'model/PhysicalLocation', 'model/Point', 'model/Process', 'model/Replay', 'model/ReplayEvent', 'model/ReplayFileList', 'model/ReplayInfo', 'model/ReplayStatus', 'model/Scenario', 'model/ScenarioConfig', 'model/ScenarioNode', 'model/ServiceConfig', 'model/ServicePort', 'model/Zone', 'api/ActiveScenarioApi', 'api/EventReplayApi', 'api/EventsApi'], factory);
+    define(['ApiClient', 'model/ActivationInfo', 'model/CellularDomainConfig', 'model/CellularPoaConfig', 'model/Deployment', 'model/Domain', 'model/EgressService', 'model/Event', 'model/EventMobility', 'model/EventNetworkCharacteristicsUpdate', 'model/EventPoasInRange', 'model/EventScenarioUpdate', 'model/ExternalConfig', 'model/GeoData', 'model/GpuConfig', 'model/IngressService', 'model/LineString', 'model/NetCharAlgorithm', 'model/NetworkCharacteristics', 'model/NetworkLocation', 'model/NodeDataUnion', 'model/NodeServiceMaps', 'model/PhysicalLocation', 'model/Point', 'model/Process', 'model/Replay', 'model/ReplayEvent', 'model/ReplayFileList', 'model/ReplayInfo', 'model/ReplayStatus', 'model/Scenario', 'model/ScenarioConfig', 'model/ScenarioNode', 'model/ServiceConfig', 'model/ServicePort', 'model/Zone', 'api/ActiveScenarioApi', 'api/EventReplayApi', 'api/EventsApi', 'api/NetworkCharacteristicsApi'], factory);
   } else if (typeof module === 'object' && module.exports) {
     // CommonJS-like environments that support module.exports, like Node.
-    module.exports = factory(require('./ApiClient'), require('./model/ActivationInfo'), require('./model/CellularDomainConfig'), require('./model/CellularPoaConfig'), require('./model/Deployment'), require('./model/Domain'), require('./model/EgressService'), require('./model/Event'), require('./model/EventMobility'), require('./model/EventNetworkCharacteristicsUpdate'), require('./model/EventPoasInRange'), require('./model/EventScenarioUpdate'), require('./model/ExternalConfig'), require('./model/GeoData'), require('./model/GpuConfig'), require('./model/IngressService'), require('./model/LineString'), require('./model/NetworkCharacteristics'), require('./model/NetworkLocation'), require('./model/NodeDataUnion'), require('./model/NodeServiceMaps'), require('./model/PhysicalLocation'), require('./model/Point'), require('./model/Process'), require('./model/Replay'), require('./model/ReplayEvent'), require('./model/ReplayFileList'), require('./model/ReplayInfo'), require('./model/ReplayStatus'), require('./model/Scenario'), require('./model/ScenarioConfig'), require('./model/ScenarioNode'), require('./model/ServiceConfig'), require('./model/ServicePort'), require('./model/Zone'), require('./api/ActiveScenarioApi'), require('./api/EventReplayApi'), require('./api/EventsApi'));
+    module.exports = factory(require('./ApiClient'), require('./model/ActivationInfo'), require('./model/CellularDomainConfig'), require('./model/CellularPoaConfig'), require('./model/Deployment'), require('./model/Domain'), require('./model/EgressService'), require('./model/Event'), require('./model/EventMobility'), require('./model/EventNetworkCharacteristicsUpdate'), require('./model/EventPoasInRange'), require('./model/EventScenarioUpdate'), require('./model/ExternalConfig'), require('./model/GeoData'), require('./model/GpuConfig'), require('./model/IngressService'), require('./model/LineString'), require('./model/NetCharAlgorithm'), require('./model/NetworkCharacteristics'), require('./model/NetworkLocation'), require('./model/NodeDataUnion'), require('./model/NodeServiceMaps'), require('./model/PhysicalLocation'), require('./model/Point'), require('./model/Process'), require('./model/Replay'), require('./model/ReplayEvent'), require('./model/ReplayFileList'), require('./model/ReplayInfo'), require('./model/ReplayStatus'), require('./model/Scenario'), require('./model/ScenarioConfig'), require('./model/ScenarioNode'), require('./model/ServiceConfig'), require('./model/ServicePort'), require('./model/Zone'), require('./api/ActiveScenarioApi'), require('./api/EventReplayApi'), require('./api/EventsApi'), require('./api/NetworkCharacteristicsApi'));
   }
-}(function(ApiClient, ActivationInfo, CellularDomainConfig, CellularPoaConfig, Deployment, Domain, EgressService, Event, EventMobility, EventNetworkCharacteristicsUpdate, EventPoasInRange, EventScenarioUpdate, ExternalConfig, GeoData, GpuConfig, IngressService, LineString, NetworkCharacteristics, NetworkLocation, NodeDataUnion, NodeServiceMaps, PhysicalLocation, Point, Process, Replay, ReplayEvent, ReplayFileList, ReplayInfo, ReplayStatus, Scenario, ScenarioConfig, ScenarioNode, ServiceConfig, ServicePort, Zone, ActiveScenarioApi, EventReplayApi, EventsApi) {
+}(function(ApiClient, ActivationInfo, CellularDomainConfig, CellularPoaConfig, Deployment, Domain, EgressService, Event, EventMobility, EventNetworkCharacteristicsUpdate, EventPoasInRange, EventScenarioUpdate, ExternalConfig, GeoData, GpuConfig, IngressService, LineString, NetCharAlgorithm, NetworkCharacteristics, NetworkLocation, NodeDataUnion, NodeServiceMaps, PhysicalLocation, Point, Process, Replay, ReplayEvent, ReplayFileList, ReplayInfo, ReplayStatus, Scenario, ScenarioConfig, ScenarioNode, ServiceConfig, ServicePort, Zone, ActiveScenarioApi, EventReplayApi, EventsApi, NetworkCharacteristicsApi) {
   'use strict';
 
   /**
@@ -156,6 +156,11 @@
      * @property {module:model/LineString}
      */
     LineString: LineString,
+    /**
+     * The NetCharAlgorithm model constructor.
+     * @property {module:model/NetCharAlgorithm}
+     */
+    NetCharAlgorithm: NetCharAlgorithm,
     /**
      * The NetworkCharacteristics model constructor.
      * @property {module:model/NetworkCharacteristics}
@@ -260,7 +265,12 @@
      * The EventsApi service constructor.
      * @property {module:api/EventsApi}
      */
-    EventsApi: EventsApi
+    EventsApi: EventsApi,
+    /**
+     * The NetworkCharacteristicsApi service constructor.
+     * @property {module:api/NetworkCharacteristicsApi}
+     */
+    NetworkCharacteristicsApi: NetworkCharacteristicsApi
   };
 
   return exports;
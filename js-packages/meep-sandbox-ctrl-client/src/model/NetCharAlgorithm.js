@@ -0,0 +1,107 @@
+/*
+ * Copyright (c) 2020  InterDigital Communications, Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the \"License\");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an \"AS IS\" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * AdvantEDGE Sandbox Controller REST API
+ * This API is the main Sandbox Controller API for scenario deployment & event injection <p>**Micro-service**<br>[meep-sandbox-ctrl](https://github.com/InterDigitalInc/AdvantEDGE/tree/master/go-apps/meep-sandbox-ctrl) <p>**Type & Usage**<br>Platform runtime interface to manage active scenarios and inject events in AdvantEDGE platform <p>**Details**<br>API details available at _your-AdvantEDGE-ip-address/api_
+ *
+ * OpenAPI spec version: 1.0.0
+ * Contact: AdvantEDGE@InterDigital.com
+ *
+ * NOTE: This class is auto generated by the swagger code generator program.
+ * https://github.com/swagger-api/swagger-codegen.git
+ *
+ * Swagger Codegen version: 2.4.9
+ *
+ * Do not edit the class manually.
+ *
+ */
+
+(function(root, factory) {
+  if (typeof define === 'function' && define.amd) {
+    // AMD. Register as an anonymous module.
+    define(['ApiClient'], factory);
+  } else if (typeof module === 'object' && module.exports) {
+    // CommonJS-like environments that support module.exports, like Node.
+    module.exports = factory(require('../ApiClient'));
+  } else {
+    // Browser globals (root is window)
+    if (!root.AdvantEdgeSandboxControllerRestApi) {
+      root.AdvantEdgeSandboxControllerRestApi = {};
+    }
+    root.AdvantEdgeSandboxControllerRestApi.NetCharAlgorithm = factory(root.AdvantEdgeSandboxControllerRestApi.ApiClient);
+  }
+}(this, function(ApiClient) {
+  'use strict';
+
+  /**
+   * The NetCharAlgorithm model module.
+   * @module model/NetCharAlgorithm
+   * @version 1.0.0
+   */
+
+  /**
+   * Constructs a new <code>NetCharAlgorithm</code>.
+   * Network characteristics algorithm
+   * @alias module:model/NetCharAlgorithm
+   * @class
+   */
+  var exports = function() {
+  };
+
+  /**
+   * Constructs a <code>NetCharAlgorithm</code> from a plain JavaScript object, optionally creating a new instance.
+   * Copies all relevant properties from <code>data</code> to <code>obj</code> if supplied or a new instance if not.
+   * @param {Object} data The plain JavaScript object bearing properties of interest.
+   * @param {module:model/NetCharAlgorithm} obj Optional instance to populate.
+   * @return {module:model/NetCharAlgorithm} The populated <code>NetCharAlgorithm</code> instance.
+   */
+  exports.constructFromObject = function(data, obj) {
+    if (data) {
+      obj = obj || new exports();
+      if (data.hasOwnProperty('algorithm'))
+        obj.algorithm = ApiClient.convertToType(data['algorithm'], 'String');
+    }
+    return obj;
+  }
+
+  /**
+   * Algorithm used to compute network characteristics in the sandbox: <li>SEGMENT: bandwidth shared per segment based on measured flow activity (default) <li>MAX-MIN-FAIR: closed-form max-min fair share of segment bandwidth over flow paths
+   * @member {module:model/NetCharAlgorithm.AlgorithmEnum} algorithm
+   */
+  exports.prototype.algorithm = undefined;
+
+
+  /**
+   * Allowed values for the <code>algorithm</code> property.
+   * @enum {String}
+   * @readonly
+   */
+  exports.AlgorithmEnum = {
+    /**
+     * value: "SEGMENT"
+     * @const
+     */
+    SEGMENT: "SEGMENT",
+
+    /**
+     * value: "MAX-MIN-FAIR"
+     * @const
+     */
+    MAX_MIN_FAIR: "MAX-MIN-FAIR"
+  };
+
+  return exports;
+
+}));
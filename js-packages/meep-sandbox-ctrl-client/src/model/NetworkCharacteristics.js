@@ -84,6 +84,24 @@
         obj.throughputUl = ApiClient.convertToType(data['throughputUl'], 'Number');
       if (data.hasOwnProperty('packetLoss'))
         obj.packetLoss = ApiClient.convertToType(data['packetLoss'], 'Number');
+      if (data.hasOwnProperty('packetLossCorrelation'))
+        obj.packetLossCorrelation = ApiClient.convertToType(data['packetLossCorrelation'], 'Number');
+      if (data.hasOwnProperty('packetLossModel'))
+        obj.packetLossModel = ApiClient.convertToType(data['packetLossModel'], 'String');
+      if (data.hasOwnProperty('burstLossGoodToBad'))
+        obj.burstLossGoodToBad = ApiClient.convertToType(data['burstLossGoodToBad'], 'Number');
+      if (data.hasOwnProperty('burstLossBadToGood'))
+        obj.burstLossBadToGood = ApiClient.convertToType(data['burstLossBadToGood'], 'Number');
+      if (data.hasOwnProperty('burstLossBad'))
+        obj.burstLossBad = ApiClient.convertToType(data['burstLossBad'], 'Number');
+      if (data.hasOwnProperty('burstLossGood'))
+        obj.burstLossGood = ApiClient.convertToType(data['burstLossGood'], 'Number');
+      if (data.hasOwnProperty('packetDuplication'))
+        obj.packetDuplication = ApiClient.convertToType(data['packetDuplication'], 'Number');
+      if (data.hasOwnProperty('packetCorruption'))
+        obj.packetCorruption = ApiClient.convertToType(data['packetCorruption'], 'Number');
+      if (data.hasOwnProperty('packetReordering'))
+        obj.packetReordering = ApiClient.convertToType(data['packetReordering'], 'Number');
     }
     return obj;
   }
@@ -130,6 +148,60 @@
    */
   exports.prototype.packetLoss = undefined;
 
+  /**
+   * Packet loss correlation percentage. Applies to the 'Random' packet loss model only
+   * @member {Number} packetLossCorrelation
+   */
+  exports.prototype.packetLossCorrelation = undefined;
+
+  /**
+   * Packet loss model. 'Random' applies packetLoss independently to each packet. 'Gilbert-Elliott' applies burst loss using the burstLoss* state transition and loss percentages, ignoring packetLoss. Default value is 'Random' model.
+   * @member {module:model/NetworkCharacteristics.PacketLossModelEnum} packetLossModel
+   */
+  exports.prototype.packetLossModel = undefined;
+
+  /**
+   * Gilbert-Elliott burst loss model: probability percentage of transitioning from the good state to the bad state
+   * @member {Number} burstLossGoodToBad
+   */
+  exports.prototype.burstLossGoodToBad = undefined;
+
+  /**
+   * Gilbert-Elliott burst loss model: probability percentage of transitioning from the bad state to the good state
+   * @member {Number} burstLossBadToGood
+   */
+  exports.prototype.burstLossBadToGood = undefined;
+
+  /**
+   * Gilbert-Elliott burst loss model: packet loss percentage in the bad state
+   * @member {Number} burstLossBad
+   */
+  exports.prototype.burstLossBad = undefined;
+
+  /**
+   * Gilbert-Elliott burst loss model: packet loss percentage in the good state
+   * @member {Number} burstLossGood
+   */
+  exports.prototype.burstLossGood = undefined;
+
+  /**
+   * Packet duplication percentage
+   * @member {Number} packetDuplication
+   */
+  exports.prototype.packetDuplication = undefined;
+
+  /**
+   * Packet corruption percentage
+   * @member {Number} packetCorruption
+   */
+  exports.prototype.packetCorruption = undefined;
+
+  /**
+   * Packet reordering percentage. Reordered packets are sent immediately while other packets are delayed; requires a non-zero latency
+   * @member {Number} packetReordering
+   */
+  exports.prototype.packetReordering = undefined;
+
 
   /**
    * Allowed values for the <code>latencyDistribution</code> property.
@@ -162,6 +234,26 @@
     uniform: "Uniform"
   };
 
+
+  /**
+   * Allowed values for the <code>packetLossModel</code> property.
+   * @enum {String}
+   * @readonly
+   */
+  exports.PacketLossModelEnum = {
+    /**
+     * value: "Random"
+     * @const
+     */
+    random: "Random",
+
+    /**
+     * value: "Gilbert-Elliott"
+     * @const
+     */
+    gilbertElliott: "Gilbert-Elliott"
+  };
+
   return exports;
 
 }));